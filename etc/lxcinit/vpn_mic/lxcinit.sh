@@ -53,7 +53,7 @@ fix_mic_name() {
 do_init() {
     local BEL_USER="beluganos"
     local FRR_USER="frr"
-    local SERVICES="beluganos.service nlad.service ribcd.service ribsd.service ribpd.service beluganos.target gobgpd.service cfgd.service netplan-ext.service vrf.service"
+    local SERVICES="beluganos.service nlad.service ribcd.service ribsd.service ribpd.service beluganos.target gobgpd.service cfgbgpbfd.service cfgd.service netplan-ext.service vrf.service"
 
     # add user and create directory for beluganos.
     adduser --system --no-create-home --group ${BEL_USER}
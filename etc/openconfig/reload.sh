@@ -32,10 +32,15 @@ MODULES=(
     beluganos-interfaces
     beluganos-if-ip
     beluganos-if-ethernet
+    beluganos-if-aggregate
+    beluganos-if-bridge
+    beluganos-bfd
     beluganos-mpls-ldp
     beluganos-mpls
     beluganos-bgp
+    beluganos-segment-routing
     beluganos-ospfv2
+    beluganos-isis
     beluganos-network-instance
     beluganos-bgp-policy
 )
@@ -0,0 +1,116 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cfgrpcapi
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	HEALTH_SERVICE_ALL     = ""
+	HEALTH_SERVICE_FRR     = "frr"
+	HEALTH_SERVICE_GOBGPD  = "gobgpd"
+	HEALTH_SERVICE_RIBXD   = "ribxd"
+	HEALTH_SERVICE_NETPLAN = "netplan"
+)
+
+//
+// HealthClient
+//
+func NewInsecureHealthClient(host string, port uint, opts ...grpc.DialOption) (healthpb.HealthClient, *grpc.ClientConn, error) {
+	target := DialTarget(host, port)
+	opts = append(opts, grpc.WithInsecure())
+	conn, err := grpc.Dial(target, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return healthpb.NewHealthClient(conn), conn, nil
+}
+
+func HealthCheck(client healthpb.HealthClient, service string, timeout time.Duration) (healthpb.HealthCheckResponse_ServingStatus, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	res, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		return healthpb.HealthCheckResponse_UNKNOWN, err
+	}
+
+	return res.Status, nil
+}
+
+func (c *Command) healthCheck(name string, service string, timeout time.Duration) (healthpb.HealthCheckResponse_ServingStatus, error) {
+	host, err := c.resolveHost(name)
+	if err != nil {
+		return healthpb.HealthCheckResponse_UNKNOWN, err
+	}
+
+	client, conn, err := NewInsecureHealthClient(host, c.port)
+	if err != nil {
+		return healthpb.HealthCheckResponse_UNKNOWN, err
+	}
+	defer conn.Close()
+
+	return HealthCheck(client, service, timeout)
+}
+
+//
+// HealthCheck returns serving status of service on the host.
+//
+func (c *Command) HealthCheck(service string, timeout time.Duration) (healthpb.HealthCheckResponse_ServingStatus, error) {
+	if c.verbose {
+		log.SetLevel(log.DebugLevel)
+	}
+
+	return c.healthCheck(c.host, service, timeout)
+}
+
+//
+// WaitReady polls the health service of the host until service is
+// serving or timeout expires. The host name is resolved on every
+// retry because the container may not have its address yet.
+//
+func (c *Command) WaitReady(service string, timeout time.Duration, interval time.Duration) error {
+	if c.verbose {
+		log.SetLevel(log.DebugLevel)
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		status, err := c.healthCheck(c.host, service, interval)
+		if err == nil && status == healthpb.HealthCheckResponse_SERVING {
+			log.Debugf("WaitReady: %s '%s' %s", c.host, service, status)
+			return nil
+		}
+
+		log.Debugf("WaitReady: %s '%s' %s %v", c.host, service, status, err)
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("WaitReady: timeout. %s '%s' %s %v", c.host, service, status, err)
+		}
+
+		time.Sleep(interval)
+	}
+}
@@ -21,12 +21,21 @@ import (
 	"bytes"
 	"fmt"
 	nclib "netconf/lib"
+	"os"
+	"path"
 	"strings"
 
 	"google.golang.org/grpc"
 )
 
-const LISTEN_PORT = 50081
+const (
+	LISTEN_PORT = 50081
+
+	UNIX_TARGET_PREFIX = "unix://"
+	UNIX_SOCKET_PATH   = "/run/cfgd.sock" // path in container.
+	LOCAL_HOST         = "localhost"
+	UNIX_DIR_ENV       = "NC_CFGD_UNIX_DIR"
+)
 
 func NewShell(cmd string, args ...string) *Shell {
 	return NewShellIn(cmd, []byte{}, args...)
@@ -74,11 +83,37 @@ func NewExecuteReply(results ...*Result) *ExecuteReply {
 	}
 }
 
+//
+// Target
+//
+func IsUnixTarget(host string) bool {
+	return strings.HasPrefix(host, UNIX_TARGET_PREFIX)
+}
+
+func UnixTarget(path string) string {
+	return fmt.Sprintf("%s%s", UNIX_TARGET_PREFIX, path)
+}
+
+func UnixSocketPath(dir string, name string) string {
+	return path.Join(dir, fmt.Sprintf("%s.sock", name))
+}
+
+func GetUnixDirFromEnv() string {
+	return os.Getenv(UNIX_DIR_ENV)
+}
+
+func DialTarget(host string, port uint) string {
+	if IsUnixTarget(host) {
+		return host
+	}
+	return fmt.Sprintf("%s:%d", host, port)
+}
+
 //
 // ApiClient
 //
 func NewInsecureClient(host string, port uint, opts ...grpc.DialOption) (RpcApiClient, *grpc.ClientConn, error) {
-	target := fmt.Sprintf("%s:%d", host, port)
+	target := DialTarget(host, port)
 	opts = append(opts, grpc.WithInsecure())
 	conn, err := grpc.Dial(target, opts...)
 	if err != nil {
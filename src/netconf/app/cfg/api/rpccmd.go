@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net"
 	lxdlib "netconf/lib/lxd"
+	"os"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -47,19 +48,26 @@ type Command struct {
 	verbose bool
 	dns     bool
 	mngif   string
+	unixdir string
+	err     error
 }
 
 func (c *Command) SetFlags(cmd *cobra.Command) *cobra.Command {
-	cmd.PersistentFlags().StringVarP(&c.host, "host", "H", "localhost", "Host Name")
+	cmd.PersistentFlags().StringVarP(&c.host, "host", "H", LOCAL_HOST, "Host Name")
 	cmd.PersistentFlags().UintVarP(&c.port, "port", "P", LISTEN_PORT, "Host port")
 	cmd.PersistentFlags().BoolVarP(&c.dns, "dns", "", false, "resolve host by dns.")
 	cmd.PersistentFlags().StringVarP(&c.mngif, "mngif", "", "eth0", "Management interface on Container.")
+	cmd.PersistentFlags().StringVarP(&c.unixdir, "unix-dir", "", GetUnixDirFromEnv(), "connect to <unix-dir>/<host>.sock.")
 	cmd.PersistentFlags().BoolVarP(&c.verbose, "verbose", "v", false, "Host port")
 
 	return cmd
 }
 
 func (c *Command) Client() (RpcApiClient, *grpc.ClientConn, error) {
+	if c.err != nil {
+		return nil, nil, c.err
+	}
+
 	return NewInsecureClient(c.host, c.port)
 }
 
@@ -68,16 +76,52 @@ func (c *Command) Init() {
 		log.SetLevel(log.DebugLevel)
 	}
 
-	if !c.dns {
-		ip, err := resolveName(c.host, c.mngif)
-		if err != nil {
-			log.Errorf("resolveName error. %s %s %s", c.host, c.mngif, err)
-			return
+	host, err := c.resolveHost(c.host)
+	if err != nil {
+		log.Errorf("resolveName error. %s %s %s", c.host, c.mngif, err)
+		c.err = err
+		return
+	}
+
+	c.host = host
+}
+
+func (c *Command) resolveHost(name string) (string, error) {
+	if IsUnixTarget(name) {
+		return name, nil
+	}
+
+	// cfgd in the container listens on the local unix socket.
+	if name == LOCAL_HOST {
+		if _, err := os.Stat(UNIX_SOCKET_PATH); err == nil {
+			return UnixTarget(UNIX_SOCKET_PATH), nil
 		}
+	}
+
+	if len(c.unixdir) != 0 {
+		// cfgd in the container does not listen on tcp,
+		// so it never falls back to tcp.
+		path := UnixSocketPath(c.unixdir, name)
+		if _, err := os.Stat(path); err != nil {
+			return "", fmt.Errorf("%s not found. %s", path, err)
+		}
+
+		target := UnixTarget(path)
+		log.Debugf("%s resolved as %s", name, target)
+		return target, nil
+	}
 
-		log.Debugf("%s/%s resolved as %s", c.host, c.mngif, ip)
-		c.host = ip.String()
+	if c.dns {
+		return name, nil
 	}
+
+	ip, err := resolveName(name, c.mngif)
+	if err != nil {
+		return "", err
+	}
+
+	log.Debugf("%s/%s resolved as %s", name, c.mngif, ip)
+	return ip.String(), nil
 }
 
 func resolveName(name string, ifname string) (net.IP, error) {
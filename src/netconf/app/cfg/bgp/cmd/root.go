@@ -41,6 +41,7 @@ func RootCmd(name string) *cobra.Command {
 
 	rootCmd.AddCommand(
 		ConfigCmd(),
+		BfdCmd(),
 	)
 
 	return rootCmd
@@ -0,0 +1,44 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package cfgbgplib
+
+import (
+	api "netconf/app/cfg/api"
+
+	"golang.org/x/net/context"
+)
+
+const (
+	GOBGP_BFD_PATH = "/etc/frr/gobgpd-bfd.peers"
+)
+
+//
+// SetBfdPeersRun adds (or deletes) the neighbors monitored by cfgbgpbfd.
+//
+func SetBfdPeersRun(negate bool, path string, addrs []string, client api.RpcApiClient) (*api.ExecuteReply, error) {
+	cmd := "bfd-set"
+	if negate {
+		cmd = "bfd-del"
+	}
+
+	params := append([]string{"-bfd", path, "-cmd", cmd}, addrs...)
+	shell := api.NewShell("cfgbgp", params...)
+	req := api.NewExecuteRequest(shell)
+	return client.Execute(context.Background(), req)
+}
@@ -21,21 +21,62 @@ import (
 	"flag"
 	"fmt"
 	api "netconf/app/cfg/api"
+	"strings"
+	"time"
+)
+
+const (
+	DEFAULT_HEALTH_INTERVAL = 2 * time.Second
+	DEFAULT_FRR_UNITS       = "frr"
+	DEFAULT_GOBGPD_UNITS    = "gobgpd"
+	DEFAULT_RIBXD_UNITS     = "nlad,ribpd"
+	DEFAULT_NETPLAN_UNITS   = "netplan-ext"
 )
 
 type Args struct {
-	Host    string
-	Port    uint
-	Verbose bool
+	Host           string
+	Port           uint
+	Unix           string
+	Verbose        bool
+	HealthInterval time.Duration
+	FrrUnits       string
+	GoBgpdUnits    string
+	RibxdUnits     string
+	NetplanUnits   string
 }
 
 func (a *Args) Init() {
 	flag.StringVar(&a.Host, "listen", "0.0.0.0", "listen address.")
-	flag.UintVar(&a.Port, "port", api.LISTEN_PORT, "port number.")
+	flag.UintVar(&a.Port, "port", api.LISTEN_PORT, "port number.(0: disable tcp)")
+	flag.StringVar(&a.Unix, "unix", api.UNIX_SOCKET_PATH, "unix socket path.(empty: disable unix)")
 	flag.BoolVar(&a.Verbose, "verbose", false, "show detail message.")
+	flag.DurationVar(&a.HealthInterval, "health-interval", DEFAULT_HEALTH_INTERVAL, "health check interval.")
+	flag.StringVar(&a.FrrUnits, "frr-units", DEFAULT_FRR_UNITS, "systemd units of frr.(comma separated)")
+	flag.StringVar(&a.GoBgpdUnits, "gobgpd-units", DEFAULT_GOBGPD_UNITS, "systemd units of gobgpd.(comma separated)")
+	flag.StringVar(&a.RibxdUnits, "ribxd-units", DEFAULT_RIBXD_UNITS, "systemd units of ribxd.(comma separated)")
+	flag.StringVar(&a.NetplanUnits, "netplan-units", DEFAULT_NETPLAN_UNITS, "systemd units of netplan.(comma separated)")
 	flag.Parse()
 }
 
 func (a *Args) ListenAddr() string {
 	return fmt.Sprintf("%s:%d", a.Host, a.Port)
 }
+
+func splitUnits(units string) []string {
+	ss := []string{}
+	for _, unit := range strings.Split(units, ",") {
+		if unit = strings.TrimSpace(unit); len(unit) != 0 {
+			ss = append(ss, unit)
+		}
+	}
+	return ss
+}
+
+func (a *Args) HealthServices() []*HealthService {
+	return []*HealthService{
+		NewHealthService(api.HEALTH_SERVICE_FRR, splitUnits(a.FrrUnits)...),
+		NewHealthService(api.HEALTH_SERVICE_GOBGPD, splitUnits(a.GoBgpdUnits)...),
+		NewHealthService(api.HEALTH_SERVICE_RIBXD, splitUnits(a.RibxdUnits)...),
+		NewHealthService(api.HEALTH_SERVICE_NETPLAN, splitUnits(a.NetplanUnits)...),
+	}
+}
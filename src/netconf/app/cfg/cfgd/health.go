@@ -0,0 +1,140 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	api "netconf/app/cfg/api"
+	nclib "netconf/lib"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+//
+// HealthService
+//
+type HealthService struct {
+	Name  string
+	Units []string
+}
+
+func NewHealthService(name string, units ...string) *HealthService {
+	return &HealthService{
+		Name:  name,
+		Units: units,
+	}
+}
+
+func (s *HealthService) String() string {
+	return fmt.Sprintf("HealthService{%s, units=%v}", s.Name, s.Units)
+}
+
+//
+// IsActive returns true if all units are active.
+//
+func (s *HealthService) IsActive() bool {
+	for _, unit := range s.Units {
+		out, err := nclib.NewShell("systemctl", "is-active", unit).Exec()
+		if err != nil {
+			log.Debugf("Health: %s %s %s", s.Name, unit, strings.TrimSpace(string(out)))
+			return false
+		}
+	}
+
+	return true
+}
+
+//
+// HealthServer
+//
+type HealthServer struct {
+	*health.Server
+	services []*HealthService
+	interval time.Duration
+}
+
+func NewHealthServer(interval time.Duration, services ...*HealthService) *HealthServer {
+	s := &HealthServer{
+		Server:   health.NewServer(),
+		services: services,
+		interval: interval,
+	}
+
+	s.setStatus(api.HEALTH_SERVICE_ALL, false)
+	for _, service := range services {
+		s.setStatus(service.Name, false)
+	}
+
+	return s
+}
+
+func RegisterHealthServer(s *grpc.Server, srv *HealthServer) {
+	healthpb.RegisterHealthServer(s, srv)
+}
+
+func (s *HealthServer) setStatus(name string, active bool) {
+	status := func() healthpb.HealthCheckResponse_ServingStatus {
+		if active {
+			return healthpb.HealthCheckResponse_SERVING
+		}
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}()
+
+	s.SetServingStatus(name, status)
+}
+
+func (s *HealthServer) Update() {
+	all := true
+	for _, service := range s.services {
+		active := service.IsActive()
+		s.setStatus(service.Name, active)
+		all = all && active
+	}
+
+	s.setStatus(api.HEALTH_SERVICE_ALL, all)
+}
+
+func (s *HealthServer) Start(done <-chan struct{}) {
+	go s.Serve(done)
+}
+
+func (s *HealthServer) Serve(done <-chan struct{}) {
+	log.Debugf("HealthServer: START interval=%s %v", s.interval, s.services)
+
+	s.Update()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.Update()
+
+		case <-done:
+			log.Debugf("HealthServer: EXIT")
+			s.Shutdown()
+			return
+		}
+	}
+}
@@ -35,9 +35,32 @@ func main() {
 		log.SetLevel(log.DebugLevel)
 	}
 
-	lis, err := net.Listen("tcp", arg.ListenAddr())
-	if err != nil {
-		log.Fatalf("failed to listen: %s %v", arg.ListenAddr(), err)
+	listeners := []net.Listener{}
+
+	if arg.Port != 0 {
+		lis, err := net.Listen("tcp", arg.ListenAddr())
+		if err != nil {
+			log.Fatalf("failed to listen: %s %v", arg.ListenAddr(), err)
+			os.Exit(1)
+		}
+		listeners = append(listeners, lis)
+	}
+
+	if len(arg.Unix) != 0 {
+		if err := os.Remove(arg.Unix); err != nil && !os.IsNotExist(err) {
+			log.Warnf("failed to remove: %s %v", arg.Unix, err)
+		}
+
+		lis, err := net.Listen("unix", arg.Unix)
+		if err != nil {
+			log.Fatalf("failed to listen: %s %v", arg.Unix, err)
+			os.Exit(1)
+		}
+		listeners = append(listeners, lis)
+	}
+
+	if len(listeners) == 0 {
+		log.Fatalf("no listener. -port or -unix is required.")
 		os.Exit(1)
 	}
 
@@ -46,9 +69,22 @@ func main() {
 		log.Debugf("SIGNAL: %s", sig)
 	}).Start(nil)
 
+	hs := NewHealthServer(arg.HealthInterval, arg.HealthServices()...)
+	hs.Start(nil)
+
 	g := grpc.NewServer()
 	RegisterRpcApiServer(g, NewRpcApiServer())
-	if err := g.Serve(lis); err != nil {
+	RegisterHealthServer(g, hs)
+
+	errCh := make(chan error, len(listeners))
+	for _, lis := range listeners {
+		log.Debugf("listen: %s %s", lis.Addr().Network(), lis.Addr())
+		go func(lis net.Listener) {
+			errCh <- g.Serve(lis)
+		}(lis)
+	}
+
+	if err := <-errCh; err != nil {
 		log.Errorf("grpc server error. %s", err)
 		os.Exit(1)
 	}
@@ -22,6 +22,8 @@ import (
 	"flag"
 	"io"
 	ncgobgp "netconf/lib/gobgp"
+	ncproplib "netconf/lib/property"
+	vtylib "netconf/lib/vty"
 	"os"
 
 	log "github.com/sirupsen/logrus"
@@ -31,10 +33,12 @@ const (
 	GOBGP_CONF_PATH  = "/etc/frr/gobgpd.conf"
 	GOBGP_CONF_TYPE  = "toml"
 	GOBGP_CONF_STDIN = "-"
+	GOBGP_BFD_PATH   = "/etc/frr/gobgpd-bfd.peers"
 )
 
 type Args struct {
 	Path    string
+	BfdPath string
 	Input   string
 	Output  string
 	Type    string
@@ -44,10 +48,11 @@ type Args struct {
 
 func (a *Args) Parse() {
 	flag.StringVar(&a.Path, "c", GOBGP_CONF_PATH, "config file name")
+	flag.StringVar(&a.BfdPath, "bfd", GOBGP_BFD_PATH, "bfd peers file name")
 	flag.StringVar(&a.Input, "i", GOBGP_CONF_STDIN, "input file name")
 	flag.StringVar(&a.Output, "o", "", "output file name")
 	flag.StringVar(&a.Type, "type", GOBGP_CONF_TYPE, "config file type")
-	flag.StringVar(&a.Cmd, "cmd", "show", "command.(show/add/del)")
+	flag.StringVar(&a.Cmd, "cmd", "show", "command.(show/add/del/bfd-set/bfd-del)")
 	flag.BoolVar(&a.Verbose, "v", false, "show detail message.")
 	flag.Parse()
 }
@@ -126,6 +131,29 @@ func writeConfig(cfg *ncgobgp.Config, path string) {
 	log.Debugf("WriteConig ok.")
 }
 
+func setBfdPeers(path string, addrs []string, add bool) {
+	peers := vtylib.NewBfdPeerConfig()
+	if err := ncproplib.ReadFile(path, peers); err != nil && !os.IsNotExist(err) {
+		log.Errorf("ReadFile error. %s", err)
+		os.Exit(1)
+	}
+
+	for _, addr := range addrs {
+		if add {
+			peers.Set(addr, "yes")
+		} else {
+			delete(peers, addr)
+		}
+	}
+
+	if err := ncproplib.WriteFile(path, peers); err != nil {
+		log.Errorf("WriteFile error. %s", err)
+		os.Exit(1)
+	}
+
+	log.Debugf("bfd peers updated. %v", peers)
+}
+
 func showUsage() {
 	flag.PrintDefaults()
 	os.Exit(2)
@@ -139,6 +167,15 @@ func main() {
 		log.SetLevel(log.DebugLevel)
 	}
 
+	switch args.Cmd {
+	case "bfd-set":
+		setBfdPeers(args.BfdPath, flag.Args(), true)
+		return
+	case "bfd-del":
+		setBfdPeers(args.BfdPath, flag.Args(), false)
+		return
+	}
+
 	cfg, err := ncgobgp.ReadConfigFile(args.Path, args.Type)
 	if err != nil {
 		log.Errorf("ReadConfigFile error %s", err)
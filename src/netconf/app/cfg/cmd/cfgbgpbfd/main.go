@@ -0,0 +1,121 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package main
+
+//
+// cfgbgpbfd disables the gobgp neighbor when the bfd peer of bfdd goes down,
+// and enables it again when the bfd peer comes up.
+//
+
+import (
+	"flag"
+	prop "netconf/lib/property"
+	vtylib "netconf/lib/vty"
+	"os"
+	"os/exec"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	GOBGP_BFD_PATH = "/etc/frr/gobgpd-bfd.peers"
+	BFD_INTERVAL   = 100 * time.Millisecond
+	VTYSH_PATH     = "vtysh"
+	GOBGP_PATH     = "gobgp"
+)
+
+type Args struct {
+	Path     string
+	Interval time.Duration
+	Vtysh    string
+	Gobgp    string
+	Verbose  bool
+}
+
+func (a *Args) Parse() {
+	flag.StringVar(&a.Path, "peers", GOBGP_BFD_PATH, "bfd peers filename")
+	flag.DurationVar(&a.Interval, "interval", BFD_INTERVAL, "polling interval")
+	flag.StringVar(&a.Vtysh, "vtysh", VTYSH_PATH, "vtysh command")
+	flag.StringVar(&a.Gobgp, "gobgp", GOBGP_PATH, "gobgp command")
+	flag.BoolVar(&a.Verbose, "v", false, "show detail message")
+	flag.Parse()
+}
+
+func readBfdPeers(path string) (vtylib.BfdPeerConfig, error) {
+	peers := vtylib.NewBfdPeerConfig()
+	if err := prop.ReadFile(path, peers); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	return peers, nil
+}
+
+func showBfdPeers(vtysh string) (map[string]string, error) {
+	out, err := exec.Command(vtysh, "-c", "show bfd peers json").Output()
+	if err != nil {
+		return nil, err
+	}
+	return vtylib.ParseBfdPeers(out)
+}
+
+func setNeighbor(gobgp string, addr string, oper string) {
+	if out, err := exec.Command(gobgp, "neighbor", addr, oper).CombinedOutput(); err != nil {
+		log.Errorf("gobgp neighbor %s %s error. %s %s", addr, oper, err, out)
+		return
+	}
+
+	log.Infof("gobgp neighbor %s %s.", addr, oper)
+}
+
+func main() {
+	args := Args{}
+	args.Parse()
+
+	if args.Verbose {
+		log.SetLevel(log.DebugLevel)
+	}
+
+	tracker := vtylib.NewBfdPeerTracker()
+	ticker := time.NewTicker(args.Interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		peers, err := readBfdPeers(args.Path)
+		if err != nil {
+			log.Errorf("readBfdPeers error. %s", err)
+			continue
+		}
+
+		statuses := map[string]string{}
+		if len(peers) != 0 {
+			if statuses, err = showBfdPeers(args.Vtysh); err != nil {
+				log.Debugf("showBfdPeers error. %s", err)
+				continue
+			}
+		}
+
+		downs, ups := tracker.Update(peers, statuses)
+		for _, addr := range downs {
+			setNeighbor(args.Gobgp, addr, "disable")
+		}
+		for _, addr := range ups {
+			setNeighbor(args.Gobgp, addr, "enable")
+		}
+	}
+}
@@ -0,0 +1,218 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+//
+// cfgevpn creates the vxlan devices of the EVPN instances in evpn.conf,
+// originates the EVPN routes of the local VTEP and macs by gobgp,
+// and programs the fdb of the vxlan devices from the EVPN routes of the remote VTEPs.
+// The address of the local VTEP is the router-id of gobgp.
+//
+
+import (
+	"flag"
+	ncevpnlib "netconf/lib/evpn"
+	prop "netconf/lib/property"
+	ncsignal "netconf/lib/signal"
+	"os"
+	"os/exec"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	EVPN_CONF_PATH = "/etc/evpn.conf"
+	EVPN_INTERVAL  = 1 * time.Second
+	GOBGP_PATH     = "gobgp"
+	IP_PATH        = "ip"
+	BRIDGE_PATH    = "bridge"
+)
+
+type Args struct {
+	Path     string
+	Interval time.Duration
+	Gobgp    string
+	Verbose  bool
+}
+
+func (a *Args) Parse() {
+	flag.StringVar(&a.Path, "c", EVPN_CONF_PATH, "evpn config filename")
+	flag.DurationVar(&a.Interval, "interval", EVPN_INTERVAL, "update interval")
+	flag.StringVar(&a.Gobgp, "gobgp", GOBGP_PATH, "gobgp command")
+	flag.BoolVar(&a.Verbose, "v", false, "show detail message")
+	flag.Parse()
+}
+
+type Server struct {
+	*Args
+	devs  ncevpnlib.Commands
+	paths ncevpnlib.Commands
+	fdbs  ncevpnlib.Commands
+}
+
+func NewServer(args *Args) *Server {
+	return &Server{
+		Args:  args,
+		devs:  ncevpnlib.NewCommands(),
+		paths: ncevpnlib.NewCommands(),
+		fdbs:  ncevpnlib.NewCommands(),
+	}
+}
+
+func runCommand(cmd string, args []string) ([]byte, error) {
+	out, err := exec.Command(cmd, args...).CombinedOutput()
+	if err != nil {
+		log.Errorf("%s %v error. %s %s", cmd, args, err, out)
+		return nil, err
+	}
+
+	log.Debugf("%s %v", cmd, args)
+	return out, nil
+}
+
+//
+// apply runs the commands of the difference from cur to next,
+// and returns the entries which are applied successfully.
+//
+func apply(cmd string, cur, next ncevpnlib.Commands) (ncevpnlib.Commands, []string) {
+	applied := ncevpnlib.NewCommands()
+	for key, c := range next {
+		applied[key] = c
+	}
+
+	dels, adds := cur.Diff(next)
+	for _, key := range dels {
+		for _, args := range cur[key].Del {
+			runCommand(cmd, args)
+		}
+	}
+
+	for _, key := range adds {
+		for _, args := range next[key].Add {
+			if _, err := runCommand(cmd, args); err != nil {
+				for _, args := range next[key].Del {
+					runCommand(cmd, args)
+				}
+				delete(applied, key)
+				break
+			}
+		}
+	}
+
+	return applied, append(dels, adds...)
+}
+
+func (s *Server) Update() {
+	cfg := ncevpnlib.NewConfig()
+	if err := prop.ReadFile(s.Path, cfg); err != nil && !os.IsNotExist(err) {
+		log.Errorf("ReadFile error. %s", err)
+		return
+	}
+
+	out, err := exec.Command(s.Gobgp, "global").Output()
+	if err != nil {
+		log.Debugf("gobgp global error. %s", err)
+		return
+	}
+
+	vtep := ncevpnlib.ParseRouterId(out)
+	if len(vtep) == 0 {
+		log.Debugf("router-id not found.")
+		return
+	}
+
+	devs, changed := apply(IP_PATH, s.devs, cfg.Devices(vtep))
+	for _, dev := range changed {
+		s.fdbs.DeleteDevice(dev)
+	}
+	s.devs = devs
+
+	macs := map[string][]string{}
+	for evi, inst := range cfg {
+		if out, err := exec.Command(BRIDGE_PATH, "fdb", "show", "br", inst.Bridge).Output(); err == nil {
+			macs[evi] = ncevpnlib.ParseLocalMacs(out)
+		}
+	}
+	s.paths, _ = apply(s.Gobgp, s.paths, cfg.LocalPaths(vtep, s.devs, macs))
+
+	out, err = exec.Command(s.Gobgp, "global", "rib", "-a", "evpn").Output()
+	if err != nil {
+		log.Debugf("gobgp global rib error. %s", err)
+		return
+	}
+	routes := ncevpnlib.ParseRoutes(out)
+	s.fdbs, _ = apply(BRIDGE_PATH, s.fdbs, cfg.RemoteFdbs(vtep, s.devs, routes))
+}
+
+//
+// Clear withdraws the routes and deletes the vxlan devices with the fdb.
+//
+func (s *Server) Clear() {
+	s.paths, _ = apply(s.Gobgp, s.paths, ncevpnlib.NewCommands())
+	s.devs, _ = apply(IP_PATH, s.devs, ncevpnlib.NewCommands())
+	s.fdbs = ncevpnlib.NewCommands()
+}
+
+func main() {
+	args := Args{}
+	args.Parse()
+
+	if args.Verbose {
+		log.SetLevel(log.DebugLevel)
+	}
+
+	reloadCh := make(chan struct{}, 1)
+	stopCh := make(chan struct{}, 1)
+	done := make(chan struct{})
+	defer close(done)
+
+	notify := func(ch chan struct{}) ncsignal.SignalFunc {
+		return func(os.Signal) {
+			select {
+			case ch <- struct{}{}:
+			default:
+			}
+		}
+	}
+
+	ncsignal.NewServer().
+		Register(syscall.SIGHUP, notify(reloadCh)).
+		Register(syscall.SIGTERM, notify(stopCh)).
+		Register(syscall.SIGINT, notify(stopCh)).
+		Start(done)
+
+	s := NewServer(&args)
+	ticker := time.NewTicker(args.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.Update()
+
+		select {
+		case <-ticker.C:
+		case <-reloadCh:
+			log.Infof("reload %s", args.Path)
+		case <-stopCh:
+			s.Clear()
+			log.Infof("stopped.")
+			return
+		}
+	}
+}
@@ -20,25 +20,35 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io/ioutil"
 	prop "netconf/lib/property"
 	vtylib "netconf/lib/vty"
 	"os"
+	"strings"
 
 	log "github.com/sirupsen/logrus"
 )
 
-const DAEMONS_PATH = "/etc/frr/daemons"
+const (
+	DAEMONS_PATH    = "/etc/frr/daemons"
+	FRR_CONF_PATH   = "/etc/frr/frr.conf"
+	ROUTE_DESC_PATH = "/etc/frr/static-routes.desc"
+)
 
 type Args struct {
-	Path    string
-	Cmd     string
-	Verbose bool
-	Args    []string
+	Path     string
+	Conf     string
+	DescPath string
+	Cmd      string
+	Verbose  bool
+	Args     []string
 }
 
 func (a *Args) Parse() {
 	flag.StringVar(&a.Path, "path", DAEMONS_PATH, "daemons filename")
-	flag.StringVar(&a.Cmd, "cmd", "", "set or del")
+	flag.StringVar(&a.Conf, "conf", FRR_CONF_PATH, "frr config filename")
+	flag.StringVar(&a.DescPath, "desc", ROUTE_DESC_PATH, "static route descriptions filename")
+	flag.StringVar(&a.Cmd, "cmd", "", "set, del, desc-set, desc-del or desc-render")
 	flag.BoolVar(&a.Verbose, "v", false, "show detail message")
 	flag.Parse()
 	a.Args = flag.Args()
@@ -52,18 +62,14 @@ func main() {
 		log.SetLevel(log.DebugLevel)
 	}
 
-	cfg := vtylib.NewDaemonConfig()
-	if err := prop.ReadFile(args.Path, cfg); err != nil {
-		log.Errorf("%s", err)
-		os.Exit(1)
-	}
-
 	err := func() error {
 		switch args.Cmd {
-		case "set":
-			return setDaemons(cfg, &args)
-		case "del":
-			return delDaemons(cfg, &args)
+		case "set", "del":
+			return runDaemons(&args)
+		case "desc-set", "desc-del":
+			return runRouteDescs(&args)
+		case "desc-render":
+			return renderRouteDescs(&args)
 		default:
 			flag.PrintDefaults()
 			return fmt.Errorf("Invalid command. %s", args.Cmd)
@@ -75,12 +81,74 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err := prop.WriteFile(args.Path, cfg); err != nil {
-		log.Errorf("%s", err)
-		os.Exit(1)
+	os.Exit(0)
+}
+
+func runDaemons(args *Args) error {
+	cfg := vtylib.NewDaemonConfig()
+	if err := prop.ReadFile(args.Path, cfg); err != nil {
+		return err
 	}
 
-	os.Exit(0)
+	if args.Cmd == "set" {
+		if err := setDaemons(cfg, args); err != nil {
+			return err
+		}
+	} else {
+		if err := delDaemons(cfg, args); err != nil {
+			return err
+		}
+	}
+
+	return prop.WriteFile(args.Path, cfg)
+}
+
+//
+// runRouteDescs sets or deletes the description of the static route.
+// desc-set <prefix> <description...> / desc-del <prefix>
+//
+func runRouteDescs(args *Args) error {
+	if len(args.Args) == 0 {
+		return fmt.Errorf("prefix not specified.")
+	}
+
+	descs := vtylib.NewRouteDescConfig()
+	if err := prop.ReadFile(args.DescPath, descs); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	prefix := args.Args[0]
+	if args.Cmd == "desc-set" {
+		desc := strings.Join(args.Args[1:], " ")
+		descs[prefix] = strings.Replace(desc, "\n", " ", -1)
+		log.Debugf("Route/%s/Description = %s", prefix, desc)
+	} else {
+		delete(descs, prefix)
+		log.Debugf("Route/%s/Description DELETED", prefix)
+	}
+
+	return prop.WriteFile(args.DescPath, descs)
+}
+
+//
+// renderRouteDescs writes the descriptions of the static routes
+// to frr.conf as the comment lines.
+//
+func renderRouteDescs(args *Args) error {
+	descs := vtylib.NewRouteDescConfig()
+	if err := prop.ReadFile(args.DescPath, descs); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	data, err := ioutil.ReadFile(args.Conf)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(args.Conf, vtylib.RenderRouteDescs(data, descs), 0640)
 }
 
 func setDaemons(cfg vtylib.DaemonConfig, args *Args) error {
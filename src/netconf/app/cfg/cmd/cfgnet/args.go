@@ -21,6 +21,7 @@ import (
 	"flag"
 	"fmt"
 	"net"
+	ncnplib "netconf/lib/netplan"
 	"strings"
 )
 
@@ -32,16 +33,35 @@ const (
 const NETPLAN_CONF_PATH = "/etc/netplan/02-beluganos.yaml"
 
 type Args struct {
-	Cmd     string
-	Path    string
-	Backup  string
-	Device  string
-	Vid     uint
-	Mtu     uint
-	Addrs   Addrs
-	Slaves  Slaves
-	Verbose bool
-	Args    []string
+	Cmd         string
+	Path        string
+	Backup      string
+	Device      string
+	Vid         uint
+	Mtu         uint
+	Addrs       Addrs
+	Routes      Routes
+	Policies    RoutingPolicies
+	Macvlans    Macvlans
+	Nameservers Slaves
+	Search      Slaves
+	AcceptRA    string
+	MacAddress  string
+	Optional    string
+	Description string
+	LinkDown    bool
+	Slaves      Slaves
+	Bond        bool
+	Mode        string
+	MinLinks    uint
+	Bridge      bool
+	Stp         string
+	Priority    int
+	FwdDelay    uint
+	Hello       uint
+	MaxAge      uint
+	Verbose     bool
+	Args        []string
 }
 
 func (a *Args) Parse() error {
@@ -52,7 +72,26 @@ func (a *Args) Parse() error {
 	flag.UintVar(&a.Vid, "vid", 0, "VLAN-ID")
 	flag.UintVar(&a.Mtu, "mtu", 0, "MTU")
 	flag.Var(&a.Addrs, "a", "Interface addresses (ip/prefix-len)")
+	flag.Var(&a.Routes, "r", "Static routes (to=<prefix>[,via=<ip>][,metric=<n>][,table=<n>][,on-link=<bool>])")
+	flag.Var(&a.Policies, "rp", "Routing policy rules ([from=<prefix>][,to=<prefix>][,table=<n>][,priority=<n>][,mark=<n>][,type-of-service=<n>])")
+	flag.Var(&a.Macvlans, "macvlan", "Macvlan devices (name=<ifname>,macaddress=<mac>[,address=<prefix>...])")
+	flag.Var(&a.Nameservers, "ns", "Nameserver addresses")
+	flag.Var(&a.Search, "search", "Nameserver search domains")
+	flag.StringVar(&a.AcceptRA, "accept-ra", "", "Accept RA ('true' or 'false')")
+	flag.StringVar(&a.MacAddress, "macaddress", "", "MAC address")
+	flag.StringVar(&a.Optional, "optional", "", "Optional device ('true' or 'false')")
+	flag.StringVar(&a.Description, "description", "", "Description (written as comment)")
+	flag.BoolVar(&a.LinkDown, "link-down", false, "Keep the link down (written as comment)")
 	flag.Var(&a.Slaves, "s", "Slave interfaces")
+	flag.BoolVar(&a.Bond, "bond", false, "Bonding device")
+	flag.StringVar(&a.Mode, "mode", "", "Bonding mode")
+	flag.UintVar(&a.MinLinks, "min-links", 0, "Bonding min-links")
+	flag.BoolVar(&a.Bridge, "bridge", false, "Bridge device")
+	flag.StringVar(&a.Stp, "stp", "", "Bridge STP ('true' or 'false')")
+	flag.IntVar(&a.Priority, "priority", -1, "Bridge priority")
+	flag.UintVar(&a.FwdDelay, "forward-delay", 0, "Bridge forward-delay")
+	flag.UintVar(&a.Hello, "hello-time", 0, "Bridge hello-time")
+	flag.UintVar(&a.MaxAge, "max-age", 0, "Bridge max-age")
 	flag.BoolVar(&a.Verbose, "v", false, "show detail message")
 	flag.Parse()
 	a.Args = flag.Args()
@@ -117,3 +156,60 @@ func (n *Slaves) Set(value string) error {
 func (n Slaves) String() string {
 	return strings.Join(n, "|")
 }
+
+type Routes []*ncnplib.Route
+
+func (n *Routes) Set(value string) error {
+	r, err := ncnplib.ParseRoute(value)
+	if err != nil {
+		return err
+	}
+	*n = append(*n, r)
+	return nil
+}
+
+func (n Routes) String() string {
+	ss := make([]string, len(n))
+	for i, v := range n {
+		ss[i] = v.String()
+	}
+	return strings.Join(ss, "|")
+}
+
+type RoutingPolicies []*ncnplib.RoutingPolicy
+
+func (n *RoutingPolicies) Set(value string) error {
+	p, err := ncnplib.ParseRoutingPolicy(value)
+	if err != nil {
+		return err
+	}
+	*n = append(*n, p)
+	return nil
+}
+
+func (n RoutingPolicies) String() string {
+	ss := make([]string, len(n))
+	for i, v := range n {
+		ss[i] = v.String()
+	}
+	return strings.Join(ss, "|")
+}
+
+type Macvlans []*ncnplib.Macvlan
+
+func (n *Macvlans) Set(value string) error {
+	m, err := ncnplib.ParseMacvlan(value)
+	if err != nil {
+		return err
+	}
+	*n = append(*n, m)
+	return nil
+}
+
+func (n Macvlans) String() string {
+	ss := make([]string, len(n))
+	for i, v := range n {
+		ss[i] = v.String()
+	}
+	return strings.Join(ss, "|")
+}
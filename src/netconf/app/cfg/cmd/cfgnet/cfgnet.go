@@ -19,6 +19,7 @@ package main
 
 import (
 	ncnplib "netconf/lib/netplan"
+	"strconv"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -38,6 +39,147 @@ func deleteSlice(slice []string, strs ...string) []string {
 	return result
 }
 
+func parseBoolPtr(s string) (*bool, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+func mergeRoutes(routes []*ncnplib.Route, srcs ...*ncnplib.Route) []*ncnplib.Route {
+	for _, src := range srcs {
+		if !hasRoute(routes, src) {
+			routes = append(routes, src)
+		}
+	}
+	return routes
+}
+
+func deleteRoutes(routes []*ncnplib.Route, srcs ...*ncnplib.Route) []*ncnplib.Route {
+	result := []*ncnplib.Route{}
+	for _, route := range routes {
+		if !hasRoute(srcs, route) {
+			result = append(result, route)
+		}
+	}
+	return result
+}
+
+func hasRoute(routes []*ncnplib.Route, route *ncnplib.Route) bool {
+	for _, r := range routes {
+		if *r == *route {
+			return true
+		}
+	}
+	return false
+}
+
+func mergeRoutingPolicies(policies []*ncnplib.RoutingPolicy, srcs ...*ncnplib.RoutingPolicy) []*ncnplib.RoutingPolicy {
+	for _, src := range srcs {
+		if !hasRoutingPolicy(policies, src) {
+			policies = append(policies, src)
+		}
+	}
+	return policies
+}
+
+func deleteRoutingPolicies(policies []*ncnplib.RoutingPolicy, srcs ...*ncnplib.RoutingPolicy) []*ncnplib.RoutingPolicy {
+	result := []*ncnplib.RoutingPolicy{}
+	for _, policy := range policies {
+		if !hasRoutingPolicy(srcs, policy) {
+			result = append(result, policy)
+		}
+	}
+	return result
+}
+
+func hasRoutingPolicy(policies []*ncnplib.RoutingPolicy, policy *ncnplib.RoutingPolicy) bool {
+	for _, p := range policies {
+		if *p == *policy {
+			return true
+		}
+	}
+	return false
+}
+
+//
+// mergeMacvlans replaces the macvlan which has the same name.
+//
+func mergeMacvlans(macvlans []*ncnplib.Macvlan, srcs ...*ncnplib.Macvlan) []*ncnplib.Macvlan {
+	return append(deleteMacvlans(macvlans, srcs...), srcs...)
+}
+
+//
+// deleteMacvlans deletes the macvlan which has the same name.
+//
+func deleteMacvlans(macvlans []*ncnplib.Macvlan, srcs ...*ncnplib.Macvlan) []*ncnplib.Macvlan {
+	result := []*ncnplib.Macvlan{}
+	for _, macvlan := range macvlans {
+		if !hasMacvlan(srcs, macvlan.Name) {
+			result = append(result, macvlan)
+		}
+	}
+	return result
+}
+
+func hasMacvlan(macvlans []*ncnplib.Macvlan, name string) bool {
+	for _, m := range macvlans {
+		if m.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func newDevice(args *Args) (*ncnplib.Device, error) {
+	acceptRA, err := parseBoolPtr(args.AcceptRA)
+	if err != nil {
+		return nil, err
+	}
+
+	optional, err := parseBoolPtr(args.Optional)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ncnplib.Device{
+		Addresses:  args.Addrs.Strings(),
+		Mtu:        uint16(args.Mtu),
+		AcceptRA:   acceptRA,
+		MacAddress: args.MacAddress,
+		Optional:   optional,
+		Nameservers: ncnplib.Nameservers{
+			Search:    args.Search,
+			Addresses: args.Nameservers,
+		},
+		Routes:        args.Routes,
+		RoutingPolicy: args.Policies,
+		Description:   args.Description,
+		Macvlans:      args.Macvlans,
+		LinkDown:      args.LinkDown,
+	}, nil
+}
+
+func isEmptyDevice(device *ncnplib.Device) bool {
+	return len(device.Addresses) == 0 &&
+		device.Mtu == 0 &&
+		device.AcceptRA == nil &&
+		len(device.MacAddress) == 0 &&
+		device.Optional == nil &&
+		len(device.Nameservers.Search) == 0 &&
+		len(device.Nameservers.Addresses) == 0 &&
+		len(device.Routes) == 0 &&
+		len(device.RoutingPolicy) == 0 &&
+		len(device.Description) == 0 &&
+		len(device.Macvlans) == 0 &&
+		!device.LinkDown
+}
+
 func mergeDevice(device *ncnplib.Device, src *ncnplib.Device) {
 	for _, address := range src.Addresses {
 		device.Addresses = append(device.Addresses, address)
@@ -49,6 +191,56 @@ func mergeDevice(device *ncnplib.Device, src *ncnplib.Device) {
 		device.Mtu = mtu
 		log.Debugf("Ethernet/MTU = %d", mtu)
 	}
+
+	if acceptRA := src.AcceptRA; acceptRA != nil {
+		device.AcceptRA = acceptRA
+		log.Debugf("Ethernet/AcceptRA = %t", *acceptRA)
+	}
+
+	if macaddr := src.MacAddress; len(macaddr) != 0 {
+		device.MacAddress = macaddr
+		log.Debugf("Ethernet/MacAddress = %s", macaddr)
+	}
+
+	if optional := src.Optional; optional != nil {
+		device.Optional = optional
+		log.Debugf("Ethernet/Optional = %t", *optional)
+	}
+
+	if search := src.Nameservers.Search; len(search) != 0 {
+		device.Nameservers.Search = deleteSlice(append(device.Nameservers.Search, search...))
+		log.Debugf("Ethernet/Nameservers/Search = %s", search)
+	}
+
+	if addrs := src.Nameservers.Addresses; len(addrs) != 0 {
+		device.Nameservers.Addresses = deleteSlice(append(device.Nameservers.Addresses, addrs...))
+		log.Debugf("Ethernet/Nameservers/Addresses = %s", addrs)
+	}
+
+	for _, route := range src.Routes {
+		device.Routes = mergeRoutes(device.Routes, route)
+		log.Debugf("Ethernet/Route = %s", route)
+	}
+
+	for _, policy := range src.RoutingPolicy {
+		device.RoutingPolicy = mergeRoutingPolicies(device.RoutingPolicy, policy)
+		log.Debugf("Ethernet/RoutingPolicy = %s", policy)
+	}
+
+	if desc := src.Description; len(desc) != 0 {
+		device.Description = desc
+		log.Debugf("Ethernet/Description = %s", desc)
+	}
+
+	if macvlans := src.Macvlans; len(macvlans) != 0 {
+		device.Macvlans = mergeMacvlans(device.Macvlans, macvlans...)
+		log.Debugf("Ethernet/Macvlans = %s", macvlans)
+	}
+
+	if src.LinkDown {
+		device.LinkDown = true
+		log.Debugf("Ethernet/LinkDown = %t", src.LinkDown)
+	}
 }
 
 func mergeEthernet(ethernet *ncnplib.Ethernet, src *ncnplib.Ethernet) {
@@ -69,11 +261,153 @@ func mergeVlan(vlan *ncnplib.Vlan, src *ncnplib.Vlan) {
 	}
 }
 
+func mergeBond(bond *ncnplib.Bond, src *ncnplib.Bond) {
+	mergeDevice(&bond.Device, &src.Device)
+
+	if ifaces := src.Interfaces; len(ifaces) != 0 {
+		bond.Interfaces = deleteSlice(append(bond.Interfaces, ifaces...))
+		log.Debugf("Bond/Interfaces = %s", ifaces)
+	}
+
+	if mode := src.Params.Mode; len(mode) != 0 {
+		bond.Params.Mode = mode
+		log.Debugf("Bond/Mode = %s", mode)
+	}
+
+	if minLinks := src.Params.MinLinks; minLinks != 0 {
+		bond.Params.MinLinks = minLinks
+		log.Debugf("Bond/MinLinks = %d", minLinks)
+	}
+}
+
+func newBondParams(args *Args) (*ncnplib.BondParams, error) {
+	params := &ncnplib.BondParams{
+		MinLinks: uint32(args.MinLinks),
+	}
+
+	if len(args.Mode) != 0 {
+		mode, err := ncnplib.ParseBondMode(args.Mode)
+		if err != nil {
+			return nil, err
+		}
+		params.Mode = mode
+	}
+
+	return params, nil
+}
+
+func setBondConfig(cfg *ncnplib.Config, args *Args, device *ncnplib.Device) error {
+	ifname := args.IFName()
+	params, err := newBondParams(args)
+	if err != nil {
+		return err
+	}
+
+	src := ncnplib.NewBond(device, args.Slaves, params)
+	if bond, ok := cfg.Network.Bonds[ifname]; ok {
+		mergeBond(bond, src)
+	} else {
+		cfg.Network.Bonds[ifname] = src
+	}
+
+	// netplan requires the slave devices defined in ethernets.
+	for _, slave := range args.Slaves {
+		if _, ok := cfg.Network.Ethernets[slave]; !ok {
+			cfg.Network.Ethernets[slave] = ncnplib.NewEthernet(&ncnplib.Device{})
+			log.Debugf("Bond/Slave = %s", slave)
+		}
+	}
+
+	return nil
+}
+
+func mergeBridge(bridge *ncnplib.Bridge, src *ncnplib.Bridge) {
+	mergeDevice(&bridge.Device, &src.Device)
+
+	if ifaces := src.Interfaces; len(ifaces) != 0 {
+		bridge.Interfaces = deleteSlice(append(bridge.Interfaces, ifaces...))
+		log.Debugf("Bridge/Interfaces = %s", ifaces)
+	}
+
+	if stp := src.Params.Stp; stp != nil {
+		bridge.Params.Stp = stp
+		log.Debugf("Bridge/STP = %t", *stp)
+	}
+
+	if priority := src.Params.Priority; priority != nil {
+		bridge.Params.Priority = priority
+		log.Debugf("Bridge/Priority = %d", *priority)
+	}
+
+	if fwdDelay := src.Params.ForwardDelay; fwdDelay != 0 {
+		bridge.Params.ForwardDelay = fwdDelay
+		log.Debugf("Bridge/ForwardDelay = %d", fwdDelay)
+	}
+
+	if hello := src.Params.HelloTime; hello != 0 {
+		bridge.Params.HelloTime = hello
+		log.Debugf("Bridge/HelloTime = %d", hello)
+	}
+
+	if maxAge := src.Params.MaxAge; maxAge != 0 {
+		bridge.Params.MaxAge = maxAge
+		log.Debugf("Bridge/MaxAge = %d", maxAge)
+	}
+}
+
+func newBridgeParams(args *Args) (*ncnplib.BridgeParams, error) {
+	params := &ncnplib.BridgeParams{
+		ForwardDelay: uint32(args.FwdDelay),
+		HelloTime:    uint32(args.Hello),
+		MaxAge:       uint32(args.MaxAge),
+	}
+
+	if len(args.Stp) != 0 {
+		stp, err := strconv.ParseBool(args.Stp)
+		if err != nil {
+			return nil, err
+		}
+		params.Stp = &stp
+	}
+
+	if args.Priority >= 0 {
+		priority := uint32(args.Priority)
+		params.Priority = &priority
+	}
+
+	return params, nil
+}
+
+func setBridgeConfig(cfg *ncnplib.Config, args *Args, device *ncnplib.Device) error {
+	ifname := args.IFName()
+	params, err := newBridgeParams(args)
+	if err != nil {
+		return err
+	}
+
+	src := ncnplib.NewBridge(device, args.Slaves, params)
+	if bridge, ok := cfg.Network.Bridges[ifname]; ok {
+		mergeBridge(bridge, src)
+	} else {
+		cfg.Network.Bridges[ifname] = src
+	}
+
+	return nil
+}
+
 func setConfig(cfg *ncnplib.Config, args *Args) error {
 	ifname := args.IFName()
-	device := &ncnplib.Device{
-		Addresses: args.Addrs.Strings(),
-		Mtu:       uint16(args.Mtu),
+	device, err := newDevice(args)
+	if err != nil {
+		return err
+	}
+
+	if args.Bond && args.Vid == 0 {
+		return setBondConfig(cfg, args, device)
+	}
+
+	if args.Bridge && args.Vid == 0 {
+		return setBridgeConfig(cfg, args, device)
 	}
 
 	if vid := uint32(args.Vid); vid == 0 {
@@ -104,6 +438,56 @@ func deleteDevice(device *ncnplib.Device, src *ncnplib.Device) {
 		device.Mtu = 0
 		log.Debugf("Ethernet/MTU = %d DELETED", src.Mtu)
 	}
+
+	if src.AcceptRA != nil {
+		device.AcceptRA = nil
+		log.Debugf("Ethernet/AcceptRA = %t DELETED", *src.AcceptRA)
+	}
+
+	if len(src.MacAddress) != 0 {
+		device.MacAddress = ""
+		log.Debugf("Ethernet/MacAddress = %s DELETED", src.MacAddress)
+	}
+
+	if src.Optional != nil {
+		device.Optional = nil
+		log.Debugf("Ethernet/Optional = %t DELETED", *src.Optional)
+	}
+
+	if search := src.Nameservers.Search; len(search) != 0 {
+		device.Nameservers.Search = deleteSlice(device.Nameservers.Search, search...)
+		log.Debugf("Ethernet/Nameservers/Search = %s DELETED", search)
+	}
+
+	if addrs := src.Nameservers.Addresses; len(addrs) != 0 {
+		device.Nameservers.Addresses = deleteSlice(device.Nameservers.Addresses, addrs...)
+		log.Debugf("Ethernet/Nameservers/Addresses = %s DELETED", addrs)
+	}
+
+	if routes := src.Routes; len(routes) != 0 {
+		device.Routes = deleteRoutes(device.Routes, routes...)
+		log.Debugf("Ethernet/Routes = %s DELETED", routes)
+	}
+
+	if policies := src.RoutingPolicy; len(policies) != 0 {
+		device.RoutingPolicy = deleteRoutingPolicies(device.RoutingPolicy, policies...)
+		log.Debugf("Ethernet/RoutingPolicy = %s DELETED", policies)
+	}
+
+	if len(src.Description) != 0 {
+		device.Description = ""
+		log.Debugf("Ethernet/Description = %s DELETED", src.Description)
+	}
+
+	if macvlans := src.Macvlans; len(macvlans) != 0 {
+		device.Macvlans = deleteMacvlans(device.Macvlans, macvlans...)
+		log.Debugf("Ethernet/Macvlans = %s DELETED", macvlans)
+	}
+
+	if src.LinkDown {
+		device.LinkDown = false
+		log.Debugf("Ethernet/LinkDown = %t DELETED", src.LinkDown)
+	}
 }
 
 func deleteEthernet(ethernet *ncnplib.Ethernet, src *ncnplib.Ethernet) {
@@ -126,16 +510,128 @@ func deleteVlan(vlan *ncnplib.Vlan, src *ncnplib.Vlan) {
 	*/
 }
 
+func deleteBond(bond *ncnplib.Bond, src *ncnplib.Bond) {
+	deleteDevice(&bond.Device, &src.Device)
+
+	if ifaces := src.Interfaces; len(ifaces) != 0 {
+		bond.Interfaces = deleteSlice(bond.Interfaces, ifaces...)
+		log.Debugf("Bond/Interfaces = %s DELETED", ifaces)
+	}
+
+	if len(src.Params.Mode) != 0 {
+		bond.Params.Mode = ""
+		log.Debugf("Bond/Mode = %s DELETED", src.Params.Mode)
+	}
+
+	if src.Params.MinLinks != 0 {
+		bond.Params.MinLinks = 0
+		log.Debugf("Bond/MinLinks = %d DELETED", src.Params.MinLinks)
+	}
+}
+
+func delBondConfig(cfg *ncnplib.Config, args *Args, device *ncnplib.Device) error {
+	ifname := args.IFName()
+	bond, ok := cfg.Network.Bonds[ifname]
+	if !ok {
+		log.Warnf("%s not found.", ifname)
+		return nil
+	}
+
+	if isEmptyDevice(device) && len(args.Slaves) == 0 && len(args.Mode) == 0 && args.MinLinks == 0 {
+		// delete the slave devices added by setBondConfig.
+		for _, slave := range bond.Interfaces {
+			if ethernet, ok := cfg.Network.Ethernets[slave]; ok && isEmptyDevice(&ethernet.Device) {
+				delete(cfg.Network.Ethernets, slave)
+				log.Debugf("Bond/Slave = %s DELETED", slave)
+			}
+		}
+		delete(cfg.Network.Bonds, ifname)
+		return nil
+	}
+
+	params, err := newBondParams(args)
+	if err != nil {
+		return err
+	}
+
+	deleteBond(bond, ncnplib.NewBond(device, args.Slaves, params))
+	return nil
+}
+
+func deleteBridge(bridge *ncnplib.Bridge, src *ncnplib.Bridge) {
+	deleteDevice(&bridge.Device, &src.Device)
+
+	if ifaces := src.Interfaces; len(ifaces) != 0 {
+		bridge.Interfaces = deleteSlice(bridge.Interfaces, ifaces...)
+		log.Debugf("Bridge/Interfaces = %s DELETED", ifaces)
+	}
+
+	if src.Params.Stp != nil {
+		bridge.Params.Stp = nil
+		log.Debugf("Bridge/STP = %t DELETED", *src.Params.Stp)
+	}
+
+	if src.Params.Priority != nil {
+		bridge.Params.Priority = nil
+		log.Debugf("Bridge/Priority = %d DELETED", *src.Params.Priority)
+	}
+
+	if src.Params.ForwardDelay != 0 {
+		bridge.Params.ForwardDelay = 0
+		log.Debugf("Bridge/ForwardDelay = %d DELETED", src.Params.ForwardDelay)
+	}
+
+	if src.Params.HelloTime != 0 {
+		bridge.Params.HelloTime = 0
+		log.Debugf("Bridge/HelloTime = %d DELETED", src.Params.HelloTime)
+	}
+
+	if src.Params.MaxAge != 0 {
+		bridge.Params.MaxAge = 0
+		log.Debugf("Bridge/MaxAge = %d DELETED", src.Params.MaxAge)
+	}
+}
+
+func delBridgeConfig(cfg *ncnplib.Config, args *Args, device *ncnplib.Device) error {
+	ifname := args.IFName()
+	bridge, ok := cfg.Network.Bridges[ifname]
+	if !ok {
+		log.Warnf("%s not found.", ifname)
+		return nil
+	}
+
+	params, err := newBridgeParams(args)
+	if err != nil {
+		return err
+	}
+
+	if isEmptyDevice(device) && len(args.Slaves) == 0 && params.Stp == nil && params.Priority == nil && args.FwdDelay == 0 && args.Hello == 0 && args.MaxAge == 0 {
+		delete(cfg.Network.Bridges, ifname)
+		return nil
+	}
+
+	deleteBridge(bridge, ncnplib.NewBridge(device, args.Slaves, params))
+	return nil
+}
+
 func delConfig(cfg *ncnplib.Config, args *Args) error {
 	ifname := args.IFName()
-	device := &ncnplib.Device{
-		Addresses: args.Addrs.Strings(),
-		Mtu:       uint16(args.Mtu),
+	device, err := newDevice(args)
+	if err != nil {
+		return err
+	}
+
+	if args.Bond && args.Vid == 0 {
+		return delBondConfig(cfg, args, device)
+	}
+
+	if args.Bridge && args.Vid == 0 {
+		return delBridgeConfig(cfg, args, device)
 	}
 
 	if vid := uint32(args.Vid); vid == 0 {
 		if ethernet, ok := cfg.Network.Ethernets[ifname]; ok {
-			if len(args.Addrs) == 0 && args.Mtu == 0 {
+			if isEmptyDevice(device) {
 				delete(cfg.Network.Ethernets, ifname)
 			} else {
 				deleteEthernet(ethernet, ncnplib.NewEthernet(device))
@@ -145,7 +641,7 @@ func delConfig(cfg *ncnplib.Config, args *Args) error {
 		}
 	} else {
 		if vlan, ok := cfg.Network.Vlans[ifname]; ok {
-			if len(args.Addrs) == 0 && args.Mtu == 0 {
+			if isEmptyDevice(device) {
 				delete(cfg.Network.Vlans, ifname)
 			} else {
 				deleteVlan(vlan, ncnplib.NewVlan(device, args.Device, vid))
@@ -29,6 +29,7 @@ import (
 
 const SYSCTL_CONF_PATH = "/etc/sysctl.d/30-beluganos.conf"
 const VRFCTL_CONF_PATH = "/etc/vrf.conf"
+const EVPNCTL_CONF_PATH = "/etc/evpn.conf"
 
 type Args struct {
 	Path    string
@@ -39,9 +40,11 @@ type Args struct {
 
 func (a *Args) Parse() {
 	vrf := false
+	evpn := false
 	flag.StringVar(&a.Path, "path", "", "config filename.")
 	flag.StringVar(&a.Cmd, "cmd", "", "'set' or 'del'")
 	flag.BoolVar(&vrf, "vrf", false, "vrf configuration mode.")
+	flag.BoolVar(&evpn, "evpn", false, "evpn configuration mode.")
 	flag.BoolVar(&a.Verbose, "v", false, "show detail message.")
 	flag.Parse()
 	a.Args = flag.Args()
@@ -50,6 +53,8 @@ func (a *Args) Parse() {
 		a.Path = func() string {
 			if vrf {
 				return VRFCTL_CONF_PATH
+			} else if evpn {
+				return EVPNCTL_CONF_PATH
 			} else {
 				return SYSCTL_CONF_PATH
 			}
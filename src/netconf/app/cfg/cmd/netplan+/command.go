@@ -109,6 +109,89 @@ func (c *NpCommand) SetMtu(ifname string, device *ncnplib.Device) error {
 	return nil
 }
 
+//
+// SetMacvlans creates the macvlans on the device, which are the extension
+// of netplan. addrgenmode is random so as not to use the same ipv6 link-local
+// address on the routers which have the same macvlan (e.g. vrrp).
+//
+func (c *NpCommand) SetMacvlans(ifname string, device *ncnplib.Device) error {
+	for _, macvlan := range device.Macvlans {
+		if _, err := netlink.LinkByName(macvlan.Name); err != nil {
+			log.Debugf("[%s] Macvlan: %s add", ifname, macvlan)
+			if _, err := c.Exec("ip", "link", "add", macvlan.Name, "link", ifname, "addrgenmode", "random", "type", "macvlan", "mode", "bridge"); err != nil {
+				return err
+			}
+		}
+
+		if _, err := c.Exec("ip", "link", "set", "dev", macvlan.Name, "address", macvlan.MacAddress); err != nil {
+			return err
+		}
+
+		for _, address := range macvlan.Addresses {
+			if _, err := c.Exec("ip", "addr", "replace", address, "dev", macvlan.Name); err != nil {
+				return err
+			}
+		}
+
+		if _, err := c.Exec("ip", "link", "set", "dev", macvlan.Name, "up"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//
+// SetLinkDown sets the link down, because netplan has no admin state
+// and netplan apply brings the link up.
+//
+func (c *NpCommand) SetLinkDown(ifname string, device *ncnplib.Device) error {
+	if !device.LinkDown {
+		return nil
+	}
+
+	link, err := netlink.LinkByName(ifname)
+	if err != nil {
+		if c.force {
+			log.Warnf("%s", err)
+			return nil
+		} else {
+			return err
+		}
+	}
+
+	log.Debugf("[%s] Link: down", ifname)
+
+	if err := netlink.LinkSetDown(link); err != nil {
+		if c.force {
+			log.Warnf("%s", err)
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//
+// SetExtensions sets the extensions of netplan to the device.
+//
+func (c *NpCommand) SetExtensions(ifname string, device *ncnplib.Device) error {
+	if err := c.SetMacvlans(ifname, device); err != nil {
+		return err
+	}
+
+	return c.SetLinkDown(ifname, device)
+}
+
+func (c *NpCommand) SetDevice(ifname string, device *ncnplib.Device) error {
+	if err := c.SetMtu(ifname, device); err != nil {
+		return err
+	}
+
+	return c.SetExtensions(ifname, device)
+}
+
 func (c *NpCommand) Init() error {
 	return c.DoInit(false) // force flag: false
 }
@@ -122,7 +205,7 @@ func (c *NpCommand) DoInit(force bool) error {
 
 	for ifname, eth := range cfg.Network.Ethernets {
 		log.Debugf("Ethernet[%s] %v", ifname, eth)
-		if err := c.SetMtu(ifname, &eth.Device); err != nil {
+		if err := c.SetDevice(ifname, &eth.Device); err != nil {
 			if !force {
 				log.Errorf("%s %s", ifname, err)
 				return err
@@ -133,7 +216,7 @@ func (c *NpCommand) DoInit(force bool) error {
 
 	for ifname, vlan := range cfg.Network.Vlans {
 		log.Debugf("VLAN[%s] %v", ifname, vlan)
-		if err := c.SetMtu(ifname, &vlan.Device); err != nil {
+		if err := c.SetDevice(ifname, &vlan.Device); err != nil {
 			if !force {
 				log.Errorf("%s %s", ifname, err)
 				return err
@@ -144,7 +227,7 @@ func (c *NpCommand) DoInit(force bool) error {
 
 	for ifname, bond := range cfg.Network.Bonds {
 		log.Debugf("BOND[%s] %v", ifname, bond)
-		if err := c.SetMtu(ifname, &bond.Device); err != nil {
+		if err := c.SetDevice(ifname, &bond.Device); err != nil {
 			if !force {
 				log.Errorf("%s %s", ifname, err)
 				return err
@@ -153,6 +236,17 @@ func (c *NpCommand) DoInit(force bool) error {
 		}
 	}
 
+	for ifname, bridge := range cfg.Network.Bridges {
+		log.Debugf("BRIDGE[%s] %v", ifname, bridge)
+		if err := c.SetExtensions(ifname, &bridge.Device); err != nil {
+			if !force {
+				log.Errorf("%s %s", ifname, err)
+				return err
+			}
+			log.Warnf("%s %s. but ignored.", ifname, err)
+		}
+	}
+
 	return nil
 }
 
@@ -18,6 +18,7 @@
 package cfglxccmd
 
 import (
+	api "netconf/app/cfg/api"
 	lib "netconf/app/cfg/lxc/lib"
 
 	"github.com/spf13/cobra"
@@ -35,6 +36,7 @@ type ContainerCommand struct {
 	dellog   bool
 	mngIf    string
 	bridgeIf string
+	unixDir  string
 }
 
 func (c *ContainerCommand) SetFlags(cmd *cobra.Command) *cobra.Command {
@@ -43,6 +45,7 @@ func (c *ContainerCommand) SetFlags(cmd *cobra.Command) *cobra.Command {
 	cmd.PersistentFlags().BoolVarP(&c.dellog, "delete-log", "", false, "delete log dir.")
 	cmd.PersistentFlags().StringVarP(&c.mngIf, "mng-if", "m", ContainerDefaultMngIfName, "management interface name.")
 	cmd.PersistentFlags().StringVarP(&c.bridgeIf, "bridge-if", "b", ContainerDefaultBridgeIfName, "bridge interface name.")
+	cmd.PersistentFlags().StringVarP(&c.unixDir, "unix-dir", "", api.GetUnixDirFromEnv(), "proxy cfgd to <unix-dir>/<name>.sock.")
 	return c.Command.SetFlags(cmd)
 }
 
@@ -61,7 +64,7 @@ func (c *ContainerCommand) Create(name string) error {
 		return lib.MakeLogDir(name)
 	}()
 
-	if err := lib.CreateContainer(client, name, c.keep, logdir, c.mngIf, c.bridgeIf); err != nil {
+	if err := lib.CreateContainer(client, name, c.keep, logdir, c.mngIf, c.bridgeIf, c.unixDir); err != nil {
 		return err
 	}
 
@@ -21,6 +21,7 @@ import (
 	"flag"
 	"fmt"
 	"io/ioutil"
+	rpcapi "netconf/app/cfg/api"
 	lxdlib "netconf/lib/lxd"
 	"os"
 	"path"
@@ -140,10 +141,17 @@ func ClearBackup(path string) error {
 	return nil
 }
 
-func CreateContainer(client *lxdlib.Client, name string, keep bool, logdir, mngIf, bridgeIf string) error {
+func CreateContainer(client *lxdlib.Client, name string, keep bool, logdir, mngIf, bridgeIf, sockdir string) error {
 	log.Debugf("CreateContainer: name='%s'", name)
 
-	if _, _, err := client.InitializeProfile(name, logdir, mngIf, bridgeIf); err != nil {
+	listen, connect := func() (string, string) {
+		if len(sockdir) == 0 {
+			return "", ""
+		}
+		return MakeSockPath(name, sockdir), fmt.Sprintf("unix:%s", rpcapi.UNIX_SOCKET_PATH)
+	}()
+
+	if _, _, err := client.InitializeProfile(name, logdir, mngIf, bridgeIf, listen, connect); err != nil {
 		log.Errorf("CreateContainer: InitializeProfile error. %s", err)
 		return err
 	}
@@ -279,6 +287,18 @@ func DeleteInterface(client *lxdlib.Client, name string, ifname string) error {
 	return nil
 }
 
+//
+// MakeSockPath creates sockdir and returns the address of proxy device
+// listening on <sockdir>/<name>.sock.
+//
+func MakeSockPath(name string, sockdir string) string {
+	if err := os.MkdirAll(sockdir, 0755); err != nil {
+		log.Warnf("MakeSockPath: mkdir error. %s", err)
+	}
+
+	return fmt.Sprintf("unix:%s", rpcapi.UnixSocketPath(sockdir, name))
+}
+
 func logDirPath(name string) string {
 	return fmt.Sprintf("%s/%s", CONTAINER_LOG_DIR, name)
 }
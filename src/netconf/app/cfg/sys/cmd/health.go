@@ -0,0 +1,93 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cfgsyscmd
+
+import (
+	api "netconf/app/cfg/api"
+	lib "netconf/app/cfg/sys/lib"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+type HealthCommand struct {
+	api.Command
+	timeout  time.Duration
+	interval time.Duration
+}
+
+func (c *HealthCommand) SetFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.PersistentFlags().DurationVarP(&c.timeout, "timeout", "t", lib.HEALTH_TIMEOUT, "timeout.")
+	return c.Command.SetFlags(cmd)
+}
+
+func (c *HealthCommand) SetWaitFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.PersistentFlags().DurationVarP(&c.interval, "interval", "i", lib.HEALTH_INTERVAL, "retry interval.")
+	return c.SetFlags(cmd)
+}
+
+func healthService(args []string) string {
+	if len(args) == 0 {
+		return api.HEALTH_SERVICE_ALL
+	}
+	return args[0]
+}
+
+func (c *HealthCommand) Check(args []string) error {
+	service := healthService(args)
+	status, err := c.HealthCheck(service, c.timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("'%s' %s", service, status)
+	return nil
+}
+
+func (c *HealthCommand) Wait(args []string) error {
+	return c.WaitReady(healthService(args), c.timeout, c.interval)
+}
+
+func HealthCmd() *cobra.Command {
+	check := HealthCommand{}
+	c := check.SetFlags(
+		&cobra.Command{
+			Use:   "health [service]",
+			Short: "Health check commands.",
+			Args:  cobra.MaximumNArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return check.Check(args)
+			},
+		},
+	)
+
+	wait := HealthCommand{}
+	c.AddCommand(wait.SetWaitFlags(
+		&cobra.Command{
+			Use:   "wait [service]",
+			Short: "Wait until service is ready.",
+			Args:  cobra.MaximumNArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return wait.Wait(args)
+			},
+		},
+	))
+
+	return c
+}
@@ -0,0 +1,110 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cfgsyscmd
+
+import (
+	api "netconf/app/cfg/api"
+	lib "netconf/app/cfg/sys/lib"
+
+	"github.com/spf13/cobra"
+)
+
+type LinkCommand struct {
+	api.Command
+}
+
+func (c *LinkCommand) SetState(ifname string, up bool) error {
+	c.Command.Init()
+
+	client, conn, err := c.Client()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	res, err := lib.LinkStateRun(ifname, up, client)
+	if err != nil {
+		return err
+	}
+
+	api.PrintReply(res)
+	return nil
+}
+
+func (c *LinkCommand) Del(ifname string) error {
+	c.Command.Init()
+
+	client, conn, err := c.Client()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	res, err := lib.LinkDelRun(ifname, client)
+	if err != nil {
+		return err
+	}
+
+	api.PrintReply(res)
+	return nil
+}
+
+func LinkCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "link",
+		Short: "Link state commands.",
+	}
+
+	up := LinkCommand{}
+	c.AddCommand(up.SetFlags(
+		&cobra.Command{
+			Use:   "up [ifname]",
+			Short: "Set link up.",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return up.SetState(args[0], true)
+			},
+		},
+	))
+
+	down := LinkCommand{}
+	c.AddCommand(down.SetFlags(
+		&cobra.Command{
+			Use:   "down [ifname]",
+			Short: "Set link down.",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return down.SetState(args[0], false)
+			},
+		},
+	))
+
+	del := LinkCommand{}
+	c.AddCommand(del.SetFlags(
+		&cobra.Command{
+			Use:   "del [ifname]",
+			Short: "Delete link.",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return del.Del(args[0])
+			},
+		},
+	))
+
+	return c
+}
@@ -28,11 +28,15 @@ import (
 
 type NetworkCommand struct {
 	api.Command
-	path   string
-	wait   time.Duration
-	negate bool
-	mtu    uint16
-	addrs  lib.NetowrkAddrs
+	path     string
+	wait     time.Duration
+	negate   bool
+	mtu      uint16
+	addrs    lib.NetowrkAddrs
+	device   lib.NetworkDeviceParams
+	mode     string
+	minLinks uint16
+	bridge   lib.NetworkBridgeParams
 }
 
 func parseVid(s string) (uint, error) {
@@ -61,10 +65,35 @@ func (c *NetworkCommand) SetFlags(cmd *cobra.Command) *cobra.Command {
 func (c *NetworkCommand) SetEthFlags(cmd *cobra.Command) *cobra.Command {
 	cmd.PersistentFlags().Uint16Var(&c.mtu, "mtu", 0, "MTU.")
 	cmd.PersistentFlags().VarP(&c.addrs, "addr", "a", "Interface address.")
+	cmd.PersistentFlags().StringArrayVarP(&c.device.Routes, "route", "r", nil, "Static route (to=<prefix>[,via=<ip>][,metric=<n>][,table=<n>][,on-link=<bool>]).")
+	cmd.PersistentFlags().StringArrayVar(&c.device.RoutingPolicies, "routing-policy", nil, "Routing policy rule ([from=<prefix>][,to=<prefix>][,table=<n>][,priority=<n>][,mark=<n>][,type-of-service=<n>]).")
+	cmd.PersistentFlags().StringArrayVar(&c.device.Nameservers, "nameserver", nil, "Nameserver address.")
+	cmd.PersistentFlags().StringArrayVar(&c.device.Search, "search", nil, "Nameserver search domain.")
+	cmd.PersistentFlags().StringVar(&c.device.AcceptRA, "accept-ra", "", "Accept RA (true or false).")
+	cmd.PersistentFlags().StringVar(&c.device.MacAddress, "macaddress", "", "MAC address.")
+	cmd.PersistentFlags().StringVar(&c.device.Optional, "optional", "", "Optional device (true or false).")
+	cmd.PersistentFlags().StringVar(&c.device.Description, "description", "", "Description.")
+	cmd.PersistentFlags().BoolVar(&c.device.LinkDown, "link-down", false, "Keep the link down.")
+	cmd.PersistentFlags().StringArrayVar(&c.device.Macvlans, "macvlan", nil, "Macvlan device (name=<ifname>,macaddress=<mac>[,address=<prefix>...]).")
 	cmd.PersistentFlags().BoolVarP(&c.negate, "negate", "n", false, "Negate command")
 	return c.SetFlags(cmd)
 }
 
+func (c *NetworkCommand) SetBondFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.PersistentFlags().StringVar(&c.mode, "mode", "", "Bonding mode.")
+	cmd.PersistentFlags().Uint16Var(&c.minLinks, "min-links", 0, "Minimum number of active links.")
+	return c.SetEthFlags(cmd)
+}
+
+func (c *NetworkCommand) SetBridgeFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.PersistentFlags().StringVar(&c.bridge.Stp, "stp", "", "Enable STP (true or false).")
+	cmd.PersistentFlags().IntVar(&c.bridge.Priority, "priority", -1, "Bridge priority.")
+	cmd.PersistentFlags().UintVar(&c.bridge.ForwardDelay, "forward-delay", 0, "Forward delay (sec).")
+	cmd.PersistentFlags().UintVar(&c.bridge.HelloTime, "hello-time", 0, "Hello time (sec).")
+	cmd.PersistentFlags().UintVar(&c.bridge.MaxAge, "max-age", 0, "Max age (sec).")
+	return c.SetEthFlags(cmd)
+}
+
 func (c *NetworkCommand) DoNetwork(device string, vid string, slaves []string) error {
 	c.Command.Init()
 
@@ -87,7 +116,59 @@ func (c *NetworkCommand) DoNetwork(device string, vid string, slaves []string) e
 		}
 	}()
 
-	res, err := lib.DoNetworkRun(cmd, device, v, uint(c.mtu), c.addrs.Strings(), client)
+	res, err := lib.DoNetworkRun(cmd, device, v, uint(c.mtu), c.addrs.Strings(), &c.device, client)
+	if err != nil {
+		return err
+	}
+
+	api.PrintReply(res)
+	return nil
+}
+
+func (c *NetworkCommand) DoBond(device string, slaves []string) error {
+	c.Command.Init()
+
+	client, conn, err := c.Client()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	cmd := func() string {
+		if c.negate {
+			return "del"
+		} else {
+			return "set"
+		}
+	}()
+
+	res, err := lib.DoBondRun(cmd, device, uint(c.mtu), c.addrs.Strings(), &c.device, slaves, c.mode, uint(c.minLinks), client)
+	if err != nil {
+		return err
+	}
+
+	api.PrintReply(res)
+	return nil
+}
+
+func (c *NetworkCommand) DoBridge(device string, members []string) error {
+	c.Command.Init()
+
+	client, conn, err := c.Client()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	cmd := func() string {
+		if c.negate {
+			return "del"
+		} else {
+			return "set"
+		}
+	}()
+
+	res, err := lib.DoBridgeRun(cmd, device, uint(c.mtu), c.addrs.Strings(), &c.device, members, &c.bridge, client)
 	if err != nil {
 		return err
 	}
@@ -187,13 +268,25 @@ func NetworkCmd() *cobra.Command {
 	))
 
 	bond := NetworkCommand{}
-	c_set.AddCommand(bond.SetEthFlags(
+	c_set.AddCommand(bond.SetBondFlags(
 		&cobra.Command{
 			Use:   "bond [device] [slave...]",
 			Short: "Bonding device configuration.",
 			Args:  cobra.MinimumNArgs(1),
 			RunE: func(cmd *cobra.Command, args []string) error {
-				return bond.DoNetwork(args[0], "", args[1:])
+				return bond.DoBond(args[0], args[1:])
+			},
+		},
+	))
+
+	bridge := NetworkCommand{}
+	c_set.AddCommand(bridge.SetBridgeFlags(
+		&cobra.Command{
+			Use:   "bridge [device] [interface...]",
+			Short: "Bridge device configuration.",
+			Args:  cobra.MinimumNArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return bridge.DoBridge(args[0], args[1:])
 			},
 		},
 	))
@@ -40,10 +40,13 @@ func RootCmd(name string) *cobra.Command {
 	rootCmd.PersistentFlags().BoolVar(&showCompletion, "show-completion", false, "Show bash-comnpletion")
 
 	rootCmd.AddCommand(
+		HealthCmd(),
 		NetworkCmd(),
+		LinkCmd(),
 		SysctlCmd(),
 		SystemdCmd(),
 		VrfCmd(),
+		EvpnCmd(),
 	)
 
 	return rootCmd
@@ -0,0 +1,43 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cfgsyslib
+
+import (
+	api "netconf/app/cfg/api"
+
+	"golang.org/x/net/context"
+)
+
+func LinkStateRun(ifname string, up bool, client api.RpcApiClient) (*api.ExecuteReply, error) {
+	state := func() string {
+		if up {
+			return "up"
+		}
+		return "down"
+	}()
+
+	shell := api.NewShell("ip", "link", "set", "dev", ifname, state)
+	req := api.NewExecuteRequest(shell)
+	return client.Execute(context.Background(), req)
+}
+
+func LinkDelRun(ifname string, client api.RpcApiClient) (*api.ExecuteReply, error) {
+	shell := api.NewShell("ip", "link", "del", "dev", ifname)
+	req := api.NewExecuteRequest(shell)
+	return client.Execute(context.Background(), req)
+}
@@ -33,7 +33,24 @@ func NetworkBackupPath(path string) string {
 	return fmt.Sprintf("%s.backup", path)
 }
 
-func makeCfgnetParams(cmd string, device string, vid uint, mtu uint, addrs []string) []string {
+//
+// NetworkDeviceParams are the optional device parameters of cfgnet.
+// Empty AcceptRA and Optional are not set.
+//
+type NetworkDeviceParams struct {
+	Routes          []string
+	RoutingPolicies []string
+	Nameservers     []string
+	Search          []string
+	AcceptRA        string
+	MacAddress      string
+	Optional        string
+	Description     string
+	Macvlans        []string
+	LinkDown        bool
+}
+
+func makeCfgnetParams(cmd string, device string, vid uint, mtu uint, addrs []string, dp *NetworkDeviceParams) []string {
 	params := []string{
 		"-device", device,
 		"-cmd", cmd,
@@ -43,22 +60,112 @@ func makeCfgnetParams(cmd string, device string, vid uint, mtu uint, addrs []str
 	for _, addr := range addrs {
 		params = append(params, "-a", addr)
 	}
+	if dp == nil {
+		return params
+	}
+
+	for _, route := range dp.Routes {
+		params = append(params, "-r", route)
+	}
+	for _, policy := range dp.RoutingPolicies {
+		params = append(params, "-rp", policy)
+	}
+	for _, ns := range dp.Nameservers {
+		params = append(params, "-ns", ns)
+	}
+	for _, search := range dp.Search {
+		params = append(params, "-search", search)
+	}
+	if len(dp.AcceptRA) != 0 {
+		params = append(params, "-accept-ra", dp.AcceptRA)
+	}
+	if len(dp.MacAddress) != 0 {
+		params = append(params, "-macaddress", dp.MacAddress)
+	}
+	if len(dp.Optional) != 0 {
+		params = append(params, "-optional", dp.Optional)
+	}
+	if len(dp.Description) != 0 {
+		params = append(params, "-description", dp.Description)
+	}
+	for _, macvlan := range dp.Macvlans {
+		params = append(params, "-macvlan", macvlan)
+	}
+	if dp.LinkDown {
+		params = append(params, "-link-down")
+	}
+	return params
+}
+
+func makeCfgnetBondParams(cmd string, device string, mtu uint, addrs []string, dp *NetworkDeviceParams, slaves []string, mode string, minLinks uint) []string {
+	params := makeCfgnetParams(cmd, device, 0, mtu, addrs, dp)
+	params = append(params, "-bond", "-min-links", fmt.Sprintf("%d", minLinks))
+	if len(mode) != 0 {
+		params = append(params, "-mode", mode)
+	}
+	for _, slave := range slaves {
+		params = append(params, "-s", slave)
+	}
+	return params
+}
+
+//
+// NetworkBridgeParams are the bridge parameters of cfgnet.
+// Priority < 0 and empty Stp are not set.
+//
+type NetworkBridgeParams struct {
+	Stp          string
+	Priority     int
+	ForwardDelay uint
+	HelloTime    uint
+	MaxAge       uint
+}
+
+func makeCfgnetBridgeParams(cmd string, device string, mtu uint, addrs []string, dp *NetworkDeviceParams, members []string, bp *NetworkBridgeParams) []string {
+	params := makeCfgnetParams(cmd, device, 0, mtu, addrs, dp)
+	params = append(params,
+		"-bridge",
+		"-priority", fmt.Sprintf("%d", bp.Priority),
+		"-forward-delay", fmt.Sprintf("%d", bp.ForwardDelay),
+		"-hello-time", fmt.Sprintf("%d", bp.HelloTime),
+		"-max-age", fmt.Sprintf("%d", bp.MaxAge),
+	)
+	if len(bp.Stp) != 0 {
+		params = append(params, "-stp", bp.Stp)
+	}
+	for _, member := range members {
+		params = append(params, "-s", member)
+	}
 	return params
 }
 
-func DoNetworkRun(cmd string, device string, vid uint, mtu uint, addrs []string, client api.RpcApiClient) (*api.ExecuteReply, error) {
-	params := makeCfgnetParams(cmd, device, vid, mtu, addrs)
+func DoNetworkRun(cmd string, device string, vid uint, mtu uint, addrs []string, dp *NetworkDeviceParams, client api.RpcApiClient) (*api.ExecuteReply, error) {
+	params := makeCfgnetParams(cmd, device, vid, mtu, addrs, dp)
+	shell := api.NewShell("cfgnet", params...)
+	req := api.NewExecuteRequest(shell)
+	return client.Execute(context.Background(), req)
+}
+
+func DoBondRun(cmd string, device string, mtu uint, addrs []string, dp *NetworkDeviceParams, slaves []string, mode string, minLinks uint, client api.RpcApiClient) (*api.ExecuteReply, error) {
+	params := makeCfgnetBondParams(cmd, device, mtu, addrs, dp, slaves, mode, minLinks)
+	shell := api.NewShell("cfgnet", params...)
+	req := api.NewExecuteRequest(shell)
+	return client.Execute(context.Background(), req)
+}
+
+func DoBridgeRun(cmd string, device string, mtu uint, addrs []string, dp *NetworkDeviceParams, members []string, bp *NetworkBridgeParams, client api.RpcApiClient) (*api.ExecuteReply, error) {
+	params := makeCfgnetBridgeParams(cmd, device, mtu, addrs, dp, members, bp)
 	shell := api.NewShell("cfgnet", params...)
 	req := api.NewExecuteRequest(shell)
 	return client.Execute(context.Background(), req)
 }
 
 func SetNetworkRun(device string, vid uint, mtu uint, addrs []string, client api.RpcApiClient) (*api.ExecuteReply, error) {
-	return DoNetworkRun("set", device, vid, mtu, addrs, client)
+	return DoNetworkRun("set", device, vid, mtu, addrs, nil, client)
 }
 
 func DelNetworkRun(device string, vid uint, mtu uint, addrs []string, client api.RpcApiClient) (*api.ExecuteReply, error) {
-	return DoNetworkRun("del", device, vid, mtu, addrs, client)
+	return DoNetworkRun("del", device, vid, mtu, addrs, nil, client)
 }
 
 func LoadNetworkRun(wait time.Duration, client api.RpcApiClient) (*api.ExecuteReply, error) {
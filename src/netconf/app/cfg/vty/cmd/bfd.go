@@ -0,0 +1,109 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cfgvtycmd
+
+import (
+	api "netconf/app/cfg/api"
+	lib "netconf/app/cfg/vty/lib"
+
+	"github.com/spf13/cobra"
+)
+
+type BfdCommand struct {
+	api.Command
+	negate bool
+}
+
+func (c *BfdCommand) SetFlags(cmd *cobra.Command) *cobra.Command {
+	c.Command.SetFlags(cmd)
+	cmd.PersistentFlags().BoolVarP(&c.negate, "negate", "n", false, "Negate command")
+	return cmd
+}
+
+func (c *BfdCommand) Profile(profile string, args []string) error {
+	c.Command.Init()
+
+	client, conn, err := c.Client()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	res, err := lib.SetBfdProfileRun(c.negate, profile, args, client)
+	if err != nil {
+		return err
+	}
+
+	api.PrintReply(res)
+	return nil
+}
+
+func (c *BfdCommand) Peer(peer string, args []string) error {
+	c.Command.Init()
+
+	client, conn, err := c.Client()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	res, err := lib.SetBfdPeerRun(c.negate, peer, args, client)
+	if err != nil {
+		return err
+	}
+
+	api.PrintReply(res)
+	return nil
+}
+
+func BfdCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "bfd",
+		Short: "BFD configuration commands.",
+	}
+
+	// bfd
+	// profile <name> [command...]
+	profile := BfdCommand{}
+	c.AddCommand(profile.SetFlags(
+		&cobra.Command{
+			Use:   "profile <name> [command...]",
+			Short: "BFD profile configuration.",
+			Args:  cobra.MinimumNArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return profile.Profile(args[0], args[1:])
+			},
+		},
+	))
+
+	// bfd
+	// peer <address> [command...]
+	peer := BfdCommand{}
+	c.AddCommand(peer.SetFlags(
+		&cobra.Command{
+			Use:   "peer <address> [command...]",
+			Short: "BFD peer configuration.",
+			Args:  cobra.MinimumNArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return peer.Peer(args[0], args[1:])
+			},
+		},
+	))
+
+	return c
+}
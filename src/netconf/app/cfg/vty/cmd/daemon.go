@@ -21,11 +21,18 @@ import (
 	api "netconf/app/cfg/api"
 	lib "netconf/app/cfg/vty/lib"
 
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
 
 type DaemonCommand struct {
 	api.Command
+	restart bool
+}
+
+func (c *DaemonCommand) SetRestartFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.PersistentFlags().BoolVarP(&c.restart, "restart", "r", false, "Restart frr if daemons file is changed.")
+	return c.Command.SetFlags(cmd)
 }
 
 func (c *DaemonCommand) Enable(args []string) error {
@@ -37,12 +44,34 @@ func (c *DaemonCommand) Enable(args []string) error {
 	}
 	defer conn.Close()
 
+	if c.restart {
+		enabled, err := lib.DaemonsEnabledRun(args, client)
+		if err != nil {
+			return err
+		}
+
+		if enabled {
+			log.Infof("daemon(s) already enabled. %v", args)
+			return nil
+		}
+	}
+
 	res, err := lib.SetDaemonRun(args, "set", client)
 	if err != nil {
 		return err
 	}
 
 	api.PrintReply(res)
+
+	if c.restart {
+		res, err := lib.RestartFrrRun(client)
+		if err != nil {
+			return err
+		}
+
+		api.PrintReply(res)
+	}
+
 	return nil
 }
 
@@ -89,7 +118,7 @@ func DaemonCmd() *cobra.Command {
 	}
 
 	enable := DaemonCommand{}
-	c.AddCommand(enable.SetFlags(
+	c.AddCommand(enable.SetRestartFlags(
 		&cobra.Command{
 			Use:   "enable [daemon...]",
 			Short: "Enable daemon(s).",
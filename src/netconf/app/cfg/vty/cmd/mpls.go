@@ -70,10 +70,28 @@ func (c *MplsCommand) AddrssFamily(ipver uint, ifname string, args []string) err
 	return nil
 }
 
+func (c *MplsCommand) Lsp(args []string) error {
+	c.Command.Init()
+
+	client, conn, err := c.Client()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	res, err := lib.SetMplsLspRun(c.negate, args, client)
+	if err != nil {
+		return err
+	}
+
+	api.PrintReply(res)
+	return nil
+}
+
 func MplsCmd() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "mpls",
-		Short: "MPLS LDP configuration commands.",
+		Short: "MPLS configuration commands.",
 	}
 
 	// mpls ldp
@@ -147,5 +165,20 @@ func MplsCmd() *cobra.Command {
 	)
 	c_ipv6.AddCommand(c_ifv6)
 
+	// mpls lsp
+	// <in-label> <nexthop> <out-label>
+	lsp := MplsCommand{}
+	c_lsp := lsp.SetFlags(
+		&cobra.Command{
+			Use:   "lsp <in-label> [nexthop out-label]",
+			Short: "MPLS static LSP configuration.",
+			Args:  cobra.MinimumNArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return lsp.Lsp(args)
+			},
+		},
+	)
+	c.AddCommand(c_lsp)
+
 	return c
 }
@@ -49,7 +49,13 @@ func RootCmd(name string) *cobra.Command {
 		IPv6Cmd(),
 		OspfCmd(),
 		Ospfv3Cmd(),
+		IsisCmd(),
 		MplsCmd(),
+		SegmentRoutingCmd(),
+		BfdCmd(),
+		VrrpCmd(),
+		RouteCmd(),
+		RouteMapCmd(),
 	)
 
 	return rootCmd
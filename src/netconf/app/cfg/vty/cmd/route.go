@@ -0,0 +1,76 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cfgvtycmd
+
+import (
+	api "netconf/app/cfg/api"
+	lib "netconf/app/cfg/vty/lib"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+type RouteCommand struct {
+	api.Command
+	negate bool
+}
+
+func (c *RouteCommand) SetFlags(cmd *cobra.Command) *cobra.Command {
+	c.Command.SetFlags(cmd)
+	cmd.PersistentFlags().BoolVarP(&c.negate, "negate", "n", false, "Negate command")
+	return cmd
+}
+
+func (c *RouteCommand) Description(prefix string, args []string) error {
+	c.Command.Init()
+
+	client, conn, err := c.Client()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	res, err := lib.SetRouteDescRun(c.negate, prefix, strings.Join(args, " "), client)
+	if err != nil {
+		return err
+	}
+
+	api.PrintReply(res)
+	return nil
+}
+
+func RouteCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "route",
+		Short: "Static route commands.",
+	}
+
+	desc := RouteCommand{}
+	c.AddCommand(desc.SetFlags(
+		&cobra.Command{
+			Use:   "description <prefix> [description...]",
+			Short: "Set description of static route (written to frr.conf as comment).",
+			Args:  cobra.MinimumNArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return desc.Description(args[0], args[1:])
+			},
+		},
+	))
+
+	return c
+}
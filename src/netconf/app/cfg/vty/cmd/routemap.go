@@ -0,0 +1,70 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cfgvtycmd
+
+import (
+	api "netconf/app/cfg/api"
+	lib "netconf/app/cfg/vty/lib"
+
+	"github.com/spf13/cobra"
+)
+
+type RouteMapCommand struct {
+	api.Command
+	negate bool
+}
+
+func (c *RouteMapCommand) SetFlags(cmd *cobra.Command) *cobra.Command {
+	c.Command.SetFlags(cmd)
+	cmd.PersistentFlags().BoolVarP(&c.negate, "negate", "n", false, "Negate command")
+	return cmd
+}
+
+func (c *RouteMapCommand) RouteMap(name string, action string, seq string, args []string) error {
+	c.Command.Init()
+
+	client, conn, err := c.Client()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	res, err := lib.SetRouteMapRun(c.negate, name, action, seq, args, client)
+	if err != nil {
+		return err
+	}
+
+	api.PrintReply(res)
+	return nil
+}
+
+func RouteMapCmd() *cobra.Command {
+	rmap := RouteMapCommand{}
+	c := rmap.SetFlags(
+		&cobra.Command{
+			Use:   "route-map <name> <permit|deny> <seq> [command...]",
+			Short: "Route-map configuration commands.",
+			Args:  cobra.MinimumNArgs(3),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return rmap.RouteMap(args[0], args[1], args[2], args[3:])
+			},
+		},
+	)
+
+	return c
+}
@@ -0,0 +1,68 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cfgvtylib
+
+import (
+	"fmt"
+	api "netconf/app/cfg/api"
+
+	"golang.org/x/net/context"
+)
+
+const (
+	CMD_BFD         = "bfd"
+	CMD_BFD_PROFILE = "profile"
+	CMD_BFD_PEER    = "peer"
+)
+
+//
+// SetBfdCmd returns the commands to configure the node (profile or peer) of bfdd.
+// The node is removed if args is empty and negate is true.
+//
+func SetBfdCmd(negate bool, node string, name string, args []string) []string {
+	neg := NegateToStr(negate)
+	cmds := []string{CMD_CONF_BEGIN, CMD_BFD}
+
+	if len(args) != 0 {
+		cmds = append(cmds,
+			fmt.Sprintf("%s %s", node, name),
+			fmt.Sprintf("%s%s", neg, joinArgs(args)),
+			CMD_EXIT,
+		)
+	} else {
+		cmds = append(cmds,
+			fmt.Sprintf("%s%s %s", neg, node, name),
+		)
+		if !negate {
+			cmds = append(cmds, CMD_EXIT)
+		}
+	}
+
+	cmds = append(cmds, CMD_EXIT, CMD_CONF_END)
+	return cmds
+}
+
+func SetBfdProfileRun(negate bool, profile string, args []string, client api.RpcApiClient) (*api.ExecuteReply, error) {
+	req := makeVtyExecuteRequest(SetBfdCmd(negate, CMD_BFD_PROFILE, profile, args))
+	return client.Execute(context.Background(), req)
+}
+
+func SetBfdPeerRun(negate bool, peer string, args []string, client api.RpcApiClient) (*api.ExecuteReply, error) {
+	req := makeVtyExecuteRequest(SetBfdCmd(negate, CMD_BFD_PEER, peer, args))
+	return client.Execute(context.Background(), req)
+}
@@ -18,8 +18,11 @@
 package cfgvtylib
 
 import (
+	"bytes"
 	"fmt"
 	api "netconf/app/cfg/api"
+	prop "netconf/lib/property"
+	vtylib "netconf/lib/vty"
 
 	"golang.org/x/net/context"
 )
@@ -54,8 +57,15 @@ func SaveConfigCmd() []string {
 	}
 }
 
+//
+// SaveConfigRun saves the running config and renders the descriptions
+// of the static routes to it, because write file drops the comments.
+//
 func SaveConfigRun(client api.RpcApiClient) (*api.ExecuteReply, error) {
-	req := makeVtyExecuteRequest(SaveConfigCmd())
+	req := api.NewExecuteRequest(
+		api.NewShell("vtysh", makeVtyArgs(SaveConfigCmd())...),
+		api.NewShell("cfgfrr", "-cmd", "desc-render"),
+	)
 	return client.Execute(context.Background(), req)
 }
 
@@ -74,6 +84,36 @@ func DaemonConfigRun(client api.RpcApiClient) (*api.ExecuteReply, error) {
 	return client.Execute(context.Background(), req)
 }
 
+//
+// DaemonsEnabledRun returns true if all of daemons are enabled in daemons file.
+//
+func DaemonsEnabledRun(names []string, client api.RpcApiClient) (bool, error) {
+	res, err := DaemonConfigRun(client)
+	if err != nil {
+		return false, err
+	}
+
+	cfg := vtylib.NewDaemonConfig()
+	for _, r := range res.Results {
+		if err := prop.Read(bytes.NewReader(r.Output), cfg); err != nil {
+			return false, err
+		}
+	}
+
+	for _, name := range names {
+		if state, ok := cfg[name]; !ok || state != vtylib.DAEMON_STATE_YES {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func RestartFrrRun(client api.RpcApiClient) (*api.ExecuteReply, error) {
+	req := makeExecuteRequest("systemctl", "restart", "frr")
+	return client.Execute(context.Background(), req)
+}
+
 func SetDaemonRun(args []string, cmd string, client api.RpcApiClient) (*api.ExecuteReply, error) {
 	params := []string{"-cmd", cmd}
 	params = append(params, args...)
@@ -42,3 +42,16 @@ func SetIPv6(negate bool, args []string, client api.RpcApiClient) (*api.ExecuteR
 	req := makeVtyExecuteRequest(SetIPCmd(negate, "ipv6", args))
 	return client.Execute(context.Background(), req)
 }
+
+//
+// SetRouteDescRun sets (or deletes) the description of the static route.
+// It is written to frr.conf when the config is saved.
+//
+func SetRouteDescRun(negate bool, prefix string, desc string, client api.RpcApiClient) (*api.ExecuteReply, error) {
+	params := []string{"-cmd", "desc-set", prefix, desc}
+	if negate {
+		params = []string{"-cmd", "desc-del", prefix}
+	}
+	req := makeExecuteRequest("cfgfrr", params...)
+	return client.Execute(context.Background(), req)
+}
@@ -0,0 +1,57 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cfgvtylib
+
+import (
+	"fmt"
+	api "netconf/app/cfg/api"
+
+	"golang.org/x/net/context"
+)
+
+const CMD_ROUTEMAP = "route-map"
+
+//
+// SetRouteMapCmd returns the commands to configure the entry of the route-map.
+// The entry is removed if args is empty and negate is true.
+//
+func SetRouteMapCmd(negate bool, name string, action string, seq string, args []string) []string {
+	neg := NegateToStr(negate)
+	rmap := joinArgs([]string{CMD_ROUTEMAP, name, action, seq})
+
+	if len(args) == 0 {
+		return []string{
+			CMD_CONF_BEGIN,
+			fmt.Sprintf("%s%s", neg, rmap),
+			CMD_CONF_END,
+		}
+	}
+
+	return []string{
+		CMD_CONF_BEGIN,
+		rmap,
+		fmt.Sprintf("%s%s", neg, joinArgs(args)),
+		CMD_EXIT,
+		CMD_CONF_END,
+	}
+}
+
+func SetRouteMapRun(negate bool, name string, action string, seq string, args []string, client api.RpcApiClient) (*api.ExecuteReply, error) {
+	req := makeVtyExecuteRequest(SetRouteMapCmd(negate, name, action, seq, args))
+	return client.Execute(context.Background(), req)
+}
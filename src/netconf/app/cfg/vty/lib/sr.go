@@ -0,0 +1,50 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cfgvtylib
+
+import (
+	"fmt"
+	api "netconf/app/cfg/api"
+
+	"golang.org/x/net/context"
+)
+
+const CMD_SEGMENT_ROUTING = "segment-routing"
+
+func SetSegmentRoutingCmd(negate bool, router string, args []string) []string {
+	neg := NegateToStr(negate)
+
+	return []string{
+		CMD_CONF_BEGIN,
+		router,
+		fmt.Sprintf("%s%s %s", neg, CMD_SEGMENT_ROUTING, joinArgs(args)),
+		CMD_EXIT,
+		CMD_CONF_END,
+	}
+}
+
+func SetOspfSegmentRoutingRun(negate bool, args []string, client api.RpcApiClient) (*api.ExecuteReply, error) {
+	req := makeVtyExecuteRequest(SetSegmentRoutingCmd(negate, CMD_OSPF_ROUTER, args))
+	return client.Execute(context.Background(), req)
+}
+
+func SetIsisSegmentRoutingRun(negate bool, tag string, args []string, client api.RpcApiClient) (*api.ExecuteReply, error) {
+	router := fmt.Sprintf("%s %s", CMD_ISIS_ROUTER, tag)
+	req := makeVtyExecuteRequest(SetSegmentRoutingCmd(negate, router, args))
+	return client.Execute(context.Background(), req)
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"time"
 
 	"github.com/BurntSushi/toml"
 )
@@ -29,18 +30,37 @@ const (
 	DEFAULT_CLI_PATH = "/usr/bin"
 	NC_HOME_ENV      = "NC_HOME"
 	DEFAULT_LXC_MTU  = 9000
+
+	DEFAULT_LXC_READY_TIMEOUT = 120 * time.Second
 )
 
 //
 // Config - Global
 //
 type GlobalConfig struct {
-	Persist bool   `toml:"persist"`
-	LxcMtu  uint16 `toml:"lxc-mtu"`
+	Persist         bool     `toml:"persist"`
+	LxcMtu          uint16   `toml:"lxc-mtu"`
+	LxcReadyTimeout Duration `toml:"lxc-ready-timeout"`
 }
 
 func (c *GlobalConfig) String() string {
-	return fmt.Sprintf("Global{persist=%t, lxc-mtu=%d}", c.Persist, c.LxcMtu)
+	return fmt.Sprintf("Global{persist=%t, lxc-mtu=%d, lxc-ready-timeout=%s}", c.Persist, c.LxcMtu, c.LxcReadyTimeout)
+}
+
+//
+// Duration
+//
+type Duration struct {
+	time.Duration
+}
+
+func (d *Duration) UnmarshalText(text []byte) error {
+	v, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	d.Duration = v
+	return nil
 }
 
 //
@@ -126,6 +146,7 @@ func GetCliPathFromEnv() string {
 func (c *Config) Load(path string) error {
 	c.Cli.Path = GetCliPathFromEnv() // set default value
 	c.Global.LxcMtu = DEFAULT_LXC_MTU
+	c.Global.LxcReadyTimeout.Duration = DEFAULT_LXC_READY_TIMEOUT
 	if _, err := toml.DecodeFile(path, c); err != nil {
 		return err
 	}
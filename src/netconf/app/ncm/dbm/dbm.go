@@ -28,8 +28,10 @@ type Tables struct {
 	session *srlib.SrSession
 	ifaces  *InterfaceTable
 	subifs  *SubinterfaceTable
+	nis     *NetworkInstanceTable
 	defs    *PolicyDefinitionTable
 	stmts   *PolicyStatementTable
+	sets    *PolicyDefinedSetsTable
 }
 
 func NewTables(session *srlib.SrSession) *Tables {
@@ -37,8 +39,10 @@ func NewTables(session *srlib.SrSession) *Tables {
 		session: session,
 		ifaces:  NewInterfaceTable(session),
 		subifs:  NewSubinterfaceTable(session),
+		nis:     NewNetworkInstanceTable(session),
 		defs:    NewPolicyDefinitionTable(session),
 		stmts:   NewPolicyStatementTable(session),
+		sets:    NewPolicyDefinedSetsTable(session),
 	}
 }
 
@@ -50,6 +54,10 @@ func (t *Tables) Subinterface() *SubinterfaceTable {
 	return t.subifs
 }
 
+func (t *Tables) NetworkInstance() *NetworkInstanceTable {
+	return t.nis
+}
+
 func (t *Tables) PolicyDefinitions() *PolicyDefinitionTable {
 	return t.defs
 }
@@ -58,6 +66,10 @@ func (t *Tables) PolicyStatements() *PolicyStatementTable {
 	return t.stmts
 }
 
+func (t *Tables) PolicyDefinedSets() *PolicyDefinedSetsTable {
+	return t.sets
+}
+
 func (t *Tables) Refresh() error {
 	return t.session.Refresh()
 }
@@ -80,6 +92,10 @@ func Subinterfaces() *SubinterfaceTable {
 	return tables.Subinterface()
 }
 
+func NetworkInstances() *NetworkInstanceTable {
+	return tables.NetworkInstance()
+}
+
 func PolicyDefinitions() *PolicyDefinitionTable {
 	return tables.PolicyDefinitions()
 }
@@ -87,3 +103,7 @@ func PolicyDefinitions() *PolicyDefinitionTable {
 func PolicyStatements() *PolicyStatementTable {
 	return tables.PolicyStatements()
 }
+
+func PolicyDefinedSets() *PolicyDefinedSetsTable {
+	return tables.PolicyDefinedSets()
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"netconf/lib/openconfig"
 	srlib "netconf/lib/sysrepo"
+	"sort"
 )
 
 //
@@ -71,3 +72,21 @@ func (t *InterfaceTable) Walk(f func(string, *openconfig.Interface)) {
 		f(name, iface)
 	}
 }
+
+//
+// SelectMembers returns the interfaces whose aggregate-id is the name.
+//
+func (t *InterfaceTable) SelectMembers(name string) []*openconfig.Interface {
+	members := []*openconfig.Interface{}
+	t.Walk(func(ifname string, iface *openconfig.Interface) {
+		if iface.Ethernet.Config.AggregateId == name {
+			members = append(members, iface)
+		}
+	})
+
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Name < members[j].Name
+	})
+
+	return members
+}
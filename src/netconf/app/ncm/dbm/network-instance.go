@@ -0,0 +1,105 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ncmdbm
+
+import (
+	"fmt"
+	"netconf/lib/openconfig"
+	srlib "netconf/lib/sysrepo"
+)
+
+//
+// NetworkInstance Table
+//
+type NetworkInstanceTable struct {
+	session *srlib.SrSession
+}
+
+func NewNetworkInstanceTable(session *srlib.SrSession) *NetworkInstanceTable {
+	return &NetworkInstanceTable{
+		session: session,
+	}
+}
+
+//
+// SelectByInterface returns the name of network-instance which the interface belongs to.
+//
+func (t *NetworkInstanceTable) SelectByInterface(ifaceId string) (string, error) {
+
+	xpath := fmt.Sprintf("/%s:%s/%s/%s/%s[%s='%s']//*",
+		openconfig.NETWORKINSTANCES_MODULE, openconfig.NETWORKINSTANCES_KEY,
+		openconfig.NETWORKINSTANCE_KEY,
+		openconfig.INTERFACES_KEY,
+		openconfig.INTERFACE_KEY, openconfig.OC_ID_KEY, ifaceId,
+	)
+
+	nis := openconfig.NewNetworkInstances()
+	for cv := range t.session.GetItems(xpath) {
+		if err := cv.Dispatch(nis, nil, nil); err != nil {
+			continue
+		}
+	}
+
+	for name, ni := range nis {
+		if _, ok := ni.Interfaces[ifaceId]; ok {
+			return name, nil
+		}
+	}
+
+	return "", fmt.Errorf("NetworkInstance not found. %s", ifaceId)
+}
+
+//
+// Select returns the network-instance.
+//
+func (t *NetworkInstanceTable) Select(name string) (*openconfig.NetworkInstance, error) {
+
+	xpath := fmt.Sprintf("/%s:%s/%s[%s='%s']//*",
+		openconfig.NETWORKINSTANCES_MODULE, openconfig.NETWORKINSTANCES_KEY,
+		openconfig.NETWORKINSTANCE_KEY, openconfig.OC_NAME_KEY, name,
+	)
+
+	nis := openconfig.NewNetworkInstances()
+	for cv := range t.session.GetItems(xpath) {
+		cv.Dispatch(nis, nil, nil)
+	}
+
+	ni, ok := nis[name]
+	if !ok {
+		return nil, fmt.Errorf("NetworkInstance not found. %s", name)
+	}
+
+	return ni, nil
+}
+
+//
+// SelectProtocol returns the protocol of the network-instance.
+//
+func (t *NetworkInstanceTable) SelectProtocol(name string, key *openconfig.NetworkInstanceProtocolKey) (*openconfig.NetworkInstanceProtocol, error) {
+	ni, err := t.Select(name)
+	if err != nil {
+		return nil, err
+	}
+
+	proto, ok := ni.Protocols[*key]
+	if !ok {
+		return nil, fmt.Errorf("Protocol not found. %s %s", name, key)
+	}
+
+	return proto, nil
+}
@@ -107,3 +107,27 @@ func (t *PolicyStatementTable) Select(defName string, stmtName string) (*opencon
 
 	return stmt, nil
 }
+
+type PolicyDefinedSetsTable struct {
+	session *srlib.SrSession
+}
+
+func NewPolicyDefinedSetsTable(session *srlib.SrSession) *PolicyDefinedSetsTable {
+	return &PolicyDefinedSetsTable{
+		session: session,
+	}
+}
+
+func (t *PolicyDefinedSetsTable) Get() *openconfig.PolicyDefinedSets {
+	xpath := fmt.Sprintf("/%s:%s/%s//*",
+		openconfig.ROUTINGPOLICY_MODULE, openconfig.ROUTINGPOLICY_KEY,
+		openconfig.POLICYDEFSETS_KEY,
+	)
+
+	rpol := openconfig.NewRoutingPolicy()
+	for cv := range t.session.GetItems(xpath) {
+		cv.Dispatch(rpol, nil, nil)
+	}
+
+	return rpol.DefinedSets
+}
@@ -0,0 +1,253 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ncm
+
+import (
+	"fmt"
+	ncmdbm "netconf/app/ncm/dbm"
+	ncnet "netconf/lib/net"
+	"netconf/lib/openconfig"
+	srlib "netconf/lib/sysrepo"
+
+	log "github.com/sirupsen/logrus"
+)
+
+//
+// IfChangeController applies the admin state and the description of
+// the interfaces to the network-instances which they belong to.
+//
+type IfChangeController struct {
+	session *srlib.SrSession
+	DryRun  bool
+}
+
+func NewIfChangeController(session *srlib.SrSession) *IfChangeController {
+	return &IfChangeController{
+		session: session,
+		DryRun:  false,
+	}
+}
+
+func (c *IfChangeController) Subscribe(flags ...srlib.SrSubscrFlag) (*srlib.Subscriber, error) {
+	return srlib.NewModuleChangeSubscriber(
+		c.session,
+		openconfig.INTERFACES_MODULE,
+		c,
+		srlib.SR_SUBSCR_DEFAULT,
+	)
+}
+
+func (c *IfChangeController) Notify(session *srlib.SrSession, module string, ev srlib.SrNotifEvent) error {
+	log.Debugf("IfChangeController module=%s ev=%s", module, ev)
+
+	if ev != srlib.SR_EV_APPLY {
+		return nil
+	}
+
+	chgset := NewInterfacesSet()
+	for cv := range session.GetChanges(fmt.Sprintf("/%s:*", module)) {
+		log.Debugf("InterfaceChange %s", cv)
+
+		if err := chgset.Unmarshall(cv); err != nil {
+			return err
+		}
+	}
+
+	if err := ncmdbm.Refresh(); err != nil {
+		return err
+	}
+
+	for _, oper := range []srlib.SrChangeOper{srlib.SR_OP_MODIFIED, srlib.SR_OP_DELETED, srlib.SR_OP_CREATED} {
+		for name, iface := range chgset[oper] {
+			h := NewIfApplyHandler(ev, oper)
+			h.SetOpt("dryrun", c.DryRun)
+
+			log.Debugf("IfChangeController BEGIN(%s/%s). %s", ev, oper, iface)
+			if err := h.Interface(name, iface); err != nil {
+				log.Errorf("IfChangeController BEGIN(%s/%s) error. %s %s", ev, oper, err, iface)
+				h.Rollback()
+				return err
+			}
+
+			if err := h.Commit(); err != nil {
+				log.Errorf("IfChangeController COMMIT(%s/%s) error. %s %s", ev, oper, err, iface)
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+type InterfacesSet map[srlib.SrChangeOper]openconfig.Interfaces
+
+func NewInterfacesSet() InterfacesSet {
+	return InterfacesSet{
+		srlib.SR_OP_CREATED:  openconfig.NewInterfaces(),
+		srlib.SR_OP_MODIFIED: openconfig.NewInterfaces(),
+		srlib.SR_OP_DELETED:  openconfig.NewInterfaces(),
+	}
+}
+
+func (s InterfacesSet) Unmarshall(cv *srlib.SrChangeVal) error {
+	return cv.Dispatch(
+		s[srlib.SR_OP_CREATED],
+		s[srlib.SR_OP_MODIFIED],
+		s[srlib.SR_OP_DELETED],
+	)
+}
+
+type IfApplyHandler struct {
+	*NIAnyHandler
+}
+
+func NewIfApplyHandler(ev srlib.SrNotifEvent, oper srlib.SrChangeOper) *IfApplyHandler {
+	return &IfApplyHandler{
+		NIAnyHandler: newNIAnyHandler(ev, oper),
+	}
+}
+
+//
+// /interfaces/interface[name]
+//
+func (h *IfApplyHandler) Interface(name string, iface *openconfig.Interface) error {
+	log.Debugf("IF/%s/%s/%s: %s", h.ev, h.oper, name, iface)
+
+	keys := []string{openconfig.OC_ENABLED_KEY, openconfig.OC_DESCRIPTION_KEY}
+	indexes := map[uint32]struct{}{}
+
+	if iface.Config.OneOfChange(keys...) {
+		indexes[0] = struct{}{}
+	}
+
+	// the admin state of the interface affects all subinterfaces.
+	if iface.Config.GetChange(openconfig.OC_ENABLED_KEY) {
+		if cur, err := ncmdbm.Interfaces().Select(name); err == nil {
+			for index, _ := range cur.Subinterfaces {
+				indexes[index] = struct{}{}
+			}
+		}
+	}
+
+	for index, subif := range iface.Subinterfaces {
+		if subif.Config.OneOfChange(keys...) {
+			indexes[index] = struct{}{}
+		}
+	}
+
+	h.Clear()
+	for index, _ := range indexes {
+		if err := h.Subinterface(name, index, iface); err != nil {
+			h.Clear()
+			return err
+		}
+	}
+
+	return h.DoCmds()
+}
+
+//
+// /interfaces/interface[name]/subinterfaces/subinterface[index]
+//
+func (h *IfApplyHandler) Subinterface(name string, index uint32, chg *openconfig.Interface) error {
+	id := ncnet.NewIFName(name, index)
+	niName, err := ncmdbm.NetworkInstances().SelectByInterface(id)
+	if err != nil {
+		log.Debugf("IF/%s/%s/%s: %s", h.ev, h.oper, id, err)
+		return nil
+	}
+
+	subif, _, err := ncmdbm.Subinterfaces().Select(name, index)
+	if err != nil {
+		return err
+	}
+
+	iface, _ := ncmdbm.Interfaces().Select(name)
+	if iface == nil {
+		iface = openconfig.NewInterface(name)
+	}
+
+	// the datastore may not be updated yet.
+	enabledChanged, descChanged, oldDesc := h.putChanges(iface, subif, chg)
+	enabled, desc := getInterfaceState(iface, subif)
+
+	log.Debugf("IF/%s/%s/%s: NI=%s enabled=%t desc='%s'", h.ev, h.oper, id, niName, enabled, desc)
+
+	if descChanged {
+		if len(desc) != 0 {
+			AddNIVtyInterfaceCmd(h, niName, id, "description", desc, true)
+			AddNINetworkDescriptionCmd(h, niName, getNetworkDevice(name, subif), desc, true)
+		} else if len(oldDesc) != 0 {
+			AddNIVtyInterfaceCmd(h, niName, id, "description", "", false)
+			AddNINetworkDescriptionCmd(h, niName, getNetworkDevice(name, subif), oldDesc, false)
+		}
+	}
+
+	if enabledChanged {
+		AddNIVtyInterfaceCmd(h, niName, id, "shutdown", "", !enabled)
+	}
+
+	if enabledChanged {
+		AddNILinkStateCmd(h, niName, getNetworkDevice(name, subif), id, enabled)
+	}
+
+	return nil
+}
+
+//
+// putChanges overwrites the interface and the subinterface by the changes,
+// and returns which of enabled and description are changed and the old description.
+//
+func (h *IfApplyHandler) putChanges(iface *openconfig.Interface, subif *openconfig.Subinterface, chg *openconfig.Interface) (bool, bool, string) {
+	// the deleted values fall back on the defaults.
+	deleted := h.oper == srlib.SR_OP_DELETED
+	changeDesc := func(cur string, val string) (string, string) {
+		if deleted {
+			return val, ""
+		}
+		return cur, val
+	}
+
+	enabledChanged, descChanged, oldDesc := false, false, ""
+
+	if config := chg.Config; config.GetChange(openconfig.OC_ENABLED_KEY) {
+		iface.Config.Enabled = config.Enabled || deleted
+		enabledChanged = true
+	}
+
+	if config := chg.Config; subif.Index == 0 {
+		if config.GetChange(openconfig.OC_DESCRIPTION_KEY) {
+			oldDesc, iface.Config.Desc = changeDesc(iface.Config.Desc, config.Desc)
+			descChanged = true
+		}
+	}
+
+	if chgSubif, ok := chg.Subinterfaces[subif.Index]; ok {
+		config := chgSubif.Config
+		if config.GetChange(openconfig.OC_ENABLED_KEY) {
+			subif.Config.Enabled = config.Enabled || deleted
+			enabledChanged = true
+		}
+		if config.GetChange(openconfig.OC_DESCRIPTION_KEY) {
+			oldDesc, subif.Config.Desc = changeDesc(subif.Config.Desc, config.Desc)
+			descChanged = true
+		}
+	}
+
+	return enabledChanged, descChanged, oldDesc
+}
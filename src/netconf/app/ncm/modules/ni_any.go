@@ -148,6 +148,11 @@ func (h *NIAnyHandler) MplsInterfaceAttrRefConfig(name string, ifaceId string, c
 	return nil
 }
 
+func (h *NIAnyHandler) MplsStaticLspPathConfig(name string, lspName string, pathName string, config *openconfig.MplsStaticLspPathConfig) error {
+	log.Debugf("NI/%s/%s/%s/LSP/%s/%s* %s", h.ev, h.oper, name, lspName, pathName, config)
+	return nil
+}
+
 func (h *NIAnyHandler) MplsLdpConfig(name string, config *openconfig.MplsLdpConfig) error {
 	log.Debugf("NI/%s/%s/%s/LDP/CONF* %s", h.ev, h.oper, name, config)
 	return nil
@@ -158,6 +163,11 @@ func (h *NIAnyHandler) MplsLdpAddressFamilyV4Config(name string, config *opencon
 	return nil
 }
 
+func (h *NIAnyHandler) MplsLdpAddressFamilyV6Config(name string, config *openconfig.MplsLdpAddressFamilyV6Config) error {
+	log.Debugf("NI/%s/%s/%s/LDP/ADDR6* %s", h.ev, h.oper, name, config)
+	return nil
+}
+
 func (h *NIAnyHandler) MplsLdpDiscovInterfacesConfig(name string, config *openconfig.MplsLdpDiscovInterfacesConfig) error {
 	log.Debugf("NI/%s/%s/%s/LDP/DISC/IFCONF* %s", h.ev, h.oper, name, config)
 	return nil
@@ -173,11 +183,51 @@ func (h *NIAnyHandler) MplsLdpInterfaceConfig(name string, ifaceId string, confi
 	return nil
 }
 
+func (h *NIAnyHandler) MplsLdpInterfaceAfConfig(name string, ifaceId string, afName string, config *openconfig.MplsLdpInterfaceAfConfig) error {
+	log.Debugf("NI/%s/%s/%s/LDP/DISC/%s/%s* %s", h.ev, h.oper, name, ifaceId, afName, config)
+	return nil
+}
+
 func (h *NIAnyHandler) MplsLdpInterfaceRefConfig(name string, ifaceId string, config *openconfig.InterfaceRefConfig) error {
 	log.Debugf("NI/%s/%s/%s/LDP/DISCC/%s/REF* %s", h.ev, h.oper, name, ifaceId, config)
 	return nil
 }
 
+func (h *NIAnyHandler) BfdInterface(name string, ifaceId string, iface *openconfig.BfdInterface) error {
+	log.Debugf("NI/%s/%s/%s/BFD/%s* %s", h.ev, h.oper, name, ifaceId, iface)
+	return nil
+}
+
+func (h *NIAnyHandler) BfdInterfaceConfig(name string, ifaceId string, config *openconfig.BfdInterfaceConfig) error {
+	log.Debugf("NI/%s/%s/%s/BFD/%s/CONF* %s", h.ev, h.oper, name, ifaceId, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BfdInterfaceRefConfig(name string, ifaceId string, config *openconfig.InterfaceRefConfig) error {
+	log.Debugf("NI/%s/%s/%s/BFD/%s/REF* %s", h.ev, h.oper, name, ifaceId, config)
+	return nil
+}
+
+func (h *NIAnyHandler) NetworkInstanceEvpn(name string, evpn *openconfig.NetworkInstanceEvpn) error {
+	log.Debugf("NI/%s/%s/%s/EVPN* %s", h.ev, h.oper, name, evpn)
+	return nil
+}
+
+func (h *NIAnyHandler) NetworkInstanceEvpnInstance(name string, evi string, inst *openconfig.EvpnInstance) error {
+	log.Debugf("NI/%s/%s/%s/EVPN/%s* %s", h.ev, h.oper, name, evi, inst)
+	return nil
+}
+
+func (h *NIAnyHandler) NetworkInstanceEvpnInstanceConfig(name string, evi string, config *openconfig.EvpnInstanceConfig) error {
+	log.Debugf("NI/%s/%s/%s/EVPN/%s/CONF* %s", h.ev, h.oper, name, evi, config)
+	return nil
+}
+
+func (h *NIAnyHandler) TableConnectionConfig(name string, key *openconfig.TableConnectionKey, config *openconfig.TableConnectionConfig) error {
+	log.Debugf("NI/%s/%s/%s/TBLCONN/%s/CONF* %s", h.ev, h.oper, name, key, config)
+	return nil
+}
+
 func (h *NIAnyHandler) NetworkInstanceProtocol(name string, key *openconfig.NetworkInstanceProtocolKey, proto *openconfig.NetworkInstanceProtocol) error {
 	log.Debugf("NI/%s/%s/%s/PROTOS/%s* %s", h.ev, h.oper, name, key, proto)
 	return nil
@@ -228,6 +278,26 @@ func (h *NIAnyHandler) Ospfv2InterfaceTimers(name string, key *openconfig.Networ
 	return nil
 }
 
+func (h *NIAnyHandler) Ospfv2RedistributionConfig(name string, key *openconfig.NetworkInstanceProtocolKey, proto string, config *openconfig.Ospfv2RedistributionConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/REDIST/%s/CONF* %s", h.ev, h.oper, name, key, proto, config)
+	return nil
+}
+
+func (h *NIAnyHandler) Ospfv2AreaRange(name string, nikey *openconfig.NetworkInstanceProtocolKey, areaId string, rngkey *openconfig.Ospfv2AreaRangeKey, rng *openconfig.Ospfv2AreaRange) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s* %s", h.ev, h.oper, name, nikey, areaId, rngkey, rng)
+	return nil
+}
+
+func (h *NIAnyHandler) Ospfv2AreaRangeConfig(name string, nikey *openconfig.NetworkInstanceProtocolKey, areaId string, rngkey *openconfig.Ospfv2AreaRangeKey, config *openconfig.Ospfv2AreaRangeConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s/CONF* %s", h.ev, h.oper, name, nikey, areaId, rngkey, config)
+	return nil
+}
+
+func (h *NIAnyHandler) Ospfv2InterfaceAuthenticationConfig(name string, key *openconfig.NetworkInstanceProtocolKey, areaId string, ifaceId string, config *openconfig.Ospfv2InterfaceAuthenticationConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s/AUTH* %s", h.ev, h.oper, name, key, areaId, ifaceId, config)
+	return nil
+}
+
 func (h *NIAnyHandler) Ospfv3(name string, nikey *openconfig.NetworkInstanceProtocolKey, ospf *openconfig.Ospfv3) error {
 	log.Debugf("NI/%s/%s/%s/PROTOS/%s* %s", h.ev, h.oper, name, nikey, ospf)
 	return nil
@@ -304,6 +374,11 @@ func (h *NIAnyHandler) StaticRouteNexthopIfaceRefConfig(name string, prkey *open
 	return nil
 }
 
+func (h *NIAnyHandler) StaticRouteNexthopEnableBfdConfig(name string, prkey *openconfig.NetworkInstanceProtocolKey, rtkey *openconfig.StaticRouteKey, index string, config *openconfig.EnableBfdConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s/BFD* %s", h.ev, h.oper, name, prkey, rtkey, index, config)
+	return nil
+}
+
 func (h *NIAnyHandler) Bgp(name string, key *openconfig.NetworkInstanceProtocolKey, bgp *openconfig.Bgp) error {
 	log.Debugf("NI/%s/%s/%s/PROTOS/%s* %s", h.ev, h.oper, name, key, bgp)
 	return nil
@@ -314,11 +389,136 @@ func (h *NIAnyHandler) BgpGlobalConfig(name string, key *openconfig.NetworkInsta
 	return nil
 }
 
+func (h *NIAnyHandler) BgpGlobalConfederationConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.BgpGlobalConfederationConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/GLOBAL/CONFED* %s", h.ev, h.oper, name, key, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpGlobalGracefulRestartConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.BgpGracefulRestartConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/GLOBAL/GR* %s", h.ev, h.oper, name, key, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpGlobalUseMultiplePathsConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.BgpUseMultiplePathsConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/GLOBAL/MULTIPATH* %s", h.ev, h.oper, name, key, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpGlobalUseMultiplePathsEbgpConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.BgpUseMultiplePathsEbgpConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/GLOBAL/MULTIPATH/EBGP* %s", h.ev, h.oper, name, key, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpGlobalUseMultiplePathsIbgpConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.BgpUseMultiplePathsIbgpConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/GLOBAL/MULTIPATH/IBGP* %s", h.ev, h.oper, name, key, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpGlobalRouteSelectionOptionsConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.BgpRouteSelectionOptionsConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/GLOBAL/ROUTESEL* %s", h.ev, h.oper, name, key, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpGlobalAfiSafi(name string, key *openconfig.NetworkInstanceProtocolKey, afiSafiName string, afiSafi *openconfig.BgpAfiSafi) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/GLOBAL/AFISAFI/%s* %s", h.ev, h.oper, name, key, afiSafiName, afiSafi)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpGlobalAfiSafiConfig(name string, key *openconfig.NetworkInstanceProtocolKey, afiSafiName string, config *openconfig.BgpAfiSafiConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/GLOBAL/AFISAFI/%s/CONF* %s", h.ev, h.oper, name, key, afiSafiName, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpGlobalDynamicNeighbor(name string, key *openconfig.NetworkInstanceProtocolKey, prefix string, neigh *openconfig.BgpDynamicNeighbor) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/GLOBAL/DYNNEIGH/%s* %s", h.ev, h.oper, name, key, prefix, neigh)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpGlobalDynamicNeighborConfig(name string, key *openconfig.NetworkInstanceProtocolKey, prefix string, config *openconfig.BgpDynamicNeighborConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/GLOBAL/DYNNEIGH/%s/CONF* %s", h.ev, h.oper, name, key, prefix, config)
+	return nil
+}
+
 func (h *NIAnyHandler) BgpZebraConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.BgpZebraConfig) error {
 	log.Debugf("NI/%s/%s/%s/PROTOS/%s/ZEBRA/CONF* %s", h.ev, h.oper, name, key, config)
 	return nil
 }
 
+func (h *NIAnyHandler) BgpPeerGroup(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, pg *openconfig.BgpPeerGroup) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/PG/%s* %s", h.ev, h.oper, name, key, pgName, pg)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpPeerGroupConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, config *openconfig.BgpPeerGroupConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/PG/%s/CONF* %s", h.ev, h.oper, name, key, pgName, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpPeerGroupTimersConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, config *openconfig.BgpNeighborTimersConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/PG/%s/TIMERS* %s", h.ev, h.oper, name, key, pgName, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpPeerGroupTransportConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, config *openconfig.BgpNeighborTransportConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/PG/%s/TRANS* %s", h.ev, h.oper, name, key, pgName, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpPeerGroupApplyPolicyConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, config *openconfig.PolicyApplyConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/PG/%s/APPLYPOL* %s", h.ev, h.oper, name, key, pgName, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpPeerGroupAfiSafi(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, AfiSafiName string, afiSafi *openconfig.BgpAfiSafi) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/PG/%s/%s* %s", h.ev, h.oper, name, key, pgName, AfiSafiName, afiSafi)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpPeerGroupAfiSafiConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, AfiSafiName string, config *openconfig.BgpAfiSafiConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/PG/%s/%s/CONF* %s", h.ev, h.oper, name, key, pgName, AfiSafiName, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpPeerGroupRouteReflectorConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, config *openconfig.BgpRouteReflectorConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/PG/%s/RR* %s", h.ev, h.oper, name, key, pgName, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpPeerGroupGracefulRestartConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, config *openconfig.BgpGracefulRestartConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/PG/%s/GR* %s", h.ev, h.oper, name, key, pgName, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpPeerGroupAddPathsConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, config *openconfig.BgpAddPathsConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/PG/%s/ADDPATHS* %s", h.ev, h.oper, name, key, pgName, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpPeerGroupEbgpMultihopConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, config *openconfig.BgpEbgpMultihopConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/PG/%s/MULTIHOP* %s", h.ev, h.oper, name, key, pgName, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpPeerGroupTtlSecurityConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, config *openconfig.BgpTtlSecurityConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/PG/%s/TTLSEC* %s", h.ev, h.oper, name, key, pgName, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpPeerGroupAfiSafiGracefulRestartConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, AfiSafiName string, config *openconfig.BgpAfiSafiGracefulRestartConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/PG/%s/%s/GR* %s", h.ev, h.oper, name, key, pgName, AfiSafiName, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpPeerGroupAfiSafiLongLivedGracefulRestartConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, AfiSafiName string, config *openconfig.BgpAfiSafiLongLivedGracefulRestartConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/PG/%s/%s/LLGR* %s", h.ev, h.oper, name, key, pgName, AfiSafiName, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpPeerGroupAfiSafiPrefixLimitConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, AfiSafiName string, config *openconfig.BgpAfiSafiPrefixLimitConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/PG/%s/%s/PREFIXLIMIT* %s", h.ev, h.oper, name, key, pgName, AfiSafiName, config)
+	return nil
+}
+
 func (h *NIAnyHandler) BgpNeighbor(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, neigh *openconfig.BgpNeighbor) error {
 	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s* %s", h.ev, h.oper, name, key, addr, neigh)
 	return nil
@@ -344,6 +544,11 @@ func (h *NIAnyHandler) BgpNeighborApplyPolicyConfig(name string, key *openconfig
 	return nil
 }
 
+func (h *NIAnyHandler) BgpNeighborEnableBfdConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, config *openconfig.EnableBfdConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/BFD* %s", h.ev, h.oper, name, key, addr, config)
+	return nil
+}
+
 func (h *NIAnyHandler) BgpNeighborAfiSafi(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, AfiSafiName string, afiSafi *openconfig.BgpAfiSafi) error {
 	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s* %s", h.ev, h.oper, name, key, addr, AfiSafiName, afiSafi)
 	return nil
@@ -353,3 +558,108 @@ func (h *NIAnyHandler) BgpNeighborAfiSafiConfig(name string, key *openconfig.Net
 	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s/CONF* %s", h.ev, h.oper, name, key, addr, AfiSafiName, config)
 	return nil
 }
+
+func (h *NIAnyHandler) BgpNeighborRouteReflectorConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, config *openconfig.BgpRouteReflectorConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/RR* %s", h.ev, h.oper, name, key, addr, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpNeighborGracefulRestartConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, config *openconfig.BgpGracefulRestartConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/GR* %s", h.ev, h.oper, name, key, addr, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpNeighborAddPathsConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, config *openconfig.BgpAddPathsConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/ADDPATHS* %s", h.ev, h.oper, name, key, addr, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpNeighborEbgpMultihopConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, config *openconfig.BgpEbgpMultihopConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/MULTIHOP* %s", h.ev, h.oper, name, key, addr, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpNeighborTtlSecurityConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, config *openconfig.BgpTtlSecurityConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/TTLSEC* %s", h.ev, h.oper, name, key, addr, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpNeighborAfiSafiGracefulRestartConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, AfiSafiName string, config *openconfig.BgpAfiSafiGracefulRestartConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s/GR* %s", h.ev, h.oper, name, key, addr, AfiSafiName, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpNeighborAfiSafiLongLivedGracefulRestartConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, AfiSafiName string, config *openconfig.BgpAfiSafiLongLivedGracefulRestartConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s/LLGR* %s", h.ev, h.oper, name, key, addr, AfiSafiName, config)
+	return nil
+}
+
+func (h *NIAnyHandler) BgpNeighborAfiSafiPrefixLimitConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, AfiSafiName string, config *openconfig.BgpAfiSafiPrefixLimitConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s/PREFIXLIMIT* %s", h.ev, h.oper, name, key, addr, AfiSafiName, config)
+	return nil
+}
+
+func (h *NIAnyHandler) Isis(name string, key *openconfig.NetworkInstanceProtocolKey, isis *openconfig.Isis) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s* %s", h.ev, h.oper, name, key, isis)
+	return nil
+}
+
+func (h *NIAnyHandler) IsisGlobalConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.IsisGlobalConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/GLOBAL/CONF* %s", h.ev, h.oper, name, key, config)
+	return nil
+}
+
+func (h *NIAnyHandler) IsisLevel(name string, key *openconfig.NetworkInstanceProtocolKey, levelNum string, level *openconfig.IsisLevel) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/LEVEL/%s* %s", h.ev, h.oper, name, key, levelNum, level)
+	return nil
+}
+
+func (h *NIAnyHandler) IsisLevelAuthenticationConfig(name string, key *openconfig.NetworkInstanceProtocolKey, levelNum string, config *openconfig.IsisAuthenticationConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/LEVEL/%s/AUTH* %s", h.ev, h.oper, name, key, levelNum, config)
+	return nil
+}
+
+func (h *NIAnyHandler) IsisInterface(name string, key *openconfig.NetworkInstanceProtocolKey, ifaceId string, iface *openconfig.IsisInterface) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s* %s", h.ev, h.oper, name, key, ifaceId, iface)
+	return nil
+}
+
+func (h *NIAnyHandler) IsisInterfaceConfig(name string, key *openconfig.NetworkInstanceProtocolKey, ifaceId string, config *openconfig.IsisInterfaceConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/CONF* %s", h.ev, h.oper, name, key, ifaceId, config)
+	return nil
+}
+
+func (h *NIAnyHandler) IsisInterfaceRefConfig(name string, key *openconfig.NetworkInstanceProtocolKey, ifaceId string, config *openconfig.InterfaceRefConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/IFREF* %s", h.ev, h.oper, name, key, ifaceId, config)
+	return nil
+}
+
+func (h *NIAnyHandler) IsisInterfaceTimersConfig(name string, key *openconfig.NetworkInstanceProtocolKey, ifaceId string, config *openconfig.IsisInterfaceTimersConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/TIMERS* %s", h.ev, h.oper, name, key, ifaceId, config)
+	return nil
+}
+
+func (h *NIAnyHandler) IsisInterfaceAfConfig(name string, key *openconfig.NetworkInstanceProtocolKey, ifaceId string, afiName string, config *openconfig.IsisInterfaceAfConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s* %s", h.ev, h.oper, name, key, ifaceId, afiName, config)
+	return nil
+}
+
+func (h *NIAnyHandler) IsisInterfaceAuthenticationConfig(name string, key *openconfig.NetworkInstanceProtocolKey, ifaceId string, config *openconfig.IsisAuthenticationConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/AUTH* %s", h.ev, h.oper, name, key, ifaceId, config)
+	return nil
+}
+
+func (h *NIAnyHandler) SegmentRoutingConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.SegmentRoutingConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/SR/CONF* %s", h.ev, h.oper, name, key, config)
+	return nil
+}
+
+func (h *NIAnyHandler) SegmentRoutingBlockConfig(name string, key *openconfig.NetworkInstanceProtocolKey, blockName string, config *openconfig.SegmentRoutingBlockConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/SR/%s* %s", h.ev, h.oper, name, key, blockName, config)
+	return nil
+}
+
+func (h *NIAnyHandler) SegmentRoutingPrefixSidConfig(name string, key *openconfig.NetworkInstanceProtocolKey, prefix string, config *openconfig.SegmentRoutingPrefixSidConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/SR/SID/%s* %s", h.ev, h.oper, name, key, prefix, config)
+	return nil
+}
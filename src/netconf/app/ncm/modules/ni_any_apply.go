@@ -22,6 +22,7 @@ import (
 	"io"
 	ncmcfg "netconf/app/ncm/cfg"
 	nclib "netconf/lib"
+	ncevpnlib "netconf/lib/evpn"
 	ncnet "netconf/lib/net"
 	"netconf/lib/openconfig"
 	ncsclib "netconf/lib/sysctl"
@@ -61,6 +62,18 @@ func AddNIContainterInitCmd(h NICommandsHandler, name string, config *openconfig
 		nil, // Undo
 		nil, // End
 	)
+
+	AddNIContainerWaitCmd(h, config.Name)
+}
+
+func AddNIContainerWaitCmd(h NICommandsHandler, name string) {
+	cmd := cliConfig().SysPath()
+	timeout := ncmcfg.GetConfig().Global.LxcReadyTimeout.String()
+	h.AddCmd(
+		nclib.NewShell(cmd, "health", "wait", "--timeout", timeout, "-H", name), // Do
+		nil, // Undo
+		nil, // End
+	)
 }
 
 func AddNIContainerInterfaceCmd(h NICommandsHandler, name string, ifname string, hwaddr string, mtu uint16, add bool) {
@@ -166,6 +179,138 @@ func AddNIInterfaceNetworkCmd(h NICommandsHandler, name string, device string, s
 	}
 }
 
+func AddNIBondNetworkCmd(h NICommandsHandler, name string, device string, subif *openconfig.Subinterface, config *openconfig.InterfaceAggregationConfig, members []*openconfig.Interface, add bool) {
+
+	AddNINetworkConfigCmd(h, name)
+
+	cmd := cliConfig().SysPath()
+	arg := func(flags ...string) []string {
+		args := []string{"network", "set", "bond", device}
+		if len(flags) == 0 {
+			mode, _ := getBondMode(config.LagType)
+			for _, member := range members {
+				args = append(args, member.Name)
+			}
+			flags = append(flags,
+				"--mode", mode,
+				"--min-links", fmt.Sprintf("%d", config.MinLinks),
+				"--mtu", fmt.Sprintf("%d", subif.IPv4.Config.Mtu),
+			)
+		}
+		flags = append(flags, "-H", name)
+		return append(args, flags...)
+	}
+
+	if add {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg()...),     // Do
+			nclib.NewShell(cmd, arg("-n")...), // UnDo
+			nil,                               // End
+		)
+	} else {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg("-n")...), // Do
+			nclib.NewShell(cmd, arg()...),     // UnDo
+			nil,                               // End
+		)
+	}
+}
+
+func AddNIBridgeNetworkCmd(h NICommandsHandler, name string, device string, subif *openconfig.Subinterface, config *openconfig.InterfaceBridgeConfig, add bool) {
+
+	AddNINetworkConfigCmd(h, name)
+
+	cmd := cliConfig().SysPath()
+	arg := func(flags ...string) []string {
+		args := []string{"network", "set", "bridge", device}
+		if len(flags) == 0 {
+			args = append(args, config.Members...)
+			flags = append(flags,
+				"--stp", fmt.Sprintf("%t", config.Stp),
+				"--priority", fmt.Sprintf("%d", config.Priority),
+				"--forward-delay", fmt.Sprintf("%d", config.ForwardDelay),
+				"--hello-time", fmt.Sprintf("%d", config.HelloTime),
+				"--max-age", fmt.Sprintf("%d", config.MaxAge),
+				"--mtu", fmt.Sprintf("%d", subif.IPv4.Config.Mtu),
+			)
+		}
+		flags = append(flags, "-H", name)
+		return append(args, flags...)
+	}
+
+	if add {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg()...),     // Do
+			nclib.NewShell(cmd, arg("-n")...), // UnDo
+			nil,                               // End
+		)
+	} else {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg("-n")...), // Do
+			nclib.NewShell(cmd, arg()...),     // UnDo
+			nil,                               // End
+		)
+	}
+}
+
+func AddNINetworkDescriptionCmd(h NICommandsHandler, name string, device []string, desc string, add bool) {
+
+	AddNINetworkConfigCmd(h, name)
+
+	cmd := cliConfig().SysPath()
+	arg := func(flags ...string) []string {
+		args := append([]string{"network", "set"}, device...)
+		flags = append(flags, "--description", desc, "-H", name)
+		return append(args, flags...)
+	}
+
+	if add {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg()...),     // Do
+			nclib.NewShell(cmd, arg("-n")...), // UnDo
+			nil,                               // End
+		)
+	} else {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg("-n")...), // Do
+			nclib.NewShell(cmd, arg()...),     // UnDo
+			nil,                               // End
+		)
+	}
+}
+
+//
+// AddNILinkStateCmd keeps the link down in the network config,
+// because loading the network config brings the links up.
+// netplan+ sets the link down after netplan apply and on boot.
+// The link is set up explicitly when the network config is loaded.
+//
+func AddNILinkStateCmd(h NICommandsHandler, name string, device []string, ifname string, up bool) {
+
+	AddNINetworkConfigCmd(h, name)
+
+	cmd := cliConfig().SysPath()
+	arg := func(flags ...string) []string {
+		args := append([]string{"network", "set"}, device...)
+		flags = append(flags, "--link-down", "-H", name)
+		return append(args, flags...)
+	}
+
+	if up {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg("-n")...),                     // Do
+			nclib.NewShell(cmd, arg()...),                         // UnDo
+			nclib.NewShell(cmd, "link", "up", ifname, "-H", name), // End
+		)
+	} else {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg()...),     // Do
+			nclib.NewShell(cmd, arg("-n")...), // UnDo
+			nil,                               // End
+		)
+	}
+}
+
 func AddNIRouterIdCmd(h NICommandsHandler, name string, routerId string, add bool) {
 
 	AddNIVtyConfigCmd(h, name)
@@ -223,6 +368,38 @@ func AddNIVrfCmd(h NICommandsHandler, name string, rd string, rt string, add boo
 	}
 }
 
+//
+// AddNIEvpnCmd sets (or deletes) the evpn-instance to evpn.conf
+// which cfgevpn creates the vxlan device and programs its fdb from.
+//
+func AddNIEvpnCmd(h NICommandsHandler, name string, evi string, config *openconfig.EvpnInstanceConfig, add bool) {
+
+	AddNIEvpnConfigCmd(h, name)
+
+	inst := ncevpnlib.NewInstance(evi, fmt.Sprintf("%d", config.Vni), config.Bridge, config.RD.String(), config.RT.String())
+
+	cmd := cliConfig().SysPath()
+	arg := func(flags ...string) []string {
+		args := []string{"evpn", "set", fmt.Sprintf("%s=%s", evi, inst)}
+		flags = append(flags, "-H", name)
+		return append(args, flags...)
+	}
+
+	if add {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg()...), // Do
+			nil,                           // Undo (rollback by evpn config.)
+			nil,                           // End
+		)
+	} else {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg("-n")...), // Do
+			nil,                               // Undo (rollback by evpn config.)
+			nil,                               // End
+		)
+	}
+}
+
 func AddNIVtyInterfaceCmd(h NICommandsHandler, name string, ifname string, key string, val interface{}, add bool) {
 
 	AddNIVtyConfigCmd(h, name)
@@ -296,6 +473,211 @@ func AddNIOspfv3RouterCmd(h NICommandsHandler, name string, key string, val inte
 	}
 }
 
+func AddNITableConnectionCmd(h NICommandsHandler, name string, key *openconfig.TableConnectionKey, redist string, add bool) {
+	switch key.DstProtocol {
+	case openconfig.INSTALL_PROTOCOL_OSPF:
+		AddNIOspfRouterCmd(h, name, "redistribute", redist, add)
+
+	case openconfig.INSTALL_PROTOCOL_OSPF3:
+		AddNIOspfv3RouterCmd(h, name, "redistribute", redist, add)
+	}
+}
+
+func AddNIVtyGlobalCmd(h NICommandsHandler, name string, args []string, add bool) {
+	AddNIVtyConfigCmd(h, name)
+
+	cmd := cliConfig().VtyPath()
+	arg := func(flags ...string) []string {
+		flags = append(flags, "-H", name)
+		return append(append([]string{"global"}, args...), flags...)
+	}
+
+	if add {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg()...), // Do
+			nil,                           // Undo (restart frr if failed.)
+			nil,                           // End
+		)
+	} else {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg("-n")...), // Do
+			nil,                               // Undo (restart frr if failed.)
+			nil,                               // End
+		)
+	}
+}
+
+//
+// AddNIRouteMapEntryCmd adds the entry of the route-map.
+// entry is the action, the sequence number and the match command.
+// The entry is removed if add is false and no match command is specified.
+//
+func AddNIRouteMapEntryCmd(h NICommandsHandler, name string, rmapName string, entry []string, add bool) {
+	AddNIVtyConfigCmd(h, name)
+
+	cmd := cliConfig().VtyPath()
+	arg := func(flags ...string) []string {
+		flags = append(flags, "-H", name)
+		return append(append([]string{"route-map", rmapName}, entry...), flags...)
+	}
+
+	if add {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg()...), // Do
+			nil,                           // Undo (restart frr if failed.)
+			nil,                           // End
+		)
+	} else {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg("-n")...), // Do
+			nil,                               // Undo (restart frr if failed.)
+			nil,                               // End
+		)
+	}
+}
+
+//
+// AddNIRouteMapCmd adds the prefix-lists and the entries of the route-map,
+// or removes them entirely.
+//
+func AddNIRouteMapCmd(h NICommandsHandler, name string, rmap *NIRouteMap, add bool) {
+	if rmap == nil {
+		return
+	}
+
+	if add {
+		for _, prefix := range rmap.Prefixes {
+			AddNIVtyGlobalCmd(h, name, prefix, true)
+		}
+		for _, entry := range rmap.Entries {
+			AddNIRouteMapEntryCmd(h, name, rmap.Name, entry, true)
+		}
+	} else {
+		AddNIVtyGlobalCmd(h, name, []string{"route-map", rmap.Name}, false)
+		for _, plist := range rmap.PrefixLists {
+			AddNIVtyGlobalCmd(h, name, []string{rmap.IPVer, "prefix-list", plist}, false)
+		}
+	}
+}
+
+//
+// AddNITableConnectionDefaultCmd adds or removes the last entry of the route-map
+// which permits the routes matching no statement of the import-policy.
+//
+func AddNITableConnectionDefaultCmd(h NICommandsHandler, name string, key *openconfig.TableConnectionKey, importDefault openconfig.PolicyDefaultType) {
+	accept := importDefault == openconfig.POLICY_DEFAULT_ACCEPT_ROUTE
+	AddNIRouteMapEntryCmd(h, name, getTableConnectionRouteMapName(key), getTableConnectionDefaultEntry(), accept)
+}
+
+func AddNIIsisRouterCmd(h NICommandsHandler, name string, tag string, key string, val interface{}, add bool) {
+	AddNIVtyConfigCmd(h, name)
+
+	cmd := cliConfig().VtyPath()
+	arg := func(flags ...string) []string {
+		flags = append(flags, "-H", name)
+		return append([]string{"isis", tag, key, fmt.Sprintf("%v", val)}, flags...)
+	}
+
+	if add {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg()...), // Do
+			nil,                           // Undo (restart frr if failed.)
+			nil,                           // End
+		)
+	} else {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg("-n")...), // Do
+			nil,                               // Undo (restart frr if failed.)
+			nil,                               // End
+		)
+	}
+}
+
+func AddNIIsisCmd(h NICommandsHandler, name string, tag string, add bool) {
+	AddNIVtyConfigCmd(h, name)
+
+	cmd := cliConfig().VtyPath()
+	if add {
+		AddNIVtyDaemonCmd(h, name, NI_UPDATE_VTY_ISISD, "isisd")
+
+		h.AddCmd(
+			nclib.NewShell(cmd, "isis", tag, "-H", name), // Do
+			nil, // Undo (restart frr if failed.)
+			nil, // End
+		)
+	} else {
+		h.AddCmd(
+			nclib.NewShell(cmd, "isis", tag, "-n", "-H", name), // Do
+			nil, // Undo (restart frr if failed.)
+			nil, // End
+		)
+	}
+}
+
+func AddNISegmentRoutingCmd(h NICommandsHandler, name string, key *openconfig.NetworkInstanceProtocolKey, srKey string, val interface{}, add bool) {
+	AddNIVtyConfigCmd(h, name)
+
+	var router []string
+	switch key.Ident {
+	case openconfig.INSTALL_PROTOCOL_OSPF:
+		router = []string{"segment-routing", "ospf"}
+
+	case openconfig.INSTALL_PROTOCOL_ISIS:
+		router = []string{"segment-routing", "isis", key.Name}
+
+	default:
+		return
+	}
+
+	cmd := cliConfig().VtyPath()
+	arg := func(flags ...string) []string {
+		args := append(router, srKey)
+		if v := fmt.Sprintf("%v", val); len(v) > 0 {
+			args = append(args, v)
+		}
+		return append(append(args, flags...), "-H", name)
+	}
+
+	if add {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg()...), // Do
+			nil,                           // Undo (restart frr if failed.)
+			nil,                           // End
+		)
+	} else {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg("-n")...), // Do
+			nil,                               // Undo (restart frr if failed.)
+			nil,                               // End
+		)
+	}
+}
+
+//
+// AddNIVtyDaemonCmd enables the daemon of frr only once in the transaction
+// because it is required by all of the commands of the daemon.
+// The running config is saved before enabling not to lose the changes of
+// the transaction by restarting frr, and it is rolled back if failed.
+// frr is restarted only if the daemon is not enabled yet.
+//
+func AddNIVtyDaemonCmd(h NICommandsHandler, name string, up NIUpdateType, daemon string) {
+	AddNIVtyConfigCmd(h, name)
+
+	vtycmd := cliConfig().VtyPath()
+
+	h.OnceCmd(NI_UPDATE_VTY_DAEMON,
+		nclib.NewShell(vtycmd, "config", "save", "-H", name),     // Do
+		nclib.NewShell(vtycmd, "config", "rollback", "-H", name), // Undo
+		nil, // End
+	)
+
+	h.OnceCmd(up,
+		nclib.NewShell(vtycmd, "daemon", "enable", daemon, "-r", "-H", name), // Do
+		nil, // Undo
+		nil, // End
+	)
+}
+
 func AddNIMplsLdpCmd(h NICommandsHandler, name string, key string, val interface{}, add bool) {
 
 	AddNIVtyConfigCmd(h, name)
@@ -370,7 +752,200 @@ func AddNIMplsLdpIPv4IfaceCmd(h NICommandsHandler, name string, ifname string, k
 	}
 }
 
-func AddNIStaticRouteCmd(h NICommandsHandler, name string, dest string, nexthop string, add bool) {
+func AddNIMplsLdpIPv6Cmd(h NICommandsHandler, name string, key string, val interface{}, add bool) {
+	AddNIVtyConfigCmd(h, name)
+
+	cmd := cliConfig().VtyPath()
+	arg := func(flags ...string) []string {
+		flags = append(flags, "-H", name)
+		return append([]string{"mpls", "ldp", "address-family", "ipv6", key, fmt.Sprintf("%v", val)}, flags...)
+	}
+
+	if add {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg()...), // Do,
+			nil,                           // Undo (restart frr if failed.)
+			nil,                           // End
+		)
+	} else {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg("-n")...), // Do,
+			nil,                               // Undo (restart frr if failed.)
+			nil,                               // End
+		)
+	}
+}
+
+func AddNIMplsLdpIPv6IfaceCmd(h NICommandsHandler, name string, ifname string, key string, val interface{}, add bool) {
+
+	AddNIVtyConfigCmd(h, name)
+
+	cmd := cliConfig().VtyPath()
+	arg := func(flags ...string) []string {
+		flags = append(flags, "-H", name)
+		return append([]string{"mpls", "ldp", "address-family", "ipv6", "interface", ifname, key, fmt.Sprintf("%v", val)}, flags...)
+	}
+
+	if add {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg()...), // Do,
+			nil,                           // Undo (restart frr if failed.)
+			nil,                           // End
+		)
+	} else {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg("-n")...), // Do,
+			nil,                               // Undo (restart frr if failed.)
+			nil,                               // End
+		)
+	}
+}
+
+//
+// AddNIMplsLdpIfaceAfCmd enables (or disables) the address family of afName on the interface.
+//
+func AddNIMplsLdpIfaceAfCmd(h NICommandsHandler, name string, ifaceId string, afName string, enable bool) {
+	switch afName {
+	case openconfig.MPLS_LDP_AF_IPV4_KEY:
+		AddNIMplsLdpIPv4Cmd(h, name, "interface", ifaceId, enable)
+
+	case openconfig.MPLS_LDP_AF_IPV6_KEY:
+		AddNIMplsLdpIPv6Cmd(h, name, "interface", ifaceId, enable)
+	}
+}
+
+func AddNIMplsLspCmd(h NICommandsHandler, name string, inLabel openconfig.MplsLabel, nexthop string, outLabel string, add bool) {
+	AddNIVtyConfigCmd(h, name)
+
+	cmd := cliConfig().VtyPath()
+	if add {
+		h.AddCmd(
+			nclib.NewShell(cmd, "mpls", "lsp", inLabel.String(), nexthop, outLabel, "-H", name), // Do
+			nil, // Undo (restart frr if failed.)
+			nil, // End
+		)
+	} else {
+		h.AddCmd(
+			nclib.NewShell(cmd, "mpls", "lsp", inLabel.String(), "-n", "-H", name), // Do
+			nil, // Undo (restart frr if failed.)
+			nil, // End
+		)
+	}
+}
+
+//
+// AddNIBfdCmd configures the node (profile or peer) of bfdd.
+// The node itself is added (or removed) if args is empty.
+//
+func AddNIBfdCmd(h NICommandsHandler, name string, node string, nodeName string, args []string, add bool) {
+	AddNIVtyConfigCmd(h, name)
+
+	cmd := cliConfig().VtyPath()
+	arg := func(flags ...string) []string {
+		flags = append(flags, "-H", name)
+		return append(append([]string{"bfd", node, nodeName}, args...), flags...)
+	}
+
+	if add {
+		AddNIVtyDaemonCmd(h, name, NI_UPDATE_VTY_BFDD, "bfdd")
+
+		h.AddCmd(
+			nclib.NewShell(cmd, arg()...), // Do
+			nil,                           // Undo (restart frr if failed.)
+			nil,                           // End
+		)
+	} else {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg("-n")...), // Do
+			nil,                               // Undo (restart frr if failed.)
+			nil,                               // End
+		)
+	}
+}
+
+//
+// AddNIVrrpCmd configures the virtual router of vrrpd on the interface.
+// The virtual router itself is added (or removed) if args is empty.
+//
+func AddNIVrrpCmd(h NICommandsHandler, name string, ifname string, vrid uint8, args []string, add bool) {
+	AddNIVtyConfigCmd(h, name)
+
+	cmd := cliConfig().VtyPath()
+	arg := func(flags ...string) []string {
+		flags = append(flags, "-H", name)
+		return append(append([]string{"vrrp", ifname, fmt.Sprintf("%d", vrid)}, args...), flags...)
+	}
+
+	if add {
+		AddNIVtyDaemonCmd(h, name, NI_UPDATE_VTY_VRRPD, "vrrpd")
+
+		h.AddCmd(
+			nclib.NewShell(cmd, arg()...), // Do
+			nil,                           // Undo (restart frr if failed.)
+			nil,                           // End
+		)
+	} else {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg("-n")...), // Do
+			nil,                               // Undo (restart frr if failed.)
+			nil,                               // End
+		)
+	}
+}
+
+//
+// AddNIVrrpGroupCmd adds the virtual router with the settings of the vrrp group.
+// preempt is enabled by default in vrrpd, so it is negated only if disabled.
+//
+func AddNIVrrpGroupCmd(h NICommandsHandler, name string, ifname string, config *openconfig.VrrpGroupConfig) {
+	vrid := config.VirtualRouterId
+
+	AddNIVrrpCmd(h, name, ifname, vrid, nil, true)
+
+	for _, args := range getVrrpGroupArgs(config) {
+		AddNIVrrpCmd(h, name, ifname, vrid, args, true)
+	}
+
+	if !config.Preempt {
+		AddNIVrrpCmd(h, name, ifname, vrid, []string{"preempt"}, false)
+	}
+}
+
+//
+// AddNIVrrpMacvlanCmd adds (or removes) the macvlan of the virtual router to the network config.
+// vrrpd sends the advertisements and the virtual addresses are used on the macvlan.
+// The macvlan is created by netplan+ when the network config is loaded,
+// but it is deleted explicitly because netplan does not delete the device.
+//
+func AddNIVrrpMacvlanCmd(h NICommandsHandler, name string, device []string, ifname string, config *openconfig.VrrpGroupConfig, add bool) {
+
+	AddNINetworkConfigCmd(h, name)
+
+	macvlan, macvlanArg := getVrrpMacvlan(ifname, config)
+
+	cmd := cliConfig().SysPath()
+	arg := func(flags ...string) []string {
+		args := append([]string{"network", "set"}, device...)
+		flags = append(flags, "--macvlan", macvlanArg, "-H", name)
+		return append(args, flags...)
+	}
+
+	if add {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg()...),     // Do
+			nclib.NewShell(cmd, arg("-n")...), // UnDo
+			nil,                               // End
+		)
+	} else {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg("-n")...),                       // Do
+			nclib.NewShell(cmd, arg()...),                           // UnDo
+			nclib.NewShell(cmd, "link", "del", macvlan, "-H", name), // End
+		)
+	}
+}
+
+func AddNIStaticRouteCmd(h NICommandsHandler, name string, dest string, nexthop []string, add bool) {
 
 	AddNIVtyConfigCmd(h, name)
 
@@ -380,9 +955,9 @@ func AddNIStaticRouteCmd(h NICommandsHandler, name string, dest string, nexthop
 	arg := func(flags ...string) []string {
 		flags = append(flags, "-H", name)
 		if ipver == ncnet.IPVER6 {
-			return append([]string{"ipv6", "route", dest, nexthop}, flags...)
+			return append(append([]string{"ipv6", "route", dest}, nexthop...), flags...)
 		}
-		return append([]string{"ip", "route", dest, nexthop}, flags...)
+		return append(append([]string{"ip", "route", dest}, nexthop...), flags...)
 	}
 
 	if add {
@@ -400,6 +975,35 @@ func AddNIStaticRouteCmd(h NICommandsHandler, name string, dest string, nexthop
 	}
 }
 
+//
+// AddNIStaticRouteDescCmd sets the description of the static route,
+// which is written to frr.conf as the comment when the config is saved.
+//
+func AddNIStaticRouteDescCmd(h NICommandsHandler, name string, dest string, desc string, add bool) {
+
+	AddNIVtyConfigCmd(h, name)
+
+	cmd := cliConfig().VtyPath()
+	arg := func(flags ...string) []string {
+		flags = append(flags, "-H", name)
+		return append([]string{"route", "description", dest, desc}, flags...)
+	}
+
+	if add {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg()...),     // Do
+			nclib.NewShell(cmd, arg("-n")...), // UnDo
+			nil,                               // End
+		)
+	} else {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg("-n")...), // Do
+			nclib.NewShell(cmd, arg()...),     // UnDo
+			nil,                               // End
+		)
+	}
+}
+
 func AddNIBgpConfigCmd(h NICommandsHandler, name string, cfgs io.Reader, restart bool, add bool) {
 	cmd := cliConfig().GoBgpPath()
 	arg := func(flags ...string) []string {
@@ -438,6 +1042,35 @@ func AddNIBgpConfigCmd(h NICommandsHandler, name string, cfgs io.Reader, restart
 	}
 }
 
+//
+// AddNIBgpNeighborBfdCmd adds (or deletes) the bfd peer of the bgp neighbor,
+// and registers it to cfgbgpbfd which disables the gobgp neighbor
+// while the bfd peer is down.
+//
+func AddNIBgpNeighborBfdCmd(h NICommandsHandler, name string, addr string, add bool) {
+	AddNIBfdCmd(h, name, "peer", addr, nil, add)
+
+	cmd := cliConfig().GoBgpPath()
+	arg := func(flags ...string) []string {
+		flags = append(flags, "-H", name)
+		return append([]string{"bfd", addr}, flags...)
+	}
+
+	if add {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg()...),     // Do
+			nclib.NewShell(cmd, arg("-n")...), // Undo
+			nil,                               // End
+		)
+	} else {
+		h.AddCmd(
+			nclib.NewShell(cmd, arg("-n")...), // Do
+			nclib.NewShell(cmd, arg()...),     // Undo
+			nil,                               // End
+		)
+	}
+}
+
 func AddNIVtyConfigCmd(h NICommandsHandler, name string) {
 	vtycmd := cliConfig().VtyPath()
 
@@ -481,6 +1114,16 @@ func AddNIVrfConfigCmd(h NICommandsHandler, name string) {
 
 }
 
+func AddNIEvpnConfigCmd(h NICommandsHandler, name string) {
+	cmd := cliConfig().SysPath()
+
+	h.OnceCmd(NI_UPDATE_SYSEVPN,
+		nclib.NewShell(cmd, "evpn", "backup", "-H", name),   // Do
+		nclib.NewShell(cmd, "evpn", "rollback", "-H", name), // Undo
+		nclib.NewShell(cmd, "evpn", "load", "-H", name),     // End
+	)
+}
+
 func AddNINetworkConfigCmd(h NICommandsHandler, name string) {
 	cmd := cliConfig().SysPath()
 
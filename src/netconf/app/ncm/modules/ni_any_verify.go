@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"net"
 	ncmdbm "netconf/app/ncm/dbm"
+	ncianalib "netconf/lib/iana"
+	ncnet "netconf/lib/net"
 	"netconf/lib/openconfig"
 )
 
@@ -38,6 +40,206 @@ func VerifyNIInterface(iface *openconfig.NetworkInstanceInterface) error {
 	return nil
 }
 
+func VerifyNIInterfaceAggregate(name string, iface *openconfig.NetworkInstanceInterface) error {
+	_, device, err := ncmdbm.Subinterfaces().SelectById(iface.Id)
+	if err != nil {
+		return err
+	}
+
+	lag, err := ncmdbm.Interfaces().Select(device)
+	if err != nil {
+		return err
+	}
+
+	if lag.Config.Type != ncianalib.IANAifType_ieee8023adLag {
+		if aggregateId := lag.Ethernet.Config.AggregateId; len(aggregateId) != 0 {
+			return fmt.Errorf("%s is a member of %s.", device, aggregateId)
+		}
+		return nil
+	}
+
+	if _, err := getBondMode(lag.Aggregation.Config.LagType); err != nil {
+		return err
+	}
+
+	// the members are moved into one container,
+	// so that all subinterfaces of the LAG must be in the same network-instance.
+	for index, _ := range lag.Subinterfaces {
+		id := ncnet.NewIFName(device, index)
+		if niName, err := ncmdbm.NetworkInstances().SelectByInterface(id); err == nil && niName != name {
+			return fmt.Errorf("%s is in another network-instance. %s", id, niName)
+		}
+	}
+
+	members := ncmdbm.Interfaces().SelectMembers(device)
+	if len(members) == 0 {
+		return fmt.Errorf("%s has no member.", device)
+	}
+
+	if minLinks := int(lag.Aggregation.Config.MinLinks); minLinks > len(members) {
+		return fmt.Errorf("min-links must be <= #members. %s min-links=%d, members=%d", device, minLinks, len(members))
+	}
+
+	return nil
+}
+
+//
+// VerifyNIInterfaceBridge verifies the bridge interface and its members.
+// The members must be the interfaces of the same network-instance,
+// which are created in the transaction (ni) or already committed.
+//
+func VerifyNIInterfaceBridge(name string, ni *openconfig.NetworkInstance, iface *openconfig.NetworkInstanceInterface) error {
+	subif, device, err := ncmdbm.Subinterfaces().SelectById(iface.Id)
+	if err != nil {
+		return err
+	}
+
+	if subif.Index != 0 {
+		return nil
+	}
+
+	bridge, err := ncmdbm.Interfaces().Select(device)
+	if err != nil {
+		return err
+	}
+
+	if bridge.Config.Type != ncianalib.IANAifType_bridge {
+		return nil
+	}
+
+	config := bridge.Bridge.Config
+	for _, member := range config.Members {
+		if _, _, err := ncmdbm.Subinterfaces().SelectById(member); err != nil {
+			return err
+		}
+
+		if _, ok := ni.Interfaces[member]; ok {
+			continue
+		}
+
+		if niName, _ := ncmdbm.NetworkInstances().SelectByInterface(member); niName != name {
+			return fmt.Errorf("%s is not an interface of %s.", member, name)
+		}
+	}
+
+	return verifyBridgeStpConfig(config)
+}
+
+//
+// verifyBridgeStpConfig checks the timers of the spanning tree protocol
+// as IEEE 802.1D requires, 2*(forward-delay - 1) >= max-age >= 2*(hello-time + 1).
+//
+func verifyBridgeStpConfig(config *openconfig.InterfaceBridgeConfig) error {
+	if !config.Stp {
+		return nil
+	}
+
+	fwdDelay := int(config.ForwardDelay)
+	helloTime := int(config.HelloTime)
+	maxAge := int(config.MaxAge)
+
+	if maxAge > 2*(fwdDelay-1) || maxAge < 2*(helloTime+1) {
+		return fmt.Errorf("Invalid STP timers. forward-delay=%d, hello-time=%d, max-age=%d", fwdDelay, helloTime, maxAge)
+	}
+
+	return nil
+}
+
+//
+// VerifyNIInterfaceVrrp verifies the vrrp groups of the subinterface.
+// ipv4 and ipv6 groups of the same vrid are the same virtual router in vrrpd,
+// so they must have the same settings.
+//
+func VerifyNIInterfaceVrrp(iface *openconfig.NetworkInstanceInterface) error {
+	subif, _, err := ncmdbm.Subinterfaces().SelectById(iface.Id)
+	if err != nil {
+		return err
+	}
+
+	groups := map[uint8]*openconfig.VrrpGroupConfig{}
+	macvlans := map[string]struct{}{}
+	verify := func(config *openconfig.VrrpGroupConfig, ipv6 bool) error {
+		if err := verifyVrrpGroupConfig(config, ipv6); err != nil {
+			return err
+		}
+
+		macvlan := getVrrpMacvlanName(iface.Id, config.VirtualRouterId, ipv6)
+		if len(macvlan) > VRRP_MACVLAN_NAME_MAX {
+			return fmt.Errorf("macvlan name too long. %s", macvlan)
+		}
+		if _, ok := macvlans[macvlan]; ok {
+			return fmt.Errorf("duplicated virtual-router-id. %d", config.VirtualRouterId)
+		}
+		macvlans[macvlan] = struct{}{}
+
+		if group, ok := groups[config.VirtualRouterId]; ok {
+			return verifyVrrpGroupPair(group, config)
+		}
+		groups[config.VirtualRouterId] = config
+		return nil
+	}
+
+	for _, addr := range subif.IPv4.Addresses {
+		for _, group := range addr.Vrrp {
+			if err := verify(group.Config, false); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, addr := range subif.IPv6.Addresses {
+		for _, group := range addr.Vrrp {
+			if err := verify(group.Config, true); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func verifyVrrpGroupPair(config4 *openconfig.VrrpGroupConfig, config6 *openconfig.VrrpGroupConfig) error {
+	if config4.Priority != config6.Priority {
+		return fmt.Errorf("priority unmatch in virtual-router-id %d. %d, %d", config4.VirtualRouterId, config4.Priority, config6.Priority)
+	}
+
+	if config4.AdvInterval != config6.AdvInterval {
+		return fmt.Errorf("advertisement-interval unmatch in virtual-router-id %d. %d, %d", config4.VirtualRouterId, config4.AdvInterval, config6.AdvInterval)
+	}
+
+	if config4.Preempt != config6.Preempt {
+		return fmt.Errorf("preempt unmatch in virtual-router-id %d. %t, %t", config4.VirtualRouterId, config4.Preempt, config6.Preempt)
+	}
+
+	return nil
+}
+
+func verifyVrrpGroupConfig(config *openconfig.VrrpGroupConfig, ipv6 bool) error {
+	if config.VirtualRouterId == 0 {
+		return fmt.Errorf("invalid virtual-router-id. %s", config)
+	}
+
+	if len(config.VirtualAddrs) == 0 {
+		return fmt.Errorf("virtual-address not specified. %s", config)
+	}
+
+	for _, vaddr := range config.VirtualAddrs {
+		if isIPv4 := vaddr.To4() != nil; isIPv4 == ipv6 {
+			return fmt.Errorf("invalid virtual-address. %s", vaddr)
+		}
+	}
+
+	if config.Priority < 1 || config.Priority > 254 {
+		return fmt.Errorf("invalid priority. %d", config.Priority)
+	}
+
+	if config.AdvInterval < 1 || config.AdvInterval > 4095 {
+		return fmt.Errorf("invalid advertisement-interval. %d", config.AdvInterval)
+	}
+
+	return nil
+}
+
 func VerifyNIInterfaceRefConfig(config *openconfig.InterfaceRefConfig) error {
 	keys := []string{openconfig.INTERFACE_KEY, openconfig.SUBINTERFACE_KEY}
 	if chg := config.GetChanges(keys...); !chg {
@@ -47,6 +249,31 @@ func VerifyNIInterfaceRefConfig(config *openconfig.InterfaceRefConfig) error {
 	return nil
 }
 
+func VerifyNIStaticRouteNexthop(nexthop *openconfig.StaticRouteNexthop) error {
+	_, nhType, err := nexthop.Config.GetNexthop()
+	if err != nil {
+		return fmt.Errorf("invalid next-hop. %s", err)
+	}
+
+	if nhType == openconfig.LOCAL_DEFINED_NEXT_HOP_LOCAL_LINK {
+		if err := VerifyNIInterfaceRefConfig(nexthop.IfaceRef.Config); err != nil {
+			return err
+		}
+	}
+
+	if !nexthop.Config.Recurse {
+		if nhType != openconfig.LOCAL_DEFINED_NEXT_HOP {
+			return fmt.Errorf("recurse must be true for %s.", nhType)
+		}
+
+		if err := VerifyNIInterfaceRefConfig(nexthop.IfaceRef.Config); err != nil {
+			return fmt.Errorf("interface-ref is required if recurse is false. %s", err)
+		}
+	}
+
+	return nil
+}
+
 func VerifyNIInterfaceConfig(id string, config *openconfig.NetworkInstanceInterfaceConfig) error {
 	keys := []string{openconfig.INTERFACE_KEY, openconfig.SUBINTERFACE_KEY}
 
@@ -102,3 +329,533 @@ func VerifyNIStaticRouteConfig(config *openconfig.StaticRouteConfig) error {
 
 	return nil
 }
+
+//
+// VerifyNIEvpnInstanceConfig verifies the config of evpn-instance
+// which the changes are put to the stored config.
+// The vni and the bridge must not be shared with the other evpn-instances
+// because cfgevpn attaches the vxlan device of each vni to the bridge.
+//
+func VerifyNIEvpnInstanceConfig(name string, ni *openconfig.NetworkInstance, evi string, config *openconfig.EvpnInstanceConfig) error {
+	keys := []string{
+		openconfig.EVPN_EVI_KEY,
+		openconfig.EVPN_VNI_KEY,
+		openconfig.EVPN_BRIDGE_KEY,
+		openconfig.NETWORKINSTANCE_RD_KEY,
+		openconfig.NETWORKINSTANCE_RT_KEY,
+	}
+
+	if chg := config.GetChanges(keys...); !chg {
+		return fmt.Errorf("evi, vni, bridge, route-distinguisher or route-target not specified. %s", config)
+	}
+
+	if evi != fmt.Sprintf("%d", config.Evi) {
+		return fmt.Errorf("Invalid evi. %s", config)
+	}
+
+	if config.RD.Type() == ncnet.RD_TYPE_NONE || config.RT.Type() == ncnet.RD_TYPE_NONE {
+		return fmt.Errorf("Invalid route-distinguisher or route-target. %s", config)
+	}
+
+	if err := verifyNIEvpnBridge(name, ni, config.Bridge); err != nil {
+		return err
+	}
+
+	for other, c := range newEvpnInstanceConfigs(name, ni) {
+		if other == evi {
+			continue
+		}
+
+		if c.Vni == config.Vni {
+			return fmt.Errorf("vni %d is used by evpn-instance %s.", config.Vni, other)
+		}
+
+		if c.Bridge == config.Bridge {
+			return fmt.Errorf("bridge %s is used by evpn-instance %s.", config.Bridge, other)
+		}
+	}
+
+	return nil
+}
+
+//
+// verifyNIEvpnBridge verifies that the bridge is a bridge interface of the network-instance,
+// which is created in the transaction (ni) or already committed.
+//
+func verifyNIEvpnBridge(name string, ni *openconfig.NetworkInstance, bridge string) error {
+	iface, err := ncmdbm.Interfaces().Select(bridge)
+	if err != nil {
+		return err
+	}
+
+	if iface.Config.Type != ncianalib.IANAifType_bridge {
+		return fmt.Errorf("%s is not a bridge interface.", bridge)
+	}
+
+	ifaceId := ncnet.NewIFName(bridge, 0)
+	if _, ok := ni.Interfaces[ifaceId]; ok {
+		return nil
+	}
+
+	if niName, _ := ncmdbm.NetworkInstances().SelectByInterface(ifaceId); niName != name {
+		return fmt.Errorf("%s is not an interface of %s.", bridge, name)
+	}
+
+	return nil
+}
+
+//
+// VerifyBgpPeerGroupRefs verifies that the peer-groups of the neighbors
+// are created in the transaction (bgp) or already committed (stored).
+//
+func VerifyBgpPeerGroupRefs(bgp *openconfig.Bgp, stored *openconfig.Bgp) error {
+	exists := func(pgName string) bool {
+		if _, ok := bgp.PeerGroups[pgName]; ok {
+			return true
+		}
+		_, ok := stored.PeerGroups[pgName]
+		return ok
+	}
+
+	for addr, neigh := range bgp.Neighbors {
+		config := neigh.Config
+		if config.GetChange(openconfig.BGP_PEERGROUP_KEY) && len(config.PeerGroup) != 0 && !exists(config.PeerGroup) {
+			return fmt.Errorf("%s: peer-group not found. %s", addr, config.PeerGroup)
+		}
+	}
+
+	for prefix, neigh := range bgp.Global.DynamicNeighbors {
+		config := neigh.Config
+		if config.GetChange(openconfig.BGP_PEERGROUP_KEY) && len(config.PeerGroup) != 0 && !exists(config.PeerGroup) {
+			return fmt.Errorf("%s: peer-group not found. %s", prefix, config.PeerGroup)
+		}
+	}
+
+	return nil
+}
+
+//
+// VerifyBgpPeerGroupDeletes verifies that the peer-groups deleted in the transaction (bgp)
+// are not referenced by the committed neighbors (stored) which are not deleted together.
+//
+func VerifyBgpPeerGroupDeletes(bgp *openconfig.Bgp, stored *openconfig.Bgp) error {
+	for pgName, pg := range bgp.PeerGroups {
+		if !pg.GetChange(openconfig.BGP_PEERGROUP_NAME_KEY) {
+			continue
+		}
+
+		for addr, neigh := range stored.Neighbors {
+			if neigh.Config.PeerGroup != pgName {
+				continue
+			}
+			if del, ok := bgp.Neighbors[addr]; ok {
+				if del.GetChange(openconfig.BGP_NEIGHBOR_ADDR_KEY) || del.Config.GetChange(openconfig.BGP_PEERGROUP_KEY) {
+					continue
+				}
+			}
+			return fmt.Errorf("PG/%s: referenced by %s.", pgName, addr)
+		}
+
+		for prefix, neigh := range stored.Global.DynamicNeighbors {
+			if neigh.Config.PeerGroup != pgName {
+				continue
+			}
+			if del, ok := bgp.Global.DynamicNeighbors[prefix]; ok {
+				if del.GetChange(openconfig.BGP_DYNAMIC_PREFIX_KEY) || del.Config.GetChange(openconfig.BGP_PEERGROUP_KEY) {
+					continue
+				}
+			}
+			return fmt.Errorf("PG/%s: referenced by %s.", pgName, prefix)
+		}
+	}
+
+	return nil
+}
+
+func VerifyNIOspfv2RedistributionConfig(proto string) error {
+	if _, err := getOspfv2RedistributeProtocol(proto); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+//
+// VerifyNITableConnectionImportPolicy rejects import-policy to BGP
+// because GoBGP redistributes the routes of zebra without policy,
+// and the change of import-policy of the existing table-connection
+// because the route-map is rendered only when the table-connection is created.
+//
+func VerifyNITableConnectionImportPolicy(key *openconfig.TableConnectionKey, config *openconfig.TableConnectionConfig) error {
+	if !config.GetChange(openconfig.TBLCONN_IMPORT_KEY) {
+		return nil
+	}
+
+	if key.DstProtocol == openconfig.INSTALL_PROTOCOL_BGP {
+		return fmt.Errorf("table-connection import-policy is not supported to BGP. %s", key)
+	}
+
+	keys := []string{openconfig.TBLCONN_SRC_PROTO_KEY, openconfig.TBLCONN_DST_PROTO_KEY, openconfig.TBLCONN_AF_KEY}
+	if !config.OneOfChange(keys...) {
+		return fmt.Errorf("table-connection import-policy must be changed with the table-connection. %s", key)
+	}
+
+	return nil
+}
+
+func VerifyNITableConnectionConfig(key *openconfig.TableConnectionKey, config *openconfig.TableConnectionConfig) error {
+	keys := []string{openconfig.TBLCONN_SRC_PROTO_KEY, openconfig.TBLCONN_DST_PROTO_KEY, openconfig.TBLCONN_AF_KEY}
+	if config.GetChanges(keys...) {
+		if k := openconfig.NewTableConnectionKey(config.SrcProtocol, config.DstProtocol, config.AddressFamily); *k != *key {
+			return fmt.Errorf("Invalid table-connection. %s", config)
+		}
+	}
+
+	if err := VerifyNITableConnectionImportPolicy(key, config); err != nil {
+		return err
+	}
+
+	if _, err := getTableConnectionRouteMap(key, config); err != nil {
+		return fmt.Errorf("Invalid table-connection import-policy. %s", err)
+	}
+
+	switch key.DstProtocol {
+	case openconfig.INSTALL_PROTOCOL_OSPF, openconfig.INSTALL_PROTOCOL_OSPF3:
+		af := openconfig.ADDRESS_FAMILY_IPV4
+		if key.DstProtocol == openconfig.INSTALL_PROTOCOL_OSPF3 {
+			af = openconfig.ADDRESS_FAMILY_IPV6
+		}
+		if key.AddressFamily != af {
+			return fmt.Errorf("Invalid table-connection address-family. %s", key)
+		}
+
+		if _, err := getTableConnectionRedistributeProtocol(key); err != nil {
+			return err
+		}
+
+	case openconfig.INSTALL_PROTOCOL_BGP:
+		switch key.AddressFamily {
+		case openconfig.ADDRESS_FAMILY_IPV4, openconfig.ADDRESS_FAMILY_IPV6:
+		default:
+			return fmt.Errorf("Invalid table-connection address-family. %s", key)
+		}
+
+		switch key.SrcProtocol {
+		case openconfig.INSTALL_PROTOCOL_DIRECTLY_CONNECTED,
+			openconfig.INSTALL_PROTOCOL_STATIC,
+			openconfig.INSTALL_PROTOCOL_OSPF,
+			openconfig.INSTALL_PROTOCOL_OSPF3,
+			openconfig.INSTALL_PROTOCOL_ISIS:
+		default:
+			return fmt.Errorf("Unsupported table-connection %s", key)
+		}
+
+	default:
+		return fmt.Errorf("Unsupported table-connection %s", key)
+	}
+
+	return nil
+}
+
+func VerifyNIMplsLdpAddressFamilyV6Config(config *openconfig.MplsLdpAddressFamilyV6Config) error {
+	if config.GetChange(openconfig.MPLS_LDP_AF_TARNSADDR_KEY) {
+		if ip := config.TransportAddr; ip == nil || ip.To4() != nil {
+			return fmt.Errorf("Invalid transport-address. %s", config.TransportAddr)
+		}
+	}
+
+	return nil
+}
+
+func VerifyNIOspfv2AuthenticationConfig(config *openconfig.Ospfv2InterfaceAuthenticationConfig) error {
+	if !config.Enabled {
+		return nil
+	}
+
+	keys := []string{openconfig.OSPFV2_AUTH_KEY_ID_KEY, openconfig.OSPFV2_AUTH_AUTH_KEY_KEY}
+	if chg := config.GetChanges(keys...); !chg {
+		return fmt.Errorf("key-id or auth-key not specified. %s", config)
+	}
+
+	return nil
+}
+
+func VerifyNIIsisAuthenticationConfig(config *openconfig.IsisAuthenticationConfig) error {
+	if !config.Enabled {
+		return nil
+	}
+
+	if len(config.AuthPassword) == 0 {
+		return fmt.Errorf("auth-password not specified. %s", config)
+	}
+
+	return nil
+}
+
+//
+// VerifyNISegmentRoutingProtocol checks protocol which segment-routing is supported.
+// (ospfd and isisd only)
+//
+func VerifyNISegmentRoutingProtocol(key *openconfig.NetworkInstanceProtocolKey) error {
+	switch key.Ident {
+	case openconfig.INSTALL_PROTOCOL_OSPF, openconfig.INSTALL_PROTOCOL_ISIS:
+		return nil
+
+	default:
+		return fmt.Errorf("segment-routing is not supported. %s", key)
+	}
+}
+
+func VerifyNISegmentRoutingBlockConfig(config *openconfig.SegmentRoutingBlockConfig) error {
+	keys := []string{openconfig.SR_LOWER_BOUND_KEY, openconfig.SR_UPPER_BOUND_KEY}
+	if !config.OneOfChange(keys...) {
+		return nil
+	}
+
+	if chg := config.GetChanges(keys...); !chg {
+		return fmt.Errorf("lower-bound or upper-bound not specified. %s", config)
+	}
+
+	if config.LowerBound > config.UpperBound {
+		return fmt.Errorf("Invalid label block. %d-%d", config.LowerBound, config.UpperBound)
+	}
+
+	return nil
+}
+
+func VerifyNISegmentRoutingPrefixSidConfig(prefix string, config *openconfig.SegmentRoutingPrefixSidConfig) error {
+	if config.GetChange(openconfig.SR_PREFIX_KEY) {
+		if prefix != config.Prefix.String() {
+			return fmt.Errorf("Invalid prefix. %s", config)
+		}
+
+		if ones, bits := config.Prefix.Mask.Size(); ones != bits {
+			return fmt.Errorf("prefix-sid must be a host prefix. %s", config.Prefix)
+		}
+	}
+
+	if config.GetChange(openconfig.SR_LABEL_OPTIONS_KEY) && !config.GetChange(openconfig.SR_SID_ID_KEY) {
+		return fmt.Errorf("sid-id not specified. %s", config)
+	}
+
+	return nil
+}
+
+func VerifyNIMplsStaticLspPathConfig(pathName string, config *openconfig.MplsStaticLspPathConfig) error {
+	keys := []string{
+		openconfig.MPLS_LSP_INCOMING_LABEL_KEY,
+		openconfig.MPLS_LSP_NEXTHOP_KEY,
+		openconfig.MPLS_LSP_PUSH_LABEL_KEY,
+	}
+
+	if !config.OneOfChange(append(keys, openconfig.MPLS_LSP_DESTINATION_KEY)...) {
+		return nil
+	}
+
+	// 'mpls lsp' of zebra swaps the incoming label only,
+	// so ingress is configured as 'ip route <destination> <next-hop> label <push-label>'.
+	if pathName == openconfig.MPLS_LSP_INGRESS_KEY {
+		return verifyNIMplsStaticLspIngressConfig(config)
+	}
+
+	if config.GetChange(openconfig.MPLS_LSP_DESTINATION_KEY) {
+		return fmt.Errorf("destination is available for ingress only. %s", config)
+	}
+
+	if chg := config.GetChanges(keys...); !chg {
+		return fmt.Errorf("incoming-label, next-hop or push-label not specified. %s", config)
+	}
+
+	if label := config.IncomingLabel; !label.IsUnreserved() {
+		return fmt.Errorf("Invalid incoming-label. %s (%d-%d)", label, openconfig.MPLS_LABEL_MIN, openconfig.MPLS_LABEL_MAX)
+	}
+
+	switch label := config.PushLabel; label {
+	case openconfig.MPLS_LABEL_IPV4_EXPLICIT_NULL, openconfig.MPLS_LABEL_IPV6_EXPLICIT_NULL, openconfig.MPLS_LABEL_IMPLICIT_NULL:
+		// ok
+
+	default:
+		if !label.IsUnreserved() {
+			return fmt.Errorf("Invalid push-label. %s (%d-%d)", label, openconfig.MPLS_LABEL_MIN, openconfig.MPLS_LABEL_MAX)
+		}
+	}
+
+	return nil
+}
+
+func verifyNIMplsStaticLspIngressConfig(config *openconfig.MplsStaticLspPathConfig) error {
+	if config.GetChange(openconfig.MPLS_LSP_INCOMING_LABEL_KEY) {
+		return fmt.Errorf("incoming-label is not available for ingress. %s", config)
+	}
+
+	if chg := config.GetChanges(openconfig.MPLS_LSP_NEXTHOP_KEY, openconfig.MPLS_LSP_PUSH_LABEL_KEY, openconfig.MPLS_LSP_DESTINATION_KEY); !chg {
+		return fmt.Errorf("next-hop, push-label or destination not specified. %s", config)
+	}
+
+	if label := config.PushLabel; !label.IsUnreserved() {
+		return fmt.Errorf("Invalid push-label. %s (%d-%d)", label, openconfig.MPLS_LABEL_MIN, openconfig.MPLS_LABEL_MAX)
+	}
+
+	if destVer, nhVer := len(config.Destination.IP.To4()), len(config.NextHop.To4()); destVer != nhVer {
+		return fmt.Errorf("next-hop and destination must be the same address family. %s", config)
+	}
+
+	return nil
+}
+
+func VerifyNIBfdInterfaceConfig(ifaceId string, config *openconfig.BfdInterfaceConfig) error {
+	if config.GetChange(openconfig.OC_ID_KEY) && ifaceId != config.Id {
+		return fmt.Errorf("Invalid id. %s", config)
+	}
+
+	verifyInterval := func(key string, interval uint32) error {
+		if interval < openconfig.BFD_INTERVAL_MIN || interval > openconfig.BFD_INTERVAL_MAX || interval%1000 != 0 {
+			return fmt.Errorf("Invalid %s. %d (%d-%d, multiple of 1000)", key, interval, openconfig.BFD_INTERVAL_MIN, openconfig.BFD_INTERVAL_MAX)
+		}
+		return nil
+	}
+
+	if config.GetChange(openconfig.BFD_DESIRED_MIN_TX_KEY) {
+		if err := verifyInterval(openconfig.BFD_DESIRED_MIN_TX_KEY, config.DesiredMinTxInt); err != nil {
+			return err
+		}
+	}
+
+	if config.GetChange(openconfig.BFD_REQUIRED_MIN_RX_KEY) {
+		if err := verifyInterval(openconfig.BFD_REQUIRED_MIN_RX_KEY, config.RequiredMinRx); err != nil {
+			return err
+		}
+	}
+
+	if config.GetChange(openconfig.BFD_DETECTION_MULTIPLIER_KEY) {
+		if m := config.DetectionMultiplier; m < openconfig.BFD_MULTIPLIER_MIN || m > openconfig.BFD_MULTIPLIER_MAX {
+			return fmt.Errorf("Invalid %s. %d (%d-%d)", openconfig.BFD_DETECTION_MULTIPLIER_KEY, m, openconfig.BFD_MULTIPLIER_MIN, openconfig.BFD_MULTIPLIER_MAX)
+		}
+	}
+
+	return nil
+}
+
+func VerifyBgpSessionOptions(multihop *openconfig.BgpEbgpMultihop, ttlSec *openconfig.BgpTtlSecurity) error {
+	if multihop.Config.Enabled && ttlSec.Config.Enabled {
+		return fmt.Errorf("%s and %s can not be enabled at the same time.", openconfig.BGP_EBGP_MULTIHOP_KEY, openconfig.BGP_TTL_SECURITY_KEY)
+	}
+
+	return nil
+}
+
+//
+// VerifyBgpSessionChanges verifies the session options of peer-groups and neighbors
+// changed over the stored bgp. The options not changed are taken from stored.
+//
+func VerifyBgpSessionChanges(bgp *openconfig.Bgp, stored *openconfig.Bgp) error {
+	merge := func(multihop *openconfig.BgpEbgpMultihop, ttlSec *openconfig.BgpTtlSecurity, storedMultihop *openconfig.BgpEbgpMultihop, storedTtlSec *openconfig.BgpTtlSecurity) (*openconfig.BgpEbgpMultihop, *openconfig.BgpTtlSecurity) {
+		if !multihop.Config.GetChange(openconfig.OC_ENABLED_KEY) {
+			multihop = storedMultihop
+		}
+		if !ttlSec.Config.GetChange(openconfig.OC_ENABLED_KEY) {
+			ttlSec = storedTtlSec
+		}
+		return multihop, ttlSec
+	}
+
+	for pgName, pg := range bgp.PeerGroups {
+		multihop, ttlSec := pg.EbgpMultihop, pg.TtlSecurity
+		if s, ok := stored.PeerGroups[pgName]; ok {
+			multihop, ttlSec = merge(multihop, ttlSec, s.EbgpMultihop, s.TtlSecurity)
+		}
+
+		if err := VerifyBgpSessionOptions(multihop, ttlSec); err != nil {
+			return fmt.Errorf("PG/%s: %s", pgName, err)
+		}
+	}
+
+	for addr, neigh := range bgp.Neighbors {
+		multihop, ttlSec := neigh.EbgpMultihop, neigh.TtlSecurity
+		if s, ok := stored.Neighbors[addr]; ok {
+			multihop, ttlSec = merge(multihop, ttlSec, s.EbgpMultihop, s.TtlSecurity)
+		}
+
+		if err := VerifyBgpSessionOptions(multihop, ttlSec); err != nil {
+			return fmt.Errorf("%s: %s", addr, err)
+		}
+	}
+
+	return nil
+}
+
+func VerifyPolicyDefinition(pol *openconfig.PolicyDefinition, sets *openconfig.PolicyDefinedSets) error {
+	for stmtName, stmt := range pol.Stmts {
+		if err := VerifyPolicyBgpActions(stmt.Actions.Bgp, sets); err != nil {
+			return fmt.Errorf("%s: %s", stmtName, err)
+		}
+	}
+
+	return nil
+}
+
+func verifyPolicyBgpSetCommunityMethod(method openconfig.BgpSetCommunityMethodType, inline bool, ref bool) error {
+	if inline && ref {
+		return fmt.Errorf("both inline and reference specified.")
+	}
+
+	switch method {
+	case openconfig.BGP_SET_COMMUNITY_METHOD_INLINE:
+		if !inline {
+			return fmt.Errorf("inline communities not specified.")
+		}
+
+	case openconfig.BGP_SET_COMMUNITY_METHOD_REFERENCE:
+		if !ref {
+			return fmt.Errorf("reference set not specified.")
+		}
+	}
+
+	return nil
+}
+
+func VerifyPolicyBgpActions(actions *openconfig.PolicyBgpActions, sets *openconfig.PolicyDefinedSets) error {
+	if actions.GetChange(openconfig.BGP_ACTIONS_SET_ASPATH_PREPEND_KEY) {
+		config := actions.SetAsPathPrepend.Config
+		if !config.GetChange(openconfig.BGP_ACTIONS_ASN_KEY) {
+			return fmt.Errorf("set-as-path-prepend asn not specified. %s", config)
+		}
+		if config.RepeatN == 0 {
+			return fmt.Errorf("set-as-path-prepend invalid repeat-n. %s", config)
+		}
+	}
+
+	if actions.GetChange(openconfig.BGP_ACTIONS_SET_COMM_KEY) {
+		comm := actions.SetCommunity
+		ref := comm.GetChange(openconfig.BGP_ACTIONS_REFERENCE_KEY)
+		inline := comm.GetChange(openconfig.BGP_ACTIONS_INLINE_KEY)
+		if err := verifyPolicyBgpSetCommunityMethod(comm.Config.Method, inline, ref); err != nil {
+			return fmt.Errorf("set-community %s", err)
+		}
+
+		if ref {
+			name := comm.Reference.Config.CommunitySetRef
+			if _, ok := sets.Bgp.CommunitySets[name]; !ok {
+				return fmt.Errorf("community-set not found. %s", name)
+			}
+		}
+	}
+
+	if actions.GetChange(openconfig.BGP_ACTIONS_SET_EXTCOMM_KEY) {
+		comm := actions.SetExtCommunity
+		ref := comm.GetChange(openconfig.BGP_ACTIONS_REFERENCE_KEY)
+		inline := comm.GetChange(openconfig.BGP_ACTIONS_INLINE_KEY)
+		if err := verifyPolicyBgpSetCommunityMethod(comm.Config.Method, inline, ref); err != nil {
+			return fmt.Errorf("set-ext-community %s", err)
+		}
+
+		if ref {
+			name := comm.Reference.Config.ExtCommunitySetRef
+			if _, ok := sets.Bgp.ExtCommunitySets[name]; !ok {
+				return fmt.Errorf("ext-community-set not found. %s", name)
+			}
+		}
+	}
+
+	return nil
+}
@@ -98,23 +98,61 @@ func (h *NICreateApplyHandler) NetworkInstanceInterfaceConfig(name string, id st
 	log.Debugf("NI/%s/%s/%s/%s/CONF: %s", h.ev, h.oper, name, id, config)
 
 	subif, device, _ := ncmdbm.Subinterfaces().SelectById(id)
+	lag, members := selectAggregate(device, subif)
+	bridge := selectBridge(device, subif)
 
 	if config.GetChange(openconfig.OC_ID_KEY) {
-		if subif.Index == 0 {
-			iface, _ := ncmdbm.Interfaces().Select(id)
-			hwaddr := iface.Ethernet.Config.MacAddr.String()
-			AddNIContainerInterfaceCmd(h, name, id, hwaddr, h.mtu, true)
+		switch {
+		case lag != nil:
+			if h.AttachLag(name, device) {
+				for _, member := range members {
+					hwaddr := member.Ethernet.Config.MacAddr.String()
+					AddNIContainerInterfaceCmd(h, name, member.Name, hwaddr, h.mtu, true)
+				}
+				parent := selectAggregateParent(lag)
+				AddNIBondNetworkCmd(h, name, device, parent, lag.Aggregation.Config, members, true)
+			}
+			if subif.Index != 0 {
+				AddNIInterfaceNetworkCmd(h, name, device, subif, true)
+			}
+
+		case bridge != nil:
+			AddNIBridgeNetworkCmd(h, name, device, subif, bridge.Bridge.Config, true)
+
+		default:
+			if subif.Index == 0 {
+				iface, _ := ncmdbm.Interfaces().Select(id)
+				hwaddr := iface.Ethernet.Config.MacAddr.String()
+				AddNIContainerInterfaceCmd(h, name, id, hwaddr, h.mtu, true)
+			}
+			AddNIInterfaceNetworkCmd(h, name, device, subif, true)
 		}
 
-		AddNIInterfaceNetworkCmd(h, name, device, subif, true)
 		AddNIInterfaceSysctlCmd(h, name, id, true)
 
 	} else if config.GetChanges(openconfig.SUBINTERFACE_KEY) {
-		AddNIInterfaceNetworkCmd(h, name, device, subif, true)
+		switch {
+		case lag != nil && subif.Index == 0:
+			AddNIBondNetworkCmd(h, name, device, subif, lag.Aggregation.Config, members, true)
+		case bridge != nil:
+			AddNIBridgeNetworkCmd(h, name, device, subif, bridge.Bridge.Config, true)
+		default:
+			AddNIInterfaceNetworkCmd(h, name, device, subif, true)
+		}
 	}
 
 	if config.GetChanges(openconfig.INTERFACE_KEY, openconfig.SUBINTERFACE_KEY) {
-		AddNIVtyInterfaceCmd(h, name, id, "shutdown", "", false)
+		iface, _ := ncmdbm.Interfaces().Select(device)
+		enabled, desc := getInterfaceState(iface, subif)
+
+		AddNIVtyInterfaceCmd(h, name, id, "shutdown", "", !enabled)
+		if len(desc) != 0 {
+			AddNIVtyInterfaceCmd(h, name, id, "description", desc, true)
+			AddNINetworkDescriptionCmd(h, name, getNetworkDevice(device, subif), desc, true)
+		}
+		if !enabled {
+			AddNILinkStateCmd(h, name, getNetworkDevice(device, subif), id, false)
+		}
 
 		for _, ifv4 := range subif.IPv4.Addresses {
 			AddNIVtyInterfaceCmd(h, name, id, "ip address", ifv4.Config.IFAddr(), true)
@@ -122,6 +160,10 @@ func (h *NICreateApplyHandler) NetworkInstanceInterfaceConfig(name string, id st
 		for _, ifv6 := range subif.IPv6.Addresses {
 			AddNIVtyInterfaceCmd(h, name, id, "ipv6 address", ifv6.Config.IFAddr(), true)
 		}
+		for _, group := range getVrrpGroups(subif) {
+			AddNIVrrpGroupCmd(h, name, id, group.Config)
+			AddNIVrrpMacvlanCmd(h, name, getNetworkDevice(device, subif), id, group.Config, true)
+		}
 	}
 
 	log.Debugf("NI/%s/%s/%s/%s: OK", h.ev, h.oper, name, id)
@@ -135,6 +177,41 @@ func (h *NICreateApplyHandler) Ospfv2GlobalConfig(name string, key *openconfig.N
 		AddNIOspfRouterCmd(h, name, "router-id", config.RouterId, true)
 	}
 
+	if config.DefaultInformationOriginate {
+		AddNIOspfRouterCmd(h, name, "default-information", getOspfv2DefaultInformation(config), true)
+	}
+
+	return nil
+}
+
+func (h *NICreateApplyHandler) Ospfv2RedistributionConfig(name string, key *openconfig.NetworkInstanceProtocolKey, proto string, config *openconfig.Ospfv2RedistributionConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/REDIST/%s/CONF: %s", h.ev, h.oper, name, key, proto, config)
+
+	if config.GetChange(openconfig.OSPFV2_PROTOCOL_KEY) {
+		redist, err := getOspfv2Redistribute(proto, config)
+		if err != nil {
+			return err
+		}
+		AddNIOspfRouterCmd(h, name, "redistribute", redist, true)
+	}
+
+	return nil
+}
+
+func (h *NICreateApplyHandler) Ospfv2AreaConfig(name string, key *openconfig.NetworkInstanceProtocolKey, areaId string, config *openconfig.Ospfv2AreaConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/CONF: %s", h.ev, h.oper, name, key, areaId, config)
+
+	if config.GetChange(openconfig.OSPFV2_AREA_TYPE_KEY) {
+		areaType, areaTypeOpts, err := getOspfv2AreaType(config.AreaType)
+		if err != nil {
+			return err
+		}
+
+		if len(areaType) != 0 {
+			AddNIOspfRouterCmd(h, name, fmt.Sprintf("area %s", areaId), areaTypeOpts, true)
+		}
+	}
+
 	return nil
 }
 
@@ -158,6 +235,12 @@ func (h *NICreateApplyHandler) Ospfv2InterfaceConfig(name string, key *openconfi
 		AddNIVtyInterfaceCmd(h, name, ifaceId, "ip ospf network", n, true)
 	}
 
+	if config.GetChange(openconfig.OSPFV2_ENABLE_BFD_KEY) && config.EnableBfd {
+		AddNIVtyDaemonCmd(h, name, NI_UPDATE_VTY_BFDD, "bfdd")
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "ip ospf bfd", "", true)
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "ip ospf bfd profile", ifaceId, true)
+	}
+
 	return nil
 }
 
@@ -185,6 +268,29 @@ func (h *NICreateApplyHandler) Ospfv2InterfaceTimers(name string, key *openconfi
 	return nil
 }
 
+func (h *NICreateApplyHandler) Ospfv2InterfaceAuthenticationConfig(name string, key *openconfig.NetworkInstanceProtocolKey, areaId string, ifaceId string, config *openconfig.Ospfv2InterfaceAuthenticationConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s/AUTH: %s", h.ev, h.oper, name, key, areaId, ifaceId, config)
+
+	if config.Enabled {
+		md5Key := fmt.Sprintf("%d md5 %s", config.KeyId, config.AuthKey)
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "ip ospf message-digest-key", md5Key, true)
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "ip ospf authentication", "message-digest", true)
+	}
+
+	return nil
+}
+
+func (h *NICreateApplyHandler) Ospfv2AreaRangeConfig(name string, nikey *openconfig.NetworkInstanceProtocolKey, areaId string, rngkey *openconfig.Ospfv2AreaRangeKey, config *openconfig.Ospfv2AreaRangeConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s/CONF %s", h.ev, h.oper, name, nikey, areaId, rngkey, config)
+
+	if config.GetChanges(openconfig.OSPFV2_RANGE_IP_KEY, openconfig.OSPFV2_RANGE_PREFIXLEN_KEY) {
+		cmd := fmt.Sprintf("area %s range", areaId)
+		AddNIOspfRouterCmd(h, name, cmd, getOspfv2AreaRange(config.IPNet().String(), config.Advertise), true)
+	}
+
+	return nil
+}
+
 func (h *NICreateApplyHandler) Ospfv3GlobalConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.Ospfv3GlobalConfig) error {
 	log.Debugf("NI/%s/%s/%s/PROTOS/%s/CONF: %s", h.ev, h.oper, name, key, config)
 
@@ -254,6 +360,138 @@ func (h *NICreateApplyHandler) Ospfv3AreaRangeConfig(name string, nikey *opencon
 	return nil
 }
 
+func (h *NICreateApplyHandler) NetworkInstanceProtocol(name string, key *openconfig.NetworkInstanceProtocolKey, proto *openconfig.NetworkInstanceProtocol) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s: %s", h.ev, h.oper, name, key, proto)
+
+	if key.Ident == openconfig.INSTALL_PROTOCOL_ISIS {
+		AddNIIsisCmd(h, name, key.Name, true)
+	}
+
+	return nil
+}
+
+func (h *NICreateApplyHandler) IsisGlobalConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.IsisGlobalConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/GLOBAL/CONF: %s", h.ev, h.oper, name, key, config)
+
+	if config.GetChange(openconfig.ISIS_NET_KEY) {
+		for _, net := range config.Net {
+			AddNIIsisRouterCmd(h, name, key.Name, "net", net, true)
+		}
+	}
+
+	if config.GetChange(openconfig.ISIS_LEVEL_CAPABILITY_KEY) {
+		level, _ := getIsisLevelType(config.LevelCapability)
+		AddNIIsisRouterCmd(h, name, key.Name, "is-type", level, true)
+	}
+
+	return nil
+}
+
+func (h *NICreateApplyHandler) IsisLevelAuthenticationConfig(name string, key *openconfig.NetworkInstanceProtocolKey, levelNum string, config *openconfig.IsisAuthenticationConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/LEVEL/%s/AUTH: %s", h.ev, h.oper, name, key, levelNum, config)
+
+	if config.Enabled && config.OneOfChange(openconfig.OC_ENABLED_KEY, openconfig.ISIS_AUTH_MODE_KEY, openconfig.ISIS_AUTH_PASSWORD_KEY) {
+		cmd, _ := getIsisLevelPasswordCmd(levelNum)
+		passwd, _ := getIsisPassword(config)
+		AddNIIsisRouterCmd(h, name, key.Name, cmd, passwd, true)
+	}
+
+	return nil
+}
+
+func (h *NICreateApplyHandler) IsisInterfaceConfig(name string, key *openconfig.NetworkInstanceProtocolKey, ifaceId string, config *openconfig.IsisInterfaceConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/CONF: %s", h.ev, h.oper, name, key, ifaceId, config)
+
+	if config.GetChange(openconfig.ISIS_PASSIVE_KEY) {
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "isis passive", "", config.Passive)
+	}
+
+	if config.GetChange(openconfig.ISIS_CIRCUIT_TYPE_KEY) {
+		level, _ := getIsisLevelType(config.CircuitType)
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "isis circuit-type", level, true)
+	}
+
+	if config.GetChange(openconfig.ISIS_METRIC_KEY) {
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "isis metric", config.Metric, true)
+	}
+
+	return nil
+}
+
+func (h *NICreateApplyHandler) IsisInterfaceTimersConfig(name string, key *openconfig.NetworkInstanceProtocolKey, ifaceId string, config *openconfig.IsisInterfaceTimersConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/TIMERS: %s", h.ev, h.oper, name, key, ifaceId, config)
+
+	if config.GetChange(openconfig.ISIS_HELLO_INTERVAL_KEY) {
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "isis hello-interval", config.HelloInterval, true)
+	}
+
+	if config.GetChange(openconfig.ISIS_HELLO_MULTIPLIER_KEY) {
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "isis hello-multiplier", config.HelloMultiplier, true)
+	}
+
+	return nil
+}
+
+func (h *NICreateApplyHandler) IsisInterfaceAfConfig(name string, key *openconfig.NetworkInstanceProtocolKey, ifaceId string, afiName string, config *openconfig.IsisInterfaceAfConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s: %s", h.ev, h.oper, name, key, ifaceId, afiName, config)
+
+	if config.GetChange(openconfig.OC_ENABLED_KEY) {
+		afi, _ := openconfig.ParseIsisAfiType(afiName)
+		cmd, _ := getIsisAfiCmd(afi)
+		AddNIVtyInterfaceCmd(h, name, ifaceId, cmd, key.Name, config.Enabled)
+	}
+
+	return nil
+}
+
+func (h *NICreateApplyHandler) IsisInterfaceAuthenticationConfig(name string, key *openconfig.NetworkInstanceProtocolKey, ifaceId string, config *openconfig.IsisAuthenticationConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/AUTH: %s", h.ev, h.oper, name, key, ifaceId, config)
+
+	if config.Enabled && config.OneOfChange(openconfig.OC_ENABLED_KEY, openconfig.ISIS_AUTH_MODE_KEY, openconfig.ISIS_AUTH_PASSWORD_KEY) {
+		passwd, _ := getIsisPassword(config)
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "isis password", passwd, true)
+	}
+
+	return nil
+}
+
+func (h *NICreateApplyHandler) SegmentRoutingConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.SegmentRoutingConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/SR/CONF: %s", h.ev, h.oper, name, key, config)
+
+	if config.GetChange(openconfig.OC_ENABLED_KEY) {
+		if config.Enabled && key.Ident == openconfig.INSTALL_PROTOCOL_OSPF {
+			// ospfd distributes SIDs by Router Information Opaque LSA.
+			AddNIOspfRouterCmd(h, name, "capability", "opaque", true)
+			AddNIOspfRouterCmd(h, name, "router-info", "area", true)
+		}
+		AddNISegmentRoutingCmd(h, name, key, "on", "", config.Enabled)
+	}
+
+	return nil
+}
+
+func (h *NICreateApplyHandler) SegmentRoutingBlockConfig(name string, key *openconfig.NetworkInstanceProtocolKey, blockName string, config *openconfig.SegmentRoutingBlockConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/SR/%s: %s", h.ev, h.oper, name, key, blockName, config)
+
+	if config.GetChanges(openconfig.SR_LOWER_BOUND_KEY, openconfig.SR_UPPER_BOUND_KEY) {
+		cmd, _ := getSegmentRoutingBlockCmd(blockName)
+		block := fmt.Sprintf("%d %d", config.LowerBound, config.UpperBound)
+		AddNISegmentRoutingCmd(h, name, key, cmd, block, true)
+	}
+
+	return nil
+}
+
+func (h *NICreateApplyHandler) SegmentRoutingPrefixSidConfig(name string, key *openconfig.NetworkInstanceProtocolKey, prefix string, config *openconfig.SegmentRoutingPrefixSidConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/SR/SID/%s: %s", h.ev, h.oper, name, key, prefix, config)
+
+	if config.OneOfChange(openconfig.SR_SID_ID_KEY, openconfig.SR_LABEL_OPTIONS_KEY) {
+		AddNISegmentRoutingCmd(h, name, key, "prefix", getSegmentRoutingPrefixSid(prefix, config), true)
+	}
+
+	return nil
+}
+
 func (h *NICreateApplyHandler) MplsInterfaceAttrRefConfig(name string, ifaceId string, config *openconfig.InterfaceRefConfig) error {
 	log.Debugf("NI/%s/%s/%s/MPLS/%s/REF: %s", h.ev, h.oper, name, ifaceId, config)
 
@@ -262,6 +500,52 @@ func (h *NICreateApplyHandler) MplsInterfaceAttrRefConfig(name string, ifaceId s
 	return nil
 }
 
+func (h *NICreateApplyHandler) MplsStaticLspPathConfig(name string, lspName string, pathName string, config *openconfig.MplsStaticLspPathConfig) error {
+	log.Debugf("NI/%s/%s/%s/LSP/%s/%s: %s", h.ev, h.oper, name, lspName, pathName, config)
+
+	if pathName == openconfig.MPLS_LSP_INGRESS_KEY {
+		if config.GetChanges(openconfig.MPLS_LSP_NEXTHOP_KEY, openconfig.MPLS_LSP_PUSH_LABEL_KEY, openconfig.MPLS_LSP_DESTINATION_KEY) {
+			nexthop, err := getMplsLspIngressNexthop(config)
+			if err != nil {
+				return err
+			}
+
+			AddNIStaticRouteCmd(h, name, config.Destination.String(), nexthop, true)
+		}
+
+		return nil
+	}
+
+	if config.GetChanges(openconfig.MPLS_LSP_INCOMING_LABEL_KEY, openconfig.MPLS_LSP_NEXTHOP_KEY, openconfig.MPLS_LSP_PUSH_LABEL_KEY) {
+		outLabel, err := getMplsLspOutLabel(config.PushLabel)
+		if err != nil {
+			return err
+		}
+
+		AddNIMplsLspCmd(h, name, config.IncomingLabel, config.NextHop.String(), outLabel, true)
+	}
+
+	return nil
+}
+
+func (h *NICreateApplyHandler) BfdInterfaceConfig(name string, ifaceId string, config *openconfig.BfdInterfaceConfig) error {
+	log.Debugf("NI/%s/%s/%s/BFD/%s/CONF: %s", h.ev, h.oper, name, ifaceId, config)
+
+	if config.GetChange(openconfig.OC_ID_KEY) {
+		AddNIBfdCmd(h, name, "profile", ifaceId, nil, true)
+	}
+
+	for _, args := range getBfdProfileArgs(config) {
+		AddNIBfdCmd(h, name, "profile", ifaceId, args, true)
+	}
+
+	if config.GetChange(openconfig.OC_ENABLED_KEY) && !config.Enabled {
+		AddNIBfdCmd(h, name, "profile", ifaceId, []string{"shutdown"}, true)
+	}
+
+	return nil
+}
+
 func (h *NICreateApplyHandler) MplsLdpConfig(name string, config *openconfig.MplsLdpConfig) error {
 	log.Debugf("NI/%s/%s/%s/LDP/CONF: %s", h.ev, h.oper, name, config)
 
@@ -269,6 +553,10 @@ func (h *NICreateApplyHandler) MplsLdpConfig(name string, config *openconfig.Mpl
 		AddNIMplsLdpCmd(h, name, "router-id", config.LsrId, true)
 	}
 
+	if config.GetChange(openconfig.MPLS_LDP_DUALSTACK_PREFER_KEY) {
+		AddNIMplsLdpCmd(h, name, "dual-stack transport-connection prefer", "ipv4", config.DualStackPref == openconfig.MPLS_LDP_TRANSPORT_PREFER_IPV4)
+	}
+
 	return nil
 }
 
@@ -291,6 +579,25 @@ func (h *NICreateApplyHandler) MplsLdpAddressFamilyV4Config(name string, config
 	return nil
 }
 
+func (h *NICreateApplyHandler) MplsLdpAddressFamilyV6Config(name string, config *openconfig.MplsLdpAddressFamilyV6Config) error {
+	log.Debugf("NI/%s/%s/%s/LDP/ADDR6: %s", h.ev, h.oper, name, config)
+
+	if config.GetChange(openconfig.MPLS_LDP_AF_TARNSADDR_KEY) {
+		AddNIMplsLdpIPv6Cmd(h, name, "discovery transport-address", config.TransportAddr, true)
+	}
+
+	if config.GetChange(openconfig.MPLS_LDP_AF_SESSION_HOLDTIME_KEY) {
+		AddNIMplsLdpIPv6Cmd(h, name, "session holdtime", config.SessionHoldTime, true)
+	}
+
+	explicitNll := config.LabelPolicy.Advertise.EngressExplicitNull
+	if explicitNll.GetChange(openconfig.MPLS_LDP_LABELPOLICY_ENABLE_KEY) {
+		AddNIMplsLdpIPv6Cmd(h, name, "label local advertise explicit-null", "", explicitNll.Enable)
+	}
+
+	return nil
+}
+
 func (h *NICreateApplyHandler) MplsLdpDiscovInterfacesConfig(name string, config *openconfig.MplsLdpDiscovInterfacesConfig) error {
 	log.Debugf("NI/%s/%s/%s/LDP/DISC/IFCONF: %s", h.ev, h.oper, name, config)
 
@@ -325,19 +632,84 @@ func (h *NICreateApplyHandler) MplsLdpInterfaceConfig(name string, ifaceId strin
 	return nil
 }
 
+func (h *NICreateApplyHandler) MplsLdpInterfaceAfConfig(name string, ifaceId string, afName string, config *openconfig.MplsLdpInterfaceAfConfig) error {
+	log.Debugf("NI/%s/%s/%s/LDP/DISC/%s/%s: %s", h.ev, h.oper, name, ifaceId, afName, config)
+
+	if config.GetChange(openconfig.MPLS_LDP_AF_ENABLE_KEY) {
+		AddNIMplsLdpIfaceAfCmd(h, name, ifaceId, afName, config.Enable)
+	}
+
+	return nil
+}
+
+func (h *NICreateApplyHandler) StaticRouteConfig(name string, prkey *openconfig.NetworkInstanceProtocolKey, rtkey *openconfig.StaticRouteKey, config *openconfig.StaticRouteConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/CONF: %s", h.ev, h.oper, name, prkey, rtkey, config)
+
+	if config.GetChange(openconfig.STATICROUTE_DESC_KEY) {
+		if len(config.Description) != 0 {
+			AddNIStaticRouteDescCmd(h, name, rtkey.String(), config.Description, true)
+		}
+	}
+
+	return nil
+}
+
 func (h *NICreateApplyHandler) StaticRouteNexthop(name string, prkey *openconfig.NetworkInstanceProtocolKey, rtkey *openconfig.StaticRouteKey, index string, nexthop *openconfig.StaticRouteNexthop) error {
 	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s: %s", h.ev, h.oper, name, prkey, rtkey, index, nexthop)
 
-	ip, nhtype, _ := nexthop.Config.GetNexthop()
-	switch nhtype {
-	case openconfig.LOCAL_DEFINED_NEXT_HOP_LOCAL_LINK:
-		AddNIStaticRouteCmd(h, name, rtkey.String(), nexthop.IfaceRef.Config.IFName(), true)
-	case openconfig.LOCAL_DEFINED_NEXT_HOP_DROP:
-		AddNIStaticRouteCmd(h, name, rtkey.String(), "nill0", true)
-	default:
-		AddNIStaticRouteCmd(h, name, rtkey.String(), ip.String(), true)
+	if nexthop.EnableBfd.Config.Enabled {
+		AddNIVtyDaemonCmd(h, name, NI_UPDATE_VTY_BFDD, "bfdd")
 	}
 
+	args := append(getStaticRouteNexthop(nexthop), getStaticRouteOptions(nexthop)...)
+	AddNIStaticRouteCmd(h, name, rtkey.String(), args, true)
+
+	return nil
+}
+
+func (h *NICreateApplyHandler) NetworkInstanceEvpnInstanceConfig(name string, evi string, config *openconfig.EvpnInstanceConfig) error {
+	log.Debugf("NI/%s/%s/%s/EVPN/%s/CONF: %s", h.ev, h.oper, name, evi, config)
+
+	stored := getStoredEvpnInstanceConfig(name, evi)
+	AddNIEvpnCmd(h, name, evi, newEvpnInstanceConfig(stored, config), true)
+
+	return nil
+}
+
+func (h *NICreateApplyHandler) TableConnectionConfig(name string, key *openconfig.TableConnectionKey, config *openconfig.TableConnectionConfig) error {
+	log.Debugf("NI/%s/%s/%s/TBLCONN/%s/CONF: %s", h.ev, h.oper, name, key, config)
+
+	if !config.OneOfChange(openconfig.TBLCONN_SRC_PROTO_KEY, openconfig.TBLCONN_DST_PROTO_KEY, openconfig.TBLCONN_AF_KEY) {
+		// default-import-policy is set to the existing table-connection.
+		if config.GetChange(openconfig.TBLCONN_IMPORT_DEF_KEY) && key.DstProtocol != openconfig.INSTALL_PROTOCOL_BGP {
+			if stored := selectNITableConnectionConfig(name, key); len(stored.ImportPolicy) != 0 {
+				AddNITableConnectionDefaultCmd(h, name, key, config.ImportDefault)
+			}
+		}
+		return nil
+	}
+
+	if key.DstProtocol == openconfig.INSTALL_PROTOCOL_BGP {
+		h.Bgps.TableConnectionConfig(name, key, config)
+		AddNIBgpConfigCmd(h, name, h.Bgps.Bytes(), false, true)
+
+		h.TraceBgps(fmt.Sprintf("NI/%s/%s/%s/TBLCONN/%s/CONF:", h.ev, h.oper, name, key))
+		return nil
+	}
+
+	rmap, err := getTableConnectionRouteMap(key, config)
+	if err != nil {
+		return err
+	}
+
+	redist, err := getTableConnectionRedistribute(key, rmap)
+	if err != nil {
+		return err
+	}
+
+	AddNIRouteMapCmd(h, name, rmap, true)
+	AddNITableConnectionCmd(h, name, key, redist, true)
+
 	return nil
 }
 
@@ -357,6 +729,9 @@ func (h *NICreateApplyHandler) Bgp(name string, key *openconfig.NetworkInstanceP
 func (h *NICreateApplyHandler) BgpNeighborApplyPolicyConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, config *openconfig.PolicyApplyConfig) error {
 	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/APPLYPOL: %s", h.ev, h.oper, name, key, addr, config)
 
+	sets := ncmdbm.PolicyDefinedSets().Get()
+	h.Bgps.SetDefinedSets(sets)
+
 	if config.GetChange(openconfig.POLICYAPPLY_IMPORT_KEY) {
 		for _, polName := range config.ImportPolicy {
 			pol, _ := ncmdbm.PolicyDefinitions().Select(polName)
@@ -371,9 +746,27 @@ func (h *NICreateApplyHandler) BgpNeighborApplyPolicyConfig(name string, key *op
 		}
 	}
 
+	if config.OneOfChange(openconfig.POLICYAPPLY_IMPORT_KEY, openconfig.POLICYAPPLY_EXPORT_KEY) {
+		openconfig.ProcessPolicyDefinedSets(h.Bgps, false, sets)
+	}
+
 	AddNIBgpConfigCmd(h, name, h.Bgps.Bytes(), false, true)
 
 	h.TraceBgps(fmt.Sprintf("NI/%s/%s/%s/PROTOS/%s/%s/APPLYPOL:", h.ev, h.oper, name, key, addr))
 
 	return nil
 }
+
+func (h *NICreateApplyHandler) BgpNeighborEnableBfdConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, config *openconfig.EnableBfdConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/BFD: %s", h.ev, h.oper, name, key, addr, config)
+
+	if config.GetChange(openconfig.OC_ENABLED_KEY) && config.Enabled {
+		AddNIBgpNeighborBfdCmd(h, name, addr, true)
+	}
+
+	return nil
+}
+
+func (h *NICreateApplyHandler) BgpPeerGroupApplyPolicyConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, config *openconfig.PolicyApplyConfig) error {
+	return h.BgpNeighborApplyPolicyConfig(name, key, pgName, config)
+}
@@ -28,6 +28,7 @@ import (
 
 type NICreateVerifyHandler struct {
 	*NIAnyHandler
+	ni *openconfig.NetworkInstance
 }
 
 func NewNICreateVerifyHandler(ev srlib.SrNotifEvent, oper srlib.SrChangeOper) NIChangeHandler {
@@ -39,6 +40,7 @@ func NewNICreateVerifyHandler(ev srlib.SrNotifEvent, oper srlib.SrChangeOper) NI
 func (h *NICreateVerifyHandler) Begin(name string, ni *openconfig.NetworkInstance) error {
 	log.Debugf("NI/%s/%s/%s/BEGIN; %s", h.ev, h.oper, name, ni)
 	h.Clear()
+	h.ni = ni
 	return openconfig.ProcessNetworkInstance(h, false, name, ni)
 }
 
@@ -64,6 +66,21 @@ func (h *NICreateVerifyHandler) NetworkInstanceInterface(name string, id string,
 		return err
 	}
 
+	if err := VerifyNIInterfaceAggregate(name, iface); err != nil {
+		log.Errorf("NI/%s/%s/%s/%s: %s", h.ev, h.oper, name, id, err)
+		return err
+	}
+
+	if err := VerifyNIInterfaceBridge(name, h.ni, iface); err != nil {
+		log.Errorf("NI/%s/%s/%s/%s: %s", h.ev, h.oper, name, id, err)
+		return err
+	}
+
+	if err := VerifyNIInterfaceVrrp(iface); err != nil {
+		log.Errorf("NI/%s/%s/%s/%s: %s", h.ev, h.oper, name, id, err)
+		return err
+	}
+
 	log.Debugf("NI/%s/%s/%s/%s: OK", h.ev, h.oper, name, id)
 	return nil
 }
@@ -121,15 +138,8 @@ func (h *NICreateVerifyHandler) StaticRouteNexthop(name string, prkey *openconfi
 		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s/%s/%s: do not change next-hop patrially.", h.ev, h.oper, name, prkey, rtkey, index)
 	}
 
-	_, nhType, err := nexthop.Config.GetNexthop()
-	if err != nil {
-		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s/%s/%s: invalid next-hop. %s", h.ev, h.oper, name, prkey, rtkey, index, err)
-	}
-
-	if nhType == openconfig.LOCAL_DEFINED_NEXT_HOP_LOCAL_LINK {
-		if err := VerifyNIInterfaceRefConfig(nexthop.IfaceRef.Config); err != nil {
-			return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s/%s/%s: %s", h.ev, h.oper, name, prkey, rtkey, index, err)
-		}
+	if err := VerifyNIStaticRouteNexthop(nexthop); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s/%s/%s: %s", h.ev, h.oper, name, prkey, rtkey, index, err)
 	}
 
 	return nil
@@ -162,6 +172,32 @@ func (h *NICreateVerifyHandler) Ospfv2(name string, key *openconfig.NetworkInsta
 	return nil
 }
 
+//
+// /network-instances/network-instance[name]/protocols/protocol[prkey]/ospfv2/global/redistributions/redistribution[protocol]/config
+//
+func (h *NICreateVerifyHandler) Ospfv2RedistributionConfig(name string, key *openconfig.NetworkInstanceProtocolKey, proto string, config *openconfig.Ospfv2RedistributionConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/REDIST/%s/CONF: %s", h.ev, h.oper, name, key, proto, config)
+
+	if err := VerifyNIOspfv2RedistributionConfig(proto); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s/REDIST/%s/CONF: %s", h.ev, h.oper, name, key, proto, err)
+	}
+
+	return nil
+}
+
+//
+// /network-instances/network-instance[name]/protocols/protocol[prkey]/ospfv2/areas/area[identifier]/interfaces/interface[id]/authentication/config
+//
+func (h *NICreateVerifyHandler) Ospfv2InterfaceAuthenticationConfig(name string, key *openconfig.NetworkInstanceProtocolKey, areaId string, ifaceId string, config *openconfig.Ospfv2InterfaceAuthenticationConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s/AUTH: %s", h.ev, h.oper, name, key, areaId, ifaceId, config)
+
+	if err := VerifyNIOspfv2AuthenticationConfig(config); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s/%s/%s/AUTH: %s", h.ev, h.oper, name, key, areaId, ifaceId, err)
+	}
+
+	return nil
+}
+
 //
 // /network-instances/network-instance[name]/protocols/protocol[prkey]/ospfv3
 //
@@ -176,6 +212,177 @@ func (h *NICreateVerifyHandler) Ospfv3(name string, key *openconfig.NetworkInsta
 	return nil
 }
 
+//
+// /network-instances/network-instance[name]/protocols/protocol[prkey]/isis
+//
+func (h *NICreateVerifyHandler) Isis(name string, key *openconfig.NetworkInstanceProtocolKey, isis *openconfig.Isis) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s: %s", h.ev, h.oper, name, key, isis)
+
+	if key.Ident != openconfig.INSTALL_PROTOCOL_ISIS {
+		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s: Invalid Protocol identifier.", h.ev, h.oper, name, key)
+
+	}
+
+	return nil
+}
+
+//
+// /network-instances/network-instance[name]/protocols/protocol[prkey]/isis/levels/level[level-number]/authentication/config
+//
+func (h *NICreateVerifyHandler) IsisLevelAuthenticationConfig(name string, key *openconfig.NetworkInstanceProtocolKey, levelNum string, config *openconfig.IsisAuthenticationConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/LEVEL/%s/AUTH: %s", h.ev, h.oper, name, key, levelNum, config)
+
+	if err := VerifyNIIsisAuthenticationConfig(config); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s/LEVEL/%s/AUTH: %s", h.ev, h.oper, name, key, levelNum, err)
+	}
+
+	return nil
+}
+
+//
+// /network-instances/network-instance[name]/protocols/protocol[prkey]/isis/interfaces/interface[interface-id]/authentication/config
+//
+func (h *NICreateVerifyHandler) IsisInterfaceAuthenticationConfig(name string, key *openconfig.NetworkInstanceProtocolKey, ifaceId string, config *openconfig.IsisAuthenticationConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/AUTH: %s", h.ev, h.oper, name, key, ifaceId, config)
+
+	if err := VerifyNIIsisAuthenticationConfig(config); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s/%s/AUTH: %s", h.ev, h.oper, name, key, ifaceId, err)
+	}
+
+	return nil
+}
+
+//
+// /network-instances/network-instance[name]/protocols/protocol[prkey]/<igp>/global/segment-routing/config
+//
+func (h *NICreateVerifyHandler) SegmentRoutingConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.SegmentRoutingConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/SR/CONF %s", h.ev, h.oper, name, key, config)
+
+	if err := VerifyNISegmentRoutingProtocol(key); err != nil {
+		log.Errorf("NI/%s/%s/%s/PROTOS/%s/SR/CONF %s", h.ev, h.oper, name, key, err)
+		return err
+	}
+
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/SR/CONF OK", h.ev, h.oper, name, key)
+	return nil
+}
+
+//
+// /network-instances/network-instance[name]/protocols/protocol[prkey]/<igp>/global/segment-routing/<block>/config
+//
+func (h *NICreateVerifyHandler) SegmentRoutingBlockConfig(name string, key *openconfig.NetworkInstanceProtocolKey, blockName string, config *openconfig.SegmentRoutingBlockConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/SR/%s %s", h.ev, h.oper, name, key, blockName, config)
+
+	if err := VerifyNISegmentRoutingProtocol(key); err != nil {
+		log.Errorf("NI/%s/%s/%s/PROTOS/%s/SR/%s %s", h.ev, h.oper, name, key, blockName, err)
+		return err
+	}
+
+	if err := VerifyNISegmentRoutingBlockConfig(config); err != nil {
+		log.Errorf("NI/%s/%s/%s/PROTOS/%s/SR/%s %s", h.ev, h.oper, name, key, blockName, err)
+		return err
+	}
+
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/SR/%s OK", h.ev, h.oper, name, key, blockName)
+	return nil
+}
+
+//
+// /network-instances/network-instance[name]/protocols/protocol[prkey]/<igp>/global/segment-routing/prefix-sids/prefix-sid[prefix]/config
+//
+func (h *NICreateVerifyHandler) SegmentRoutingPrefixSidConfig(name string, key *openconfig.NetworkInstanceProtocolKey, prefix string, config *openconfig.SegmentRoutingPrefixSidConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/SR/SID/%s %s", h.ev, h.oper, name, key, prefix, config)
+
+	if err := VerifyNISegmentRoutingProtocol(key); err != nil {
+		log.Errorf("NI/%s/%s/%s/PROTOS/%s/SR/SID/%s %s", h.ev, h.oper, name, key, prefix, err)
+		return err
+	}
+
+	if err := VerifyNISegmentRoutingPrefixSidConfig(prefix, config); err != nil {
+		log.Errorf("NI/%s/%s/%s/PROTOS/%s/SR/SID/%s %s", h.ev, h.oper, name, key, prefix, err)
+		return err
+	}
+
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/SR/SID/%s OK", h.ev, h.oper, name, key, prefix)
+	return nil
+}
+
+//
+// /network-instances/network-instance[name]/evpn/evpn-instances/evpn-instance[evi]/config
+//
+func (h *NICreateVerifyHandler) NetworkInstanceEvpnInstanceConfig(name string, evi string, config *openconfig.EvpnInstanceConfig) error {
+	log.Debugf("NI/%s/%s/%s/EVPN/%s/CONF %s", h.ev, h.oper, name, evi, config)
+
+	stored := getStoredEvpnInstanceConfig(name, evi)
+	if err := VerifyNIEvpnInstanceConfig(name, h.ni, evi, newEvpnInstanceConfig(stored, config)); err != nil {
+		log.Errorf("NI/%s/%s/%s/EVPN/%s/CONF %s", h.ev, h.oper, name, evi, err)
+		return err
+	}
+
+	log.Debugf("NI/%s/%s/%s/EVPN/%s/CONF OK", h.ev, h.oper, name, evi)
+	return nil
+}
+
+//
+// /network-instances/network-instance[name]/table-connections/table-connection[key]/config
+//
+func (h *NICreateVerifyHandler) TableConnectionConfig(name string, key *openconfig.TableConnectionKey, config *openconfig.TableConnectionConfig) error {
+	log.Debugf("NI/%s/%s/%s/TBLCONN/%s/CONF %s", h.ev, h.oper, name, key, config)
+
+	if err := VerifyNITableConnectionConfig(key, config); err != nil {
+		log.Errorf("NI/%s/%s/%s/TBLCONN/%s/CONF %s", h.ev, h.oper, name, key, err)
+		return err
+	}
+
+	log.Debugf("NI/%s/%s/%s/TBLCONN/%s/CONF OK", h.ev, h.oper, name, key)
+	return nil
+}
+
+//
+// /network-instances/network-instance[name]/mpls/signaling-protocols/ldp/global/address-families/ipv6/config
+//
+func (h *NICreateVerifyHandler) MplsLdpAddressFamilyV6Config(name string, config *openconfig.MplsLdpAddressFamilyV6Config) error {
+	log.Debugf("NI/%s/%s/%s/LDP/ADDR6 %s", h.ev, h.oper, name, config)
+
+	if err := VerifyNIMplsLdpAddressFamilyV6Config(config); err != nil {
+		log.Errorf("NI/%s/%s/%s/LDP/ADDR6 %s", h.ev, h.oper, name, err)
+		return err
+	}
+
+	log.Debugf("NI/%s/%s/%s/LDP/ADDR6 OK", h.ev, h.oper, name)
+	return nil
+}
+
+//
+// /network-instances/network-instance[name]/mpls/lsps/static-lsps/static-lsp[name]/<ingress|transit|egress>/config
+//
+func (h *NICreateVerifyHandler) MplsStaticLspPathConfig(name string, lspName string, pathName string, config *openconfig.MplsStaticLspPathConfig) error {
+	log.Debugf("NI/%s/%s/%s/LSP/%s/%s %s", h.ev, h.oper, name, lspName, pathName, config)
+
+	if err := VerifyNIMplsStaticLspPathConfig(pathName, config); err != nil {
+		log.Errorf("NI/%s/%s/%s/LSP/%s/%s %s", h.ev, h.oper, name, lspName, pathName, err)
+		return err
+	}
+
+	log.Debugf("NI/%s/%s/%s/LSP/%s/%s OK", h.ev, h.oper, name, lspName, pathName)
+	return nil
+}
+
+//
+// /network-instances/network-instance[name]/bfd/interfaces/interface[id]/config
+//
+func (h *NICreateVerifyHandler) BfdInterfaceConfig(name string, ifaceId string, config *openconfig.BfdInterfaceConfig) error {
+	log.Debugf("NI/%s/%s/%s/BFD/%s/CONF %s", h.ev, h.oper, name, ifaceId, config)
+
+	if err := VerifyNIBfdInterfaceConfig(ifaceId, config); err != nil {
+		log.Errorf("NI/%s/%s/%s/BFD/%s/CONF %s", h.ev, h.oper, name, ifaceId, err)
+		return err
+	}
+
+	log.Debugf("NI/%s/%s/%s/BFD/%s/CONF OK", h.ev, h.oper, name, ifaceId)
+	return nil
+}
+
 //
 // /network-instances/network-instance[name]/protocols/protocol[prkey]/bgp
 //
@@ -187,6 +394,19 @@ func (h *NICreateVerifyHandler) Bgp(name string, key *openconfig.NetworkInstance
 
 	}
 
+	stored := openconfig.NewBgp()
+	if proto, err := ncmdbm.NetworkInstances().SelectProtocol(name, key); err == nil {
+		stored = proto.Bgp
+	}
+
+	if err := VerifyBgpSessionChanges(bgp, stored); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s/%s", h.ev, h.oper, name, key, err)
+	}
+
+	if err := VerifyBgpPeerGroupRefs(bgp, stored); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s/%s", h.ev, h.oper, name, key, err)
+	}
+
 	if err := openconfig.ProcessBgp(h.Bgps, false, name, key, bgp); err != nil {
 		log.Errorf("NI/%s/%s/%s/PROTOS/%s: Processor error. %s", h.ev, h.oper, name, key, err)
 		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s: Processor error. %s", h.ev, h.oper, name, key, err)
@@ -201,6 +421,9 @@ func (h *NICreateVerifyHandler) Bgp(name string, key *openconfig.NetworkInstance
 func (h *NICreateVerifyHandler) BgpNeighborApplyPolicyConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, config *openconfig.PolicyApplyConfig) error {
 	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/APPLYPOL: %s", h.ev, h.oper, name, key, addr, config)
 
+	sets := ncmdbm.PolicyDefinedSets().Get()
+	h.Bgps.SetDefinedSets(sets)
+
 	if config.GetChange(openconfig.POLICYAPPLY_IMPORT_KEY) {
 		for _, polName := range config.ImportPolicy {
 			pol, err := ncmdbm.PolicyDefinitions().Select(polName)
@@ -227,5 +450,30 @@ func (h *NICreateVerifyHandler) BgpNeighborApplyPolicyConfig(name string, key *o
 		}
 	}
 
+	if config.OneOfChange(openconfig.POLICYAPPLY_IMPORT_KEY, openconfig.POLICYAPPLY_EXPORT_KEY) {
+		if err := openconfig.ProcessPolicyDefinedSets(h.Bgps, false, sets); err != nil {
+			return fmt.Errorf("DefinedSets process error. %s", err)
+		}
+
+		polNames := append(append([]string{}, config.ImportPolicy...), config.ExportPolicy...)
+		for _, polName := range polNames {
+			pol, err := ncmdbm.PolicyDefinitions().Select(polName)
+			if err != nil {
+				return fmt.Errorf("Policy not found in Policy module. %s %s", polName, err)
+			}
+
+			if err := VerifyPolicyDefinition(pol, sets); err != nil {
+				return fmt.Errorf("Policy verify error. %s %s", polName, err)
+			}
+		}
+	}
+
 	return nil
 }
+
+//
+// /network-instances/network-instance[name]/protocols/protocol[prkey]/bgp/peer-groups/peer-group[name]/apply-policy/config
+//
+func (h *NICreateVerifyHandler) BgpPeerGroupApplyPolicyConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, config *openconfig.PolicyApplyConfig) error {
+	return h.BgpNeighborApplyPolicyConfig(name, key, pgName, config)
+}
@@ -95,8 +95,20 @@ func (h *NIDeleteApplyHandler) NetworkInstanceInterfaceConfig(name string, id st
 	log.Debugf("NI/%s/%s/%s/%s/CONF: %s", h.ev, h.oper, name, id, config)
 
 	subif, device, _ := ncmdbm.Subinterfaces().SelectById(id)
+	lag, members := selectAggregate(device, subif)
+	bridge := selectBridge(device, subif)
 
 	if config.GetChanges(openconfig.INTERFACE_KEY, openconfig.SUBINTERFACE_KEY) {
+		// ipv4 and ipv6 groups of the same vrid are the same virtual router in vrrpd.
+		vrids := map[uint8]struct{}{}
+		for _, group := range getVrrpGroups(subif) {
+			if _, ok := vrids[group.VirtualRouterId]; !ok {
+				vrids[group.VirtualRouterId] = struct{}{}
+				AddNIVrrpCmd(h, name, id, group.VirtualRouterId, nil, false)
+			}
+			AddNIVrrpMacvlanCmd(h, name, getNetworkDevice(device, subif), id, group.Config, false)
+		}
+
 		for _, ifv4 := range subif.IPv4.Addresses {
 			AddNIVtyInterfaceCmd(h, name, id, "ip address", ifv4.Config.IFAddr(), false)
 		}
@@ -104,19 +116,49 @@ func (h *NIDeleteApplyHandler) NetworkInstanceInterfaceConfig(name string, id st
 			AddNIVtyInterfaceCmd(h, name, id, "ipv6 address", ifv6.Config.IFAddr(), false)
 		}
 
+		iface, _ := ncmdbm.Interfaces().Select(device)
+		if _, desc := getInterfaceState(iface, subif); len(desc) != 0 {
+			AddNIVtyInterfaceCmd(h, name, id, "description", "", false)
+		}
+
 		AddNIVtyInterfaceCmd(h, name, id, "shutdown", "", true)
 	}
 
 	if config.GetChange(openconfig.OC_ID_KEY) {
 		AddNIInterfaceSysctlCmd(h, name, id, false)
-		AddNIInterfaceNetworkCmd(h, name, device, subif, false)
 
-		if subif.Index == 0 {
-			AddNIContainerInterfaceCmd(h, name, id, "", h.mtu, false)
+		switch {
+		case lag != nil:
+			if subif.Index != 0 {
+				AddNIInterfaceNetworkCmd(h, name, device, subif, false)
+			}
+			if h.DetachLag(name, device) {
+				parent := selectAggregateParent(lag)
+				AddNIBondNetworkCmd(h, name, device, parent, lag.Aggregation.Config, members, false)
+				for _, member := range members {
+					AddNIContainerInterfaceCmd(h, name, member.Name, "", h.mtu, false)
+				}
+			}
+
+		case bridge != nil:
+			AddNIBridgeNetworkCmd(h, name, device, subif, bridge.Bridge.Config, false)
+
+		default:
+			AddNIInterfaceNetworkCmd(h, name, device, subif, false)
+			if subif.Index == 0 {
+				AddNIContainerInterfaceCmd(h, name, id, "", h.mtu, false)
+			}
 		}
 
 	} else if config.GetChanges(openconfig.SUBINTERFACE_KEY) {
-		AddNIInterfaceNetworkCmd(h, name, device, subif, false)
+		switch {
+		case lag != nil && subif.Index == 0:
+			AddNIBondNetworkCmd(h, name, device, subif, lag.Aggregation.Config, members, false)
+		case bridge != nil:
+			AddNIBridgeNetworkCmd(h, name, device, subif, bridge.Bridge.Config, false)
+		default:
+			AddNIInterfaceNetworkCmd(h, name, device, subif, false)
+		}
 	}
 
 	log.Debugf("NI/%s/%s/%s/%s: OK", h.ev, h.oper, name, id)
@@ -130,6 +172,41 @@ func (h *NIDeleteApplyHandler) Ospfv2GlobalConfig(name string, key *openconfig.N
 		AddNIOspfRouterCmd(h, name, "router-id", config.RouterId, false)
 	}
 
+	if config.DefaultInformationOriginate {
+		AddNIOspfRouterCmd(h, name, "default-information", "originate", false)
+	}
+
+	return nil
+}
+
+func (h *NIDeleteApplyHandler) Ospfv2RedistributionConfig(name string, key *openconfig.NetworkInstanceProtocolKey, proto string, config *openconfig.Ospfv2RedistributionConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/REDIST/%s/CONF: %s", h.ev, h.oper, name, key, proto, config)
+
+	if config.GetChange(openconfig.OSPFV2_PROTOCOL_KEY) {
+		redist, err := getOspfv2RedistributeProtocol(proto)
+		if err != nil {
+			return err
+		}
+		AddNIOspfRouterCmd(h, name, "redistribute", redist, false)
+	}
+
+	return nil
+}
+
+func (h *NIDeleteApplyHandler) Ospfv2AreaConfig(name string, key *openconfig.NetworkInstanceProtocolKey, areaId string, config *openconfig.Ospfv2AreaConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/CONF: %s", h.ev, h.oper, name, key, areaId, config)
+
+	if config.GetChange(openconfig.OSPFV2_AREA_TYPE_KEY) {
+		areaType, _, err := getOspfv2AreaType(config.AreaType)
+		if err != nil {
+			return err
+		}
+
+		if len(areaType) != 0 {
+			AddNIOspfRouterCmd(h, name, fmt.Sprintf("area %s", areaId), areaType, false)
+		}
+	}
+
 	return nil
 }
 
@@ -153,6 +230,10 @@ func (h *NIDeleteApplyHandler) Ospfv2InterfaceConfig(name string, key *openconfi
 		AddNIVtyInterfaceCmd(h, name, ifaceId, "ip ospf network", n, false)
 	}
 
+	if config.GetChange(openconfig.OSPFV2_ENABLE_BFD_KEY) && config.EnableBfd {
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "ip ospf bfd", "", false)
+	}
+
 	return nil
 }
 
@@ -180,6 +261,31 @@ func (h *NIDeleteApplyHandler) Ospfv2InterfaceTimers(name string, key *openconfi
 	return nil
 }
 
+func (h *NIDeleteApplyHandler) Ospfv2InterfaceAuthenticationConfig(name string, key *openconfig.NetworkInstanceProtocolKey, areaId string, ifaceId string, config *openconfig.Ospfv2InterfaceAuthenticationConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s/AUTH: %s", h.ev, h.oper, name, key, areaId, ifaceId, config)
+
+	if config.Enabled {
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "ip ospf authentication", "message-digest", false)
+	}
+
+	if config.GetChange(openconfig.OSPFV2_AUTH_KEY_ID_KEY) {
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "ip ospf message-digest-key", config.KeyId, false)
+	}
+
+	return nil
+}
+
+func (h *NIDeleteApplyHandler) Ospfv2AreaRangeConfig(name string, nikey *openconfig.NetworkInstanceProtocolKey, areaId string, rngkey *openconfig.Ospfv2AreaRangeKey, config *openconfig.Ospfv2AreaRangeConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s/CONF %s", h.ev, h.oper, name, nikey, areaId, rngkey, config)
+
+	if config.GetChanges(openconfig.OSPFV2_RANGE_IP_KEY, openconfig.OSPFV2_RANGE_PREFIXLEN_KEY) {
+		cmd := fmt.Sprintf("area %s range", areaId)
+		AddNIOspfRouterCmd(h, name, cmd, config.IPNet().String(), false)
+	}
+
+	return nil
+}
+
 func (h *NIDeleteApplyHandler) Ospfv3GlobalConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.Ospfv3GlobalConfig) error {
 	log.Debugf("NI/%s/%s/%s/PROTOS/%s/CONF: %s", h.ev, h.oper, name, key, config)
 
@@ -249,6 +355,130 @@ func (h *NIDeleteApplyHandler) Ospfv3AreaRangeConfig(name string, nikey *opencon
 	return nil
 }
 
+func (h *NIDeleteApplyHandler) NetworkInstanceProtocol(name string, key *openconfig.NetworkInstanceProtocolKey, proto *openconfig.NetworkInstanceProtocol) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s: %s", h.ev, h.oper, name, key, proto)
+
+	if key.Ident == openconfig.INSTALL_PROTOCOL_ISIS {
+		AddNIIsisCmd(h, name, key.Name, false)
+	}
+
+	return nil
+}
+
+func (h *NIDeleteApplyHandler) IsisGlobalConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.IsisGlobalConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/GLOBAL/CONF: %s", h.ev, h.oper, name, key, config)
+
+	if config.GetChange(openconfig.ISIS_NET_KEY) {
+		for _, net := range config.Net {
+			AddNIIsisRouterCmd(h, name, key.Name, "net", net, false)
+		}
+	}
+
+	if config.GetChange(openconfig.ISIS_LEVEL_CAPABILITY_KEY) {
+		level, _ := getIsisLevelType(config.LevelCapability)
+		AddNIIsisRouterCmd(h, name, key.Name, "is-type", level, false)
+	}
+
+	return nil
+}
+
+func (h *NIDeleteApplyHandler) IsisLevelAuthenticationConfig(name string, key *openconfig.NetworkInstanceProtocolKey, levelNum string, config *openconfig.IsisAuthenticationConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/LEVEL/%s/AUTH: %s", h.ev, h.oper, name, key, levelNum, config)
+
+	if config.OneOfChange(openconfig.OC_ENABLED_KEY, openconfig.ISIS_AUTH_MODE_KEY, openconfig.ISIS_AUTH_PASSWORD_KEY) {
+		cmd, _ := getIsisLevelPasswordCmd(levelNum)
+		AddNIIsisRouterCmd(h, name, key.Name, cmd, "", false)
+	}
+
+	return nil
+}
+
+func (h *NIDeleteApplyHandler) IsisInterfaceConfig(name string, key *openconfig.NetworkInstanceProtocolKey, ifaceId string, config *openconfig.IsisInterfaceConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/CONF: %s", h.ev, h.oper, name, key, ifaceId, config)
+
+	if config.GetChange(openconfig.ISIS_PASSIVE_KEY) {
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "isis passive", "", false)
+	}
+
+	if config.GetChange(openconfig.ISIS_CIRCUIT_TYPE_KEY) {
+		level, _ := getIsisLevelType(config.CircuitType)
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "isis circuit-type", level, false)
+	}
+
+	if config.GetChange(openconfig.ISIS_METRIC_KEY) {
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "isis metric", config.Metric, false)
+	}
+
+	return nil
+}
+
+func (h *NIDeleteApplyHandler) IsisInterfaceTimersConfig(name string, key *openconfig.NetworkInstanceProtocolKey, ifaceId string, config *openconfig.IsisInterfaceTimersConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/TIMERS: %s", h.ev, h.oper, name, key, ifaceId, config)
+
+	if config.GetChange(openconfig.ISIS_HELLO_INTERVAL_KEY) {
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "isis hello-interval", config.HelloInterval, false)
+	}
+
+	if config.GetChange(openconfig.ISIS_HELLO_MULTIPLIER_KEY) {
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "isis hello-multiplier", config.HelloMultiplier, false)
+	}
+
+	return nil
+}
+
+func (h *NIDeleteApplyHandler) IsisInterfaceAfConfig(name string, key *openconfig.NetworkInstanceProtocolKey, ifaceId string, afiName string, config *openconfig.IsisInterfaceAfConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s: %s", h.ev, h.oper, name, key, ifaceId, afiName, config)
+
+	if config.GetChange(openconfig.OC_ENABLED_KEY) {
+		afi, _ := openconfig.ParseIsisAfiType(afiName)
+		cmd, _ := getIsisAfiCmd(afi)
+		AddNIVtyInterfaceCmd(h, name, ifaceId, cmd, key.Name, false)
+	}
+
+	return nil
+}
+
+func (h *NIDeleteApplyHandler) IsisInterfaceAuthenticationConfig(name string, key *openconfig.NetworkInstanceProtocolKey, ifaceId string, config *openconfig.IsisAuthenticationConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/AUTH: %s", h.ev, h.oper, name, key, ifaceId, config)
+
+	if config.OneOfChange(openconfig.OC_ENABLED_KEY, openconfig.ISIS_AUTH_MODE_KEY, openconfig.ISIS_AUTH_PASSWORD_KEY) {
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "isis password", "", false)
+	}
+
+	return nil
+}
+
+func (h *NIDeleteApplyHandler) SegmentRoutingConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.SegmentRoutingConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/SR/CONF: %s", h.ev, h.oper, name, key, config)
+
+	if config.GetChange(openconfig.OC_ENABLED_KEY) && config.Enabled {
+		AddNISegmentRoutingCmd(h, name, key, "on", "", false)
+	}
+
+	return nil
+}
+
+func (h *NIDeleteApplyHandler) SegmentRoutingBlockConfig(name string, key *openconfig.NetworkInstanceProtocolKey, blockName string, config *openconfig.SegmentRoutingBlockConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/SR/%s: %s", h.ev, h.oper, name, key, blockName, config)
+
+	if config.OneOfChange(openconfig.SR_LOWER_BOUND_KEY, openconfig.SR_UPPER_BOUND_KEY) {
+		cmd, _ := getSegmentRoutingBlockCmd(blockName)
+		AddNISegmentRoutingCmd(h, name, key, cmd, "", false)
+	}
+
+	return nil
+}
+
+func (h *NIDeleteApplyHandler) SegmentRoutingPrefixSidConfig(name string, key *openconfig.NetworkInstanceProtocolKey, prefix string, config *openconfig.SegmentRoutingPrefixSidConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/SR/SID/%s: %s", h.ev, h.oper, name, key, prefix, config)
+
+	if config.GetChange(openconfig.SR_SID_ID_KEY) {
+		AddNISegmentRoutingCmd(h, name, key, "prefix", prefix, false)
+	}
+
+	return nil
+}
+
 func (h *NIDeleteApplyHandler) MplsInterfaceAttrRefConfig(name string, ifaceId string, config *openconfig.InterfaceRefConfig) error {
 	log.Debugf("NI/%s/%s/%s/MPLS/%s/REF: %s", h.ev, h.oper, name, ifaceId, config)
 
@@ -257,6 +487,50 @@ func (h *NIDeleteApplyHandler) MplsInterfaceAttrRefConfig(name string, ifaceId s
 	return nil
 }
 
+func (h *NIDeleteApplyHandler) MplsStaticLspPathConfig(name string, lspName string, pathName string, config *openconfig.MplsStaticLspPathConfig) error {
+	log.Debugf("NI/%s/%s/%s/LSP/%s/%s: %s", h.ev, h.oper, name, lspName, pathName, config)
+
+	if pathName == openconfig.MPLS_LSP_INGRESS_KEY {
+		// ip route must be removed with the stored next-hop and label.
+		stored := getStoredMplsStaticLspPathConfig(name, lspName, pathName)
+		if config.OneOfChange(openconfig.MPLS_LSP_NEXTHOP_KEY, openconfig.MPLS_LSP_PUSH_LABEL_KEY, openconfig.MPLS_LSP_DESTINATION_KEY) && stored.GetChanges(openconfig.MPLS_LSP_NEXTHOP_KEY, openconfig.MPLS_LSP_PUSH_LABEL_KEY, openconfig.MPLS_LSP_DESTINATION_KEY) {
+			nexthop, err := getMplsLspIngressNexthop(stored)
+			if err != nil {
+				return err
+			}
+
+			AddNIStaticRouteCmd(h, name, stored.Destination.String(), nexthop, false)
+		}
+
+		return nil
+	}
+
+	if config.GetChange(openconfig.MPLS_LSP_INCOMING_LABEL_KEY) {
+		AddNIMplsLspCmd(h, name, config.IncomingLabel, "", "", false)
+	}
+
+	return nil
+}
+
+func (h *NIDeleteApplyHandler) BfdInterfaceConfig(name string, ifaceId string, config *openconfig.BfdInterfaceConfig) error {
+	log.Debugf("NI/%s/%s/%s/BFD/%s/CONF: %s", h.ev, h.oper, name, ifaceId, config)
+
+	if config.GetChange(openconfig.OC_ID_KEY) {
+		AddNIBfdCmd(h, name, "profile", ifaceId, nil, false)
+		return nil
+	}
+
+	for _, args := range getBfdProfileArgs(config) {
+		AddNIBfdCmd(h, name, "profile", ifaceId, args, false)
+	}
+
+	if config.GetChange(openconfig.OC_ENABLED_KEY) && !config.Enabled {
+		AddNIBfdCmd(h, name, "profile", ifaceId, []string{"shutdown"}, false)
+	}
+
+	return nil
+}
+
 func (h *NIDeleteApplyHandler) MplsLdpConfig(name string, config *openconfig.MplsLdpConfig) error {
 	log.Debugf("NI/%s/%s/%s/LDP/CONF: %s", h.ev, h.oper, name, config)
 
@@ -264,6 +538,10 @@ func (h *NIDeleteApplyHandler) MplsLdpConfig(name string, config *openconfig.Mpl
 		AddNIMplsLdpCmd(h, name, "router-id", config.LsrId, false)
 	}
 
+	if config.GetChange(openconfig.MPLS_LDP_DUALSTACK_PREFER_KEY) {
+		AddNIMplsLdpCmd(h, name, "dual-stack transport-connection prefer", "ipv4", false)
+	}
+
 	return nil
 }
 
@@ -286,6 +564,25 @@ func (h *NIDeleteApplyHandler) MplsLdpAddressFamilyV4Config(name string, config
 	return nil
 }
 
+func (h *NIDeleteApplyHandler) MplsLdpAddressFamilyV6Config(name string, config *openconfig.MplsLdpAddressFamilyV6Config) error {
+	log.Debugf("NI/%s/%s/%s/LDP/ADDR6: %s", h.ev, h.oper, name, config)
+
+	if config.GetChange(openconfig.MPLS_LDP_AF_TARNSADDR_KEY) {
+		AddNIMplsLdpIPv6Cmd(h, name, "discovery transport-address", config.TransportAddr, false)
+	}
+
+	if config.GetChange(openconfig.MPLS_LDP_AF_SESSION_HOLDTIME_KEY) {
+		AddNIMplsLdpIPv6Cmd(h, name, "session holdtime", config.SessionHoldTime, false)
+	}
+
+	explicitNll := config.LabelPolicy.Advertise.EngressExplicitNull
+	if explicitNll.GetChange(openconfig.MPLS_LDP_LABELPOLICY_ENABLE_KEY) {
+		AddNIMplsLdpIPv6Cmd(h, name, "label local advertise explicit-null", "", false)
+	}
+
+	return nil
+}
+
 func (h *NIDeleteApplyHandler) MplsLdpDiscovInterfacesConfig(name string, config *openconfig.MplsLdpDiscovInterfacesConfig) error {
 	log.Debugf("NI/%s/%s/%s/LDP/DISC/IFCONF: %s", h.ev, h.oper, name, config)
 
@@ -320,27 +617,105 @@ func (h *NIDeleteApplyHandler) MplsLdpInterfaceConfig(name string, ifaceId strin
 	return nil
 }
 
+func (h *NIDeleteApplyHandler) MplsLdpInterfaceAfConfig(name string, ifaceId string, afName string, config *openconfig.MplsLdpInterfaceAfConfig) error {
+	log.Debugf("NI/%s/%s/%s/LDP/DISC/%s/%s: %s", h.ev, h.oper, name, ifaceId, afName, config)
+
+	if config.GetChange(openconfig.MPLS_LDP_AF_ENABLE_KEY) {
+		// ipv4 is enabled and ipv6 is disabled by default.
+		if defaultEnable := afName == openconfig.MPLS_LDP_AF_IPV4_KEY; config.Enable != defaultEnable {
+			AddNIMplsLdpIfaceAfCmd(h, name, ifaceId, afName, defaultEnable)
+		}
+	}
+
+	return nil
+}
+
+func (h *NIDeleteApplyHandler) StaticRouteConfig(name string, prkey *openconfig.NetworkInstanceProtocolKey, rtkey *openconfig.StaticRouteKey, config *openconfig.StaticRouteConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/CONF: %s", h.ev, h.oper, name, prkey, rtkey, config)
+
+	if config.GetChange(openconfig.STATICROUTE_DESC_KEY) {
+		AddNIStaticRouteDescCmd(h, name, rtkey.String(), config.Description, false)
+	}
+
+	return nil
+}
+
 func (h *NIDeleteApplyHandler) StaticRouteNexthop(name string, prkey *openconfig.NetworkInstanceProtocolKey, rtkey *openconfig.StaticRouteKey, index string, nexthop *openconfig.StaticRouteNexthop) error {
 	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s: %s", h.ev, h.oper, name, prkey, rtkey, index, nexthop)
 
-	ip, nhtype, _ := nexthop.Config.GetNexthop()
-	switch nhtype {
-	case openconfig.LOCAL_DEFINED_NEXT_HOP_LOCAL_LINK:
-		AddNIStaticRouteCmd(h, name, rtkey.String(), nexthop.IfaceRef.Config.IFName(), false)
-	case openconfig.LOCAL_DEFINED_NEXT_HOP_DROP:
-		AddNIStaticRouteCmd(h, name, rtkey.String(), "nill0", false)
-	default:
-		AddNIStaticRouteCmd(h, name, rtkey.String(), ip.String(), false)
+	// ip route must be removed with the options it was added with.
+	stored, err := getStoredStaticRouteNexthop(name, prkey, rtkey, index)
+	if err != nil {
+		return err
+	}
+
+	args := append(getStaticRouteNexthop(stored), getStaticRouteOptions(stored)...)
+	AddNIStaticRouteCmd(h, name, rtkey.String(), args, false)
+
+	return nil
+}
+
+func (h *NIDeleteApplyHandler) NetworkInstanceEvpnInstanceConfig(name string, evi string, config *openconfig.EvpnInstanceConfig) error {
+	log.Debugf("NI/%s/%s/%s/EVPN/%s/CONF: %s", h.ev, h.oper, name, evi, config)
+
+	if config.GetChange(openconfig.EVPN_EVI_KEY) {
+		AddNIEvpnCmd(h, name, evi, config, false)
 	}
 
 	return nil
 }
 
+func (h *NIDeleteApplyHandler) TableConnectionConfig(name string, key *openconfig.TableConnectionKey, config *openconfig.TableConnectionConfig) error {
+	log.Debugf("NI/%s/%s/%s/TBLCONN/%s/CONF: %s", h.ev, h.oper, name, key, config)
+
+	if !config.OneOfChange(openconfig.TBLCONN_SRC_PROTO_KEY, openconfig.TBLCONN_DST_PROTO_KEY, openconfig.TBLCONN_AF_KEY) {
+		// default-import-policy is reset to REJECT_ROUTE.
+		if config.GetChange(openconfig.TBLCONN_IMPORT_DEF_KEY) && key.DstProtocol != openconfig.INSTALL_PROTOCOL_BGP {
+			if stored := selectNITableConnectionConfig(name, key); len(stored.ImportPolicy) != 0 {
+				AddNITableConnectionDefaultCmd(h, name, key, openconfig.POLICY_DEFAULT_REJECT_ROUTE)
+			}
+		}
+		return nil
+	}
+
+	if key.DstProtocol == openconfig.INSTALL_PROTOCOL_BGP {
+		if !h.DetachRedist(name, key.SrcProtocol) {
+			log.Debugf("NI/%s/%s/%s/TBLCONN/%s/CONF: redistributed by other table-connections.", h.ev, h.oper, name, key)
+			return nil
+		}
+
+		h.Bgps.TableConnectionConfig(name, key, config)
+		AddNIBgpConfigCmd(h, name, h.Bgps.Bytes(), false, false)
+
+		h.TraceBgps(fmt.Sprintf("NI/%s/%s/%s/TBLCONN/%s/CONF:", h.ev, h.oper, name, key))
+		return nil
+	}
+
+	redist, err := getTableConnectionRedistributeProtocol(key)
+	if err != nil {
+		return err
+	}
+	AddNITableConnectionCmd(h, name, key, redist, false)
+
+	rmap, err := getTableConnectionRouteMap(key, config)
+	if err != nil {
+		log.Warnf("NI/%s/%s/%s/TBLCONN/%s/CONF: prefix-lists may be left. %s", h.ev, h.oper, name, key, err)
+	}
+	AddNIRouteMapCmd(h, name, rmap, false)
+
+	return nil
+}
+
 func (h *NIDeleteApplyHandler) Bgp(name string, key *openconfig.NetworkInstanceProtocolKey, bgp *openconfig.Bgp) error {
 	log.Debugf("NI/%s/%s/%s/PROTOS/%s: %s", h.ev, h.oper, name, key, bgp)
 
 	restart := bgp.Global.Config.OneOfChange(openconfig.BGP_AS_KEY, openconfig.BGP_ROUTERID_KEY)
 
+	// keep the route types redistributed by table-connections.
+	if config := bgp.Zebra.Config; config.GetChange(openconfig.BGP_ZEBRA_REDISTROUTES_KEY) {
+		config.RedistRoutes = h.DetachRedists(name, config.RedistRoutes)
+	}
+
 	openconfig.ProcessBgp(h.Bgps, false, name, key, bgp)
 	AddNIBgpConfigCmd(h, name, h.Bgps.Bytes(), restart, false)
 
@@ -352,6 +727,8 @@ func (h *NIDeleteApplyHandler) Bgp(name string, key *openconfig.NetworkInstanceP
 func (h *NIDeleteApplyHandler) BgpNeighborApplyPolicyConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, config *openconfig.PolicyApplyConfig) error {
 	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/APPLYPOL: %s", h.ev, h.oper, name, key, addr, config)
 
+	h.Bgps.SetDefinedSets(ncmdbm.PolicyDefinedSets().Get())
+
 	if config.GetChange(openconfig.POLICYAPPLY_IMPORT_KEY) {
 		for _, polName := range config.ImportPolicy {
 			pol, _ := ncmdbm.PolicyDefinitions().Select(polName)
@@ -372,3 +749,17 @@ func (h *NIDeleteApplyHandler) BgpNeighborApplyPolicyConfig(name string, key *op
 
 	return nil
 }
+
+func (h *NIDeleteApplyHandler) BgpNeighborEnableBfdConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, config *openconfig.EnableBfdConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/BFD: %s", h.ev, h.oper, name, key, addr, config)
+
+	if config.GetChange(openconfig.OC_ENABLED_KEY) && config.Enabled {
+		AddNIBgpNeighborBfdCmd(h, name, addr, false)
+	}
+
+	return nil
+}
+
+func (h *NIDeleteApplyHandler) BgpPeerGroupApplyPolicyConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, config *openconfig.PolicyApplyConfig) error {
+	return h.BgpNeighborApplyPolicyConfig(name, key, pgName, config)
+}
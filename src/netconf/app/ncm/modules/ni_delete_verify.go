@@ -19,6 +19,7 @@ package ncm
 
 import (
 	"fmt"
+	ncmdbm "netconf/app/ncm/dbm"
 	"netconf/lib/openconfig"
 	srlib "netconf/lib/sysrepo"
 
@@ -175,6 +176,20 @@ func (h *NIDeleteVerifyHandler) Ospfv3(name string, key *openconfig.NetworkInsta
 	return nil
 }
 
+//
+// /network-instances/network-instance[name]/protocols/protocol[prkey]/isis
+//
+func (h *NIDeleteVerifyHandler) Isis(name string, key *openconfig.NetworkInstanceProtocolKey, isis *openconfig.Isis) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s: %s", h.ev, h.oper, name, key, isis)
+
+	if key.Ident != openconfig.INSTALL_PROTOCOL_ISIS {
+		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s: Invalid Protocol identifier.", h.ev, h.oper, name, key)
+
+	}
+
+	return nil
+}
+
 //
 // /network-instances/network-instance[name]/protocols/protocol[prkey]/bgp
 //
@@ -186,6 +201,28 @@ func (h *NIDeleteVerifyHandler) Bgp(name string, key *openconfig.NetworkInstance
 
 	}
 
+	stored := openconfig.NewBgp()
+	if proto, err := ncmdbm.NetworkInstances().SelectProtocol(name, key); err == nil {
+		stored = proto.Bgp
+	}
+
+	if err := VerifyBgpPeerGroupDeletes(bgp, stored); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s/%s", h.ev, h.oper, name, key, err)
+	}
+
+	return nil
+}
+
+//
+// /network-instances/network-instance[name]/table-connections/table-connection[key]/config
+//
+func (h *NIDeleteVerifyHandler) TableConnectionConfig(name string, key *openconfig.TableConnectionKey, config *openconfig.TableConnectionConfig) error {
+	log.Debugf("NI/%s/%s/%s/TBLCONN/%s/CONF: %s", h.ev, h.oper, name, key, config)
+
+	if err := VerifyNITableConnectionImportPolicy(key, config); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/TBLCONN/%s/CONF: %s", h.ev, h.oper, name, key, err)
+	}
+
 	return nil
 }
 
@@ -199,3 +236,25 @@ func (h *NIDeleteVerifyHandler) BgpNeighborApplyPolicyConfig(name string, key *o
 
 	return nil
 }
+
+//
+// /network-instances/network-instance[name]/protocols/protocol[prkey]/bgp/peer-groups/peer-group[name]/apply-policy/config
+//
+func (h *NIDeleteVerifyHandler) BgpPeerGroupApplyPolicyConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, config *openconfig.PolicyApplyConfig) error {
+	return h.BgpNeighborApplyPolicyConfig(name, key, pgName, config)
+}
+
+//
+// /network-instances/network-instance[name]/evpn/evpn-instances/evpn-instance[evi]/config
+//
+func (h *NIDeleteVerifyHandler) NetworkInstanceEvpnInstanceConfig(name string, evi string, config *openconfig.EvpnInstanceConfig) error {
+	log.Debugf("NI/%s/%s/%s/EVPN/%s/CONF: %s", h.ev, h.oper, name, evi, config)
+
+	// evpn.conf has the whole evpn-instance only,
+	// so the leaves must be deleted with evpn-instance.
+	if !config.GetChange(openconfig.EVPN_EVI_KEY) {
+		return fmt.Errorf("NI/%s/%s/%s/EVPN/%s/CONF: do not delete the leaves of evpn-instance.", h.ev, h.oper, name, evi)
+	}
+
+	return nil
+}
@@ -18,6 +18,7 @@
 package ncm
 
 import (
+	"fmt"
 	"netconf/lib/openconfig"
 	srlib "netconf/lib/sysrepo"
 
@@ -53,6 +54,68 @@ func (h *NIModifyApplyHandler) Ospfv2GlobalConfig(name string, key *openconfig.N
 		AddNIOspfRouterCmd(h, name, "router-id", config.RouterId, true)
 	}
 
+	if config.GetChange(openconfig.OSPFV2_DEFAULT_INFO_ORIGINATE_KEY) {
+		AddNIOspfRouterCmd(h, name, "default-information", getOspfv2DefaultInformation(config), config.DefaultInformationOriginate)
+	} else if config.GetChange(openconfig.OSPFV2_DEFAULT_INFO_ALWAYS_KEY) {
+		// default-information-always is meaningful only if originated.
+		AddNIOspfRouterCmd(h, name, "default-information", getOspfv2DefaultInformation(config), true)
+	}
+
+	return nil
+}
+
+func (h *NIModifyApplyHandler) Ospfv2RedistributionConfig(name string, key *openconfig.NetworkInstanceProtocolKey, proto string, config *openconfig.Ospfv2RedistributionConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/REDIST/%s/CONF: %s", h.ev, h.oper, name, key, proto, config)
+
+	if config.OneOfChange(openconfig.OSPFV2_METRIC_KEY, openconfig.OSPFV2_METRIC_TYPE_KEY) {
+		// redistribute command replaces all options, so put changes
+		// to the stored config and re-emit all of them.
+		newConfig := openconfig.NewOspfv2RedistributionConfig()
+		if redist, ok := getStoredOspfv2(name, key).Global.Redistributions[proto]; ok {
+			newConfig = redist.Config
+		}
+
+		if config.GetChange(openconfig.OSPFV2_METRIC_KEY) {
+			newConfig.Metric = config.Metric
+			newConfig.SetChange(openconfig.OSPFV2_METRIC_KEY)
+		}
+
+		if config.GetChange(openconfig.OSPFV2_METRIC_TYPE_KEY) {
+			newConfig.MetricType = config.MetricType
+			newConfig.SetChange(openconfig.OSPFV2_METRIC_TYPE_KEY)
+		}
+
+		redist, err := getOspfv2Redistribute(proto, newConfig)
+		if err != nil {
+			return err
+		}
+		AddNIOspfRouterCmd(h, name, "redistribute", redist, true)
+	}
+
+	return nil
+}
+
+func (h *NIModifyApplyHandler) Ospfv2AreaConfig(name string, key *openconfig.NetworkInstanceProtocolKey, areaId string, config *openconfig.Ospfv2AreaConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/CONF: %s", h.ev, h.oper, name, key, areaId, config)
+
+	if config.GetChange(openconfig.OSPFV2_AREA_TYPE_KEY) {
+		areaType, areaTypeOpts, err := getOspfv2AreaType(config.AreaType)
+		if err != nil {
+			return err
+		}
+
+		area := fmt.Sprintf("area %s", areaId)
+		if stored, ok := getStoredOspfv2(name, key).Areas[areaId]; ok {
+			if oldType, _, err := getOspfv2AreaType(stored.Config.AreaType); err == nil && len(oldType) != 0 {
+				AddNIOspfRouterCmd(h, name, area, oldType, false)
+			}
+		}
+
+		if len(areaType) != 0 {
+			AddNIOspfRouterCmd(h, name, area, areaTypeOpts, true)
+		}
+	}
+
 	return nil
 }
 
@@ -76,6 +139,18 @@ func (h *NIModifyApplyHandler) Ospfv2InterfaceConfig(name string, key *openconfi
 		AddNIVtyInterfaceCmd(h, name, ifaceId, "ip ospf network", n, true)
 	}
 
+	if config.GetChange(openconfig.OSPFV2_ENABLE_BFD_KEY) {
+		if config.EnableBfd {
+			AddNIVtyDaemonCmd(h, name, NI_UPDATE_VTY_BFDD, "bfdd")
+		}
+
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "ip ospf bfd", "", config.EnableBfd)
+
+		if config.EnableBfd {
+			AddNIVtyInterfaceCmd(h, name, ifaceId, "ip ospf bfd profile", ifaceId, true)
+		}
+	}
+
 	return nil
 }
 
@@ -93,6 +168,53 @@ func (h *NIModifyApplyHandler) Ospfv2InterfaceTimers(name string, key *openconfi
 	return nil
 }
 
+func (h *NIModifyApplyHandler) Ospfv2InterfaceAuthenticationConfig(name string, key *openconfig.NetworkInstanceProtocolKey, areaId string, ifaceId string, config *openconfig.Ospfv2InterfaceAuthenticationConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s/AUTH: %s", h.ev, h.oper, name, key, areaId, ifaceId, config)
+
+	if config.OneOfChange(openconfig.OSPFV2_AUTH_KEY_ID_KEY, openconfig.OSPFV2_AUTH_AUTH_KEY_KEY) {
+		oldConfig := openconfig.NewOspfv2InterfaceAuthenticationConfig()
+		if area, ok := getStoredOspfv2(name, key).Areas[areaId]; ok {
+			if iface, ok := area.Interfaces[ifaceId]; ok {
+				oldConfig = iface.Authentication.Config
+			}
+		}
+
+		newKeyId, newAuthKey := oldConfig.KeyId, oldConfig.AuthKey
+		if config.GetChange(openconfig.OSPFV2_AUTH_KEY_ID_KEY) {
+			newKeyId = config.KeyId
+		}
+		if config.GetChange(openconfig.OSPFV2_AUTH_AUTH_KEY_KEY) {
+			newAuthKey = config.AuthKey
+		}
+
+		if oldConfig.GetChange(openconfig.OSPFV2_AUTH_KEY_ID_KEY) {
+			AddNIVtyInterfaceCmd(h, name, ifaceId, "ip ospf message-digest-key", oldConfig.KeyId, false)
+		}
+
+		if len(newAuthKey) != 0 {
+			md5Key := fmt.Sprintf("%d md5 %s", newKeyId, newAuthKey)
+			AddNIVtyInterfaceCmd(h, name, ifaceId, "ip ospf message-digest-key", md5Key, true)
+		}
+	}
+
+	if config.GetChange(openconfig.OC_ENABLED_KEY) {
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "ip ospf authentication", "message-digest", config.Enabled)
+	}
+
+	return nil
+}
+
+func (h *NIModifyApplyHandler) Ospfv2AreaRangeConfig(name string, nikey *openconfig.NetworkInstanceProtocolKey, areaId string, rngkey *openconfig.Ospfv2AreaRangeKey, config *openconfig.Ospfv2AreaRangeConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s/CONF %s", h.ev, h.oper, name, nikey, areaId, rngkey, config)
+
+	if config.GetChange(openconfig.OSPFV2_RANGE_ADVERTISE_KEY) {
+		cmd := fmt.Sprintf("area %s range", areaId)
+		AddNIOspfRouterCmd(h, name, cmd, getOspfv2AreaRange(rngkey.String(), config.Advertise), true)
+	}
+
+	return nil
+}
+
 func (h *NIModifyApplyHandler) Ospfv3GlobalConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.Ospfv3GlobalConfig) error {
 	log.Debugf("NI/%s/%s/%s/PROTOS/%s/CONF: %s", h.ev, h.oper, name, key, config)
 
@@ -140,6 +262,73 @@ func (h *NIModifyApplyHandler) Ospfv3InterfaceTimers(name string, key *openconfi
 	return nil
 }
 
+func (h *NIModifyApplyHandler) MplsStaticLspPathConfig(name string, lspName string, pathName string, config *openconfig.MplsStaticLspPathConfig) error {
+	log.Debugf("NI/%s/%s/%s/LSP/%s/%s: %s", h.ev, h.oper, name, lspName, pathName, config)
+
+	keys := []string{openconfig.MPLS_LSP_INCOMING_LABEL_KEY, openconfig.MPLS_LSP_NEXTHOP_KEY, openconfig.MPLS_LSP_PUSH_LABEL_KEY}
+	if pathName == openconfig.MPLS_LSP_INGRESS_KEY {
+		keys = []string{openconfig.MPLS_LSP_NEXTHOP_KEY, openconfig.MPLS_LSP_PUSH_LABEL_KEY, openconfig.MPLS_LSP_DESTINATION_KEY}
+	}
+
+	if !config.OneOfChange(keys...) {
+		return nil
+	}
+
+	stored := getStoredMplsStaticLspPathConfig(name, lspName, pathName)
+	newConfig := newMplsStaticLspPathConfig(stored, config)
+
+	if pathName == openconfig.MPLS_LSP_INGRESS_KEY {
+		if stored.GetChanges(keys...) {
+			nexthop, err := getMplsLspIngressNexthop(stored)
+			if err != nil {
+				return err
+			}
+
+			AddNIStaticRouteCmd(h, name, stored.Destination.String(), nexthop, false)
+		}
+
+		if newConfig.GetChanges(keys...) {
+			nexthop, err := getMplsLspIngressNexthop(newConfig)
+			if err != nil {
+				return err
+			}
+
+			AddNIStaticRouteCmd(h, name, newConfig.Destination.String(), nexthop, true)
+		}
+
+		return nil
+	}
+
+	if stored.GetChange(openconfig.MPLS_LSP_INCOMING_LABEL_KEY) {
+		AddNIMplsLspCmd(h, name, stored.IncomingLabel, "", "", false)
+	}
+
+	if newConfig.GetChanges(keys...) {
+		outLabel, err := getMplsLspOutLabel(newConfig.PushLabel)
+		if err != nil {
+			return err
+		}
+
+		AddNIMplsLspCmd(h, name, newConfig.IncomingLabel, newConfig.NextHop.String(), outLabel, true)
+	}
+
+	return nil
+}
+
+func (h *NIModifyApplyHandler) BfdInterfaceConfig(name string, ifaceId string, config *openconfig.BfdInterfaceConfig) error {
+	log.Debugf("NI/%s/%s/%s/BFD/%s/CONF: %s", h.ev, h.oper, name, ifaceId, config)
+
+	for _, args := range getBfdProfileArgs(config) {
+		AddNIBfdCmd(h, name, "profile", ifaceId, args, true)
+	}
+
+	if config.GetChange(openconfig.OC_ENABLED_KEY) {
+		AddNIBfdCmd(h, name, "profile", ifaceId, []string{"shutdown"}, !config.Enabled)
+	}
+
+	return nil
+}
+
 func (h *NIModifyApplyHandler) MplsLdpConfig(name string, config *openconfig.MplsLdpConfig) error {
 	log.Debugf("NI/%s/%s/%s/LDP/CONF: %s", h.ev, h.oper, name, config)
 
@@ -147,6 +336,10 @@ func (h *NIModifyApplyHandler) MplsLdpConfig(name string, config *openconfig.Mpl
 		AddNIMplsLdpCmd(h, name, "router-id", config.LsrId, true)
 	}
 
+	if config.GetChange(openconfig.MPLS_LDP_DUALSTACK_PREFER_KEY) {
+		AddNIMplsLdpCmd(h, name, "dual-stack transport-connection prefer", "ipv4", config.DualStackPref == openconfig.MPLS_LDP_TRANSPORT_PREFER_IPV4)
+	}
+
 	return nil
 }
 
@@ -169,6 +362,25 @@ func (h *NIModifyApplyHandler) MplsLdpAddressFamilyV4Config(name string, config
 	return nil
 }
 
+func (h *NIModifyApplyHandler) MplsLdpAddressFamilyV6Config(name string, config *openconfig.MplsLdpAddressFamilyV6Config) error {
+	log.Debugf("NI/%s/%s/%s/LDP/ADDR6: %s", h.ev, h.oper, name, config)
+
+	if config.GetChange(openconfig.MPLS_LDP_AF_TARNSADDR_KEY) {
+		AddNIMplsLdpIPv6Cmd(h, name, "discovery transport-address", config.TransportAddr, true)
+	}
+
+	if config.GetChange(openconfig.MPLS_LDP_AF_SESSION_HOLDTIME_KEY) {
+		AddNIMplsLdpIPv6Cmd(h, name, "session holdtime", config.SessionHoldTime, true)
+	}
+
+	explicitNll := config.LabelPolicy.Advertise.EngressExplicitNull
+	if explicitNll.GetChange(openconfig.MPLS_LDP_LABELPOLICY_ENABLE_KEY) {
+		AddNIMplsLdpIPv6Cmd(h, name, "label local advertise explicit-null", "", explicitNll.Enable)
+	}
+
+	return nil
+}
+
 func (h *NIModifyApplyHandler) MplsLdpDiscovInterfacesConfig(name string, config *openconfig.MplsLdpDiscovInterfacesConfig) error {
 	log.Debugf("NI/%s/%s/%s/LDP/DISC/IFCONF: %s", h.ev, h.oper, name, config)
 
@@ -196,3 +408,218 @@ func (h *NIModifyApplyHandler) MplsLdpInterfaceConfig(name string, ifaceId strin
 
 	return nil
 }
+
+func (h *NIModifyApplyHandler) MplsLdpInterfaceAfConfig(name string, ifaceId string, afName string, config *openconfig.MplsLdpInterfaceAfConfig) error {
+	log.Debugf("NI/%s/%s/%s/LDP/DISC/%s/%s: %s", h.ev, h.oper, name, ifaceId, afName, config)
+
+	if config.GetChange(openconfig.MPLS_LDP_AF_ENABLE_KEY) {
+		AddNIMplsLdpIfaceAfCmd(h, name, ifaceId, afName, config.Enable)
+	}
+
+	return nil
+}
+
+func (h *NIModifyApplyHandler) IsisGlobalConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.IsisGlobalConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/GLOBAL/CONF: %s", h.ev, h.oper, name, key, config)
+
+	if config.GetChange(openconfig.ISIS_LEVEL_CAPABILITY_KEY) {
+		level, _ := getIsisLevelType(config.LevelCapability)
+		AddNIIsisRouterCmd(h, name, key.Name, "is-type", level, true)
+	}
+
+	return nil
+}
+
+func (h *NIModifyApplyHandler) IsisLevelAuthenticationConfig(name string, key *openconfig.NetworkInstanceProtocolKey, levelNum string, config *openconfig.IsisAuthenticationConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/LEVEL/%s/AUTH: %s", h.ev, h.oper, name, key, levelNum, config)
+
+	if config.OneOfChange(openconfig.OC_ENABLED_KEY, openconfig.ISIS_AUTH_MODE_KEY, openconfig.ISIS_AUTH_PASSWORD_KEY) {
+		cmd, _ := getIsisLevelPasswordCmd(levelNum)
+		passwd, _ := getIsisPassword(config)
+		AddNIIsisRouterCmd(h, name, key.Name, cmd, passwd, config.Enabled)
+	}
+
+	return nil
+}
+
+func (h *NIModifyApplyHandler) IsisInterfaceConfig(name string, key *openconfig.NetworkInstanceProtocolKey, ifaceId string, config *openconfig.IsisInterfaceConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/CONF: %s", h.ev, h.oper, name, key, ifaceId, config)
+
+	if config.GetChange(openconfig.ISIS_PASSIVE_KEY) {
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "isis passive", "", config.Passive)
+	}
+
+	if config.GetChange(openconfig.ISIS_CIRCUIT_TYPE_KEY) {
+		level, _ := getIsisLevelType(config.CircuitType)
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "isis circuit-type", level, true)
+	}
+
+	if config.GetChange(openconfig.ISIS_METRIC_KEY) {
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "isis metric", config.Metric, true)
+	}
+
+	return nil
+}
+
+func (h *NIModifyApplyHandler) IsisInterfaceTimersConfig(name string, key *openconfig.NetworkInstanceProtocolKey, ifaceId string, config *openconfig.IsisInterfaceTimersConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/TIMERS: %s", h.ev, h.oper, name, key, ifaceId, config)
+
+	if config.GetChange(openconfig.ISIS_HELLO_INTERVAL_KEY) {
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "isis hello-interval", config.HelloInterval, true)
+	}
+
+	if config.GetChange(openconfig.ISIS_HELLO_MULTIPLIER_KEY) {
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "isis hello-multiplier", config.HelloMultiplier, true)
+	}
+
+	return nil
+}
+
+func (h *NIModifyApplyHandler) IsisInterfaceAfConfig(name string, key *openconfig.NetworkInstanceProtocolKey, ifaceId string, afiName string, config *openconfig.IsisInterfaceAfConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s: %s", h.ev, h.oper, name, key, ifaceId, afiName, config)
+
+	if config.GetChange(openconfig.OC_ENABLED_KEY) {
+		afi, _ := openconfig.ParseIsisAfiType(afiName)
+		cmd, _ := getIsisAfiCmd(afi)
+		AddNIVtyInterfaceCmd(h, name, ifaceId, cmd, key.Name, config.Enabled)
+	}
+
+	return nil
+}
+
+func (h *NIModifyApplyHandler) IsisInterfaceAuthenticationConfig(name string, key *openconfig.NetworkInstanceProtocolKey, ifaceId string, config *openconfig.IsisAuthenticationConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/AUTH: %s", h.ev, h.oper, name, key, ifaceId, config)
+
+	if config.OneOfChange(openconfig.OC_ENABLED_KEY, openconfig.ISIS_AUTH_MODE_KEY, openconfig.ISIS_AUTH_PASSWORD_KEY) {
+		passwd, _ := getIsisPassword(config)
+		AddNIVtyInterfaceCmd(h, name, ifaceId, "isis password", passwd, config.Enabled)
+	}
+
+	return nil
+}
+
+func (h *NIModifyApplyHandler) SegmentRoutingConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.SegmentRoutingConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/SR/CONF: %s", h.ev, h.oper, name, key, config)
+
+	if config.GetChange(openconfig.OC_ENABLED_KEY) {
+		if config.Enabled && key.Ident == openconfig.INSTALL_PROTOCOL_OSPF {
+			// ospfd distributes SIDs by Router Information Opaque LSA.
+			AddNIOspfRouterCmd(h, name, "capability", "opaque", true)
+			AddNIOspfRouterCmd(h, name, "router-info", "area", true)
+		}
+		AddNISegmentRoutingCmd(h, name, key, "on", "", config.Enabled)
+	}
+
+	return nil
+}
+
+func (h *NIModifyApplyHandler) SegmentRoutingBlockConfig(name string, key *openconfig.NetworkInstanceProtocolKey, blockName string, config *openconfig.SegmentRoutingBlockConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/SR/%s: %s", h.ev, h.oper, name, key, blockName, config)
+
+	if config.GetChanges(openconfig.SR_LOWER_BOUND_KEY, openconfig.SR_UPPER_BOUND_KEY) {
+		cmd, _ := getSegmentRoutingBlockCmd(blockName)
+		block := fmt.Sprintf("%d %d", config.LowerBound, config.UpperBound)
+		AddNISegmentRoutingCmd(h, name, key, cmd, block, true)
+	}
+
+	return nil
+}
+
+func (h *NIModifyApplyHandler) SegmentRoutingPrefixSidConfig(name string, key *openconfig.NetworkInstanceProtocolKey, prefix string, config *openconfig.SegmentRoutingPrefixSidConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/SR/SID/%s: %s", h.ev, h.oper, name, key, prefix, config)
+
+	if config.OneOfChange(openconfig.SR_SID_ID_KEY, openconfig.SR_LABEL_OPTIONS_KEY) {
+		AddNISegmentRoutingCmd(h, name, key, "prefix", getSegmentRoutingPrefixSid(prefix, config), true)
+	}
+
+	return nil
+}
+
+func (h *NIModifyApplyHandler) StaticRouteConfig(name string, prkey *openconfig.NetworkInstanceProtocolKey, rtkey *openconfig.StaticRouteKey, config *openconfig.StaticRouteConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/CONF: %s", h.ev, h.oper, name, prkey, rtkey, config)
+
+	if config.GetChange(openconfig.STATICROUTE_DESC_KEY) {
+		AddNIStaticRouteDescCmd(h, name, rtkey.String(), config.Description, len(config.Description) != 0)
+	}
+
+	return nil
+}
+
+func (h *NIModifyApplyHandler) StaticRouteNexthopConfig(name string, prkey *openconfig.NetworkInstanceProtocolKey, rtkey *openconfig.StaticRouteKey, index string, config *openconfig.StaticRouteNexthopConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s/CONF: %s", h.ev, h.oper, name, prkey, rtkey, index, config)
+
+	nexthop, err := getStoredStaticRouteNexthop(name, prkey, rtkey, index)
+	if err != nil {
+		return err
+	}
+
+	// remove the current route and add it again with all options.
+	args := append(getStaticRouteNexthop(nexthop), getStaticRouteOptions(nexthop)...)
+	AddNIStaticRouteCmd(h, name, rtkey.String(), args, false)
+
+	putStaticRouteNexthopConfig(nexthop, config)
+
+	args = append(getStaticRouteNexthop(nexthop), getStaticRouteOptions(nexthop)...)
+	AddNIStaticRouteCmd(h, name, rtkey.String(), args, true)
+
+	return nil
+}
+
+func (h *NIModifyApplyHandler) StaticRouteNexthopEnableBfdConfig(name string, prkey *openconfig.NetworkInstanceProtocolKey, rtkey *openconfig.StaticRouteKey, index string, config *openconfig.EnableBfdConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s/BFD: %s", h.ev, h.oper, name, prkey, rtkey, index, config)
+
+	nexthop, err := getStoredStaticRouteNexthop(name, prkey, rtkey, index)
+	if err != nil {
+		return err
+	}
+
+	args := append(getStaticRouteNexthop(nexthop), getStaticRouteOptions(nexthop)...)
+	AddNIStaticRouteCmd(h, name, rtkey.String(), args, false)
+
+	nexthop.EnableBfd.Config.Enabled = config.Enabled
+	if config.Enabled {
+		AddNIVtyDaemonCmd(h, name, NI_UPDATE_VTY_BFDD, "bfdd")
+	}
+
+	args = append(getStaticRouteNexthop(nexthop), getStaticRouteOptions(nexthop)...)
+	AddNIStaticRouteCmd(h, name, rtkey.String(), args, true)
+
+	return nil
+}
+
+func (h *NIModifyApplyHandler) TableConnectionConfig(name string, key *openconfig.TableConnectionKey, config *openconfig.TableConnectionConfig) error {
+	log.Debugf("NI/%s/%s/%s/TBLCONN/%s/CONF: %s", h.ev, h.oper, name, key, config)
+
+	// import-policy of the existing table-connection is rejected by verify,
+	// and default-import-policy takes effect only with import-policy.
+	if !config.GetChange(openconfig.TBLCONN_IMPORT_DEF_KEY) || key.DstProtocol == openconfig.INSTALL_PROTOCOL_BGP {
+		return nil
+	}
+
+	if stored := selectNITableConnectionConfig(name, key); len(stored.ImportPolicy) != 0 {
+		AddNITableConnectionDefaultCmd(h, name, key, config.ImportDefault)
+	}
+
+	return nil
+}
+
+func (h *NIModifyApplyHandler) BgpNeighborEnableBfdConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, config *openconfig.EnableBfdConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/BFD: %s", h.ev, h.oper, name, key, addr, config)
+
+	if config.GetChange(openconfig.OC_ENABLED_KEY) {
+		AddNIBgpNeighborBfdCmd(h, name, addr, config.Enabled)
+	}
+
+	return nil
+}
+
+func (h *NIModifyApplyHandler) NetworkInstanceEvpnInstanceConfig(name string, evi string, config *openconfig.EvpnInstanceConfig) error {
+	log.Debugf("NI/%s/%s/%s/EVPN/%s/CONF: %s", h.ev, h.oper, name, evi, config)
+
+	// evpn.conf is reloaded by cfgevpn, which re-creates the vxlan device
+	// and re-originates the routes if vni, bridge, route-distinguisher or route-target is changed.
+	stored := getStoredEvpnInstanceConfig(name, evi)
+	AddNIEvpnCmd(h, name, evi, newEvpnInstanceConfig(stored, config), true)
+
+	return nil
+}
@@ -17,10 +17,18 @@
 
 package ncm
 
-import srlib "netconf/lib/sysrepo"
+import (
+	"fmt"
+	ncmdbm "netconf/app/ncm/dbm"
+	"netconf/lib/openconfig"
+	srlib "netconf/lib/sysrepo"
+
+	log "github.com/sirupsen/logrus"
+)
 
 type NIModifyVerifyHandler struct {
 	*NIAnyHandler
+	ni *openconfig.NetworkInstance
 }
 
 func NewNIModifyVerifyHandler(ev srlib.SrNotifEvent, oper srlib.SrChangeOper) NIChangeHandler {
@@ -28,3 +36,216 @@ func NewNIModifyVerifyHandler(ev srlib.SrNotifEvent, oper srlib.SrChangeOper) NI
 		NIAnyHandler: newNIAnyHandler(ev, oper),
 	}
 }
+
+func (h *NIModifyVerifyHandler) Begin(name string, ni *openconfig.NetworkInstance) error {
+	log.Debugf("NI/%s/%s/%s/BEGIN; %s", h.ev, h.oper, name, ni)
+	h.Clear()
+	h.ni = ni
+	return openconfig.ProcessNetworkInstance(h, false, name, ni)
+}
+
+//
+// /network-instances/network-instance[name]/protocols/protocol[prkey]/bgp
+//
+func (h *NIModifyVerifyHandler) Bgp(name string, key *openconfig.NetworkInstanceProtocolKey, bgp *openconfig.Bgp) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s: %s", h.ev, h.oper, name, key, bgp)
+
+	stored := openconfig.NewBgp()
+	if proto, err := ncmdbm.NetworkInstances().SelectProtocol(name, key); err == nil {
+		stored = proto.Bgp
+	}
+
+	if err := VerifyBgpSessionChanges(bgp, stored); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s/%s", h.ev, h.oper, name, key, err)
+	}
+
+	if err := VerifyBgpPeerGroupRefs(bgp, stored); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s/%s", h.ev, h.oper, name, key, err)
+	}
+
+	return nil
+}
+
+//
+// /network-instances/network-instance[name]/protocols/protocol[prkey]/ospfv2/areas/area[identifier]/interfaces/interface[id]/authentication/config
+//
+func (h *NIModifyVerifyHandler) Ospfv2InterfaceAuthenticationConfig(name string, key *openconfig.NetworkInstanceProtocolKey, areaId string, ifaceId string, config *openconfig.Ospfv2InterfaceAuthenticationConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s/AUTH: %s", h.ev, h.oper, name, key, areaId, ifaceId, config)
+
+	if err := VerifyNIOspfv2AuthenticationConfig(config); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s/%s/%s/AUTH: %s", h.ev, h.oper, name, key, areaId, ifaceId, err)
+	}
+
+	return nil
+}
+
+//
+// /network-instances/network-instance[name]/protocols/protocol[prkey]/isis/levels/level[level-number]/authentication/config
+//
+func (h *NIModifyVerifyHandler) IsisLevelAuthenticationConfig(name string, key *openconfig.NetworkInstanceProtocolKey, levelNum string, config *openconfig.IsisAuthenticationConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/LEVEL/%s/AUTH: %s", h.ev, h.oper, name, key, levelNum, config)
+
+	if err := VerifyNIIsisAuthenticationConfig(config); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s/LEVEL/%s/AUTH: %s", h.ev, h.oper, name, key, levelNum, err)
+	}
+
+	return nil
+}
+
+//
+// /network-instances/network-instance[name]/protocols/protocol[prkey]/isis/interfaces/interface[interface-id]/authentication/config
+//
+func (h *NIModifyVerifyHandler) IsisInterfaceAuthenticationConfig(name string, key *openconfig.NetworkInstanceProtocolKey, ifaceId string, config *openconfig.IsisAuthenticationConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/AUTH: %s", h.ev, h.oper, name, key, ifaceId, config)
+
+	if err := VerifyNIIsisAuthenticationConfig(config); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s/%s/AUTH: %s", h.ev, h.oper, name, key, ifaceId, err)
+	}
+
+	return nil
+}
+
+//
+// /network-instances/network-instance[name]/table-connections/table-connection[key]/config
+//
+func (h *NIModifyVerifyHandler) TableConnectionConfig(name string, key *openconfig.TableConnectionKey, config *openconfig.TableConnectionConfig) error {
+	log.Debugf("NI/%s/%s/%s/TBLCONN/%s/CONF: %s", h.ev, h.oper, name, key, config)
+
+	if err := VerifyNITableConnectionConfig(key, config); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/TBLCONN/%s/CONF: %s", h.ev, h.oper, name, key, err)
+	}
+
+	return nil
+}
+
+//
+// /network-instances/network-instance[name]/mpls/signaling-protocols/ldp/global/address-families/ipv6/config
+//
+func (h *NIModifyVerifyHandler) MplsLdpAddressFamilyV6Config(name string, config *openconfig.MplsLdpAddressFamilyV6Config) error {
+	log.Debugf("NI/%s/%s/%s/LDP/ADDR6: %s", h.ev, h.oper, name, config)
+
+	if err := VerifyNIMplsLdpAddressFamilyV6Config(config); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/LDP/ADDR6: %s", h.ev, h.oper, name, err)
+	}
+
+	return nil
+}
+
+//
+// /network-instances/network-instance[name]/mpls/lsps/static-lsps/static-lsp[name]/<ingress|transit|egress>/config
+//
+func (h *NIModifyVerifyHandler) MplsStaticLspPathConfig(name string, lspName string, pathName string, config *openconfig.MplsStaticLspPathConfig) error {
+	log.Debugf("NI/%s/%s/%s/LSP/%s/%s: %s", h.ev, h.oper, name, lspName, pathName, config)
+
+	stored := getStoredMplsStaticLspPathConfig(name, lspName, pathName)
+	if err := VerifyNIMplsStaticLspPathConfig(pathName, newMplsStaticLspPathConfig(stored, config)); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/LSP/%s/%s: %s", h.ev, h.oper, name, lspName, pathName, err)
+	}
+
+	return nil
+}
+
+//
+// /network-instances/network-instance[name]/protocols/protocol[prkey]/static-routes/static[rtkey]/next-hops/next-hop[index]/config
+//
+func (h *NIModifyVerifyHandler) StaticRouteNexthopConfig(name string, prkey *openconfig.NetworkInstanceProtocolKey, rtkey *openconfig.StaticRouteKey, index string, config *openconfig.StaticRouteNexthopConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s/CONF: %s", h.ev, h.oper, name, prkey, rtkey, index, config)
+
+	nexthop, err := getStoredStaticRouteNexthop(name, prkey, rtkey, index)
+	if err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s/%s/%s/CONF: %s", h.ev, h.oper, name, prkey, rtkey, index, err)
+	}
+
+	putStaticRouteNexthopConfig(nexthop, config)
+
+	if err := VerifyNIStaticRouteNexthop(nexthop); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s/%s/%s/CONF: %s", h.ev, h.oper, name, prkey, rtkey, index, err)
+	}
+
+	return nil
+}
+
+//
+// /network-instances/network-instance[name]/protocols/protocol[prkey]/static-routes/static[rtkey]/next-hops/next-hop[index]/interface-ref/config
+//
+func (h *NIModifyVerifyHandler) StaticRouteNexthopIfaceRefConfig(name string, prkey *openconfig.NetworkInstanceProtocolKey, rtkey *openconfig.StaticRouteKey, index string, config *openconfig.InterfaceRefConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/%s/%s/IFREF: %s", h.ev, h.oper, name, prkey, rtkey, index, config)
+
+	// ip route is replaced by StaticRouteNexthopConfig only,
+	// so interface-ref must be changed by re-creating next-hop.
+	return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s/%s/%s/IFREF: do not change interface-ref of next-hop.", h.ev, h.oper, name, prkey, rtkey, index)
+}
+
+//
+// /network-instances/network-instance[name]/protocols/protocol[prkey]/<igp>/global/segment-routing/config
+//
+func (h *NIModifyVerifyHandler) SegmentRoutingConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.SegmentRoutingConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/SR/CONF: %s", h.ev, h.oper, name, key, config)
+
+	if err := VerifyNISegmentRoutingProtocol(key); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s/SR/CONF: %s", h.ev, h.oper, name, key, err)
+	}
+
+	return nil
+}
+
+//
+// /network-instances/network-instance[name]/protocols/protocol[prkey]/<igp>/global/segment-routing/<block>/config
+//
+func (h *NIModifyVerifyHandler) SegmentRoutingBlockConfig(name string, key *openconfig.NetworkInstanceProtocolKey, blockName string, config *openconfig.SegmentRoutingBlockConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/SR/%s: %s", h.ev, h.oper, name, key, blockName, config)
+
+	if err := VerifyNISegmentRoutingProtocol(key); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s/SR/%s: %s", h.ev, h.oper, name, key, blockName, err)
+	}
+
+	if err := VerifyNISegmentRoutingBlockConfig(config); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s/SR/%s: %s", h.ev, h.oper, name, key, blockName, err)
+	}
+
+	return nil
+}
+
+//
+// /network-instances/network-instance[name]/protocols/protocol[prkey]/<igp>/global/segment-routing/prefix-sids/prefix-sid[prefix]/config
+//
+func (h *NIModifyVerifyHandler) SegmentRoutingPrefixSidConfig(name string, key *openconfig.NetworkInstanceProtocolKey, prefix string, config *openconfig.SegmentRoutingPrefixSidConfig) error {
+	log.Debugf("NI/%s/%s/%s/PROTOS/%s/SR/SID/%s: %s", h.ev, h.oper, name, key, prefix, config)
+
+	if err := VerifyNISegmentRoutingProtocol(key); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s/SR/SID/%s: %s", h.ev, h.oper, name, key, prefix, err)
+	}
+
+	if err := VerifyNISegmentRoutingPrefixSidConfig(prefix, config); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/PROTOS/%s/SR/SID/%s: %s", h.ev, h.oper, name, key, prefix, err)
+	}
+
+	return nil
+}
+
+//
+// /network-instances/network-instance[name]/bfd/interfaces/interface[id]/config
+//
+func (h *NIModifyVerifyHandler) BfdInterfaceConfig(name string, ifaceId string, config *openconfig.BfdInterfaceConfig) error {
+	log.Debugf("NI/%s/%s/%s/BFD/%s/CONF: %s", h.ev, h.oper, name, ifaceId, config)
+
+	if err := VerifyNIBfdInterfaceConfig(ifaceId, config); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/BFD/%s/CONF: %s", h.ev, h.oper, name, ifaceId, err)
+	}
+
+	return nil
+}
+
+//
+// /network-instances/network-instance[name]/evpn/evpn-instances/evpn-instance[evi]/config
+//
+func (h *NIModifyVerifyHandler) NetworkInstanceEvpnInstanceConfig(name string, evi string, config *openconfig.EvpnInstanceConfig) error {
+	log.Debugf("NI/%s/%s/%s/EVPN/%s/CONF: %s", h.ev, h.oper, name, evi, config)
+
+	stored := getStoredEvpnInstanceConfig(name, evi)
+	if err := VerifyNIEvpnInstanceConfig(name, h.ni, evi, newEvpnInstanceConfig(stored, config)); err != nil {
+		return fmt.Errorf("NI/%s/%s/%s/EVPN/%s/CONF: %s", h.ev, h.oper, name, evi, err)
+	}
+
+	return nil
+}
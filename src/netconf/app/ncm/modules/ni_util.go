@@ -19,11 +19,16 @@ package ncm
 
 import (
 	"fmt"
+	"net"
+	ncmdbm "netconf/app/ncm/dbm"
 	nclib "netconf/lib"
 	srocgobgp "netconf/lib/gobgp/openconfig"
+	ncianalib "netconf/lib/iana"
 	ncnet "netconf/lib/net"
 	"netconf/lib/openconfig"
 	srlib "netconf/lib/sysrepo"
+	"sort"
+	"strings"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -54,6 +59,9 @@ func getLxcType(config *openconfig.NetworkInstanceConfig) (string, error) {
 	case openconfig.NETWORK_INSTANCE_L3VRF:
 		return fmt.Sprintf("%s_ric", std_or_vpn), nil
 
+	case openconfig.NETWORK_INSTANCE_L2VSI, openconfig.NETWORK_INSTANCE_L2L3:
+		return "evpn_ric", nil
+
 	default:
 		return "", fmt.Errorf("Unsupported network-instance-type %s", config.Type)
 	}
@@ -86,6 +94,969 @@ func getOspfNetworkType(networkType openconfig.OspfNetworkType) (string, error)
 	}
 }
 
+//
+// getOspfv2AreaType returns the area type keyword (to negate) and
+// the area type with options (to set) of "area <id>" command.
+//
+func getOspfv2AreaType(areaType openconfig.Ospfv2AreaType) (string, string, error) {
+	switch areaType {
+	case openconfig.OSPFV2_NORMAL_AREA:
+		return "", "", nil
+
+	case openconfig.OSPFV2_STUB_AREA:
+		return "stub", "stub", nil
+
+	case openconfig.OSPFV2_TOTALLY_STUBBY_AREA:
+		return "stub", "stub no-summary", nil
+
+	case openconfig.OSPFV2_NSSA_AREA:
+		return "nssa", "nssa", nil
+
+	case openconfig.OSPFV2_TOTALLY_NSSA_AREA:
+		return "nssa", "nssa no-summary", nil
+
+	default:
+		log.Errorf("Unknown ospfv2-area-type %s", areaType)
+		return "", "", fmt.Errorf("Unknown ospfv2-area-type %s", areaType)
+	}
+}
+
+func getOspfv2RedistributeProtocol(protoName string) (string, error) {
+	proto, err := openconfig.ParseInstallProtocolType(protoName)
+	if err != nil {
+		return "", err
+	}
+
+	switch proto {
+	case openconfig.INSTALL_PROTOCOL_DIRECTLY_CONNECTED:
+		return "connected", nil
+
+	case openconfig.INSTALL_PROTOCOL_STATIC:
+		return "static", nil
+
+	case openconfig.INSTALL_PROTOCOL_BGP:
+		return "bgp", nil
+
+	default:
+		log.Errorf("Unsupported ospfv2 redistribute protocol %s", proto)
+		return "", fmt.Errorf("Unsupported ospfv2 redistribute protocol %s", proto)
+	}
+}
+
+func getOspfv2Redistribute(protoName string, config *openconfig.Ospfv2RedistributionConfig) (string, error) {
+	redist, err := getOspfv2RedistributeProtocol(protoName)
+	if err != nil {
+		return "", err
+	}
+
+	if config.GetChange(openconfig.OSPFV2_METRIC_KEY) {
+		redist = fmt.Sprintf("%s metric %d", redist, config.Metric)
+	}
+
+	if config.GetChange(openconfig.OSPFV2_METRIC_TYPE_KEY) {
+		redist = fmt.Sprintf("%s metric-type %d", redist, config.MetricType)
+	}
+
+	return redist, nil
+}
+
+//
+// getStoredOspfv2 returns ospfv2 stored in datastore (before the changes),
+// or empty ospfv2 if not found.
+//
+func getStoredOspfv2(name string, key *openconfig.NetworkInstanceProtocolKey) *openconfig.Ospfv2 {
+	if proto, err := ncmdbm.NetworkInstances().SelectProtocol(name, key); err == nil {
+		return proto.Ospfv2
+	}
+	return openconfig.NewOspfv2()
+}
+
+func getOspfv2AreaRange(prefix string, advertise bool) string {
+	if advertise {
+		return fmt.Sprintf("%s advertise", prefix)
+	}
+	return fmt.Sprintf("%s not-advertise", prefix)
+}
+
+func getOspfv2DefaultInformation(config *openconfig.Ospfv2GlobalConfig) string {
+	if config.DefaultInformationAlways {
+		return "originate always"
+	}
+	return "originate"
+}
+
+func getTableConnectionRedistributeProtocol(key *openconfig.TableConnectionKey) (string, error) {
+	switch key.SrcProtocol {
+	case openconfig.INSTALL_PROTOCOL_DIRECTLY_CONNECTED:
+		return "connected", nil
+
+	case openconfig.INSTALL_PROTOCOL_STATIC:
+		return "static", nil
+
+	case openconfig.INSTALL_PROTOCOL_BGP:
+		return "bgp", nil
+
+	case openconfig.INSTALL_PROTOCOL_ISIS:
+		return "isis", nil
+
+	default:
+		log.Errorf("Unsupported table-connection %s", key)
+		return "", fmt.Errorf("Unsupported table-connection %s", key)
+	}
+}
+
+//
+// getTableConnectionRedistribute returns the arguments of redistribute command
+// with the route-map rendered from the import-policy if exists.
+//
+func getTableConnectionRedistribute(key *openconfig.TableConnectionKey, rmap *NIRouteMap) (string, error) {
+	redist, err := getTableConnectionRedistributeProtocol(key)
+	if err != nil {
+		return "", err
+	}
+
+	if rmap != nil {
+		return fmt.Sprintf("%s route-map %s", redist, rmap.Name), nil
+	}
+
+	return redist, nil
+}
+
+const NI_ROUTEMAP_DEFAULT_SEQ = "65535"
+
+//
+// NIRouteMap is the route-map and the prefix-lists of FRR
+// rendered from the import-policy of the table-connection.
+//
+type NIRouteMap struct {
+	Name        string
+	IPVer       string
+	PrefixLists []string
+	Prefixes    [][]string
+	Entries     [][]string
+}
+
+func getTableConnectionRouteMapName(key *openconfig.TableConnectionKey) string {
+	return fmt.Sprintf("tblconn-%s-%s-%s", key.SrcProtocol, key.DstProtocol, key.AddressFamily)
+}
+
+func newNIRouteMap(key *openconfig.TableConnectionKey) *NIRouteMap {
+	rmap := &NIRouteMap{
+		Name:        getTableConnectionRouteMapName(key),
+		IPVer:       "ip",
+		PrefixLists: []string{},
+		Prefixes:    [][]string{},
+		Entries:     [][]string{},
+	}
+	if key.AddressFamily == openconfig.ADDRESS_FAMILY_IPV6 {
+		rmap.IPVer = "ipv6"
+	}
+	return rmap
+}
+
+//
+// getTableConnectionRouteMap renders the import-policy of the table-connection.
+// The statements of each policy are applied in order of their names,
+// and the routes which match no statement are redistributed
+// only if default-import-policy is ACCEPT_ROUTE.
+// It returns nil if import-policy is not specified, and the route-map
+// rendered partially with the error if the policy is not supported.
+//
+func getTableConnectionRouteMap(key *openconfig.TableConnectionKey, config *openconfig.TableConnectionConfig) (*NIRouteMap, error) {
+	if len(config.ImportPolicy) == 0 {
+		return nil, nil
+	}
+
+	rmap := newNIRouteMap(key)
+	sets := ncmdbm.PolicyDefinedSets().Get()
+	for _, polName := range config.ImportPolicy {
+		pol, err := ncmdbm.PolicyDefinitions().Select(polName)
+		if err != nil {
+			return rmap, err
+		}
+
+		stmtNames := []string{}
+		for stmtName, _ := range pol.Stmts {
+			stmtNames = append(stmtNames, stmtName)
+		}
+		sort.Strings(stmtNames)
+
+		for _, stmtName := range stmtNames {
+			if err := rmap.addStatement(pol.Stmts[stmtName], sets); err != nil {
+				return rmap, fmt.Errorf("%s/%s: %s", polName, stmtName, err)
+			}
+		}
+	}
+
+	if config.ImportDefault == openconfig.POLICY_DEFAULT_ACCEPT_ROUTE {
+		rmap.Entries = append(rmap.Entries, getTableConnectionDefaultEntry())
+	}
+
+	return rmap, nil
+}
+
+func getTableConnectionDefaultEntry() []string {
+	return []string{"permit", NI_ROUTEMAP_DEFAULT_SEQ}
+}
+
+//
+// selectNITableConnectionConfig returns the config of the table-connection
+// which is already committed in the network-instance.
+//
+func selectNITableConnectionConfig(name string, key *openconfig.TableConnectionKey) *openconfig.TableConnectionConfig {
+	ni, err := ncmdbm.NetworkInstances().Select(name)
+	if err != nil {
+		return openconfig.NewTableConnectionConfig()
+	}
+
+	if conn, ok := ni.TableConns[*key]; ok {
+		return conn.Config
+	}
+	return openconfig.NewTableConnectionConfig()
+}
+
+func (r *NIRouteMap) addStatement(stmt *openconfig.PolicyStatement, sets *openconfig.PolicyDefinedSets) error {
+	conds := stmt.Conditions
+	if conds.OneOfChange(openconfig.POLICYMATCH_NEIGHSET_KEY, openconfig.POLICYMATCH_TAGSET_KEY, openconfig.BGP_CONDS_KEY) {
+		return fmt.Errorf("conditions other than match-prefix-set are not supported.")
+	}
+
+	if stmt.Actions.GetChange(openconfig.BGP_ACTIONS_KEY) {
+		return fmt.Errorf("bgp-actions are not supported.")
+	}
+
+	entry := []string{}
+	switch stmt.Actions.Config.PolicyResult {
+	case openconfig.POLICY_RESULT_ACCEPT_ROUTE:
+		entry = append(entry, "permit")
+	case openconfig.POLICY_RESULT_REJECT_ROUTE:
+		entry = append(entry, "deny")
+	default:
+		return fmt.Errorf("policy-result not specified.")
+	}
+	entry = append(entry, fmt.Sprintf("%d", (len(r.Entries)+1)*10))
+
+	if conds.GetChange(openconfig.POLICYMATCH_PFXSET_KEY) {
+		plist, err := r.addPrefixList(conds.MatchPrefixSet.Config, sets)
+		if err != nil {
+			return err
+		}
+		entry = append(entry, "match", r.IPVer, "address", "prefix-list", plist)
+	}
+
+	r.Entries = append(r.Entries, entry)
+	return nil
+}
+
+//
+// addPrefixList adds the prefix-list which permits the prefixes of the prefix-set,
+// or denies them and permits any others if match-set-options is INVERT.
+// The prefixes of the other address-family are ignored.
+//
+func (r *NIRouteMap) addPrefixList(config *openconfig.PolicyMatchPrefixSetConfig, sets *openconfig.PolicyDefinedSets) (string, error) {
+	pset, ok := sets.PrefixSets[config.PrefixSet]
+	if !ok {
+		return "", fmt.Errorf("prefix-set not found. %s", config.PrefixSet)
+	}
+
+	name := fmt.Sprintf("%s-%s", r.Name, config.PrefixSet)
+	action := "permit"
+	if config.MatchSetOptions == openconfig.POLICY_MATCH_SET_OPTIONS_INVERT {
+		name = fmt.Sprintf("%s-invert", name)
+		action = "deny"
+	}
+
+	for _, plist := range r.PrefixLists {
+		if plist == name {
+			return name, nil
+		}
+	}
+
+	keys := []openconfig.PolicyPrefixSetPrefixKey{}
+	for key, _ := range pset.Prefixes {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].IpPrefix != keys[j].IpPrefix {
+			return keys[i].IpPrefix < keys[j].IpPrefix
+		}
+		return keys[i].MaskLenRange < keys[j].MaskLenRange
+	})
+
+	seq := 0
+	addPrefix := func(args ...string) {
+		seq += 5
+		prefix := []string{r.IPVer, "prefix-list", name, "seq", fmt.Sprintf("%d", seq)}
+		r.Prefixes = append(r.Prefixes, append(prefix, args...))
+	}
+
+	for _, key := range keys {
+		_, nw, err := net.ParseCIDR(key.IpPrefix)
+		if err != nil {
+			return "", fmt.Errorf("Invalid prefix. %s/%s %s", config.PrefixSet, key.IpPrefix, err)
+		}
+
+		if ipv4 := nw.IP.To4() != nil; ipv4 != (r.IPVer == "ip") {
+			continue
+		}
+
+		ranges, err := getPrefixListRange(nw, key.MaskLenRange)
+		if err != nil {
+			return "", fmt.Errorf("Invalid masklength-range. %s/%s %s", config.PrefixSet, key.MaskLenRange, err)
+		}
+
+		addPrefix(append([]string{action, nw.String()}, ranges...)...)
+	}
+
+	if action == "deny" {
+		addPrefix("permit", "any")
+	}
+
+	r.PrefixLists = append(r.PrefixLists, name)
+	return name, nil
+}
+
+//
+// getPrefixListRange converts masklength-range ("exact" or "<min>..<max>")
+// to ge and le of prefix-list.
+//
+func getPrefixListRange(nw *net.IPNet, mlr string) ([]string, error) {
+	if mlr == openconfig.POLICYPFXSET_MLR_EXACT {
+		return []string{}, nil
+	}
+
+	var min, max int
+	if _, err := fmt.Sscanf(mlr, "%d..%d", &min, &max); err != nil {
+		return nil, err
+	}
+
+	plen, bits := nw.Mask.Size()
+	if min < plen || min > max || max > bits {
+		return nil, fmt.Errorf("out of range. %s", nw)
+	}
+
+	ranges := []string{}
+	if min > plen {
+		ranges = append(ranges, "ge", fmt.Sprintf("%d", min))
+	}
+	if max > plen {
+		ranges = append(ranges, "le", fmt.Sprintf("%d", max))
+	}
+	return ranges, nil
+}
+
+func getIsisLevelType(levelType openconfig.IsisLevelType) (string, error) {
+	switch levelType {
+	case openconfig.ISIS_LEVEL_1:
+		return "level-1", nil
+
+	case openconfig.ISIS_LEVEL_2:
+		return "level-2-only", nil
+
+	case openconfig.ISIS_LEVEL_1_2:
+		return "level-1-2", nil
+
+	default:
+		log.Errorf("Unknown isis-level-type %s", levelType)
+		return "", fmt.Errorf("Unknown isis-level-type %s", levelType)
+	}
+}
+
+func getIsisPassword(config *openconfig.IsisAuthenticationConfig) (string, error) {
+	switch config.AuthMode {
+	case openconfig.ISIS_AUTH_TEXT:
+		return fmt.Sprintf("clear %s", config.AuthPassword), nil
+
+	case openconfig.ISIS_AUTH_MD5:
+		return fmt.Sprintf("md5 %s", config.AuthPassword), nil
+
+	default:
+		log.Errorf("Unknown isis-auth-mode %s", config.AuthMode)
+		return "", fmt.Errorf("Unknown isis-auth-mode %s", config.AuthMode)
+	}
+}
+
+func getIsisLevelPasswordCmd(levelNum string) (string, error) {
+	switch levelNum {
+	case "1":
+		return "area-password", nil
+
+	case "2":
+		return "domain-password", nil
+
+	default:
+		log.Errorf("Unknown isis-level-number %s", levelNum)
+		return "", fmt.Errorf("Unknown isis-level-number %s", levelNum)
+	}
+}
+
+func getIsisAfiCmd(afi openconfig.IsisAfiType) (string, error) {
+	switch afi {
+	case openconfig.ISIS_AFI_IPV4:
+		return "ip router isis", nil
+
+	case openconfig.ISIS_AFI_IPV6:
+		return "ipv6 router isis", nil
+
+	default:
+		log.Errorf("Unknown isis-afi-type %s", afi)
+		return "", fmt.Errorf("Unknown isis-afi-type %s", afi)
+	}
+}
+
+func getSegmentRoutingBlockCmd(blockName string) (string, error) {
+	switch blockName {
+	case openconfig.SR_SRGB_KEY:
+		return "global-block", nil
+
+	case openconfig.SR_SRLB_KEY:
+		return "local-block", nil
+
+	default:
+		log.Errorf("Unknown segment-routing block %s", blockName)
+		return "", fmt.Errorf("Unknown segment-routing block %s", blockName)
+	}
+}
+
+func getSegmentRoutingPrefixSid(prefix string, config *openconfig.SegmentRoutingPrefixSidConfig) string {
+	sid := fmt.Sprintf("%s index %d", prefix, config.SidId)
+	switch config.LabelOptions {
+	case openconfig.SR_LABEL_OPTION_NO_PHP:
+		return fmt.Sprintf("%s no-php-flag", sid)
+
+	case openconfig.SR_LABEL_OPTION_EXPLICIT_NULL:
+		return fmt.Sprintf("%s explicit-null", sid)
+
+	default:
+		return sid
+	}
+}
+
+//
+// getStaticRouteNexthop returns the next-hop arguments of ip route command.
+// (e.g. "<ip>", "<ifname>", "nill0" or "<ip> <ifname> onlink")
+//
+func getStaticRouteNexthop(nexthop *openconfig.StaticRouteNexthop) []string {
+	ip, nhtype, _ := nexthop.Config.GetNexthop()
+	switch nhtype {
+	case openconfig.LOCAL_DEFINED_NEXT_HOP_LOCAL_LINK:
+		return []string{nexthop.IfaceRef.Config.IFName()}
+
+	case openconfig.LOCAL_DEFINED_NEXT_HOP_DROP:
+		return []string{"nill0"}
+
+	default:
+		if !nexthop.Config.Recurse {
+			return []string{ip.String(), nexthop.IfaceRef.Config.IFName(), "onlink"}
+		}
+		return []string{ip.String()}
+	}
+}
+
+//
+// getStoredStaticRouteNexthop returns the next-hop of static route
+// stored in datastore (before the changes).
+//
+func getStoredStaticRouteNexthop(name string, prkey *openconfig.NetworkInstanceProtocolKey, rtkey *openconfig.StaticRouteKey, index string) (*openconfig.StaticRouteNexthop, error) {
+	proto, err := ncmdbm.NetworkInstances().SelectProtocol(name, prkey)
+	if err != nil {
+		return nil, err
+	}
+
+	route, ok := proto.StaticRoutes[*rtkey]
+	if !ok {
+		return nil, fmt.Errorf("static route not found. %s", rtkey)
+	}
+
+	nexthop, ok := route.Nexthops[index]
+	if !ok {
+		return nil, fmt.Errorf("next-hop not found. %s %s", rtkey, index)
+	}
+
+	return nexthop, nil
+}
+
+//
+// putStaticRouteNexthopConfig puts the changed leaves of config to the next-hop.
+//
+func putStaticRouteNexthopConfig(nexthop *openconfig.StaticRouteNexthop, config *openconfig.StaticRouteNexthopConfig) {
+	c := nexthop.Config
+
+	if config.GetChange(openconfig.STATICROUTE_NEXTHOP_KEY) {
+		c.Nexthop = config.Nexthop
+		c.SetChange(openconfig.STATICROUTE_NEXTHOP_KEY)
+	}
+
+	if config.GetChange(openconfig.STATICROUTE_METRIC_KEY) {
+		c.Metric = config.Metric
+		c.SetChange(openconfig.STATICROUTE_METRIC_KEY)
+	}
+
+	if config.GetChange(openconfig.STATICROUTE_RECURSE_KEY) {
+		c.Recurse = config.Recurse
+		c.SetChange(openconfig.STATICROUTE_RECURSE_KEY)
+	}
+
+	if config.GetChange(openconfig.STATICROUTE_SETTAG_KEY) {
+		c.SetTag = config.SetTag
+		c.SetChange(openconfig.STATICROUTE_SETTAG_KEY)
+	}
+}
+
+//
+// getStaticRouteOptions returns the options of ip route command.
+// (e.g. "tag <tag> <distance> bfd")
+//
+func getStaticRouteOptions(nexthop *openconfig.StaticRouteNexthop) []string {
+	opts := []string{}
+	config := nexthop.Config
+
+	if config.SetTag != 0 {
+		opts = append(opts, "tag", fmt.Sprintf("%d", config.SetTag))
+	}
+
+	if config.Metric != 0 {
+		opts = append(opts, fmt.Sprintf("%d", config.Metric))
+	}
+
+	if nexthop.EnableBfd.Config.Enabled {
+		opts = append(opts, "bfd")
+	}
+
+	return opts
+}
+
+//
+// getBfdProfileArgs returns the commands of bfd profile for the changed timers.
+// The intervals are converted from microseconds to milliseconds.
+//
+func getBfdProfileArgs(config *openconfig.BfdInterfaceConfig) [][]string {
+	args := [][]string{}
+
+	if config.GetChange(openconfig.BFD_DESIRED_MIN_TX_KEY) {
+		args = append(args, []string{"transmit-interval", fmt.Sprintf("%d", config.DesiredMinTxInt/1000)})
+	}
+
+	if config.GetChange(openconfig.BFD_REQUIRED_MIN_RX_KEY) {
+		args = append(args, []string{"receive-interval", fmt.Sprintf("%d", config.RequiredMinRx/1000)})
+	}
+
+	if config.GetChange(openconfig.BFD_DETECTION_MULTIPLIER_KEY) {
+		args = append(args, []string{"detect-multiplier", fmt.Sprintf("%d", config.DetectionMultiplier)})
+	}
+
+	return args
+}
+
+//
+// getVrrpGroups returns the vrrp groups of all ipv4 and ipv6 addresses of the subinterface.
+//
+func getVrrpGroups(subif *openconfig.Subinterface) []*openconfig.VrrpGroup {
+	groups := []*openconfig.VrrpGroup{}
+
+	for _, addr := range subif.IPv4.Addresses {
+		for _, group := range addr.Vrrp {
+			groups = append(groups, group)
+		}
+	}
+
+	for _, addr := range subif.IPv6.Addresses {
+		for _, group := range addr.Vrrp {
+			groups = append(groups, group)
+		}
+	}
+
+	return groups
+}
+
+//
+// getVrrpGroupArgs returns the commands of vrrpd for the virtual router.
+// The advertisement-interval is converted from centiseconds to milliseconds.
+//
+func getVrrpGroupArgs(config *openconfig.VrrpGroupConfig) [][]string {
+	args := [][]string{}
+
+	for _, vaddr := range config.VirtualAddrs {
+		if vaddr.To4() != nil {
+			args = append(args, []string{"ip", vaddr.String()})
+		} else {
+			args = append(args, []string{"ipv6", vaddr.String()})
+		}
+	}
+
+	args = append(args,
+		[]string{"priority", fmt.Sprintf("%d", config.Priority)},
+		[]string{"advertisement-interval", fmt.Sprintf("%d", uint32(config.AdvInterval)*10)},
+	)
+
+	return args
+}
+
+//
+// VRRP_MACVLAN_NAME_MAX is the max length of the macvlan name (IFNAMSIZ - 1).
+//
+const VRRP_MACVLAN_NAME_MAX = 15
+
+//
+// isVrrpGroupIPv6 returns true if the virtual addresses of the vrrp group are ipv6.
+//
+func isVrrpGroupIPv6(config *openconfig.VrrpGroupConfig) bool {
+	if len(config.VirtualAddrs) == 0 {
+		return false
+	}
+	return config.VirtualAddrs[0].To4() == nil
+}
+
+//
+// getVrrpMacvlanName returns the name of the macvlan which vrrpd uses
+// for the virtual router. (vrrp4-<ifname>-<vrid> or vrrp6-<ifname>-<vrid>)
+//
+func getVrrpMacvlanName(ifname string, vrid uint8, ipv6 bool) string {
+	if ipv6 {
+		return fmt.Sprintf("vrrp6-%s-%d", ifname, vrid)
+	}
+	return fmt.Sprintf("vrrp4-%s-%d", ifname, vrid)
+}
+
+//
+// getVrrpMacvlan returns the macvlan argument of "network set" command.
+// The mac address is the virtual router mac address of RFC5798.
+//
+func getVrrpMacvlan(ifname string, config *openconfig.VrrpGroupConfig) (string, string) {
+	vrid := config.VirtualRouterId
+	ipv6 := isVrrpGroupIPv6(config)
+	macvlan := getVrrpMacvlanName(ifname, vrid, ipv6)
+
+	args := []string{fmt.Sprintf("name=%s", macvlan)}
+	if ipv6 {
+		args = append(args, fmt.Sprintf("macaddress=00:00:5e:00:02:%02x", vrid))
+	} else {
+		args = append(args, fmt.Sprintf("macaddress=00:00:5e:00:01:%02x", vrid))
+	}
+
+	for _, vaddr := range config.VirtualAddrs {
+		if ipv6 {
+			args = append(args, fmt.Sprintf("address=%s/128", vaddr))
+		} else {
+			args = append(args, fmt.Sprintf("address=%s/32", vaddr))
+		}
+	}
+
+	return macvlan, strings.Join(args, ",")
+}
+
+//
+// selectAggregate returns the LAG interface and its members
+// if the subinterface is any subinterface of a LAG interface.
+//
+func selectAggregate(device string, subif *openconfig.Subinterface) (*openconfig.Interface, []*openconfig.Interface) {
+	iface, err := ncmdbm.Interfaces().Select(device)
+	if err != nil || iface.Config.Type != ncianalib.IANAifType_ieee8023adLag {
+		return nil, nil
+	}
+
+	return iface, ncmdbm.Interfaces().SelectMembers(device)
+}
+
+//
+// selectBridge returns the bridge interface
+// if the subinterface is the index 0 of a bridge interface.
+//
+func selectBridge(device string, subif *openconfig.Subinterface) *openconfig.Interface {
+	if subif.Index != 0 {
+		return nil
+	}
+
+	iface, err := ncmdbm.Interfaces().Select(device)
+	if err != nil || iface.Config.Type != ncianalib.IANAifType_bridge {
+		return nil
+	}
+
+	return iface
+}
+
+//
+// selectAggregateParent returns the subinterface index 0 of the LAG interface,
+// which has the settings of the bond device.
+//
+func selectAggregateParent(lag *openconfig.Interface) *openconfig.Subinterface {
+	if parent, ok := lag.Subinterfaces[0]; ok {
+		return parent
+	}
+	return openconfig.NewSubinterface(0)
+}
+
+//
+// countNIAggregateSubinterfaces returns the number of the subinterfaces
+// of the LAG interface which are already committed in the network-instance.
+//
+func countNIAggregateSubinterfaces(name string, device string) int {
+	ni, err := ncmdbm.NetworkInstances().Select(name)
+	if err != nil {
+		return 0
+	}
+
+	count := 0
+	for id, _ := range ni.Interfaces {
+		if dev, _, err := ncnet.ParseIFName(id); err == nil && dev == device {
+			count++
+		}
+	}
+	return count
+}
+
+//
+// getNetworkDevice returns the device arguments of "network set" command.
+//
+func getNetworkDevice(device string, subif *openconfig.Subinterface) []string {
+	if lag, _ := selectAggregate(device, subif); lag != nil && subif.Index == 0 {
+		return []string{"bond", device}
+	}
+
+	if bridge := selectBridge(device, subif); bridge != nil {
+		return []string{"bridge", device}
+	}
+
+	return []string{"vlan", device, fmt.Sprintf("%d", subif.Index)}
+}
+
+//
+// getInterfaceState returns the admin state and the description of the subinterface.
+// The subinterface is enabled only if the interface is enabled, and the subinterface #0
+// uses the description of the interface if it has no description.
+//
+func getInterfaceState(iface *openconfig.Interface, subif *openconfig.Subinterface) (bool, string) {
+	enabled, desc := subif.Config.Enabled, subif.Config.Desc
+	if iface != nil {
+		enabled = enabled && iface.Config.Enabled
+		if subif.Index == 0 && len(desc) == 0 {
+			desc = iface.Config.Desc
+		}
+	}
+
+	return enabled, desc
+}
+
+//
+// getStoredEvpnInstanceConfig returns the config of evpn-instance
+// stored in datastore (before the changes), or empty config if not found.
+//
+func getStoredEvpnInstanceConfig(name string, evi string) *openconfig.EvpnInstanceConfig {
+	ni, err := ncmdbm.NetworkInstances().Select(name)
+	if err != nil {
+		return openconfig.NewEvpnInstanceConfig()
+	}
+
+	inst, ok := ni.Evpn.Instances[evi]
+	if !ok {
+		return openconfig.NewEvpnInstanceConfig()
+	}
+
+	return inst.Config
+}
+
+//
+// newEvpnInstanceConfig returns the config of evpn-instance
+// which the changes are put to the stored config.
+//
+func newEvpnInstanceConfig(stored *openconfig.EvpnInstanceConfig, config *openconfig.EvpnInstanceConfig) *openconfig.EvpnInstanceConfig {
+	c := openconfig.NewEvpnInstanceConfig()
+	put := func(key string, src *openconfig.EvpnInstanceConfig) {
+		switch key {
+		case openconfig.EVPN_EVI_KEY:
+			c.Evi = src.Evi
+		case openconfig.EVPN_VNI_KEY:
+			c.Vni = src.Vni
+		case openconfig.EVPN_BRIDGE_KEY:
+			c.Bridge = src.Bridge
+		case openconfig.NETWORKINSTANCE_RD_KEY:
+			c.RD = src.RD
+		case openconfig.NETWORKINSTANCE_RT_KEY:
+			c.RT = src.RT
+		}
+		c.SetChange(key)
+	}
+
+	for _, key := range []string{
+		openconfig.EVPN_EVI_KEY,
+		openconfig.EVPN_VNI_KEY,
+		openconfig.EVPN_BRIDGE_KEY,
+		openconfig.NETWORKINSTANCE_RD_KEY,
+		openconfig.NETWORKINSTANCE_RT_KEY,
+	} {
+		if config.GetChange(key) {
+			put(key, config)
+		} else if stored.GetChange(key) {
+			put(key, stored)
+		}
+	}
+
+	return c
+}
+
+//
+// newEvpnInstanceConfigs returns the configs of the evpn-instances of the network-instance
+// which the changes (ni) are put to the stored configs (evi -> config).
+//
+func newEvpnInstanceConfigs(name string, ni *openconfig.NetworkInstance) map[string]*openconfig.EvpnInstanceConfig {
+	configs := map[string]*openconfig.EvpnInstanceConfig{}
+	if stored, err := ncmdbm.NetworkInstances().Select(name); err == nil {
+		for evi, inst := range stored.Evpn.Instances {
+			configs[evi] = inst.Config
+		}
+	}
+
+	for evi, inst := range ni.Evpn.Instances {
+		stored, ok := configs[evi]
+		if !ok {
+			stored = openconfig.NewEvpnInstanceConfig()
+		}
+		configs[evi] = newEvpnInstanceConfig(stored, inst.Config)
+	}
+
+	return configs
+}
+
+//
+// countNIBgpRedists returns the number of the table-connections to BGP
+// and the redistribute-routes of zebra of BGP which are already committed
+// in the network-instance and redistribute the routes of the protocol.
+// GoBGP has only one route type list shared by all of them.
+//
+func countNIBgpRedists(name string, proto openconfig.InstallProtocolType) int {
+	ni, err := ncmdbm.NetworkInstances().Select(name)
+	if err != nil {
+		return 0
+	}
+
+	count := 0
+	for key, _ := range ni.TableConns {
+		if key.DstProtocol == openconfig.INSTALL_PROTOCOL_BGP && key.SrcProtocol == proto {
+			count++
+		}
+	}
+
+	for key, p := range ni.Protocols {
+		if key.Ident != openconfig.INSTALL_PROTOCOL_BGP {
+			continue
+		}
+		for _, redist := range p.Bgp.Zebra.Config.RedistRoutes {
+			if redist == proto {
+				count++
+			}
+		}
+	}
+
+	return count
+}
+
+func getBondMode(lagType openconfig.AggregationType) (string, error) {
+	switch lagType {
+	case openconfig.AGGREGATION_LACP:
+		return "802.3ad", nil
+
+	case openconfig.AGGREGATION_STATIC:
+		return "balance-xor", nil
+
+	default:
+		log.Errorf("Unknown lag-type %s", lagType)
+		return "", fmt.Errorf("Unknown lag-type %s", lagType)
+	}
+}
+
+//
+// getStoredMplsStaticLspPathConfig returns the config of static-lsp path
+// stored in datastore (before the changes), or empty config if not found.
+//
+func getStoredMplsStaticLspPathConfig(name string, lspName string, pathName string) *openconfig.MplsStaticLspPathConfig {
+	ni, err := ncmdbm.NetworkInstances().Select(name)
+	if err != nil {
+		return openconfig.NewMplsStaticLspPathConfig()
+	}
+
+	lsp, ok := ni.Mpls.Lsps.StaticLsps[lspName]
+	if !ok {
+		return openconfig.NewMplsStaticLspPathConfig()
+	}
+
+	switch pathName {
+	case openconfig.MPLS_LSP_INGRESS_KEY:
+		return lsp.Ingress.Config
+	case openconfig.MPLS_LSP_TRANSIT_KEY:
+		return lsp.Transit.Config
+	case openconfig.MPLS_LSP_EGRESS_KEY:
+		return lsp.Egress.Config
+	default:
+		return openconfig.NewMplsStaticLspPathConfig()
+	}
+}
+
+//
+// newMplsStaticLspPathConfig returns the config of static-lsp path
+// which the changes are put to the stored config.
+//
+func newMplsStaticLspPathConfig(stored *openconfig.MplsStaticLspPathConfig, config *openconfig.MplsStaticLspPathConfig) *openconfig.MplsStaticLspPathConfig {
+	c := openconfig.NewMplsStaticLspPathConfig()
+	put := func(key string, src *openconfig.MplsStaticLspPathConfig) {
+		switch key {
+		case openconfig.MPLS_LSP_NEXTHOP_KEY:
+			c.NextHop = src.NextHop
+		case openconfig.MPLS_LSP_INCOMING_LABEL_KEY:
+			c.IncomingLabel = src.IncomingLabel
+		case openconfig.MPLS_LSP_PUSH_LABEL_KEY:
+			c.PushLabel = src.PushLabel
+		case openconfig.MPLS_LSP_DESTINATION_KEY:
+			c.Destination = src.Destination
+		}
+		c.SetChange(key)
+	}
+
+	for _, key := range []string{
+		openconfig.MPLS_LSP_NEXTHOP_KEY,
+		openconfig.MPLS_LSP_INCOMING_LABEL_KEY,
+		openconfig.MPLS_LSP_PUSH_LABEL_KEY,
+		openconfig.MPLS_LSP_DESTINATION_KEY,
+	} {
+		if config.GetChange(key) {
+			put(key, config)
+		} else if stored.GetChange(key) {
+			put(key, stored)
+		}
+	}
+
+	return c
+}
+
+func getMplsLspOutLabel(label openconfig.MplsLabel) (string, error) {
+	switch label {
+	case openconfig.MPLS_LABEL_IPV4_EXPLICIT_NULL, openconfig.MPLS_LABEL_IPV6_EXPLICIT_NULL:
+		return "explicit-null", nil
+
+	case openconfig.MPLS_LABEL_IMPLICIT_NULL:
+		return "implicit-null", nil
+
+	default:
+		if !label.IsUnreserved() {
+			log.Errorf("Unsupported push-label %s", label)
+			return "", fmt.Errorf("Unsupported push-label %s", label)
+		}
+		return fmt.Sprintf("%d", label), nil
+	}
+}
+
+//
+// getMplsLspIngressNexthop returns the next-hop arguments of "ip route"
+// which pushes the label to the packets to the destination.
+//
+func getMplsLspIngressNexthop(config *openconfig.MplsStaticLspPathConfig) ([]string, error) {
+	outLabel, err := getMplsLspOutLabel(config.PushLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{config.NextHop.String(), "label", outLabel}, nil
+}
+
 func (s NetworkInstancesSet) Unmarshall(cv *srlib.SrChangeVal) error {
 	return cv.Dispatch(
 		s[srlib.SR_OP_CREATED],
@@ -94,6 +1065,20 @@ func (s NetworkInstancesSet) Unmarshall(cv *srlib.SrChangeVal) error {
 	)
 }
 
+//
+// Diff puts the changes from src to dst as Unmarshall does with
+// the change values of sysrepo.
+//
+func (s NetworkInstancesSet) Diff(src, dst openconfig.NetworkInstances) error {
+	return openconfig.DiffNetworkInstances(
+		src,
+		dst,
+		s[srlib.SR_OP_CREATED],
+		s[srlib.SR_OP_MODIFIED],
+		s[srlib.SR_OP_DELETED],
+	)
+}
+
 func (s NetworkInstancesSet) Walk(oper srlib.SrChangeOper, f func(string, *openconfig.NetworkInstance) error) error {
 	if nis, ok := s[oper]; ok {
 		for name, ni := range nis {
@@ -111,8 +1096,13 @@ type NIUpdateType int
 const (
 	NI_UPDATE_TYPE NIUpdateType = iota
 	NI_UPDATE_VTY
+	NI_UPDATE_VTY_DAEMON
+	NI_UPDATE_VTY_ISISD
+	NI_UPDATE_VTY_BFDD
+	NI_UPDATE_VTY_VRRPD
 	NI_UPDATE_SYSCTL
 	NI_UPDATE_SYSVRF
+	NI_UPDATE_SYSEVPN
 	NI_UPDATE_NETWORK
 	NI_UPDATE_GOBGP
 	NI_UPDATE_GOBGP_CFG
@@ -137,9 +1127,35 @@ func (n NIUpdates) GetUpdate(t NIUpdateType) int {
 	return -1
 }
 
+//
+// NILags is the number of the subinterfaces of the LAG interface
+// which are added (> 0) or removed (< 0) in the transaction.
+//
+type NILags map[string]int
+
+func (n NILags) Clear() {
+	for device, _ := range n {
+		delete(n, device)
+	}
+}
+
+//
+// NIRedists is the number of the table-connections to BGP and
+// the redistribute-routes of zebra of BGP which are removed in the transaction.
+//
+type NIRedists map[openconfig.InstallProtocolType]int
+
+func (n NIRedists) Clear() {
+	for proto, _ := range n {
+		delete(n, proto)
+	}
+}
+
 type NICommands struct {
 	Cmds     *nclib.Commands
 	Upds     NIUpdates
+	Lags     NILags
+	Redists  NIRedists
 	Bgps     *srocgobgp.ConfigProcessor
 	NoCommit bool
 }
@@ -153,6 +1169,8 @@ func NewNICommands(ev srlib.SrNotifEvent, oper srlib.SrChangeOper) *NICommands {
 	return &NICommands{
 		Cmds:     cmds,
 		Upds:     NIUpdates{},
+		Lags:     NILags{},
+		Redists:  NIRedists{},
 		Bgps:     srocgobgp.NewConfigProcessor(),
 		NoCommit: false,
 	}
@@ -167,6 +1185,8 @@ type NICommandsHandler interface {
 func (n *NICommands) Clear() {
 	n.Cmds.Clear()
 	n.Upds.Clear()
+	n.Lags.Clear()
+	n.Redists.Clear()
 	n.Bgps.Clear()
 	n.NoCommit = false
 }
@@ -192,6 +1212,50 @@ func (n *NICommands) SetCmd(t NIUpdateType, do, undo, end *nclib.Shell) {
 	}
 }
 
+//
+// AttachLag returns true if the subinterface is the first one
+// of the LAG interface in the network-instance,
+// then the members of the LAG must be moved into the container.
+//
+func (n *NICommands) AttachLag(name string, device string) bool {
+	first := countNIAggregateSubinterfaces(name, device)+n.Lags[device] == 0
+	n.Lags[device]++
+	return first
+}
+
+//
+// DetachLag returns true if the subinterface is the last one
+// of the LAG interface in the network-instance,
+// then the members of the LAG must be removed from the container.
+//
+func (n *NICommands) DetachLag(name string, device string) bool {
+	n.Lags[device]--
+	return countNIAggregateSubinterfaces(name, device)+n.Lags[device] == 0
+}
+
+//
+// DetachRedist returns true if no other table-connection to BGP
+// nor redistribute-routes of zebra of BGP redistributes the routes of the protocol,
+// then the route type must be removed from zebra config of GoBGP.
+//
+func (n *NICommands) DetachRedist(name string, proto openconfig.InstallProtocolType) bool {
+	n.Redists[proto]++
+	return countNIBgpRedists(name, proto) <= n.Redists[proto]
+}
+
+//
+// DetachRedists returns the protocols which must be removed from zebra config of GoBGP.
+//
+func (n *NICommands) DetachRedists(name string, protos []openconfig.InstallProtocolType) []openconfig.InstallProtocolType {
+	detached := []openconfig.InstallProtocolType{}
+	for _, proto := range protos {
+		if n.DetachRedist(name, proto) {
+			detached = append(detached, proto)
+		}
+	}
+	return detached
+}
+
 func (n *NICommands) TraceBgps(msg string) {
 	if log.GetLevel() == log.TraceLevel {
 		n.Bgps.Iterate(func(line string) error {
@@ -44,6 +44,19 @@ func subscribeNetworkInstanceChange(s *srlib.SrSession) *srlib.Subscriber {
 	return subscr
 }
 
+func subscribeInterfaceChange(s *srlib.SrSession) *srlib.Subscriber {
+	ctrl := ncm.NewIfChangeController(s)
+	ctrl.DryRun = ncmcfg.GetOpts().DryRun
+	subscr, err := ctrl.Subscribe()
+	if err != nil {
+		log.Errorf("subscribeInterfaceChange error. %s", err)
+		os.Exit(1)
+	}
+
+	log.Infof("START: Subscriber(InterfaceChange)")
+	return subscr
+}
+
 func main() {
 	if err := ncmcfg.GetCfg().Init(); err != nil {
 		log.Errorf("Init Config error. %s", err)
@@ -77,6 +90,9 @@ func main() {
 	niSubscr := subscribeNetworkInstanceChange(session)
 	defer niSubscr.Stop()
 
+	ifSubscr := subscribeInterfaceChange(session)
+	defer ifSubscr.Stop()
+
 	ss := ncsignal.NewServer()
 	ss.Register(syscall.SIGPIPE, func(sig os.Signal) {
 		log.Infof("SIGNAL %s", sig)
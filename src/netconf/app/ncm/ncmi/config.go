@@ -34,8 +34,20 @@ func (e *PortEntry) String() string {
 	return fmt.Sprintf("port_id=%d, hw_addr='%s'", e.PortId, e.HwAddr)
 }
 
+type LagEntry struct {
+	Name     string   `mapstructure:"name"`
+	LagType  string   `mapstructure:"lag_type"`
+	MinLinks uint16   `mapstructure:"min_links"`
+	Members  []PortId `mapstructure:"members"`
+}
+
+func (e *LagEntry) String() string {
+	return fmt.Sprintf("name='%s', lag_type='%s', min_links=%d, members=%v", e.Name, e.LagType, e.MinLinks, e.Members)
+}
+
 type DpConfig struct {
 	Ports []*PortEntry `mapstructure:"ports"`
+	Lags  []*LagEntry  `mapstructure:"lags"`
 }
 
 type Config struct {
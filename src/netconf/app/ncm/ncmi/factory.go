@@ -64,18 +64,73 @@ func (f *InterfaceFactory) NewInterface(port *PortEntry) *openconfig.Interface {
 	return iface
 }
 
-func (f *InterfaceFactory) NewInterfaces(ports []*PortEntry) openconfig.Interfaces {
+func (f *InterfaceFactory) NewInterfaceAggregation(lag *LagEntry) (*openconfig.InterfaceAggregation, error) {
+	config := openconfig.NewInterfaceAggregationConfig()
+	if len(lag.LagType) != 0 {
+		lagType, err := openconfig.ParseAggregationType(lag.LagType)
+		if err != nil {
+			return nil, err
+		}
+		config.SetLagType(lagType)
+	}
+	config.SetMinLinks(lag.MinLinks)
+	aggregation := openconfig.NewInterfaceAggregation()
+	aggregation.SetConfig(config)
+	return aggregation, nil
+}
+
+func (f *InterfaceFactory) NewLagInterface(lag *LagEntry) (*openconfig.Interface, error) {
+	aggregation, err := f.NewInterfaceAggregation(lag)
+	if err != nil {
+		return nil, err
+	}
+
+	ifconf := openconfig.NewInterfaceConfig()
+	ifconf.SetName(lag.Name)
+	ifconf.SetType(ncianalib.IANAifType_ieee8023adLag)
+
+	iface := openconfig.NewInterface("")
+	iface.SetConfig(ifconf)
+	iface.SetAggregation(aggregation)
+	return iface, nil
+}
+
+func (f *InterfaceFactory) NewInterfaces(ports []*PortEntry, lags []*LagEntry) (openconfig.Interfaces, error) {
 	ifaces := openconfig.NewInterfaces()
 	for _, port := range ports {
 		iface := f.NewInterface(port)
 		ifaces[iface.Name] = iface
 	}
-	return ifaces
+
+	for _, lag := range lags {
+		iface, err := f.NewLagInterface(lag)
+		if err != nil {
+			return nil, err
+		}
+		ifaces[iface.Name] = iface
+
+		for _, portId := range lag.Members {
+			member, ok := ifaces[f.NewIfname(portId)]
+			if !ok {
+				return nil, fmt.Errorf("LAG member not found. %s port_id=%d", lag.Name, portId)
+			}
+
+			ethernet := member.Ethernet
+			ethernet.Config.SetAggregateId(lag.Name)
+			ethernet.SetConfig(ethernet.Config)
+			member.SetEthernet(ethernet)
+		}
+	}
+
+	return ifaces, nil
 }
 
-func (f *InterfaceFactory) NewInterfacesVals(ports []*PortEntry) ([]*srlib.SrVal, error) {
+func (f *InterfaceFactory) NewInterfacesVals(ports []*PortEntry, lags []*LagEntry) ([]*srlib.SrVal, error) {
 	ifvals := NewInterfaceVals()
-	ifaces := f.NewInterfaces(ports)
+	ifaces, err := f.NewInterfaces(ports, lags)
+	if err != nil {
+		return nil, err
+	}
 	if err := openconfig.ProcessInterfaces(ifvals, false, ifaces); err != nil {
 		return nil, err
 	}
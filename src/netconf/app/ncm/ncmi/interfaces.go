@@ -54,6 +54,14 @@ func (i *InterfaceVals) interfaceEthXPath(name string) string {
 	)
 }
 
+func (i *InterfaceVals) interfaceAggXPath(name string) string {
+	return fmt.Sprintf("%s/%s:%s",
+		i.interfaceXPath(name),
+		openconfig.INTERFACE_AGG_MODULE,
+		openconfig.INTERFACE_AGG_KEY,
+	)
+}
+
 /*
 func (i *InterfaceVals) subinterfaceXPath(name string, index uint32) string {
 	return fmt.Sprintf("%s/%s/%s[%s='%d']",
@@ -125,6 +133,31 @@ func (i *InterfaceVals) InterfaceEthernetConfig(name string, config *openconfig.
 		i.append(srlib.ParseSrVal(config.MacAddr, false, srlib.SR_STRING_T, xpath))
 	}
 
+	if config.GetChange(openconfig.INTERFACE_AGG_ID_KEY) {
+		xpath := fmt.Sprintf("%s/%s:%s", base, openconfig.INTERFACE_AGG_MODULE, openconfig.INTERFACE_AGG_ID_KEY)
+		i.append(srlib.ParseSrVal(config.AggregateId, false, srlib.SR_STRING_T, xpath))
+	}
+
+	return nil
+}
+
+func (i *InterfaceVals) InterfaceAggregationConfig(name string, config *openconfig.InterfaceAggregationConfig) error {
+	base := fmt.Sprintf("%s/%s", i.interfaceAggXPath(name), openconfig.OC_CONFIG_KEY)
+
+	if config.GetChange(openconfig.INTERFACE_AGG_LAGTYPE_KEY) {
+		xpath := fmt.Sprintf("%s/%s", base, openconfig.INTERFACE_AGG_LAGTYPE_KEY)
+		i.append(srlib.ParseSrVal(config.LagType, false, srlib.SR_ENUM_T, xpath))
+	}
+
+	if config.GetChange(openconfig.INTERFACE_AGG_MINLINKS_KEY) {
+		xpath := fmt.Sprintf("%s/%s", base, openconfig.INTERFACE_AGG_MINLINKS_KEY)
+		i.append(srlib.ParseSrVal(config.MinLinks, false, srlib.SR_UINT16_T, xpath))
+	}
+
+	return nil
+}
+
+func (i *InterfaceVals) InterfaceBridgeConfig(name string, config *openconfig.InterfaceBridgeConfig) error {
 	return nil
 }
 
@@ -95,7 +95,11 @@ func (s *ConfigServer) Update() {
 			log.Infof("update interfaces. dpid=%d, %v", dpid, p)
 		}
 
-		vals, err := NewInterfaceFactory("eth%d").NewInterfacesVals(ports.Ports)
+		for _, lag := range ports.Lags {
+			log.Infof("update lag interfaces. dpid=%d, %v", dpid, lag)
+		}
+
+		vals, err := NewInterfaceFactory("eth%d").NewInterfacesVals(ports.Ports, ports.Lags)
 		if err != nil {
 			log.Warnf("NewInterfacesVals error. %s", err)
 			continue
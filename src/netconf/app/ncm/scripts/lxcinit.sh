@@ -27,7 +27,7 @@ BIN_DIR="${NC_BIN}"
 SRC_DIR="${NC_HOME}/etc/lxcinit"
 DST_DIR="/tmp"
 
-BIN_FILES="cfgd cfgcp cfgnet cfgfrr cfgsysctl cfgbgp netplan+"
+BIN_FILES="cfgd cfgcp cfgnet cfgfrr cfgsysctl cfgbgp cfgbgpbfd cfgevpn netplan+"
 
 do_usage() {
     echo "$0 <containe name> <continer type>"
@@ -0,0 +1,114 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package ncevpnlib
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	EVPN_FLOOD_MAC = "00:00:00:00:00:00"
+)
+
+//
+// Command is the command lines to add and delete the entry.
+//
+type Command struct {
+	Add [][]string
+	Del [][]string
+}
+
+func (c *Command) Equals(cmd *Command) bool {
+	return fmt.Sprintf("%v", c.Add) == fmt.Sprintf("%v", cmd.Add)
+}
+
+//
+// Commands is the entries (key -> command).
+//
+type Commands map[string]*Command
+
+func NewCommands() Commands {
+	return Commands{}
+}
+
+func (c Commands) AddPath(path []string) {
+	c[strings.Join(path, " ")] = &Command{
+		Add: [][]string{append([]string{"global", "rib", "add", "-a", "evpn"}, path...)},
+		Del: [][]string{append([]string{"global", "rib", "del", "-a", "evpn"}, path...)},
+	}
+}
+
+func (c Commands) AddFdb(dev string, mac string, dst string) {
+	c[fmt.Sprintf("%s %s", dev, mac)] = &Command{
+		Add: [][]string{{"fdb", "replace", mac, "dev", dev, "dst", dst, "self", "static"}},
+		Del: [][]string{{"fdb", "del", mac, "dev", dev, "self"}},
+	}
+}
+
+func (c Commands) AddFlood(dev string, dst string) {
+	c[fmt.Sprintf("%s %s %s", dev, EVPN_FLOOD_MAC, dst)] = &Command{
+		Add: [][]string{{"fdb", "append", EVPN_FLOOD_MAC, "dev", dev, "dst", dst, "self", "permanent"}},
+		Del: [][]string{{"fdb", "del", EVPN_FLOOD_MAC, "dev", dev, "dst", dst, "self"}},
+	}
+}
+
+//
+// DeleteDevice deletes the fdb entries of the device
+// which are removed with the device.
+//
+func (c Commands) DeleteDevice(dev string) {
+	for key, _ := range c {
+		if strings.HasPrefix(key, dev+" ") {
+			delete(c, key)
+		}
+	}
+}
+
+func (c Commands) Keys() []string {
+	keys := []string{}
+	for key, _ := range c {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+//
+// Diff returns the keys to delete (not in next or changed)
+// and the keys to add (not in c or changed).
+//
+func (c Commands) Diff(next Commands) ([]string, []string) {
+	dels := []string{}
+	for _, key := range c.Keys() {
+		if cmd, ok := next[key]; !ok || !cmd.Equals(c[key]) {
+			dels = append(dels, key)
+		}
+	}
+
+	adds := []string{}
+	for _, key := range next.Keys() {
+		if cmd, ok := c[key]; !ok || !cmd.Equals(next[key]) {
+			adds = append(adds, key)
+		}
+	}
+
+	return dels, adds
+}
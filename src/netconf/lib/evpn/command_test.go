@@ -0,0 +1,59 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package ncevpnlib
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestCommands_Diff(t *testing.T) {
+	cur := NewCommands()
+	cur.AddFdb("vxlan10", "aa:bb:cc:dd:ee:01", "10.0.0.2")
+	cur.AddFdb("vxlan10", "aa:bb:cc:dd:ee:02", "10.0.0.2")
+	cur.AddFlood("vxlan10", "10.0.0.2")
+
+	next := NewCommands()
+	next.AddFdb("vxlan10", "aa:bb:cc:dd:ee:01", "10.0.0.2")
+	next.AddFdb("vxlan10", "aa:bb:cc:dd:ee:02", "10.0.0.3")
+	next.AddFlood("vxlan10", "10.0.0.3")
+
+	dels, adds := cur.Diff(next)
+
+	if v := fmt.Sprintf("%v", dels); v != "[vxlan10 00:00:00:00:00:00 10.0.0.2 vxlan10 aa:bb:cc:dd:ee:02]" {
+		t.Errorf("Diff unmatch. dels=%s", v)
+	}
+
+	if v := fmt.Sprintf("%v", adds); v != "[vxlan10 00:00:00:00:00:00 10.0.0.3 vxlan10 aa:bb:cc:dd:ee:02]" {
+		t.Errorf("Diff unmatch. adds=%s", v)
+	}
+}
+
+func TestCommands_DeleteDevice(t *testing.T) {
+	cmds := NewCommands()
+	cmds.AddFdb("vxlan10", "aa:bb:cc:dd:ee:01", "10.0.0.2")
+	cmds.AddFlood("vxlan10", "10.0.0.2")
+	cmds.AddFlood("vxlan100", "10.0.0.2")
+
+	cmds.DeleteDevice("vxlan10")
+
+	if v := fmt.Sprintf("%v", cmds.Keys()); v != "[vxlan100 00:00:00:00:00:00 10.0.0.2]" {
+		t.Errorf("DeleteDevice unmatch. %s", v)
+	}
+}
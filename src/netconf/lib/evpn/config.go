@@ -0,0 +1,208 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package ncevpnlib
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	EVPN_VXLAN_DEV_PREFIX = "vxlan"
+	EVPN_VXLAN_PORT       = "4789"
+)
+
+//
+// Instance is the EVPN instance in evpn.conf.
+// (<evi> = <vni> <bridge> <route-distinguisher> <route-target>)
+//
+type Instance struct {
+	Evi    string
+	Vni    string
+	Bridge string
+	RD     string
+	RT     string
+}
+
+func NewInstance(evi, vni, bridge, rd, rt string) *Instance {
+	return &Instance{
+		Evi:    evi,
+		Vni:    vni,
+		Bridge: bridge,
+		RD:     rd,
+		RT:     rt,
+	}
+}
+
+func ParseInstance(evi string, value string) (*Instance, error) {
+	items := strings.Fields(value)
+	if len(items) != 4 {
+		return nil, fmt.Errorf("Invalid evpn instance. %s = '%s'", evi, value)
+	}
+
+	return NewInstance(evi, items[0], items[1], items[2], items[3]), nil
+}
+
+func (i *Instance) String() string {
+	return fmt.Sprintf("%s %s %s %s", i.Vni, i.Bridge, i.RD, i.RT)
+}
+
+//
+// Device returns the name of the vxlan device (vxlan<vni>).
+//
+func (i *Instance) Device() string {
+	return fmt.Sprintf("%s%s", EVPN_VXLAN_DEV_PREFIX, i.Vni)
+}
+
+//
+// DeviceCommand returns the ip commands which create the vxlan device
+// without learning and attach it to the bridge.
+//
+func (i *Instance) DeviceCommand(vtep string) *Command {
+	dev := i.Device()
+	return &Command{
+		Add: [][]string{
+			{"link", "add", dev, "type", "vxlan", "id", i.Vni, "local", vtep, "dstport", EVPN_VXLAN_PORT, "nolearning"},
+			{"link", "set", dev, "master", i.Bridge},
+			{"link", "set", dev, "up"},
+		},
+		Del: [][]string{
+			{"link", "del", dev},
+		},
+	}
+}
+
+//
+// MulticastPath returns the arguments of the inclusive multicast route (type 3).
+//
+func (i *Instance) MulticastPath(vtep string) []string {
+	return []string{"multicast", vtep, "etag", "0", "rd", i.RD, "rt", i.RT, "encap", "vxlan", "nexthop", vtep}
+}
+
+//
+// MacadvPath returns the arguments of the mac advertisement route (type 2).
+//
+func (i *Instance) MacadvPath(vtep string, mac string) []string {
+	return []string{"macadv", mac, "0.0.0.0", "etag", "0", "label", i.Vni, "rd", i.RD, "rt", i.RT, "encap", "vxlan", "nexthop", vtep}
+}
+
+//
+// Config is the EVPN instances in evpn.conf (evi -> instance).
+//
+type Config map[string]*Instance
+
+func NewConfig() Config {
+	return Config{}
+}
+
+func (c Config) Set(evi string, value string) error {
+	inst, err := ParseInstance(evi, value)
+	if err != nil {
+		return err
+	}
+
+	c[evi] = inst
+	return nil
+}
+
+func (c Config) Get(f func(string) error) error {
+	for _, evi := range c.Evis() {
+		line := fmt.Sprintf("%s = %s", evi, c[evi])
+		if err := f(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c Config) Evis() []string {
+	evis := []string{}
+	for evi, _ := range c {
+		evis = append(evis, evi)
+	}
+	sort.Strings(evis)
+	return evis
+}
+
+//
+// Devices returns the commands of the vxlan devices (device -> command).
+//
+func (c Config) Devices(vtep string) Commands {
+	cmds := NewCommands()
+	for _, inst := range c {
+		cmds[inst.Device()] = inst.DeviceCommand(vtep)
+	}
+	return cmds
+}
+
+//
+// LocalPaths returns the gobgp commands which originate the routes
+// of the instances which have the vxlan device (macs: evi -> local macs).
+//
+func (c Config) LocalPaths(vtep string, devs Commands, macs map[string][]string) Commands {
+	cmds := NewCommands()
+	for evi, inst := range c {
+		if _, ok := devs[inst.Device()]; !ok {
+			continue
+		}
+
+		cmds.AddPath(inst.MulticastPath(vtep))
+		for _, mac := range macs[evi] {
+			cmds.AddPath(inst.MacadvPath(vtep, mac))
+		}
+	}
+	return cmds
+}
+
+//
+// RemoteFdbs returns the bridge commands which program the fdb of the vxlan devices
+// from the routes received from the remote VTEPs.
+// The mac advertisement route adds the mac address to the remote VTEP,
+// and the inclusive multicast route adds the remote VTEP to the flood list.
+//
+func (c Config) RemoteFdbs(vtep string, devs Commands, routes []*Route) Commands {
+	cmds := NewCommands()
+	for _, evi := range c.Evis() {
+		inst := c[evi]
+		dev := inst.Device()
+		if _, ok := devs[dev]; !ok {
+			continue
+		}
+
+		for _, route := range routes {
+			if !route.HasRT(inst.RT) {
+				continue
+			}
+
+			dst := route.Vtep()
+			if len(dst) == 0 || dst == vtep || dst == "0.0.0.0" {
+				continue
+			}
+
+			switch route.Type {
+			case EVPN_ROUTE_MACADV:
+				cmds.AddFdb(dev, route.Mac, dst)
+			case EVPN_ROUTE_MULTICAST:
+				cmds.AddFlood(dev, dst)
+			}
+		}
+	}
+	return cmds
+}
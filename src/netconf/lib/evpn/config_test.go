@@ -0,0 +1,130 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package ncevpnlib
+
+import (
+	"bytes"
+	"fmt"
+	prop "netconf/lib/property"
+	"strings"
+	"testing"
+)
+
+func TestConfig(t *testing.T) {
+	cfg := NewConfig()
+	if err := prop.Read(strings.NewReader("20 = 10020 br20 10.0.0.1:20 65001:20\n10=10010 br10 10.0.0.1:10 65001:10\n"), cfg); err != nil {
+		t.Errorf("Read error. %s", err)
+	}
+
+	inst, ok := cfg["10"]
+	if !ok {
+		t.Fatalf("Config unmatch. %v", cfg)
+	}
+
+	if v := inst.Device(); v != "vxlan10010" {
+		t.Errorf("Config unmatch. device=%s", v)
+	}
+
+	if v := inst.Bridge; v != "br10" {
+		t.Errorf("Config unmatch. bridge=%s", v)
+	}
+
+	b := &bytes.Buffer{}
+	if err := prop.Write(b, cfg); err != nil {
+		t.Errorf("Write error. %s", err)
+	}
+
+	if v := b.String(); v != "10 = 10010 br10 10.0.0.1:10 65001:10\n20 = 10020 br20 10.0.0.1:20 65001:20\n" {
+		t.Errorf("Config unmatch. %s", v)
+	}
+}
+
+func TestConfig_invalid(t *testing.T) {
+	cfg := NewConfig()
+	if err := prop.Read(strings.NewReader("10 = 10010 br10\n"), cfg); err == nil {
+		t.Errorf("Read must be error.")
+	}
+}
+
+func TestConfig_LocalPaths(t *testing.T) {
+	cfg := Config{
+		"10": NewInstance("10", "10010", "br10", "10.0.0.1:10", "65001:10"),
+		"20": NewInstance("20", "10020", "br20", "10.0.0.1:20", "65001:20"),
+	}
+	devs := NewCommands()
+	devs["vxlan10010"] = cfg["10"].DeviceCommand("10.0.0.1")
+
+	macs := map[string][]string{
+		"10": []string{"aa:bb:cc:dd:ee:01"},
+		"20": []string{"aa:bb:cc:dd:ee:02"},
+	}
+
+	cmds := cfg.LocalPaths("10.0.0.1", devs, macs)
+
+	exp := []string{
+		"macadv aa:bb:cc:dd:ee:01 0.0.0.0 etag 0 label 10010 rd 10.0.0.1:10 rt 65001:10 encap vxlan nexthop 10.0.0.1",
+		"multicast 10.0.0.1 etag 0 rd 10.0.0.1:10 rt 65001:10 encap vxlan nexthop 10.0.0.1",
+	}
+	if v := cmds.Keys(); fmt.Sprintf("%q", v) != fmt.Sprintf("%q", exp) {
+		t.Errorf("LocalPaths unmatch. %q", v)
+	}
+
+	if v := fmt.Sprintf("%v", cmds[exp[1]].Del); v != "[[global rib del -a evpn "+exp[1]+"]]" {
+		t.Errorf("LocalPaths unmatch. %s", v)
+	}
+}
+
+func TestConfig_RemoteFdbs(t *testing.T) {
+	cfg := Config{
+		"10": NewInstance("10", "10010", "br10", "10.0.0.1:10", "65001:10"),
+	}
+	devs := cfg.Devices("10.0.0.1")
+
+	routes := []*Route{
+		{Type: "macadv", Mac: "aa:bb:cc:dd:ee:01", Nexthop: "0.0.0.0", RTs: []string{"65001:10"}},
+		{Type: "multicast", IP: "10.0.0.1", Nexthop: "0.0.0.0", RTs: []string{"65001:10"}},
+		{Type: "macadv", Mac: "aa:bb:cc:dd:ee:02", Nexthop: "10.0.0.2", RTs: []string{"65001:10"}},
+		{Type: "multicast", IP: "10.0.0.2", Nexthop: "10.0.0.2", RTs: []string{"65001:10"}},
+		{Type: "multicast", IP: "10.0.0.3", Nexthop: "10.0.0.3", RTs: []string{"65001:10"}},
+		{Type: "macadv", Mac: "aa:bb:cc:dd:ee:04", Nexthop: "10.0.0.4", RTs: []string{"65001:20"}},
+	}
+
+	cmds := cfg.RemoteFdbs("10.0.0.1", devs, routes)
+
+	exp := []string{
+		"vxlan10010 00:00:00:00:00:00 10.0.0.2",
+		"vxlan10010 00:00:00:00:00:00 10.0.0.3",
+		"vxlan10010 aa:bb:cc:dd:ee:02",
+	}
+	if v := cmds.Keys(); fmt.Sprintf("%q", v) != fmt.Sprintf("%q", exp) {
+		t.Errorf("RemoteFdbs unmatch. %q", v)
+	}
+
+	if v := fmt.Sprintf("%v", cmds[exp[2]].Add); v != "[[fdb replace aa:bb:cc:dd:ee:02 dev vxlan10010 dst 10.0.0.2 self static]]" {
+		t.Errorf("RemoteFdbs unmatch. %s", v)
+	}
+
+	if v := fmt.Sprintf("%v", cmds[exp[0]].Del); v != "[[fdb del 00:00:00:00:00:00 dev vxlan10010 dst 10.0.0.2 self]]" {
+		t.Errorf("RemoteFdbs unmatch. %s", v)
+	}
+
+	if v := cfg.RemoteFdbs("10.0.0.1", NewCommands(), routes); len(v) != 0 {
+		t.Errorf("RemoteFdbs unmatch. %v", v)
+	}
+}
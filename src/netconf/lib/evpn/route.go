@@ -0,0 +1,189 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package ncevpnlib
+
+import (
+	"bufio"
+	"bytes"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	EVPN_ROUTE_MACADV    = "macadv"
+	EVPN_ROUTE_MULTICAST = "multicast"
+)
+
+//
+// Route is the best path of 'gobgp global rib -a evpn'.
+//
+type Route struct {
+	Type    string
+	RD      string
+	Mac     string
+	IP      string
+	Nexthop string
+	RTs     []string
+}
+
+func (r *Route) HasRT(rt string) bool {
+	for _, v := range r.RTs {
+		if v == rt {
+			return true
+		}
+	}
+	return false
+}
+
+//
+// Vtep returns the address of the VTEP which originates the route.
+// It is the next-hop of the mac advertisement route,
+// and the originating router's address of the inclusive multicast route.
+//
+func (r *Route) Vtep() string {
+	if r.Type == EVPN_ROUTE_MULTICAST && net.ParseIP(r.IP) != nil {
+		return r.IP
+	}
+	return r.Nexthop
+}
+
+var evpnNlriFieldRe = regexp.MustCompile(`\[(\w+):([^\]]*)\]`)
+
+//
+// ParseRoutes parses the best paths of 'gobgp global rib -a evpn'.
+// (e.g. "*> [type:macadv][rd:10.0.0.1:10][etag:0][mac:aa:bb:cc:dd:ee:ff][ip:<nil>] [10010] 10.0.0.1 ... [{Origin: i} {Extcomms: [65001:10], [VXLAN]}]")
+//
+func ParseRoutes(data []byte) []*Route {
+	routes := []*Route{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "*>") {
+			continue
+		}
+
+		items := strings.Fields(line[2:])
+		if len(items) == 0 {
+			continue
+		}
+
+		route := &Route{}
+		for _, m := range evpnNlriFieldRe.FindAllStringSubmatch(items[0], -1) {
+			switch m[1] {
+			case "type":
+				route.Type = m[2]
+			case "rd":
+				route.RD = m[2]
+			case "mac":
+				route.Mac = m[2]
+			case "ip":
+				route.IP = m[2]
+			}
+		}
+
+		for _, item := range items[1:] {
+			if net.ParseIP(item) != nil {
+				route.Nexthop = item
+				break
+			}
+		}
+
+		route.RTs = parseExtcomms(line)
+		routes = append(routes, route)
+	}
+
+	return routes
+}
+
+//
+// parseExtcomms returns the route targets in the Extcomms attribute.
+// (e.g. "{Extcomms: [65001:10], [VXLAN]}" -> ["65001:10"])
+//
+func parseExtcomms(line string) []string {
+	rts := []string{}
+
+	index := strings.Index(line, "Extcomms:")
+	if index < 0 {
+		return rts
+	}
+
+	attr := line[index+len("Extcomms:"):]
+	if end := strings.Index(attr, "}"); end >= 0 {
+		attr = attr[:end]
+	}
+
+	for _, item := range strings.Split(attr, ",") {
+		item = strings.Trim(strings.TrimSpace(item), "[]")
+		if strings.Contains(item, ":") {
+			rts = append(rts, item)
+		}
+	}
+
+	return rts
+}
+
+//
+// ParseLocalMacs parses the mac addresses learned on the ports
+// except for the vxlan devices in 'bridge fdb show br <bridge>'.
+// (e.g. "aa:bb:cc:dd:ee:ff dev eth1 master br10")
+//
+func ParseLocalMacs(data []byte) []string {
+	macs := map[string]struct{}{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		items := strings.Fields(scanner.Text())
+		if len(items) < 3 || items[1] != "dev" || strings.HasPrefix(items[2], EVPN_VXLAN_DEV_PREFIX) {
+			continue
+		}
+
+		if hasItem(items, "master") && !hasItem(items, "permanent") && !hasItem(items, "static") {
+			macs[items[0]] = struct{}{}
+		}
+	}
+
+	list := []string{}
+	for mac, _ := range macs {
+		list = append(list, mac)
+	}
+	sort.Strings(list)
+	return list
+}
+
+func hasItem(items []string, s string) bool {
+	for _, item := range items {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+var routerIdRe = regexp.MustCompile(`(?m)^Router-ID:\s*(\S+)`)
+
+//
+// ParseRouterId parses the router-id in 'gobgp global'.
+//
+func ParseRouterId(data []byte) string {
+	if m := routerIdRe.FindSubmatch(data); m != nil {
+		return string(m[1])
+	}
+	return ""
+}
@@ -0,0 +1,81 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package ncevpnlib
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParseRoutes(t *testing.T) {
+	rib := `   Network                                                                       Labels     Next Hop             AS_PATH              Age        Attrs
+*> [type:macadv][rd:10.0.0.1:10][etag:0][mac:aa:bb:cc:dd:ee:01][ip:<nil>]       [10010]    0.0.0.0                                   00:01:00   [{Origin: ?} {Extcomms: [65001:10], [VXLAN]}]
+*> [type:macadv][rd:10.0.0.2:10][esi:single-homed][etag:0][mac:aa:bb:cc:dd:ee:02][ip:<nil>] [10010]    10.0.0.2             65002                00:00:30   [{Origin: ?} {Extcomms: [65001:10], [VXLAN]}]
+*  [type:macadv][rd:10.0.0.2:10][etag:0][mac:aa:bb:cc:dd:ee:02][ip:<nil>]       [10010]    10.0.0.3             65003                00:00:30   [{Origin: ?} {Extcomms: [65001:10], [VXLAN]}]
+*> [type:multicast][rd:10.0.0.2:10][etag:0][ip:10.0.0.2]                                   10.0.0.2             65002                00:00:30   [{Origin: ?} {Extcomms: [VXLAN], [65001:10], [65001:11]}]
+`
+	routes := ParseRoutes([]byte(rib))
+
+	if v := len(routes); v != 3 {
+		t.Fatalf("ParseRoutes unmatch. #routes=%d", v)
+	}
+
+	if v := fmt.Sprintf("%v", *routes[1]); v != "{macadv 10.0.0.2:10 aa:bb:cc:dd:ee:02 <nil> 10.0.0.2 [65001:10]}" {
+		t.Errorf("ParseRoutes unmatch. %s", v)
+	}
+
+	if v := fmt.Sprintf("%v", *routes[2]); v != "{multicast 10.0.0.2:10  10.0.0.2 10.0.0.2 [65001:10 65001:11]}" {
+		t.Errorf("ParseRoutes unmatch. %s", v)
+	}
+
+	if v := routes[0].Vtep(); v != "0.0.0.0" {
+		t.Errorf("ParseRoutes unmatch. vtep=%s", v)
+	}
+
+	if v := routes[2].HasRT("65001:11"); !v {
+		t.Errorf("ParseRoutes unmatch. rt=%v", routes[2].RTs)
+	}
+}
+
+func TestParseLocalMacs(t *testing.T) {
+	fdb := `33:33:00:00:00:01 dev eth1 self permanent
+aa:bb:cc:dd:ee:02 dev eth2 master br10
+aa:bb:cc:dd:ee:01 dev eth1 vlan 1 master br10
+aa:bb:cc:dd:ee:03 dev vxlan10010 master br10
+de:ad:be:ef:00:01 dev br10 vlan 1 master br10 permanent
+aa:bb:cc:dd:ee:04 dev vxlan10010 dst 10.0.0.2 self static
+`
+	if v := fmt.Sprintf("%v", ParseLocalMacs([]byte(fdb))); v != "[aa:bb:cc:dd:ee:01 aa:bb:cc:dd:ee:02]" {
+		t.Errorf("ParseLocalMacs unmatch. %s", v)
+	}
+}
+
+func TestParseRouterId(t *testing.T) {
+	global := `AS:        65001
+Router-ID: 10.0.0.1
+Listening Port: 179, Addresses: 0.0.0.0, ::
+`
+	if v := ParseRouterId([]byte(global)); v != "10.0.0.1" {
+		t.Errorf("ParseRouterId unmatch. %s", v)
+	}
+
+	if v := ParseRouterId([]byte("")); v != "" {
+		t.Errorf("ParseRouterId unmatch. %s", v)
+	}
+}
@@ -45,6 +45,45 @@ func RawAfiSafis(afisafis []AfiSafi) interface{} {
 	return list
 }
 
+func SelectAfiSafi(afisafis []AfiSafi, name string) (AfiSafi, int) {
+	for index, afisafi := range afisafis {
+		if afisafi.Config().AfiSafiName() == name {
+			return afisafi, index
+		}
+	}
+	return nil, -1
+}
+
+//
+// MergeAfiSafis overwrites entries of dst by src with same afi-safi-name
+// and appends others.
+//
+func MergeAfiSafis(dst []AfiSafi, src []AfiSafi) []AfiSafi {
+	for _, sAfiSafi := range src {
+		name := sAfiSafi.Config().AfiSafiName()
+		if _, index := SelectAfiSafi(dst, name); index < 0 {
+			dst = append(dst, sAfiSafi)
+		} else {
+			dst[index] = sAfiSafi
+		}
+	}
+	return dst
+}
+
+//
+// DeleteAfiSafis returns entries of dst which are not in src.
+//
+func DeleteAfiSafis(dst []AfiSafi, src []AfiSafi) []AfiSafi {
+	afisafis := []AfiSafi{}
+	for _, afisafi := range dst {
+		name := afisafi.Config().AfiSafiName()
+		if _, index := SelectAfiSafi(src, name); index < 0 {
+			afisafis = append(afisafis, afisafi)
+		}
+	}
+	return afisafis
+}
+
 func (a AfiSafi) Config() AfiSafiConfig {
 	return NewAfiSafiConfig(getValue(a, "config"))
 }
@@ -71,7 +71,7 @@ func (c *Config) Global() Global {
 }
 
 func (c *Config) SetGlobal(g Global) {
-	c.Set("global", g)
+	c.Set("global", Entries(g).Raw())
 }
 
 func (c *Config) HasZebra() bool {
@@ -83,7 +83,7 @@ func (c *Config) Zebra() Zebra {
 }
 
 func (c *Config) SetZebra(z Zebra) {
-	c.Set("zebra", z)
+	c.Set("zebra", Entries(z).Raw())
 }
 
 func (c *Config) Neighbor(addr string) (Neighbor, int) {
@@ -102,6 +102,38 @@ func (c *Config) SetNeighbors(neighbors []Neighbor) {
 	c.Set("neighbors", RawNeighbors(neighbors))
 }
 
+func (c *Config) PeerGroup(name string) (PeerGroup, int) {
+	return SelectPeerGroup(c.Get("peer-groups"), name)
+}
+
+func (c *Config) HasPeerGroups() bool {
+	return c.InConfig("peer-groups")
+}
+
+func (c *Config) PeerGroups() []PeerGroup {
+	return NewPeerGroups(c.Get("peer-groups"))
+}
+
+func (c *Config) SetPeerGroups(pgs []PeerGroup) {
+	c.Set("peer-groups", RawPeerGroups(pgs))
+}
+
+func (c *Config) DynamicNeighbor(prefix string) (DynamicNeighbor, int) {
+	return SelectDynamicNeighbor(c.Get("dynamic-neighbors"), prefix)
+}
+
+func (c *Config) HasDynamicNeighbors() bool {
+	return c.InConfig("dynamic-neighbors")
+}
+
+func (c *Config) DynamicNeighbors() []DynamicNeighbor {
+	return NewDynamicNeighbors(c.Get("dynamic-neighbors"))
+}
+
+func (c *Config) SetDynamicNeighbors(neighs []DynamicNeighbor) {
+	c.Set("dynamic-neighbors", RawDynamicNeighbors(neighs))
+}
+
 func (c *Config) PolicyDefinition(name string) (PolicyDefinition, int) {
 	return SelectPolicyDefinition(c.Get("policy-definitions"), name)
 }
@@ -118,6 +150,18 @@ func (c *Config) SetPolicyDefinitions(defs []PolicyDefinition) {
 	c.Set("policy-definitions", RawPolicyDefinitions(defs))
 }
 
+func (c *Config) HasDefinedSets() bool {
+	return c.InConfig("defined-sets")
+}
+
+func (c *Config) DefinedSets() DefinedSets {
+	return NewDefinedSets(c.Get("defined-sets"))
+}
+
+func (c *Config) SetDefinedSets(sets DefinedSets) {
+	c.Set("defined-sets", Entries(sets).Raw())
+}
+
 func (c *Config) DeleteUnusedPolicyDefinition() {
 	polNames := make(map[string]struct{})
 	for _, neigh := range c.Neighbors() {
@@ -125,6 +169,11 @@ func (c *Config) DeleteUnusedPolicyDefinition() {
 			polNames[name] = struct{}{}
 		}
 	}
+	for _, pg := range c.PeerGroups() {
+		for _, name := range pg.ApplyPolicy().Config().PolicyList() {
+			polNames[name] = struct{}{}
+		}
+	}
 
 	pols := []PolicyDefinition{}
 	for _, pol := range c.PolicyDefinitions() {
@@ -135,6 +184,48 @@ func (c *Config) DeleteUnusedPolicyDefinition() {
 	c.SetPolicyDefinitions(pols)
 }
 
+func (c *Config) DeleteUnusedDefinedSets() {
+	if !c.HasDefinedSets() {
+		return
+	}
+
+	pfxNames := make(map[string]struct{})
+	neighNames := make(map[string]struct{})
+	tagNames := make(map[string]struct{})
+	commNames := make(map[string]struct{})
+	extCommNames := make(map[string]struct{})
+	asPathNames := make(map[string]struct{})
+	for _, pol := range c.PolicyDefinitions() {
+		for _, stmt := range pol.Statements() {
+			conds := stmt.Conditions()
+			pfxNames[conds.MatchPrefixSet()] = struct{}{}
+			neighNames[conds.MatchNeighborSet()] = struct{}{}
+			tagNames[conds.MatchTagSet()] = struct{}{}
+
+			bgpConds := conds.BgpConditions()
+			commNames[bgpConds.MatchCommunitySet()] = struct{}{}
+			extCommNames[bgpConds.MatchExtCommunitySet()] = struct{}{}
+			asPathNames[bgpConds.MatchAsPathSet()] = struct{}{}
+
+			bgpActs := stmt.Actions().BgpActions()
+			commNames[bgpActs.SetCommunitySetRef()] = struct{}{}
+			extCommNames[bgpActs.SetExtCommunitySetRef()] = struct{}{}
+		}
+	}
+
+	sets := c.DefinedSets()
+	sets.SetPrefixSets(FilterDefinedSetList(sets.PrefixSets(), PREFIX_SET_NAME_KEY, pfxNames))
+	sets.SetNeighborSets(FilterDefinedSetList(sets.NeighborSets(), NEIGHBOR_SET_NAME_KEY, neighNames))
+	sets.SetTagSets(FilterDefinedSetList(sets.TagSets(), TAG_SET_NAME_KEY, tagNames))
+
+	bgpSets := sets.BgpDefinedSets()
+	bgpSets.SetCommunitySets(FilterDefinedSetList(bgpSets.CommunitySets(), COMMUNITY_SET_NAME_KEY, commNames))
+	bgpSets.SetExtCommunitySets(FilterDefinedSetList(bgpSets.ExtCommunitySets(), EXT_COMMUNITY_SET_NAME_KEY, extCommNames))
+	bgpSets.SetAsPathSets(FilterDefinedSetList(bgpSets.AsPathSets(), AS_PATH_SET_NAME_KEY, asPathNames))
+	sets.SetBgpDefinedSets(bgpSets)
+	c.SetDefinedSets(sets)
+}
+
 func (c *Config) DeleteDuplicatePolicyDefinition() {
 	polNames := make(map[string]struct{})
 	pols := []PolicyDefinition{}
@@ -149,12 +240,27 @@ func (c *Config) DeleteDuplicatePolicyDefinition() {
 
 func (c *Config) Merge(src *Config) {
 	if src.HasGlobal() {
-		c.SetGlobal(src.Global())
+		g := c.Global()
+		g.Merge(src.Global())
+		c.SetGlobal(g)
 	}
 
 	if src.HasZebra() {
-		c.SetZebra(src.Zebra())
+		z := c.Zebra()
+		z.Merge(src.Zebra())
+		c.SetZebra(z)
+	}
+
+	pgs := c.PeerGroups()
+	for _, sPg := range src.PeerGroups() {
+		name := sPg.Config().PeerGroupName()
+		if _, index := c.PeerGroup(name); index < 0 {
+			pgs = append(pgs, sPg)
+		} else {
+			pgs[index] = sPg
+		}
 	}
+	c.SetPeerGroups(pgs)
 
 	neighs := c.Neighbors()
 	for _, sNeigh := range src.Neighbors() {
@@ -167,6 +273,17 @@ func (c *Config) Merge(src *Config) {
 	}
 	c.SetNeighbors(neighs)
 
+	dynNeighs := c.DynamicNeighbors()
+	for _, sNeigh := range src.DynamicNeighbors() {
+		prefix := sNeigh.Config().Prefix()
+		if _, index := c.DynamicNeighbor(prefix); index < 0 {
+			dynNeighs = append(dynNeighs, sNeigh)
+		} else {
+			dynNeighs[index] = sNeigh
+		}
+	}
+	c.SetDynamicNeighbors(dynNeighs)
+
 	pols := c.PolicyDefinitions()
 	for _, sPol := range src.PolicyDefinitions() {
 		name := sPol.Name()
@@ -178,15 +295,35 @@ func (c *Config) Merge(src *Config) {
 	}
 	c.SetPolicyDefinitions(pols)
 	c.DeleteDuplicatePolicyDefinition()
+
+	if src.HasDefinedSets() {
+		sets := c.DefinedSets()
+		sSets := src.DefinedSets()
+		sets.SetPrefixSets(MergeDefinedSetList(sets.PrefixSets(), sSets.PrefixSets(), PREFIX_SET_NAME_KEY))
+		sets.SetNeighborSets(MergeDefinedSetList(sets.NeighborSets(), sSets.NeighborSets(), NEIGHBOR_SET_NAME_KEY))
+		sets.SetTagSets(MergeDefinedSetList(sets.TagSets(), sSets.TagSets(), TAG_SET_NAME_KEY))
+
+		bgpSets := sets.BgpDefinedSets()
+		sBgpSets := sSets.BgpDefinedSets()
+		bgpSets.SetCommunitySets(MergeDefinedSetList(bgpSets.CommunitySets(), sBgpSets.CommunitySets(), COMMUNITY_SET_NAME_KEY))
+		bgpSets.SetExtCommunitySets(MergeDefinedSetList(bgpSets.ExtCommunitySets(), sBgpSets.ExtCommunitySets(), EXT_COMMUNITY_SET_NAME_KEY))
+		bgpSets.SetAsPathSets(MergeDefinedSetList(bgpSets.AsPathSets(), sBgpSets.AsPathSets(), AS_PATH_SET_NAME_KEY))
+		sets.SetBgpDefinedSets(bgpSets)
+		c.SetDefinedSets(sets)
+	}
 }
 
 func (c *Config) Delete(src *Config) {
 	if src.HasGlobal() {
-		c.SetGlobal(NewGlobal(nil))
+		g := c.Global()
+		g.Delete(src.Global())
+		c.SetGlobal(g)
 	}
 
 	if src.HasZebra() {
-		c.SetZebra(NewZebra(nil))
+		z := c.Zebra()
+		z.Delete(src.Zebra())
+		c.SetZebra(z)
 	}
 
 	neighs := []Neighbor{}
@@ -197,5 +334,35 @@ func (c *Config) Delete(src *Config) {
 		}
 	}
 	c.SetNeighbors(neighs)
+
+	dynNeighs := []DynamicNeighbor{}
+	for _, neigh := range c.DynamicNeighbors() {
+		prefix := neigh.Config().Prefix()
+		if _, index := src.DynamicNeighbor(prefix); index < 0 {
+			dynNeighs = append(dynNeighs, neigh)
+		}
+	}
+	c.SetDynamicNeighbors(dynNeighs)
+
+	// peer-groups still referenced by the neighbors are not deleted.
+	pgNames := make(map[string]struct{})
+	for _, neigh := range c.Neighbors() {
+		pgNames[neigh.Config().PeerGroup()] = struct{}{}
+	}
+	for _, neigh := range c.DynamicNeighbors() {
+		pgNames[neigh.Config().PeerGroup()] = struct{}{}
+	}
+
+	pgs := []PeerGroup{}
+	for _, pg := range c.PeerGroups() {
+		name := pg.Config().PeerGroupName()
+		_, used := pgNames[name]
+		if _, index := src.PeerGroup(name); index < 0 || used {
+			pgs = append(pgs, pg)
+		}
+	}
+	c.SetPeerGroups(pgs)
+
 	c.DeleteUnusedPolicyDefinition()
+	c.DeleteUnusedDefinedSets()
 }
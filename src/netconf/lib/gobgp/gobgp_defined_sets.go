@@ -0,0 +1,175 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ncgobgp
+
+const (
+	PREFIX_SET_NAME_KEY   = "prefix-set-name"
+	NEIGHBOR_SET_NAME_KEY = "neighbor-set-name"
+	TAG_SET_NAME_KEY      = "tag-set-name"
+
+	COMMUNITY_SET_NAME_KEY     = "community-set-name"
+	EXT_COMMUNITY_SET_NAME_KEY = "ext-community-set-name"
+	AS_PATH_SET_NAME_KEY       = "as-path-set-name"
+)
+
+//
+// [defined-sets]
+//
+type DefinedSets Entries
+
+func NewDefinedSets(i interface{}) DefinedSets {
+	return DefinedSets(NewEntries(i))
+}
+
+func (d DefinedSets) PrefixSets() []DefinedSet {
+	return NewDefinedSetList(getValue(d, "prefix-sets"))
+}
+
+func (d DefinedSets) SetPrefixSets(sets []DefinedSet) {
+	d["prefix-sets"] = RawDefinedSetList(sets)
+}
+
+func (d DefinedSets) NeighborSets() []DefinedSet {
+	return NewDefinedSetList(getValue(d, "neighbor-sets"))
+}
+
+func (d DefinedSets) SetNeighborSets(sets []DefinedSet) {
+	d["neighbor-sets"] = RawDefinedSetList(sets)
+}
+
+func (d DefinedSets) TagSets() []DefinedSet {
+	return NewDefinedSetList(getValue(d, "tag-sets"))
+}
+
+func (d DefinedSets) SetTagSets(sets []DefinedSet) {
+	d["tag-sets"] = RawDefinedSetList(sets)
+}
+
+func (d DefinedSets) BgpDefinedSets() BgpDefinedSets {
+	return NewBgpDefinedSets(getValue(d, "bgp-defined-sets"))
+}
+
+func (d DefinedSets) SetBgpDefinedSets(sets BgpDefinedSets) {
+	d["bgp-defined-sets"] = Entries(sets).Raw()
+}
+
+//
+// [defined-sets.bgp-defined-sets]
+//
+type BgpDefinedSets Entries
+
+func NewBgpDefinedSets(i interface{}) BgpDefinedSets {
+	return BgpDefinedSets(NewEntries(i))
+}
+
+func (d BgpDefinedSets) CommunitySets() []DefinedSet {
+	return NewDefinedSetList(getValue(d, "community-sets"))
+}
+
+func (d BgpDefinedSets) SetCommunitySets(sets []DefinedSet) {
+	d["community-sets"] = RawDefinedSetList(sets)
+}
+
+func (d BgpDefinedSets) ExtCommunitySets() []DefinedSet {
+	return NewDefinedSetList(getValue(d, "ext-community-sets"))
+}
+
+func (d BgpDefinedSets) SetExtCommunitySets(sets []DefinedSet) {
+	d["ext-community-sets"] = RawDefinedSetList(sets)
+}
+
+func (d BgpDefinedSets) AsPathSets() []DefinedSet {
+	return NewDefinedSetList(getValue(d, "as-path-sets"))
+}
+
+func (d BgpDefinedSets) SetAsPathSets(sets []DefinedSet) {
+	d["as-path-sets"] = RawDefinedSetList(sets)
+}
+
+//
+// [[defined-sets.prefix-sets]], [[defined-sets.neighbor-sets]], ...
+//
+type DefinedSet Entries
+
+func NewDefinedSet(i interface{}) DefinedSet {
+	return DefinedSet(NewEntries(i))
+}
+
+func NewDefinedSetList(i interface{}) []DefinedSet {
+	sets := []DefinedSet{}
+
+	switch i.(type) {
+	case nil:
+	default:
+		for _, s := range i.([]interface{}) {
+			sets = append(sets, NewDefinedSet(s))
+		}
+	}
+
+	return sets
+}
+
+func RawDefinedSetList(sets []DefinedSet) interface{} {
+	list := make([]interface{}, len(sets))
+	for index, set := range sets {
+		list[index] = Entries(set).Raw()
+	}
+	return list
+}
+
+func SelectDefinedSet(sets []DefinedSet, nameKey string, name string) (DefinedSet, int) {
+	for index, set := range sets {
+		if set.Name(nameKey) == name {
+			return set, index
+		}
+	}
+
+	return nil, -1
+}
+
+func (s DefinedSet) Name(nameKey string) string {
+	return convString(s, nameKey)
+}
+
+//
+// MergeDefinedSetList replaces the sets in dst by the sets in src
+// which have same name, and appends others.
+//
+func MergeDefinedSetList(dst []DefinedSet, src []DefinedSet, nameKey string) []DefinedSet {
+	for _, sSet := range src {
+		if _, index := SelectDefinedSet(dst, nameKey, sSet.Name(nameKey)); index < 0 {
+			dst = append(dst, sSet)
+		} else {
+			dst[index] = sSet
+		}
+	}
+	return dst
+}
+
+//
+// FilterDefinedSetList returns the sets whose names are in names.
+//
+func FilterDefinedSetList(sets []DefinedSet, nameKey string, names map[string]struct{}) []DefinedSet {
+	filtered := []DefinedSet{}
+	for _, set := range sets {
+		if _, ok := names[set.Name(nameKey)]; ok {
+			filtered = append(filtered, set)
+		}
+	}
+	return filtered
+}
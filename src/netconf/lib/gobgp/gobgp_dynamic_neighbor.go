@@ -0,0 +1,90 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ncgobgp
+
+//
+// [dynamic-neighbors]
+//
+type DynamicNeighbor Entries
+
+func NewDynamicNeighbor(i interface{}) DynamicNeighbor {
+	return DynamicNeighbor(NewEntries(i))
+}
+
+func NewDynamicNeighbors(i interface{}) []DynamicNeighbor {
+	neighs := []DynamicNeighbor{}
+	switch i.(type) {
+	case nil:
+	default:
+		for _, neigh := range i.([]interface{}) {
+			neighs = append(neighs, NewDynamicNeighbor(neigh))
+		}
+	}
+	return neighs
+}
+
+func RawDynamicNeighbors(neighs []DynamicNeighbor) interface{} {
+	list := make([]interface{}, len(neighs))
+	for index, neigh := range neighs {
+		list[index] = Entries(neigh).Raw()
+	}
+	return list
+}
+
+func SelectDynamicNeighbor(i interface{}, prefix string) (DynamicNeighbor, int) {
+	switch i.(type) {
+	case nil:
+	default:
+		for index, n := range i.([]interface{}) {
+			if neigh := NewDynamicNeighbor(n); neigh.Config().Prefix() == prefix {
+				return neigh, index
+			}
+		}
+	}
+
+	return nil, -1
+}
+
+func (d DynamicNeighbor) Config() DynamicNeighborConfig {
+	return NewDynamicNeighborConfig(getValue(d, "config"))
+}
+
+//
+// [dynamic-neighbors.config]
+//
+type DynamicNeighborConfig Entries
+
+func NewDynamicNeighborConfig(i interface{}) DynamicNeighborConfig {
+	return DynamicNeighborConfig(NewEntries(i))
+}
+
+func (c DynamicNeighborConfig) Prefix() string {
+	return convString(c, "prefix")
+}
+
+func (c DynamicNeighborConfig) SetPrefix(v string) {
+	c["prefix"] = v
+}
+
+func (c DynamicNeighborConfig) PeerGroup() string {
+	return convString(c, "peer-group")
+}
+
+func (c DynamicNeighborConfig) SetPeerGroup(v string) {
+	c["peer-group"] = v
+}
@@ -30,6 +30,49 @@ func (g Global) Config() GlobalConfig {
 	return NewGlobalConfig(getValue(g, "config"))
 }
 
+func (g Global) AfiSafis() []AfiSafi {
+	return NewAfiSafis(getValue(g, "afi-safis"))
+}
+
+func (g Global) SetAfiSafis(afisafis []AfiSafi) {
+	g["afi-safis"] = RawAfiSafis(afisafis)
+}
+
+//
+// Merge overwrites sub tables (config, graceful-restart, ...) of g by src.
+// afi-safis are merged by afi-safi-name.
+//
+func (g Global) Merge(src Global) {
+	for key, val := range src {
+		if key == "afi-safis" {
+			g.SetAfiSafis(MergeAfiSafis(g.AfiSafis(), src.AfiSafis()))
+			continue
+		}
+		g[key] = val
+	}
+}
+
+//
+// Delete removes sub tables of g which are in src.
+// If src has config, global is deleted entirely.
+//
+func (g Global) Delete(src Global) {
+	if _, ok := src["config"]; ok {
+		for key := range g {
+			delete(g, key)
+		}
+		return
+	}
+
+	for key := range src {
+		if key == "afi-safis" {
+			g.SetAfiSafis(DeleteAfiSafis(g.AfiSafis(), src.AfiSafis()))
+			continue
+		}
+		delete(g, key)
+	}
+}
+
 //
 // [global.config]
 //
@@ -121,6 +121,14 @@ func (c NeighborConfig) SetLocalAs(v uint32) {
 	c["local-as"] = v
 }
 
+func (c NeighborConfig) PeerGroup() string {
+	return convString(c, "peer-group")
+}
+
+func (c NeighborConfig) SetPeerGroup(v string) {
+	c["peer-group"] = v
+}
+
 //
 // [neighbors.apply-policy]
 //
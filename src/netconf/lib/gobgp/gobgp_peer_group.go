@@ -0,0 +1,122 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ncgobgp
+
+//
+// [peer-groups]
+//
+type PeerGroup Entries
+
+func NewPeerGroup(i interface{}) PeerGroup {
+	return PeerGroup(NewEntries(i))
+}
+
+func NewPeerGroups(i interface{}) []PeerGroup {
+	pgs := []PeerGroup{}
+	switch i.(type) {
+	case nil:
+	default:
+		for _, pg := range i.([]interface{}) {
+			pgs = append(pgs, NewPeerGroup(pg))
+		}
+	}
+	return pgs
+}
+
+func RawPeerGroups(pgs []PeerGroup) interface{} {
+	list := make([]interface{}, len(pgs))
+	for index, pg := range pgs {
+		list[index] = Entries(pg).Raw()
+	}
+	return list
+}
+
+func SelectPeerGroup(i interface{}, name string) (PeerGroup, int) {
+	switch i.(type) {
+	case nil:
+	default:
+		for index, p := range i.([]interface{}) {
+			if pg := NewPeerGroup(p); pg.Config().PeerGroupName() == name {
+				return pg, index
+			}
+		}
+	}
+
+	return nil, -1
+}
+
+func (p PeerGroup) Config() PeerGroupConfig {
+	return NewPeerGroupConfig(getValue(p, "config"))
+}
+
+func (p PeerGroup) SetConfig(v PeerGroupConfig) {
+	p["config"] = v
+}
+
+func (p PeerGroup) Transport() Transport {
+	return NewTransport(getValue(p, "transport"))
+}
+
+func (p PeerGroup) SetTransport(v Transport) {
+	p["transport"] = v
+}
+
+func (p PeerGroup) AfiSafis() []AfiSafi {
+	return NewAfiSafis(getValue(p, "afi-safis"))
+}
+
+func (p PeerGroup) SetAfisafis(afisafis []AfiSafi) {
+	p["afi-safis"] = RawAfiSafis(afisafis)
+}
+
+func (p PeerGroup) ApplyPolicy() ApplyPolicy {
+	return NewApplyPolicy(getValue(p, "apply-policy"))
+}
+
+//
+// [peer-groups.config]
+//
+type PeerGroupConfig Entries
+
+func NewPeerGroupConfig(i interface{}) PeerGroupConfig {
+	return PeerGroupConfig(NewEntries(i))
+}
+
+func (c PeerGroupConfig) PeerGroupName() string {
+	return convString(c, "peer-group-name")
+}
+
+func (c PeerGroupConfig) SetPeerGroupName(v string) {
+	c["peer-group-name"] = v
+}
+
+func (c PeerGroupConfig) PeerAs() uint32 {
+	return uint32(convUint(c, "peer-as"))
+}
+
+func (c PeerGroupConfig) SetPeerAs(v uint32) {
+	c["peer-as"] = v
+}
+
+func (c PeerGroupConfig) LocalAs() uint32 {
+	return uint32(convUint(c, "local-as"))
+}
+
+func (c PeerGroupConfig) SetLocalAs(v uint32) {
+	c["local-as"] = v
+}
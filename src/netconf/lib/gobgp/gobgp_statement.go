@@ -44,3 +44,89 @@ func RawStatements(stmts []Statement) interface{} {
 	}
 	return list
 }
+
+func (s Statement) Conditions() Conditions {
+	return NewConditions(getValue(s, "conditions"))
+}
+
+func (s Statement) Actions() Actions {
+	return NewActions(getValue(s, "actions"))
+}
+
+//
+// [policy-definitions.statements.conditions]
+//
+type Conditions Entries
+
+func NewConditions(i interface{}) Conditions {
+	return Conditions(NewEntries(i))
+}
+
+func (c Conditions) MatchPrefixSet() string {
+	return convString(NewEntries(getValue(c, "match-prefix-set")), "prefix-set")
+}
+
+func (c Conditions) MatchNeighborSet() string {
+	return convString(NewEntries(getValue(c, "match-neighbor-set")), "neighbor-set")
+}
+
+func (c Conditions) MatchTagSet() string {
+	return convString(NewEntries(getValue(c, "match-tag-set")), "tag-set")
+}
+
+func (c Conditions) BgpConditions() BgpConditions {
+	return NewBgpConditions(getValue(c, "bgp-conditions"))
+}
+
+//
+// [policy-definitions.statements.conditions.bgp-conditions]
+//
+type BgpConditions Entries
+
+func NewBgpConditions(i interface{}) BgpConditions {
+	return BgpConditions(NewEntries(i))
+}
+
+func (c BgpConditions) MatchCommunitySet() string {
+	return convString(NewEntries(getValue(c, "match-community-set")), "community-set")
+}
+
+func (c BgpConditions) MatchExtCommunitySet() string {
+	return convString(NewEntries(getValue(c, "match-ext-community-set")), "ext-community-set")
+}
+
+func (c BgpConditions) MatchAsPathSet() string {
+	return convString(NewEntries(getValue(c, "match-as-path-set")), "as-path-set")
+}
+
+//
+// [policy-definitions.statements.actions]
+//
+type Actions Entries
+
+func NewActions(i interface{}) Actions {
+	return Actions(NewEntries(i))
+}
+
+func (a Actions) BgpActions() BgpActions {
+	return NewBgpActions(getValue(a, "bgp-actions"))
+}
+
+//
+// [policy-definitions.statements.actions.bgp-actions]
+//
+type BgpActions Entries
+
+func NewBgpActions(i interface{}) BgpActions {
+	return BgpActions(NewEntries(i))
+}
+
+func (a BgpActions) SetCommunitySetRef() string {
+	comm := NewEntries(getValue(a, "set-community"))
+	return convString(NewEntries(getValue(comm, "set-community-method")), "community-set-ref")
+}
+
+func (a BgpActions) SetExtCommunitySetRef() string {
+	comm := NewEntries(getValue(a, "set-ext-community"))
+	return convString(NewEntries(getValue(comm, "set-ext-community-method")), "ext-community-set-ref")
+}
@@ -19,6 +19,7 @@ package ncgobgp
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -69,3 +70,467 @@ func TestViper(t *testing.T) {
 		fmt.Printf("policy-definition: %v\n", d)
 	}
 }
+
+func TestConfig_MergeDefinedSets(t *testing.T) {
+	dst, err := ReadConfigFile("test/gobgp_test.toml", "toml")
+	if err != nil {
+		t.Fatalf("ReadConfig error. %s", err)
+	}
+
+	src, err := ReadConfig(strings.NewReader(`
+[[defined-sets.neighbor-sets]]
+  neighbor-set-name = "ns-rr"
+  neighbor-info-list = ["10.0.0.101"]
+
+[[defined-sets.prefix-sets]]
+  prefix-set-name = "ps-private"
+  [[defined-sets.prefix-sets.prefix-list]]
+    ip-prefix = "10.0.0.0/8"
+`), "toml")
+	if err != nil {
+		t.Fatalf("ReadConfig error. %s", err)
+	}
+
+	dst.Merge(src)
+
+	if v := len(dst.DefinedSets().NeighborSets()); v != 1 {
+		t.Errorf("Config.Merge unmatch. #neighbor-sets=%d", v)
+	}
+
+	nset, index := SelectDefinedSet(dst.DefinedSets().NeighborSets(), NEIGHBOR_SET_NAME_KEY, "ns-rr")
+	if index < 0 {
+		t.Fatalf("Config.Merge unmatch. %v", dst.DefinedSets())
+	}
+
+	if v := fmt.Sprintf("%v", nset["neighbor-info-list"]); v != "[10.0.0.101]" {
+		t.Errorf("Config.Merge unmatch. neighbor-info-list=%s", v)
+	}
+
+	if _, index := SelectDefinedSet(dst.DefinedSets().PrefixSets(), PREFIX_SET_NAME_KEY, "ps-private"); index < 0 {
+		t.Errorf("Config.Merge unmatch. %v", dst.DefinedSets())
+	}
+
+	dst.DeleteUnusedDefinedSets()
+
+	if v := len(dst.DefinedSets().PrefixSets()); v != 0 {
+		t.Errorf("Config.DeleteUnusedDefinedSets unmatch. #prefix-sets=%d", v)
+	}
+}
+
+func TestConfig_MergeBgpDefinedSets(t *testing.T) {
+	dst, err := ReadConfigFile("test/gobgp_test.toml", "toml")
+	if err != nil {
+		t.Fatalf("ReadConfig error. %s", err)
+	}
+
+	src, err := ReadConfig(strings.NewReader(`
+[[defined-sets.bgp-defined-sets.community-sets]]
+  community-set-name = "cs-customer"
+  community-list = ["65001:100"]
+
+[[defined-sets.bgp-defined-sets.as-path-sets]]
+  as-path-set-name = "as-customer"
+  as-path-list = ["^65001_"]
+`), "toml")
+	if err != nil {
+		t.Fatalf("ReadConfig error. %s", err)
+	}
+
+	dst.Merge(src)
+
+	bgpSets := dst.DefinedSets().BgpDefinedSets()
+	if _, index := SelectDefinedSet(bgpSets.CommunitySets(), COMMUNITY_SET_NAME_KEY, "cs-customer"); index < 0 {
+		t.Errorf("Config.Merge unmatch. %v", bgpSets)
+	}
+
+	if _, index := SelectDefinedSet(bgpSets.AsPathSets(), AS_PATH_SET_NAME_KEY, "as-customer"); index < 0 {
+		t.Errorf("Config.Merge unmatch. %v", bgpSets)
+	}
+
+	dst.DeleteUnusedDefinedSets()
+
+	if v := len(dst.DefinedSets().BgpDefinedSets().CommunitySets()); v != 0 {
+		t.Errorf("Config.DeleteUnusedDefinedSets unmatch. #community-sets=%d", v)
+	}
+}
+
+func TestConfig_DeleteUnusedDefinedSets_SetCommunity(t *testing.T) {
+	c, err := ReadConfig(strings.NewReader(`
+[[defined-sets.bgp-defined-sets.community-sets]]
+  community-set-name = "cs-customer"
+  community-list = ["65001:100"]
+
+[[policy-definitions]]
+  name = "pol1"
+  [[policy-definitions.statements]]
+    name = "stmt1"
+    [policy-definitions.statements.actions.bgp-actions.set-community]
+      options = "add"
+      [policy-definitions.statements.actions.bgp-actions.set-community.set-community-method]
+        community-set-ref = "cs-customer"
+`), "toml")
+	if err != nil {
+		t.Fatalf("ReadConfig error. %s", err)
+	}
+
+	c.DeleteUnusedDefinedSets()
+
+	if v := len(c.DefinedSets().BgpDefinedSets().CommunitySets()); v != 1 {
+		t.Errorf("Config.DeleteUnusedDefinedSets unmatch. #community-sets=%d", v)
+	}
+}
+
+func TestConfig_MergeDeletePeerGroups(t *testing.T) {
+	dst, err := ReadConfig(strings.NewReader(`
+[[policy-definitions]]
+  name = "pol-pg"
+
+[[peer-groups]]
+  [peer-groups.config]
+    peer-group-name = "pg1"
+    peer-as = 65001
+`), "toml")
+	if err != nil {
+		t.Fatalf("ReadConfig error. %s", err)
+	}
+
+	src, err := ReadConfig(strings.NewReader(`
+[[peer-groups]]
+  [peer-groups.config]
+    peer-group-name = "pg1"
+    peer-as = 65002
+  [peer-groups.apply-policy.config]
+    import-policy-list = ["pol-pg"]
+
+[[peer-groups]]
+  [peer-groups.config]
+    peer-group-name = "pg2"
+
+[[neighbors]]
+  [neighbors.config]
+    neighbor-address = "10.0.0.1"
+    peer-group = "pg1"
+`), "toml")
+	if err != nil {
+		t.Fatalf("ReadConfig error. %s", err)
+	}
+
+	dst.Merge(src)
+
+	if v := len(dst.PeerGroups()); v != 2 {
+		t.Errorf("Config.Merge unmatch. #peer-groups=%d", v)
+	}
+
+	pg, index := dst.PeerGroup("pg1")
+	if index < 0 {
+		t.Fatalf("Config.Merge unmatch. %v", dst.PeerGroups())
+	}
+
+	if v := pg.Config().PeerAs(); v != 65002 {
+		t.Errorf("Config.Merge unmatch. peer-as=%d", v)
+	}
+
+	neigh, _ := dst.Neighbor("10.0.0.1")
+	if v := neigh.Config().PeerGroup(); v != "pg1" {
+		t.Errorf("Config.Merge unmatch. peer-group=%s", v)
+	}
+
+	dst.DeleteUnusedPolicyDefinition()
+
+	if _, index := dst.PolicyDefinition("pol-pg"); index < 0 {
+		t.Errorf("Config.DeleteUnusedPolicyDefinition unmatch. %v", dst.PolicyDefinitions())
+	}
+
+	del, err := ReadConfig(strings.NewReader(`
+[[peer-groups]]
+  [peer-groups.config]
+    peer-group-name = "pg1"
+
+[[neighbors]]
+  [neighbors.config]
+    neighbor-address = "10.0.0.1"
+`), "toml")
+	if err != nil {
+		t.Fatalf("ReadConfig error. %s", err)
+	}
+
+	dst.Delete(del)
+
+	if _, index := dst.PeerGroup("pg1"); index >= 0 {
+		t.Errorf("Config.Delete unmatch. %v", dst.PeerGroups())
+	}
+
+	if _, index := dst.PeerGroup("pg2"); index < 0 {
+		t.Errorf("Config.Delete unmatch. %v", dst.PeerGroups())
+	}
+
+	if v := len(dst.PolicyDefinitions()); v != 0 {
+		t.Errorf("Config.Delete unmatch. #policy-definitions=%d", v)
+	}
+}
+
+func TestConfig_DeletePeerGroups_Referenced(t *testing.T) {
+	dst, err := ReadConfig(strings.NewReader(`
+[[peer-groups]]
+  [peer-groups.config]
+    peer-group-name = "pg1"
+
+[[peer-groups]]
+  [peer-groups.config]
+    peer-group-name = "pg2"
+
+[[neighbors]]
+  [neighbors.config]
+    neighbor-address = "10.0.0.1"
+    peer-group = "pg1"
+`), "toml")
+	if err != nil {
+		t.Fatalf("ReadConfig error. %s", err)
+	}
+
+	del, err := ReadConfig(strings.NewReader(`
+[[peer-groups]]
+  [peer-groups.config]
+    peer-group-name = "pg1"
+
+[[peer-groups]]
+  [peer-groups.config]
+    peer-group-name = "pg2"
+`), "toml")
+	if err != nil {
+		t.Fatalf("ReadConfig error. %s", err)
+	}
+
+	dst.Delete(del)
+
+	if _, index := dst.PeerGroup("pg1"); index < 0 {
+		t.Errorf("Config.Delete unmatch. %v", dst.PeerGroups())
+	}
+
+	if _, index := dst.PeerGroup("pg2"); index >= 0 {
+		t.Errorf("Config.Delete unmatch. %v", dst.PeerGroups())
+	}
+}
+
+func TestConfig_MergeGlobal(t *testing.T) {
+	dst, err := ReadConfig(strings.NewReader(`
+[global.config]
+  as = 65001
+  router-id = "10.0.0.1"
+`), "toml")
+	if err != nil {
+		t.Fatalf("ReadConfig error. %s", err)
+	}
+
+	src, err := ReadConfig(strings.NewReader(`
+[global.graceful-restart.config]
+  enabled = true
+  restart-time = 120
+`), "toml")
+	if err != nil {
+		t.Fatalf("ReadConfig error. %s", err)
+	}
+
+	dst.Merge(src)
+	dst.Merge(src)
+
+	if v := dst.Global().Config().As(); v != 65001 {
+		t.Errorf("Config.Merge unmatch. as=%d", v)
+	}
+
+	if _, ok := dst.Global()["graceful-restart"]; !ok {
+		t.Errorf("Config.Merge unmatch. %v", dst.Global())
+	}
+}
+
+func TestConfig_MergeDeleteGlobalAfiSafis(t *testing.T) {
+	dst, err := ReadConfig(strings.NewReader(`
+[global.config]
+  as = 65001
+
+[[global.afi-safis]]
+  [global.afi-safis.config]
+    afi-safi-name = "ipv4-unicast"
+    enabled = true
+`), "toml")
+	if err != nil {
+		t.Fatalf("ReadConfig error. %s", err)
+	}
+
+	src, err := ReadConfig(strings.NewReader(`
+[global.use-multiple-paths.config]
+  enabled = true
+
+[[global.afi-safis]]
+  [global.afi-safis.config]
+    afi-safi-name = "ipv6-unicast"
+    enabled = true
+`), "toml")
+	if err != nil {
+		t.Fatalf("ReadConfig error. %s", err)
+	}
+
+	dst.Merge(src)
+
+	if v := len(dst.Global().AfiSafis()); v != 2 {
+		t.Errorf("Config.Merge unmatch. #afi-safis=%d", v)
+	}
+
+	del, err := ReadConfig(strings.NewReader(`
+[[global.afi-safis]]
+  [global.afi-safis.config]
+    afi-safi-name = "ipv4-unicast"
+`), "toml")
+	if err != nil {
+		t.Fatalf("ReadConfig error. %s", err)
+	}
+
+	dst.Delete(del)
+
+	afisafis := dst.Global().AfiSafis()
+	if v := len(afisafis); v != 1 {
+		t.Fatalf("Config.Delete unmatch. #afi-safis=%d", v)
+	}
+
+	if v := afisafis[0].Config().AfiSafiName(); v != "ipv6-unicast" {
+		t.Errorf("Config.Delete unmatch. afi-safi-name=%s", v)
+	}
+
+	if v := dst.Global().Config().As(); v != 65001 {
+		t.Errorf("Config.Delete unmatch. as=%d", v)
+	}
+
+	if _, ok := dst.Global()["use-multiple-paths"]; !ok {
+		t.Errorf("Config.Delete unmatch. %v", dst.Global())
+	}
+}
+
+func TestConfig_MergeDeleteDynamicNeighbors(t *testing.T) {
+	dst, err := ReadConfig(strings.NewReader(`
+[[dynamic-neighbors]]
+  [dynamic-neighbors.config]
+    prefix = "10.0.0.0/24"
+    peer-group = "pg1"
+`), "toml")
+	if err != nil {
+		t.Fatalf("ReadConfig error. %s", err)
+	}
+
+	src, err := ReadConfig(strings.NewReader(`
+[[dynamic-neighbors]]
+  [dynamic-neighbors.config]
+    prefix = "10.0.0.0/24"
+    peer-group = "pg2"
+
+[[dynamic-neighbors]]
+  [dynamic-neighbors.config]
+    prefix = "10.0.1.0/24"
+    peer-group = "pg1"
+`), "toml")
+	if err != nil {
+		t.Fatalf("ReadConfig error. %s", err)
+	}
+
+	dst.Merge(src)
+
+	if v := len(dst.DynamicNeighbors()); v != 2 {
+		t.Errorf("Config.Merge unmatch. #dynamic-neighbors=%d", v)
+	}
+
+	neigh, index := dst.DynamicNeighbor("10.0.0.0/24")
+	if index < 0 {
+		t.Fatalf("Config.Merge unmatch. %v", dst.DynamicNeighbors())
+	}
+
+	if v := neigh.Config().PeerGroup(); v != "pg2" {
+		t.Errorf("Config.Merge unmatch. peer-group=%s", v)
+	}
+
+	del, err := ReadConfig(strings.NewReader(`
+[[dynamic-neighbors]]
+  [dynamic-neighbors.config]
+    prefix = "10.0.0.0/24"
+`), "toml")
+	if err != nil {
+		t.Fatalf("ReadConfig error. %s", err)
+	}
+
+	dst.Delete(del)
+
+	if _, index := dst.DynamicNeighbor("10.0.0.0/24"); index >= 0 {
+		t.Errorf("Config.Delete unmatch. %v", dst.DynamicNeighbors())
+	}
+
+	if _, index := dst.DynamicNeighbor("10.0.1.0/24"); index < 0 {
+		t.Errorf("Config.Delete unmatch. %v", dst.DynamicNeighbors())
+	}
+}
+
+func TestConfig_MergeDeleteZebra(t *testing.T) {
+	dst, err := ReadConfig(strings.NewReader(`
+[zebra.config]
+  enabled = true
+  version = 5
+  url = "unix:/var/run/frr/zserv.api"
+  redistribute-route-type-list = ["connected"]
+`), "toml")
+	if err != nil {
+		t.Fatalf("ReadConfig error. %s", err)
+	}
+
+	src, err := ReadConfig(strings.NewReader(`
+[zebra.config]
+  redistribute-route-type-list = ["connected", "ospf"]
+`), "toml")
+	if err != nil {
+		t.Fatalf("ReadConfig error. %s", err)
+	}
+
+	dst.Merge(src)
+
+	config := dst.Zebra().Config()
+	if v := config.Enabled(); !v {
+		t.Errorf("Config.Merge unmatch. enabled=%t", v)
+	}
+
+	if v := config.Url(); v != "unix:/var/run/frr/zserv.api" {
+		t.Errorf("Config.Merge unmatch. url=%s", v)
+	}
+
+	if v := config.RedistributeRouteTypeList(); len(v) != 2 || v[0] != "connected" || v[1] != "ospf" {
+		t.Errorf("Config.Merge unmatch. redistribute-route-type-list=%v", v)
+	}
+
+	del, err := ReadConfig(strings.NewReader(`
+[zebra.config]
+  redistribute-route-type-list = ["connected"]
+`), "toml")
+	if err != nil {
+		t.Fatalf("ReadConfig error. %s", err)
+	}
+
+	dst.Delete(del)
+
+	config = dst.Zebra().Config()
+	if v := config.Enabled(); !v {
+		t.Errorf("Config.Delete unmatch. enabled=%t", v)
+	}
+
+	if v := config.RedistributeRouteTypeList(); len(v) != 1 || v[0] != "ospf" {
+		t.Errorf("Config.Delete unmatch. redistribute-route-type-list=%v", v)
+	}
+
+	del, err = ReadConfig(strings.NewReader(`
+[zebra.config]
+  enabled = true
+`), "toml")
+	if err != nil {
+		t.Fatalf("ReadConfig error. %s", err)
+	}
+
+	dst.Delete(del)
+
+	if v := dst.Zebra().Config(); v != nil {
+		t.Errorf("Config.Delete unmatch. zebra.config=%v", v)
+	}
+}
@@ -17,6 +17,10 @@
 
 package ncgobgp
 
+const (
+	ZEBRA_REDIST_ROUTE_TYPES_KEY = "redistribute-route-type-list"
+)
+
 type Zebra Entries
 
 func NewZebra(i interface{}) Zebra {
@@ -34,6 +38,53 @@ func (z Zebra) SetConfig(v ZebraConfig) {
 	z["config"] = v
 }
 
+//
+// Merge overwrites items of zebra.config of z by src.
+// redistribute-route-type-list is merged by route type.
+//
+func (z Zebra) Merge(src Zebra) {
+	sConfig := src.Config()
+	if sConfig == nil {
+		return
+	}
+
+	config := z.Config()
+	if config == nil {
+		config = NewZebraConfig(nil)
+	}
+
+	for key, val := range sConfig {
+		if key == ZEBRA_REDIST_ROUTE_TYPES_KEY {
+			config.AddRedistributeRouteTypes(sConfig.RedistributeRouteTypeList())
+			continue
+		}
+		config[key] = val
+	}
+
+	z["config"] = Entries(config).Raw()
+}
+
+//
+// Delete removes route types of src from redistribute-route-type-list
+// if src has no other items. Otherwise zebra is deleted entirely.
+//
+func (z Zebra) Delete(src Zebra) {
+	sConfig := src.Config()
+	if sConfig != nil {
+		if _, ok := sConfig[ZEBRA_REDIST_ROUTE_TYPES_KEY]; ok && len(sConfig) == 1 {
+			if config := z.Config(); config != nil {
+				config.DelRedistributeRouteTypes(sConfig.RedistributeRouteTypeList())
+				z["config"] = Entries(config).Raw()
+			}
+			return
+		}
+	}
+
+	for key := range z {
+		delete(z, key)
+	}
+}
+
 type ZebraConfig Entries
 
 func NewZebraConfig(i interface{}) ZebraConfig {
@@ -65,7 +116,11 @@ func (z ZebraConfig) SetVersion(v uint8) {
 }
 
 func (z ZebraConfig) RedistributeRouteTypeList() []string {
-	list := convList(z, "redistribute-route-type-list")
+	if types, ok := z[ZEBRA_REDIST_ROUTE_TYPES_KEY].([]string); ok {
+		return types
+	}
+
+	list := convList(z, ZEBRA_REDIST_ROUTE_TYPES_KEY)
 	types := []string{}
 	if list != nil {
 		for _, l := range list {
@@ -76,5 +131,34 @@ func (z ZebraConfig) RedistributeRouteTypeList() []string {
 }
 
 func (z ZebraConfig) SetRedistributeRouteTypeList(types []string) {
-	z["redistribute-route-type-list"] = types
+	z[ZEBRA_REDIST_ROUTE_TYPES_KEY] = types
+}
+
+func (z ZebraConfig) AddRedistributeRouteTypes(types []string) {
+	list := z.RedistributeRouteTypeList()
+	for _, t := range types {
+		if indexOfStrings(list, t) < 0 {
+			list = append(list, t)
+		}
+	}
+	z.SetRedistributeRouteTypeList(list)
+}
+
+func (z ZebraConfig) DelRedistributeRouteTypes(types []string) {
+	list := []string{}
+	for _, t := range z.RedistributeRouteTypeList() {
+		if indexOfStrings(types, t) < 0 {
+			list = append(list, t)
+		}
+	}
+	z.SetRedistributeRouteTypeList(list)
+}
+
+func indexOfStrings(list []string, s string) int {
+	for index, v := range list {
+		if v == s {
+			return index
+		}
+	}
+	return -1
 }
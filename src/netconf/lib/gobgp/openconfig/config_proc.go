@@ -24,14 +24,39 @@ import (
 	"netconf/lib/openconfig"
 )
 
+//
+// ConfigProcessor converts openconfig to gobgp config (toml).
+// Items of [zebra.config] are written by bgp/zebra and table-connections,
+// so that they are collected and put in one table at the end.
+//
 type ConfigProcessor struct {
-	items *list.List
+	items        *list.List
+	zebraItems   *list.List
+	zebraRedists []string
+	definedSets  *openconfig.PolicyDefinedSets
 }
 
 func NewConfigProcessor() *ConfigProcessor {
 	return &ConfigProcessor{
-		items: list.New(),
+		items:        list.New(),
+		zebraItems:   list.New(),
+		zebraRedists: nil,
+	}
+}
+
+func (p *ConfigProcessor) zebraLines() []string {
+	if p.zebraItems.Len() == 0 && p.zebraRedists == nil {
+		return []string{}
+	}
+
+	lines := []string{"[zebra.config]"}
+	for e := p.zebraItems.Front(); e != nil; e = e.Next() {
+		lines = append(lines, e.Value.(string))
+	}
+	if p.zebraRedists != nil {
+		lines = append(lines, fmt.Sprintf("redistribute-route-type-list = %s", QStringList(p.zebraRedists)))
 	}
+	return lines
 }
 
 func (p *ConfigProcessor) Iterate(f func(string) error) error {
@@ -40,11 +65,16 @@ func (p *ConfigProcessor) Iterate(f func(string) error) error {
 			return err
 		}
 	}
+	for _, line := range p.zebraLines() {
+		if err := f(line); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func (b *ConfigProcessor) Len() int {
-	return b.items.Len()
+	return b.items.Len() + len(b.zebraLines())
 }
 
 func (p *ConfigProcessor) Items() []string {
@@ -68,6 +98,35 @@ func (p *ConfigProcessor) Bytes() *bytes.Buffer {
 
 func (p *ConfigProcessor) Clear() {
 	p.items = list.New()
+	p.zebraItems = list.New()
+	p.zebraRedists = nil
+	p.definedSets = nil
+}
+
+//
+// SetDefinedSets sets the defined-sets to expand the set references
+// (community-set-ref, ext-community-set-ref) which gobgp does not support.
+//
+func (p *ConfigProcessor) SetDefinedSets(sets *openconfig.PolicyDefinedSets) {
+	p.definedSets = sets
+}
+
+func (p *ConfigProcessor) communitySetMembers(name string) ([]string, error) {
+	if sets := p.definedSets; sets != nil && sets.Bgp != nil {
+		if set, ok := sets.Bgp.CommunitySets[name]; ok {
+			return set.Config.Members, nil
+		}
+	}
+	return nil, fmt.Errorf("community-set not found. %s", name)
+}
+
+func (p *ConfigProcessor) extCommunitySetMembers(name string) ([]string, error) {
+	if sets := p.definedSets; sets != nil && sets.Bgp != nil {
+		if set, ok := sets.Bgp.ExtCommunitySets[name]; ok {
+			return set.Config.Members, nil
+		}
+	}
+	return nil, fmt.Errorf("ext-community-set not found. %s", name)
 }
 
 func (p *ConfigProcessor) addList(name string) {
@@ -82,6 +141,30 @@ func (p *ConfigProcessor) addItem(name string, value interface{}) {
 	p.items.PushBack(fmt.Sprintf("%s = %v", name, value))
 }
 
+func (p *ConfigProcessor) addZebraItem(name string, value interface{}) {
+	p.zebraItems.PushBack(fmt.Sprintf("%s = %v", name, value))
+}
+
+func (p *ConfigProcessor) addZebraRedists(types ...string) {
+	if p.zebraRedists == nil {
+		p.zebraRedists = []string{}
+	}
+	for _, t := range types {
+		if !containsString(p.zebraRedists, t) {
+			p.zebraRedists = append(p.zebraRedists, t)
+		}
+	}
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *ConfigProcessor) Bgp(name string, key *openconfig.NetworkInstanceProtocolKey, bgp *openconfig.Bgp) error {
 	return nil
 }
@@ -101,29 +184,416 @@ func (p *ConfigProcessor) BgpGlobalConfig(name string, key *openconfig.NetworkIn
 	return nil
 }
 
-func (p *ConfigProcessor) BgpZebraConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.BgpZebraConfig) error {
+func (p *ConfigProcessor) BgpGlobalConfederationConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.BgpGlobalConfederationConfig) error {
+
+	p.addNode("global.confederation.config")
+
+	if config.GetChange(openconfig.OC_ENABLED_KEY) {
+		p.addItem("enabled", config.Enabled)
+	}
+
+	if config.GetChange(openconfig.OC_IDENT_KEY) {
+		p.addItem("identifier", config.Identifier)
+	}
+
+	if config.GetChange(openconfig.BGP_MEMBER_AS_KEY) {
+		p.addItem("member-as-list", Uint32List(config.MemberAs))
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) BgpGlobalGracefulRestartConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.BgpGracefulRestartConfig) error {
+	return p.gracefulRestartConfig("global.graceful-restart.config", config)
+}
 
-	p.addNode("zebra.config")
+func (p *ConfigProcessor) gracefulRestartConfig(node string, config *openconfig.BgpGracefulRestartConfig) error {
+
+	p.addNode(node)
 
 	if config.GetChange(openconfig.OC_ENABLED_KEY) {
 		p.addItem("enabled", config.Enabled)
 	}
 
+	if config.GetChange(openconfig.BGP_RESTART_TIME_KEY) {
+		p.addItem("restart-time", config.RestartTime)
+	}
+
+	if config.GetChange(openconfig.BGP_STALE_TIME_KEY) {
+		p.addItem("stale-routes-time", config.StaleRoutesTime)
+	}
+
+	if config.GetChange(openconfig.BGP_HELPER_ONLY_KEY) {
+		p.addItem("helper-only", config.HelperOnly)
+	}
+
+	if config.GetChange(openconfig.BGP_LLGR_ENABLED_KEY) {
+		p.addItem("long-lived-enabled", config.LongLivedEnabled)
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) BgpGlobalUseMultiplePathsConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.BgpUseMultiplePathsConfig) error {
+
+	p.addNode("global.use-multiple-paths.config")
+
+	if config.GetChange(openconfig.OC_ENABLED_KEY) {
+		p.addItem("enabled", config.Enabled)
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) BgpGlobalUseMultiplePathsEbgpConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.BgpUseMultiplePathsEbgpConfig) error {
+
+	p.addNode("global.use-multiple-paths.ebgp.config")
+
+	if config.GetChange(openconfig.BGP_ALLOW_MULTIPLE_AS_KEY) {
+		p.addItem("allow-multiple-as", config.AllowMultipleAs)
+	}
+
+	if config.GetChange(openconfig.BGP_MAXIMUM_PATHS_KEY) {
+		p.addItem("maximum-paths", config.MaximumPaths)
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) BgpGlobalUseMultiplePathsIbgpConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.BgpUseMultiplePathsIbgpConfig) error {
+
+	p.addNode("global.use-multiple-paths.ibgp.config")
+
+	if config.GetChange(openconfig.BGP_MAXIMUM_PATHS_KEY) {
+		p.addItem("maximum-paths", config.MaximumPaths)
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) BgpGlobalRouteSelectionOptionsConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.BgpRouteSelectionOptionsConfig) error {
+
+	p.addNode("global.route-selection-options.config")
+
+	if config.GetChange(openconfig.BGP_ALWAYS_COMPARE_MED_KEY) {
+		p.addItem("always-compare-med", config.AlwaysCompareMed)
+	}
+
+	if config.GetChange(openconfig.BGP_IGNORE_AS_PATH_LEN_KEY) {
+		p.addItem("ignore-as-path-length", config.IgnoreAsPathLength)
+	}
+
+	if config.GetChange(openconfig.BGP_EXT_COMPARE_ROUTERID_KEY) {
+		p.addItem("external-compare-router-id", config.ExternalCompareRouterId)
+	}
+
+	if config.GetChange(openconfig.BGP_ADVERTISE_INACTIVE_KEY) {
+		p.addItem("advertise-inactive-routes", config.AdvertiseInactiveRoutes)
+	}
+
+	if config.GetChange(openconfig.BGP_ENABLE_AIGP_KEY) {
+		p.addItem("enable-aigp", config.EnableAigp)
+	}
+
+	if config.GetChange(openconfig.BGP_IGNORE_NEXTHOP_IGP_METRIC_KEY) {
+		p.addItem("ignore-next-hop-igp-metric", config.IgnoreNextHopIgpMetric)
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) BgpGlobalAfiSafi(name string, key *openconfig.NetworkInstanceProtocolKey, afiSafiName string, afisafi *openconfig.BgpAfiSafi) error {
+
+	p.addList("global.afi-safis")
+
+	return nil
+}
+
+func (p *ConfigProcessor) BgpGlobalAfiSafiConfig(name string, key *openconfig.NetworkInstanceProtocolKey, afiSafiName string, config *openconfig.BgpAfiSafiConfig) error {
+	return p.afiSafiConfig("global.afi-safis.config", config)
+}
+
+func (p *ConfigProcessor) afiSafiConfig(node string, config *openconfig.BgpAfiSafiConfig) error {
+
+	p.addNode(node)
+
+	if config.GetChange(openconfig.BGP_AFISAFI_NAME_KEY) {
+		p.addItem("afi-safi-name", QString(BgpAfiSafiType(config.AfiSafiName)))
+	}
+
+	if config.GetChange(openconfig.OC_ENABLED_KEY) {
+		p.addItem("enabled", config.Enabled)
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) BgpGlobalDynamicNeighbor(name string, key *openconfig.NetworkInstanceProtocolKey, prefix string, neigh *openconfig.BgpDynamicNeighbor) error {
+
+	p.addList("dynamic-neighbors")
+
+	return nil
+}
+
+func (p *ConfigProcessor) BgpGlobalDynamicNeighborConfig(name string, key *openconfig.NetworkInstanceProtocolKey, prefix string, config *openconfig.BgpDynamicNeighborConfig) error {
+
+	p.addNode("dynamic-neighbors.config")
+
+	if config.GetChange(openconfig.BGP_DYNAMIC_PREFIX_KEY) {
+		p.addItem("prefix", QString(config.Prefix.String()))
+	}
+
+	if config.GetChange(openconfig.BGP_PEERGROUP_KEY) {
+		p.addItem("peer-group", QString(config.PeerGroup))
+	}
+
+	return nil
+}
+
+//
+// TableConnectionConfig adds the source protocol of the table-connection
+// to zebra redistribution if the destination protocol is BGP.
+//
+func (p *ConfigProcessor) TableConnectionConfig(name string, key *openconfig.TableConnectionKey, config *openconfig.TableConnectionConfig) error {
+	if key.DstProtocol != openconfig.INSTALL_PROTOCOL_BGP {
+		return nil
+	}
+
+	p.addZebraRedists(InstallProtocolType(key.SrcProtocol))
+
+	return nil
+}
+
+func (p *ConfigProcessor) routeReflectorConfig(node string, config *openconfig.BgpRouteReflectorConfig) error {
+
+	p.addNode(node)
+
+	if config.GetChange(openconfig.BGP_RR_CLUSTER_ID_KEY) {
+		p.addItem("route-reflector-cluster-id", QString(config.ClusterId))
+	}
+
+	if config.GetChange(openconfig.BGP_RR_CLIENT_KEY) {
+		p.addItem("route-reflector-client", config.Client)
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) addPathsConfig(node string, config *openconfig.BgpAddPathsConfig) error {
+
+	p.addNode(node)
+
+	if config.GetChange(openconfig.BGP_ADDPATHS_RECV_KEY) {
+		p.addItem("receive", config.Receive)
+	}
+
+	if config.GetChange(openconfig.BGP_ADDPATHS_SEND_KEY) {
+		p.addItem("send-max", config.SendMax)
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) ebgpMultihopConfig(node string, config *openconfig.BgpEbgpMultihopConfig) error {
+
+	p.addNode(node)
+
+	if config.GetChange(openconfig.OC_ENABLED_KEY) {
+		p.addItem("enabled", config.Enabled)
+	}
+
+	if config.GetChange(openconfig.BGP_MULTIHOP_TTL_KEY) {
+		p.addItem("multihop-ttl", config.MultihopTtl)
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) ttlSecurityConfig(node string, config *openconfig.BgpTtlSecurityConfig) error {
+
+	p.addNode(node)
+
+	if config.GetChange(openconfig.OC_ENABLED_KEY) {
+		p.addItem("enabled", config.Enabled)
+	}
+
+	if config.GetChange(openconfig.BGP_TTL_MIN_KEY) {
+		p.addItem("ttl-min", config.TtlMin)
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) afiSafiGracefulRestartConfig(node string, config *openconfig.BgpAfiSafiGracefulRestartConfig) error {
+
+	p.addNode(node)
+
+	if config.GetChange(openconfig.OC_ENABLED_KEY) {
+		p.addItem("enabled", config.Enabled)
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) afiSafiLongLivedGracefulRestartConfig(node string, config *openconfig.BgpAfiSafiLongLivedGracefulRestartConfig) error {
+
+	p.addNode(node)
+
+	if config.GetChange(openconfig.OC_ENABLED_KEY) {
+		p.addItem("enabled", config.Enabled)
+	}
+
+	if config.GetChange(openconfig.BGP_RESTART_TIME_KEY) {
+		p.addItem("restart-time", config.RestartTime)
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) afiSafiPrefixLimitConfig(node string, config *openconfig.BgpAfiSafiPrefixLimitConfig) error {
+
+	p.addNode(node)
+
+	if config.GetChange(openconfig.BGP_MAX_PREFIXES_KEY) {
+		p.addItem("max-prefixes", config.MaxPrefixes)
+	}
+
+	if config.GetChange(openconfig.BGP_SHUTDOWN_PCT_KEY) {
+		p.addItem("shutdown-threshold-pct", config.ShutdownPct)
+	}
+
+	if config.GetChange(openconfig.BGP_RESTART_TIMER_KEY) {
+		p.addItem("restart-timer", config.RestartTimer)
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) BgpZebraConfig(name string, key *openconfig.NetworkInstanceProtocolKey, config *openconfig.BgpZebraConfig) error {
+
+	if config.GetChange(openconfig.OC_ENABLED_KEY) {
+		p.addZebraItem("enabled", config.Enabled)
+	}
+
 	if config.GetChange(openconfig.BGP_ZEBRA_VERSION_KEY) {
-		p.addItem("version", config.Version)
+		p.addZebraItem("version", config.Version)
 	}
 
 	if config.GetChange(openconfig.BGP_ZEBRA_URL_KEY) {
-		p.addItem("url", QString(config.Url))
+		p.addZebraItem("url", QString(config.Url))
 	}
 
 	if config.GetChange(openconfig.BGP_ZEBRA_REDISTROUTES_KEY) {
-		p.addItem("redistribute-route-type-list", InstallProtocolTypes(config.RedistRoutes))
+		p.addZebraRedists(InstallProtocolTypes(config.RedistRoutes)...)
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) BgpPeerGroup(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, pg *openconfig.BgpPeerGroup) error {
+	p.addList("peer-groups")
+	return nil
+}
+
+func (p *ConfigProcessor) BgpPeerGroupConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, config *openconfig.BgpPeerGroupConfig) error {
+	p.addNode("peer-groups.config")
+
+	if config.GetChange(openconfig.BGP_PEERGROUP_NAME_KEY) {
+		p.addItem("peer-group-name", QString(config.Name))
+	}
+
+	if config.GetChange(openconfig.BGP_PEERAS_KEY) {
+		p.addItem("peer-as", config.PeerAs)
+	}
+
+	if config.GetChange(openconfig.BGP_LOCALAS_KEY) {
+		p.addItem("local-as", config.LocalAs)
+	}
+
+	if config.GetChange(openconfig.BGP_AUTH_PASSWORD_KEY) {
+		p.addItem("auth-password", QString(config.AuthPass))
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) BgpPeerGroupTimersConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, config *openconfig.BgpNeighborTimersConfig) error {
+
+	p.addNode("peer-groups.timers.config")
+
+	if config.GetChange(openconfig.BGP_HOLDTIME_KEY) {
+		p.addItem("hold-time", config.HoldTime)
+	}
+
+	if config.GetChange(openconfig.BGP_KEEPALIVE_INTERVAL_KEY) {
+		p.addItem("keepalive-interval", config.KeepAlive)
 	}
 
 	return nil
 }
 
+func (p *ConfigProcessor) BgpPeerGroupTransportConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, config *openconfig.BgpNeighborTransportConfig) error {
+
+	p.addNode("peer-groups.transport.config")
+
+	if config.GetChange(openconfig.BGP_LOCAL_ADDR_KEY) {
+		p.addItem("local-address", QString(config.LocalAddr))
+	}
+
+	if config.GetChange(openconfig.BGP_PASSIVE_MODE_KEY) {
+		p.addItem("passive-mode", config.PassiveMode)
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) BgpPeerGroupAfiSafi(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, afiSafiName string, afisafi *openconfig.BgpAfiSafi) error {
+
+	p.addList("peer-groups.afi-safis")
+
+	return nil
+}
+
+func (p *ConfigProcessor) BgpPeerGroupAfiSafiConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, afiSafiName string, config *openconfig.BgpAfiSafiConfig) error {
+	return p.afiSafiConfig("peer-groups.afi-safis.config", config)
+}
+
+func (p *ConfigProcessor) BgpPeerGroupRouteReflectorConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, config *openconfig.BgpRouteReflectorConfig) error {
+	return p.routeReflectorConfig("peer-groups.route-reflector.config", config)
+}
+
+func (p *ConfigProcessor) BgpPeerGroupGracefulRestartConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, config *openconfig.BgpGracefulRestartConfig) error {
+	return p.gracefulRestartConfig("peer-groups.graceful-restart.config", config)
+}
+
+func (p *ConfigProcessor) BgpPeerGroupAddPathsConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, config *openconfig.BgpAddPathsConfig) error {
+	return p.addPathsConfig("peer-groups.add-paths.config", config)
+}
+
+func (p *ConfigProcessor) BgpPeerGroupEbgpMultihopConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, config *openconfig.BgpEbgpMultihopConfig) error {
+	return p.ebgpMultihopConfig("peer-groups.ebgp-multihop.config", config)
+}
+
+func (p *ConfigProcessor) BgpPeerGroupTtlSecurityConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, config *openconfig.BgpTtlSecurityConfig) error {
+	return p.ttlSecurityConfig("peer-groups.ttl-security.config", config)
+}
+
+func (p *ConfigProcessor) BgpPeerGroupAfiSafiGracefulRestartConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, afiSafiName string, config *openconfig.BgpAfiSafiGracefulRestartConfig) error {
+	return p.afiSafiGracefulRestartConfig("peer-groups.afi-safis.mp-graceful-restart.config", config)
+}
+
+func (p *ConfigProcessor) BgpPeerGroupAfiSafiLongLivedGracefulRestartConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, afiSafiName string, config *openconfig.BgpAfiSafiLongLivedGracefulRestartConfig) error {
+	return p.afiSafiLongLivedGracefulRestartConfig("peer-groups.afi-safis.long-lived-graceful-restart.config", config)
+}
+
+func (p *ConfigProcessor) BgpPeerGroupAfiSafiPrefixLimitConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, afiSafiName string, config *openconfig.BgpAfiSafiPrefixLimitConfig) error {
+	return p.afiSafiPrefixLimitConfig("peer-groups.afi-safis.prefix-limit.config", config)
+}
+
+func (p *ConfigProcessor) BgpPeerGroupApplyPolicyConfig(name string, key *openconfig.NetworkInstanceProtocolKey, pgName string, config *openconfig.PolicyApplyConfig) error {
+	return p.applyPolicyConfig("peer-groups.apply-policy.config", config)
+}
+
 func (p *ConfigProcessor) BgpNeighbor(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, neighbor *openconfig.BgpNeighbor) error {
 	p.addList("neighbors")
 	return nil
@@ -144,6 +614,14 @@ func (p *ConfigProcessor) BgpNeighborConfig(name string, key *openconfig.Network
 		p.addItem("local-as", config.LocalAs)
 	}
 
+	if config.GetChange(openconfig.BGP_AUTH_PASSWORD_KEY) {
+		p.addItem("auth-password", QString(config.AuthPass))
+	}
+
+	if config.GetChange(openconfig.BGP_PEERGROUP_KEY) {
+		p.addItem("peer-group", QString(config.PeerGroup))
+	}
+
 	return nil
 }
 
@@ -170,6 +648,10 @@ func (p *ConfigProcessor) BgpNeighborTransportConfig(name string, key *openconfi
 		p.addItem("local-address", QString(config.LocalAddr))
 	}
 
+	if config.GetChange(openconfig.BGP_PASSIVE_MODE_KEY) {
+		p.addItem("passive-mode", config.PassiveMode)
+	}
+
 	return nil
 }
 
@@ -181,19 +663,54 @@ func (p *ConfigProcessor) BgpNeighborAfiSafi(name string, key *openconfig.Networ
 }
 
 func (p *ConfigProcessor) BgpNeighborAfiSafiConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, afiSafiName string, config *openconfig.BgpAfiSafiConfig) error {
+	return p.afiSafiConfig("neighbors.afi-safis.config", config)
+}
 
-	p.addNode("neighbors.afi-safis.config")
+func (p *ConfigProcessor) BgpNeighborRouteReflectorConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, config *openconfig.BgpRouteReflectorConfig) error {
+	return p.routeReflectorConfig("neighbors.route-reflector.config", config)
+}
 
-	if config.GetChange(openconfig.BGP_AFISAFI_NAME_KEY) {
-		p.addItem("afi-safi-name", QString(BgpAfiSafiType(config.AfiSafiName)))
-	}
+func (p *ConfigProcessor) BgpNeighborGracefulRestartConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, config *openconfig.BgpGracefulRestartConfig) error {
+	return p.gracefulRestartConfig("neighbors.graceful-restart.config", config)
+}
+
+func (p *ConfigProcessor) BgpNeighborAddPathsConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, config *openconfig.BgpAddPathsConfig) error {
+	return p.addPathsConfig("neighbors.add-paths.config", config)
+}
+
+func (p *ConfigProcessor) BgpNeighborEbgpMultihopConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, config *openconfig.BgpEbgpMultihopConfig) error {
+	return p.ebgpMultihopConfig("neighbors.ebgp-multihop.config", config)
+}
+
+func (p *ConfigProcessor) BgpNeighborTtlSecurityConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, config *openconfig.BgpTtlSecurityConfig) error {
+	return p.ttlSecurityConfig("neighbors.ttl-security.config", config)
+}
+
+func (p *ConfigProcessor) BgpNeighborAfiSafiGracefulRestartConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, afiSafiName string, config *openconfig.BgpAfiSafiGracefulRestartConfig) error {
+	return p.afiSafiGracefulRestartConfig("neighbors.afi-safis.mp-graceful-restart.config", config)
+}
+
+func (p *ConfigProcessor) BgpNeighborAfiSafiLongLivedGracefulRestartConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, afiSafiName string, config *openconfig.BgpAfiSafiLongLivedGracefulRestartConfig) error {
+	return p.afiSafiLongLivedGracefulRestartConfig("neighbors.afi-safis.long-lived-graceful-restart.config", config)
+}
 
+func (p *ConfigProcessor) BgpNeighborAfiSafiPrefixLimitConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, afiSafiName string, config *openconfig.BgpAfiSafiPrefixLimitConfig) error {
+	return p.afiSafiPrefixLimitConfig("neighbors.afi-safis.prefix-limit.config", config)
+}
+
+func (p *ConfigProcessor) BgpNeighborEnableBfdConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, config *openconfig.EnableBfdConfig) error {
+	// gobgp has no BFD support. the session to the neighbor is
+	// configured to bfdd of frr by ncm.
 	return nil
 }
 
 func (p *ConfigProcessor) BgpNeighborApplyPolicyConfig(name string, key *openconfig.NetworkInstanceProtocolKey, addr string, config *openconfig.PolicyApplyConfig) error {
+	return p.applyPolicyConfig("neighbors.apply-policy.config", config)
+}
+
+func (p *ConfigProcessor) applyPolicyConfig(node string, config *openconfig.PolicyApplyConfig) error {
 
-	p.addNode("neighbors.apply-policy.config")
+	p.addNode(node)
 
 	if config.GetChange(openconfig.POLICYAPPLY_IMPORT_KEY) {
 		p.addItem("import-policy-list", QStringList(config.ImportPolicy))
@@ -234,6 +751,122 @@ func (p *ConfigProcessor) PolicyStatementConfig(polName string, stmtName string,
 	return nil
 }
 
+func (p *ConfigProcessor) PolicyMatchPrefixSetConfig(polName string, stmtName string, config *openconfig.PolicyMatchPrefixSetConfig) error {
+	p.addNode("policy-definitions.statements.conditions.match-prefix-set")
+
+	if config.GetChange(openconfig.POLICYPFXSET_KEY) {
+		p.addItem("prefix-set", QString(config.PrefixSet))
+	}
+
+	if config.GetChange(openconfig.POLICYMATCH_SETOPTS_KEY) {
+		p.addItem("match-set-options", QString(PolicyMatchSetOptionsType(config.MatchSetOptions)))
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) PolicyMatchNeighborSetConfig(polName string, stmtName string, config *openconfig.PolicyMatchNeighborSetConfig) error {
+	p.addNode("policy-definitions.statements.conditions.match-neighbor-set")
+
+	if config.GetChange(openconfig.POLICYNEIGHSET_KEY) {
+		p.addItem("neighbor-set", QString(config.NeighborSet))
+	}
+
+	if config.GetChange(openconfig.POLICYMATCH_SETOPTS_KEY) {
+		p.addItem("match-set-options", QString(PolicyMatchSetOptionsType(config.MatchSetOptions)))
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) PolicyMatchTagSetConfig(polName string, stmtName string, config *openconfig.PolicyMatchTagSetConfig) error {
+	p.addNode("policy-definitions.statements.conditions.match-tag-set")
+
+	if config.GetChange(openconfig.POLICYTAGSET_KEY) {
+		p.addItem("tag-set", QString(config.TagSet))
+	}
+
+	if config.GetChange(openconfig.POLICYMATCH_SETOPTS_KEY) {
+		p.addItem("match-set-options", QString(PolicyMatchSetOptionsType(config.MatchSetOptions)))
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) PolicyBgpConditionsConfig(polName string, stmtName string, config *openconfig.PolicyBgpConditionsConfig) error {
+	p.addNode("policy-definitions.statements.conditions.bgp-conditions")
+
+	if config.GetChange(openconfig.BGP_CONDS_MEDEQ_KEY) {
+		p.addItem("med-eq", config.MedEq)
+	}
+
+	if config.GetChange(openconfig.BGP_CONDS_ORIGINEQ_KEY) {
+		p.addItem("origin-eq", QString(BgpOriginAttrType(config.OriginEq)))
+	}
+
+	if config.GetChange(openconfig.BGP_CONDS_AFISAFIIN_KEY) {
+		p.addItem("afi-safi-in-list", QStringList(BgpAfiSafiTypes(config.AfiSafiIn)))
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) PolicyBgpAsPathLengthConfig(polName string, stmtName string, config *openconfig.PolicyBgpAsPathLengthConfig) error {
+	p.addNode("policy-definitions.statements.conditions.bgp-conditions.as-path-length")
+
+	if config.GetChange(openconfig.BGP_CONDS_OPERATOR_KEY) {
+		p.addItem("operator", QString(PolicyAttrComparisonType(config.Operator)))
+	}
+
+	if config.GetChange(openconfig.BGP_CONDS_VALUE_KEY) {
+		p.addItem("value", config.Value)
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) PolicyBgpMatchCommunitySetConfig(polName string, stmtName string, config *openconfig.PolicyBgpMatchCommunitySetConfig) error {
+	p.addNode("policy-definitions.statements.conditions.bgp-conditions.match-community-set")
+
+	if config.GetChange(openconfig.BGP_COMMSET_KEY) {
+		p.addItem("community-set", QString(config.CommunitySet))
+	}
+
+	if config.GetChange(openconfig.POLICYMATCH_SETOPTS_KEY) {
+		p.addItem("match-set-options", QString(PolicyMatchSetOptionsType(config.MatchSetOptions)))
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) PolicyBgpMatchExtCommunitySetConfig(polName string, stmtName string, config *openconfig.PolicyBgpMatchExtCommunitySetConfig) error {
+	p.addNode("policy-definitions.statements.conditions.bgp-conditions.match-ext-community-set")
+
+	if config.GetChange(openconfig.BGP_EXTCOMMSET_KEY) {
+		p.addItem("ext-community-set", QString(config.ExtCommunitySet))
+	}
+
+	if config.GetChange(openconfig.POLICYMATCH_SETOPTS_KEY) {
+		p.addItem("match-set-options", QString(PolicyMatchSetOptionsType(config.MatchSetOptions)))
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) PolicyBgpMatchAsPathSetConfig(polName string, stmtName string, config *openconfig.PolicyBgpMatchAsPathSetConfig) error {
+	p.addNode("policy-definitions.statements.conditions.bgp-conditions.match-as-path-set")
+
+	if config.GetChange(openconfig.BGP_ASPATHSET_KEY) {
+		p.addItem("as-path-set", QString(config.AsPathSet))
+	}
+
+	if config.GetChange(openconfig.POLICYMATCH_SETOPTS_KEY) {
+		p.addItem("match-set-options", QString(PolicyMatchSetOptionsType(config.MatchSetOptions)))
+	}
+
+	return nil
+}
+
 func (p *ConfigProcessor) PolicyStatementActionsConfig(polName string, stmtName string, config *openconfig.PolicyStatementActionsConfig) error {
 	p.addNode("policy-definitions.statements.actions")
 
@@ -268,18 +901,130 @@ func (p *ConfigProcessor) PolicyBgpActionsConfig(polName string, stmtName string
 		p.addItem("set-local-pref", config.SetLocalPref)
 	}
 
+	if config.GetChange(openconfig.BGP_ACTIONS_SET_MED_KEY) {
+		if config.SetMed == openconfig.BGP_SET_MED_IGP {
+			return fmt.Errorf("set-med %s not supported.", config.SetMed)
+		}
+
+		med, op, err := config.SetMed.Values()
+		if err != nil {
+			return err
+		}
+
+		p.addItem("set-med", QString(fmt.Sprintf("%s%d", op, med)))
+	}
+
+	if config.GetChange(openconfig.BGP_ACTIONS_SET_ORIGIN_KEY) {
+		p.addItem("set-route-origin", QString(BgpOriginAttrType(config.SetRouteOrigin)))
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) PolicyBgpSetAsPathPrependConfig(polName string, stmtName string, config *openconfig.PolicyBgpSetAsPathPrependConfig) error {
+	p.addNode("policy-definitions.statements.actions.bgp-actions.set-as-path-prepend")
+
+	if config.GetChange(openconfig.BGP_ACTIONS_ASN_KEY) {
+		p.addItem("as", QString(fmt.Sprintf("%d", config.Asn)))
+	}
+
+	// repeat-n of gobgp defaults to 0 (no prepend).
+	if config.OneOfChange(openconfig.BGP_ACTIONS_ASN_KEY, openconfig.BGP_ACTIONS_REPEATN_KEY) {
+		p.addItem("repeat-n", config.RepeatN)
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) PolicyBgpSetCommunityConfig(polName string, stmtName string, config *openconfig.PolicyBgpSetCommunityConfig) error {
+	p.addNode("policy-definitions.statements.actions.bgp-actions.set-community")
+
+	if config.GetChange(openconfig.BGP_ACTIONS_OPTIONS_KEY) {
+		p.addItem("options", QString(BgpSetCommunityOptionType(config.Options)))
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) PolicyBgpSetCommunityInlineConfig(polName string, stmtName string, config *openconfig.PolicyBgpSetCommunityInlineConfig) error {
+	p.addNode("policy-definitions.statements.actions.bgp-actions.set-community.set-community-method")
+
+	if config.GetChange(openconfig.BGP_ACTIONS_COMMUNITIES_KEY) {
+		p.addItem("communities-list", QStringList(BgpCommunities(config.Communities)))
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) PolicyBgpSetCommunityReferenceConfig(polName string, stmtName string, config *openconfig.PolicyBgpSetCommunityReferenceConfig) error {
+	p.addNode("policy-definitions.statements.actions.bgp-actions.set-community.set-community-method")
+
+	if config.GetChange(openconfig.BGP_ACTIONS_COMMSET_REF_KEY) {
+		members, err := p.communitySetMembers(config.CommunitySetRef)
+		if err != nil {
+			return err
+		}
+		p.addItem("communities-list", QStringList(BgpCommunities(members)))
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) PolicyBgpSetExtCommunityConfig(polName string, stmtName string, config *openconfig.PolicyBgpSetExtCommunityConfig) error {
+	p.addNode("policy-definitions.statements.actions.bgp-actions.set-ext-community")
+
+	if config.GetChange(openconfig.BGP_ACTIONS_OPTIONS_KEY) {
+		p.addItem("options", QString(BgpSetCommunityOptionType(config.Options)))
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) PolicyBgpSetExtCommunityInlineConfig(polName string, stmtName string, config *openconfig.PolicyBgpSetExtCommunityInlineConfig) error {
+	p.addNode("policy-definitions.statements.actions.bgp-actions.set-ext-community.set-ext-community-method")
+
+	if config.GetChange(openconfig.BGP_ACTIONS_COMMUNITIES_KEY) {
+		p.addItem("communities-list", QStringList(BgpExtCommunities(config.Communities)))
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) PolicyBgpSetExtCommunityReferenceConfig(polName string, stmtName string, config *openconfig.PolicyBgpSetExtCommunityReferenceConfig) error {
+	p.addNode("policy-definitions.statements.actions.bgp-actions.set-ext-community.set-ext-community-method")
+
+	if config.GetChange(openconfig.BGP_ACTIONS_EXTCOMMSET_REF_KEY) {
+		members, err := p.extCommunitySetMembers(config.ExtCommunitySetRef)
+		if err != nil {
+			return err
+		}
+		p.addItem("communities-list", QStringList(BgpExtCommunities(members)))
+	}
+
 	return nil
 }
 
 func (p *ConfigProcessor) PolicyNeighborSet(polName string, neighSet *openconfig.PolicyNeighborSet) error {
+	p.addList("defined-sets.neighbor-sets")
+	p.addItem("neighbor-set-name", QString(polName))
 	return nil
 }
 
 func (p *ConfigProcessor) PolicyNeighborSetConfig(polName string, config *openconfig.PolicyNeighborSetConfig) error {
+	if config.GetChange(openconfig.POLICYNEIGHSET_ADDRS_KEY) {
+		addrs := make([]string, len(config.Addrs))
+		for index, addr := range config.Addrs {
+			addrs[index] = addr.String()
+		}
+		p.addItem("neighbor-info-list", QStringList(addrs))
+	}
+
 	return nil
 }
 
 func (p *ConfigProcessor) PolicyPrefixSet(polName string, pfxSet *openconfig.PolicyPrefixSet) error {
+	p.addList("defined-sets.prefix-sets")
+	p.addItem("prefix-set-name", QString(polName))
 	return nil
 }
 
@@ -288,6 +1033,13 @@ func (p *ConfigProcessor) PolicyPrefixSetConfig(polName string, config *openconf
 }
 
 func (p *ConfigProcessor) PolicyPrefixSetPrefix(polName string, pfxKey *openconfig.PolicyPrefixSetPrefixKey, prefix *openconfig.PolicyPrefixSetPrefix) error {
+	p.addList("defined-sets.prefix-sets.prefix-list")
+	p.addItem("ip-prefix", QString(pfxKey.IpPrefix))
+
+	if mlr := pfxKey.MaskLenRange; mlr != openconfig.POLICYPFXSET_MLR_EXACT {
+		p.addItem("masklength-range", QString(mlr))
+	}
+
 	return nil
 }
 
@@ -296,9 +1048,57 @@ func (p *ConfigProcessor) PolicyPrefixSetPrefixConfig(polName string, pfxKey *op
 }
 
 func (p *ConfigProcessor) PolicyTagSet(polName string, tagSet *openconfig.PolicyTagSet) error {
+	p.addList("defined-sets.tag-sets")
+	p.addItem("tag-set-name", QString(polName))
 	return nil
 }
 
 func (p *ConfigProcessor) PolicyTagSetConfig(polName string, config *openconfig.PolicyTagSetConfig) error {
+	if config.GetChange(openconfig.POLICYTAGSET_TAGVALUE_KEY) {
+		p.addItem("tag-value-list", QStringList([]string{fmt.Sprintf("%d", config.TagValue)}))
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) PolicyBgpCommunitySet(setName string, set *openconfig.PolicyBgpCommunitySet) error {
+	p.addList("defined-sets.bgp-defined-sets.community-sets")
+	p.addItem("community-set-name", QString(setName))
+	return nil
+}
+
+func (p *ConfigProcessor) PolicyBgpCommunitySetConfig(setName string, config *openconfig.PolicyBgpCommunitySetConfig) error {
+	if config.GetChange(openconfig.BGP_COMMSET_MEMBER_KEY) {
+		p.addItem("community-list", QStringList(BgpCommunities(config.Members)))
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) PolicyBgpExtCommunitySet(setName string, set *openconfig.PolicyBgpExtCommunitySet) error {
+	p.addList("defined-sets.bgp-defined-sets.ext-community-sets")
+	p.addItem("ext-community-set-name", QString(setName))
+	return nil
+}
+
+func (p *ConfigProcessor) PolicyBgpExtCommunitySetConfig(setName string, config *openconfig.PolicyBgpExtCommunitySetConfig) error {
+	if config.GetChange(openconfig.BGP_EXTCOMMSET_MEMBER_KEY) {
+		p.addItem("ext-community-list", QStringList(BgpExtCommunities(config.Members)))
+	}
+
+	return nil
+}
+
+func (p *ConfigProcessor) PolicyBgpAsPathSet(setName string, set *openconfig.PolicyBgpAsPathSet) error {
+	p.addList("defined-sets.bgp-defined-sets.as-path-sets")
+	p.addItem("as-path-set-name", QString(setName))
+	return nil
+}
+
+func (p *ConfigProcessor) PolicyBgpAsPathSetConfig(setName string, config *openconfig.PolicyBgpAsPathSetConfig) error {
+	if config.GetChange(openconfig.BGP_ASPATHSET_MEMBER_KEY) {
+		p.addItem("as-path-list", QStringList(config.Members))
+	}
+
 	return nil
 }
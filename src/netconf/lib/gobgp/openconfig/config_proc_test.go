@@ -27,10 +27,16 @@ import (
 func TestProcessor(t *testing.T) {
 	var gp openconfig.BgpProcessor
 	var pp openconfig.PolicyDefinitionProcessor
+	var tp openconfig.TableConnectionProcessor
 
 	p := NewConfigProcessor()
 	gp = p
 	pp = p
+	tp = p
+
+	if tp == nil {
+		t.Errorf("NewConfigProcessor umatch interface.")
+	}
 
 	if pp == nil {
 		t.Errorf("NewConfigProcessor umatch interface.")
@@ -117,7 +123,7 @@ func TestProcessZebra(t *testing.T) {
 		"enabled = true",
 		"version = 4",
 		"url = \"unix:/var/run/frr/zserv.api\"",
-		"redistribute-route-type-list = [connected]",
+		"redistribute-route-type-list = [\"connected\"]",
 	}
 
 	bgp := openconfig.NewBgp()
@@ -136,3 +142,524 @@ func TestProcessZebra(t *testing.T) {
 	}
 
 }
+
+func TestProcessBgpPeerGroup(t *testing.T) {
+	xpaths := map[string]string{
+		"/bgp/peer-groups/peer-group[peer-group-name='pg1']/peer-group-name":                                                "pg1",
+		"/bgp/peer-groups/peer-group[peer-group-name='pg1']/config/peer-group-name":                                         "pg1",
+		"/bgp/peer-groups/peer-group[peer-group-name='pg1']/config/peer-as":                                                 "65001",
+		"/bgp/peer-groups/peer-group[peer-group-name='pg1']/timers/config/hold-time":                                        "30",
+		"/bgp/peer-groups/peer-group[peer-group-name='pg1']/afi-safis/afi-safi[afi-safi-name='IPV4_UNICAST']/afi-safi-name": "IPV4_UNICAST",
+		"/bgp/peer-groups/peer-group[peer-group-name='pg1']/apply-policy/config/import-policy":                              "pol1",
+		"/bgp/neighbors/neighbor[neighbor-address='10.0.0.1']/config/peer-group":                                            "pg1",
+	}
+
+	d := []string{
+		"[[peer-groups]]",
+		"[peer-groups.config]",
+		"peer-group-name = \"pg1\"",
+		"peer-as = 65001",
+		"[peer-groups.timers.config]",
+		"hold-time = 30",
+		"[peer-groups.apply-policy.config]",
+		"import-policy-list = [\"pol1\"]",
+		"[[peer-groups.afi-safis]]",
+		"[neighbors.config]",
+		"peer-group = \"pg1\"",
+	}
+
+	bgp := openconfig.NewBgp()
+	if err := makeBgp(bgp, xpaths); err != nil {
+		t.Errorf("makeBgp error. %s", err)
+	}
+
+	p := NewConfigProcessor()
+	if err := openconfig.ProcessBgp(p, false, "", nil, bgp); err != nil {
+		t.Errorf("ProcessBgp error. %s", err)
+	}
+
+	items := p.Items()
+	if err := cmpLines(items, d); err != nil {
+		t.Errorf("cmpLines error. %s", err)
+	}
+}
+
+func TestProcessBgpGlobalOptions(t *testing.T) {
+	xpaths := map[string]string{
+		"/bgp/global/confederation/config/enabled":    "true",
+		"/bgp/global/confederation/config/identifier": "65000",
+		"/bgp/global/confederation/config/member-as":  "65001",
+	}
+
+	d := []string{
+		"[global.confederation.config]",
+		"enabled = true",
+		"identifier = 65000",
+		"member-as-list = [65001]",
+	}
+
+	bgp := openconfig.NewBgp()
+	if err := makeBgp(bgp, xpaths); err != nil {
+		t.Errorf("makeBgp error. %s", err)
+	}
+
+	p := NewConfigProcessor()
+	if err := openconfig.ProcessBgp(p, false, "", nil, bgp); err != nil {
+		t.Errorf("ProcessBgp error. %s", err)
+	}
+
+	items := p.Items()
+	if err := cmpLines(items, d); err != nil {
+		t.Errorf("cmpLines error. %s", err)
+	}
+}
+
+func TestProcessBgpGlobalMultipath(t *testing.T) {
+	xpaths := map[string]string{
+		"/bgp/global/use-multiple-paths/config/enabled":                                     "true",
+		"/bgp/global/use-multiple-paths/ebgp/config/allow-multiple-as":                      "true",
+		"/bgp/global/use-multiple-paths/ebgp/config/maximum-paths":                          "4",
+		"/bgp/global/use-multiple-paths/ibgp/config/maximum-paths":                          "2",
+		"/bgp/global/route-selection-options/config/ignore-as-path-length":                  "true",
+		"/bgp/global/afi-safis/afi-safi[afi-safi-name='IPV4_UNICAST']/afi-safi-name":        "IPV4_UNICAST",
+		"/bgp/global/afi-safis/afi-safi[afi-safi-name='IPV4_UNICAST']/config/afi-safi-name": "IPV4_UNICAST",
+		"/bgp/global/afi-safis/afi-safi[afi-safi-name='IPV4_UNICAST']/config/enabled":       "true",
+	}
+
+	d := []string{
+		"[global.use-multiple-paths.config]",
+		"enabled = true",
+		"[global.use-multiple-paths.ebgp.config]",
+		"allow-multiple-as = true",
+		"maximum-paths = 4",
+		"[global.use-multiple-paths.ibgp.config]",
+		"maximum-paths = 2",
+		"[global.route-selection-options.config]",
+		"ignore-as-path-length = true",
+		"[[global.afi-safis]]",
+		"[global.afi-safis.config]",
+		"afi-safi-name = \"ipv4-unicast\"",
+		"enabled = true",
+	}
+
+	bgp := openconfig.NewBgp()
+	if err := makeBgp(bgp, xpaths); err != nil {
+		t.Errorf("makeBgp error. %s", err)
+	}
+
+	p := NewConfigProcessor()
+	if err := openconfig.ProcessBgp(p, false, "", nil, bgp); err != nil {
+		t.Errorf("ProcessBgp error. %s", err)
+	}
+
+	items := p.Items()
+	if err := cmpLines(items, d); err != nil {
+		t.Errorf("cmpLines error. %s", err)
+	}
+}
+
+func TestProcessBgpDynamicNeighbor(t *testing.T) {
+	xpaths := map[string]string{
+		"/bgp/global/dynamic-neighbors/dynamic-neighbor[prefix='10.0.0.0/24']/prefix":            "10.0.0.0/24",
+		"/bgp/global/dynamic-neighbors/dynamic-neighbor[prefix='10.0.0.0/24']/config/prefix":     "10.0.0.0/24",
+		"/bgp/global/dynamic-neighbors/dynamic-neighbor[prefix='10.0.0.0/24']/config/peer-group": "pg1",
+	}
+
+	d := []string{
+		"[[dynamic-neighbors]]",
+		"[dynamic-neighbors.config]",
+		"prefix = \"10.0.0.0/24\"",
+		"peer-group = \"pg1\"",
+	}
+
+	bgp := openconfig.NewBgp()
+	if err := makeBgp(bgp, xpaths); err != nil {
+		t.Errorf("makeBgp error. %s", err)
+	}
+
+	p := NewConfigProcessor()
+	if err := openconfig.ProcessBgp(p, false, "", nil, bgp); err != nil {
+		t.Errorf("ProcessBgp error. %s", err)
+	}
+
+	items := p.Items()
+	if err := cmpLines(items, d); err != nil {
+		t.Errorf("cmpLines error. %s", err)
+	}
+}
+
+func TestProcessTableConnections(t *testing.T) {
+	xpaths := map[string]string{
+		"/table-connections/table-connection[src-protocol='STATIC'][dst-protocol='BGP'][address-family='IPV4']/config/src-protocol": "STATIC",
+		"/table-connections/table-connection[src-protocol='BGP'][dst-protocol='OSPF'][address-family='IPV4']/config/src-protocol":   "BGP",
+	}
+
+	d := []string{
+		"[zebra.config]",
+		"enabled = true",
+		"redistribute-route-type-list = [\"connected\",\"static\"]",
+	}
+
+	bgp := openconfig.NewBgp()
+	if err := makeBgp(bgp, map[string]string{
+		"/bgp/zebra/config/enabled":             "true",
+		"/bgp/zebra/config/redistribute-routes": "DIRECTLY_CONNECTED",
+	}); err != nil {
+		t.Errorf("makeBgp error. %s", err)
+	}
+
+	conns := openconfig.NewTableConnections()
+	for xpath, value := range xpaths {
+		nodes := srlib.ParseXPath(xpath)
+		if err := conns.Put(nodes[1:], value); err != nil {
+			t.Errorf("Put error. %s", err)
+		}
+	}
+
+	p := NewConfigProcessor()
+	if err := openconfig.ProcessBgp(p, false, "", nil, bgp); err != nil {
+		t.Errorf("ProcessBgp error. %s", err)
+	}
+	if err := openconfig.ProcessTableConnections(p, false, "", conns); err != nil {
+		t.Errorf("ProcessTableConnections error. %s", err)
+	}
+
+	items := p.Items()
+	if err := cmpLines(items, d); err != nil {
+		t.Errorf("cmpLines error. %s", err)
+	}
+
+	if v := p.Len(); v != len(d) {
+		t.Errorf("Len unmatch. %d", v)
+	}
+}
+
+func TestProcessBgpNeighborOptions(t *testing.T) {
+	xpaths := map[string]string{
+		"/bgp/neighbors/neighbor[neighbor-address='10.0.0.1']/config/auth-password":                                                              "secret",
+		"/bgp/neighbors/neighbor[neighbor-address='10.0.0.1']/transport/config/passive-mode":                                                     "true",
+		"/bgp/neighbors/neighbor[neighbor-address='10.0.0.1']/route-reflector/config/route-reflector-cluster-id":                                 "1",
+		"/bgp/neighbors/neighbor[neighbor-address='10.0.0.1']/graceful-restart/config/long-lived-enabled":                                        "true",
+		"/bgp/neighbors/neighbor[neighbor-address='10.0.0.1']/add-paths/config/send-max":                                                         "8",
+		"/bgp/neighbors/neighbor[neighbor-address='10.0.0.1']/ebgp-multihop/config/multihop-ttl":                                                 "2",
+		"/bgp/neighbors/neighbor[neighbor-address='10.0.0.1']/ttl-security/config/ttl-min":                                                       "254",
+		"/bgp/neighbors/neighbor[neighbor-address='10.0.0.1']/afi-safis/afi-safi[afi-safi-name='IPV4_UNICAST']/prefix-limit/config/max-prefixes": "1000",
+	}
+
+	d := []string{
+		"[neighbors.config]",
+		"auth-password = \"secret\"",
+		"[neighbors.transport.config]",
+		"passive-mode = true",
+		"[neighbors.route-reflector.config]",
+		"route-reflector-cluster-id = \"0.0.0.1\"",
+		"[neighbors.graceful-restart.config]",
+		"long-lived-enabled = true",
+		"[neighbors.add-paths.config]",
+		"send-max = 8",
+		"[neighbors.ebgp-multihop.config]",
+		"multihop-ttl = 2",
+		"[neighbors.ttl-security.config]",
+		"ttl-min = 254",
+		"[neighbors.afi-safis.prefix-limit.config]",
+		"max-prefixes = 1000",
+	}
+
+	bgp := openconfig.NewBgp()
+	if err := makeBgp(bgp, xpaths); err != nil {
+		t.Errorf("makeBgp error. %s", err)
+	}
+
+	p := NewConfigProcessor()
+	if err := openconfig.ProcessBgp(p, false, "", nil, bgp); err != nil {
+		t.Errorf("ProcessBgp error. %s", err)
+	}
+
+	items := p.Items()
+	if err := cmpLines(items, d); err != nil {
+		t.Errorf("cmpLines error. %s", err)
+	}
+}
+
+func makeRoutingPolicy(policy *openconfig.RoutingPolicy, xpaths map[string]string) error {
+	for xpath, value := range xpaths {
+		nodes := srlib.ParseXPath(xpath)
+		if err := policy.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestProcessPolicyStatementConditions(t *testing.T) {
+	xpaths := map[string]string{
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/name":                                                                                    "pol1",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/name":                                                 "stmt1",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/conditions/match-prefix-set/config/prefix-set":        "ps1",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/conditions/match-prefix-set/config/match-set-options": "INVERT",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/conditions/match-neighbor-set/config/neighbor-set":    "ns1",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/conditions/match-tag-set/config/tag-set":              "ts1",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/conditions/match-tag-set/config/match-set-options":    "ANY",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/actions/config/policy-result":                         "ACCEPT_ROUTE",
+	}
+
+	d := []string{
+		"[[policy-definitions]]",
+		"name = \"pol1\"",
+		"[[policy-definitions.statements]]",
+		"name = \"stmt1\"",
+		"[policy-definitions.statements.conditions.match-prefix-set]",
+		"prefix-set = \"ps1\"",
+		"match-set-options = \"invert\"",
+		"[policy-definitions.statements.conditions.match-neighbor-set]",
+		"neighbor-set = \"ns1\"",
+		"[policy-definitions.statements.conditions.match-tag-set]",
+		"tag-set = \"ts1\"",
+		"match-set-options = \"any\"",
+		"[policy-definitions.statements.actions]",
+		"route-disposition = \"accept-route\"",
+	}
+
+	policy := openconfig.NewRoutingPolicy()
+	if err := makeRoutingPolicy(policy, xpaths); err != nil {
+		t.Errorf("makeRoutingPolicy error. %s", err)
+	}
+
+	p := NewConfigProcessor()
+	if err := openconfig.ProcessPolicyDefinition(p, false, "pol1", policy.Definitions["pol1"]); err != nil {
+		t.Errorf("ProcessPolicyDefinition error. %s", err)
+	}
+
+	items := p.Items()
+	if err := cmpLines(items, d); err != nil {
+		t.Errorf("cmpLines error. %s", err)
+	}
+}
+
+func TestProcessPolicyDefinedSets(t *testing.T) {
+	xpaths := map[string]string{
+		"/routing-policy/defined-sets/prefix-sets/prefix-set[name='ps1']/name":                                                                                "ps1",
+		"/routing-policy/defined-sets/prefix-sets/prefix-set[name='ps1']/prefixes/prefix[ip-prefix='10.0.0.0/8'][masklength-range='16..24']/ip-prefix":        "10.0.0.0/8",
+		"/routing-policy/defined-sets/prefix-sets/prefix-set[name='ps1']/prefixes/prefix[ip-prefix='10.0.0.0/8'][masklength-range='16..24']/masklength-range": "16..24",
+		"/routing-policy/defined-sets/neighbor-sets/neighbor-set[name='ns1']/name":                                                                            "ns1",
+		"/routing-policy/defined-sets/neighbor-sets/neighbor-set[name='ns1']/config/address":                                                                  "10.0.0.1",
+		"/routing-policy/defined-sets/tag-sets/tag-set[name='ts1']/name":                                                                                      "ts1",
+		"/routing-policy/defined-sets/tag-sets/tag-set[name='ts1']/config/tag-value":                                                                          "100",
+	}
+
+	d := []string{
+		"[[defined-sets.prefix-sets]]",
+		"prefix-set-name = \"ps1\"",
+		"[[defined-sets.prefix-sets.prefix-list]]",
+		"ip-prefix = \"10.0.0.0/8\"",
+		"masklength-range = \"16..24\"",
+		"[[defined-sets.neighbor-sets]]",
+		"neighbor-set-name = \"ns1\"",
+		"neighbor-info-list = [\"10.0.0.1\"]",
+		"[[defined-sets.tag-sets]]",
+		"tag-set-name = \"ts1\"",
+		"tag-value-list = [\"100\"]",
+	}
+
+	policy := openconfig.NewRoutingPolicy()
+	if err := makeRoutingPolicy(policy, xpaths); err != nil {
+		t.Errorf("makeRoutingPolicy error. %s", err)
+	}
+
+	p := NewConfigProcessor()
+	if err := openconfig.ProcessPolicyDefinedSets(p, false, policy.DefinedSets); err != nil {
+		t.Errorf("ProcessPolicyDefinedSets error. %s", err)
+	}
+
+	items := p.Items()
+	if err := cmpLines(items, d); err != nil {
+		t.Errorf("cmpLines error. %s", err)
+	}
+}
+
+func TestProcessPolicyBgpConditions(t *testing.T) {
+	xpaths := map[string]string{
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/name":                                                                                                          "pol1",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/name":                                                                       "stmt1",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/conditions/bgp-conditions/config/med-eq":                                    "10",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/conditions/bgp-conditions/config/origin-eq":                                 "EGP",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/conditions/bgp-conditions/config/afi-safi-in":                               "IPV4_UNICAST",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/conditions/bgp-conditions/as-path-length/config/operator":                   "ATTRIBUTE_GE",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/conditions/bgp-conditions/as-path-length/config/value":                      "2",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/conditions/bgp-conditions/match-community-set/config/community-set":         "cs1",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/conditions/bgp-conditions/match-community-set/config/match-set-options":     "ALL",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/conditions/bgp-conditions/match-ext-community-set/config/ext-community-set": "ecs1",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/conditions/bgp-conditions/match-as-path-set/config/as-path-set":             "as1",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/conditions/bgp-conditions/match-as-path-set/config/match-set-options":       "INVERT",
+	}
+
+	d := []string{
+		"[[policy-definitions]]",
+		"name = \"pol1\"",
+		"[[policy-definitions.statements]]",
+		"name = \"stmt1\"",
+		"[policy-definitions.statements.conditions.bgp-conditions]",
+		"med-eq = 10",
+		"origin-eq = \"egp\"",
+		"afi-safi-in-list = [\"ipv4-unicast\"]",
+		"[policy-definitions.statements.conditions.bgp-conditions.as-path-length]",
+		"operator = \"ge\"",
+		"value = 2",
+		"[policy-definitions.statements.conditions.bgp-conditions.match-community-set]",
+		"community-set = \"cs1\"",
+		"match-set-options = \"all\"",
+		"[policy-definitions.statements.conditions.bgp-conditions.match-ext-community-set]",
+		"ext-community-set = \"ecs1\"",
+		"[policy-definitions.statements.conditions.bgp-conditions.match-as-path-set]",
+		"as-path-set = \"as1\"",
+		"match-set-options = \"invert\"",
+	}
+
+	policy := openconfig.NewRoutingPolicy()
+	if err := makeRoutingPolicy(policy, xpaths); err != nil {
+		t.Errorf("makeRoutingPolicy error. %s", err)
+	}
+
+	p := NewConfigProcessor()
+	if err := openconfig.ProcessPolicyDefinition(p, false, "pol1", policy.Definitions["pol1"]); err != nil {
+		t.Errorf("ProcessPolicyDefinition error. %s", err)
+	}
+
+	items := p.Items()
+	if err := cmpLines(items, d); err != nil {
+		t.Errorf("cmpLines error. %s", err)
+	}
+}
+
+func TestProcessPolicyBgpDefinedSets(t *testing.T) {
+	xpaths := map[string]string{
+		"/routing-policy/defined-sets/bgp-defined-sets/community-sets/community-set[community-set-name='cs1']/community-set-name":                       "cs1",
+		"/routing-policy/defined-sets/bgp-defined-sets/community-sets/community-set[community-set-name='cs1']/config/community-member":                  "NO_EXPORT",
+		"/routing-policy/defined-sets/bgp-defined-sets/ext-community-sets/ext-community-set[ext-community-set-name='ecs1']/ext-community-set-name":      "ecs1",
+		"/routing-policy/defined-sets/bgp-defined-sets/ext-community-sets/ext-community-set[ext-community-set-name='ecs1']/config/ext-community-member": "route-target:65001:10",
+		"/routing-policy/defined-sets/bgp-defined-sets/as-path-sets/as-path-set[as-path-set-name='as1']/as-path-set-name":                               "as1",
+		"/routing-policy/defined-sets/bgp-defined-sets/as-path-sets/as-path-set[as-path-set-name='as1']/config/as-path-set-member":                      "^65001_",
+	}
+
+	d := []string{
+		"[[defined-sets.bgp-defined-sets.community-sets]]",
+		"community-set-name = \"cs1\"",
+		"community-list = [\"no-export\"]",
+		"[[defined-sets.bgp-defined-sets.ext-community-sets]]",
+		"ext-community-set-name = \"ecs1\"",
+		"ext-community-list = [\"rt:65001:10\"]",
+		"[[defined-sets.bgp-defined-sets.as-path-sets]]",
+		"as-path-set-name = \"as1\"",
+		"as-path-list = [\"^65001_\"]",
+	}
+
+	policy := openconfig.NewRoutingPolicy()
+	if err := makeRoutingPolicy(policy, xpaths); err != nil {
+		t.Errorf("makeRoutingPolicy error. %s", err)
+	}
+
+	p := NewConfigProcessor()
+	if err := openconfig.ProcessPolicyDefinedSets(p, false, policy.DefinedSets); err != nil {
+		t.Errorf("ProcessPolicyDefinedSets error. %s", err)
+	}
+
+	items := p.Items()
+	if err := cmpLines(items, d); err != nil {
+		t.Errorf("cmpLines error. %s", err)
+	}
+}
+
+func TestProcessPolicyBgpActions(t *testing.T) {
+	xpaths := map[string]string{
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/name":                                                                                                            "pol1",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/name":                                                                         "stmt1",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/actions/bgp-actions/config/set-med":                                           "+10",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/actions/bgp-actions/config/set-route-origin":                                  "INCOMPLETE",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/actions/bgp-actions/set-as-path-prepend/config/asn":                           "65001",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/actions/bgp-actions/set-as-path-prepend/config/repeat-n":                      "3",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/actions/bgp-actions/set-community/config/method":                              "INLINE",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/actions/bgp-actions/set-community/config/options":                             "ADD",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/actions/bgp-actions/set-community/inline/config/communities":                  "NO_EXPORT",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/actions/bgp-actions/set-ext-community/config/method":                          "REFERENCE",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/actions/bgp-actions/set-ext-community/config/options":                         "REPLACE",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/actions/bgp-actions/set-ext-community/reference/config/ext-community-set-ref": "ecs1",
+		"/routing-policy/defined-sets/bgp-defined-sets/ext-community-sets/ext-community-set[ext-community-set-name='ecs1']/ext-community-set-name":                                          "ecs1",
+		"/routing-policy/defined-sets/bgp-defined-sets/ext-community-sets/ext-community-set[ext-community-set-name='ecs1']/config/ext-community-member":                                     "route-target:65001:10",
+	}
+
+	d := []string{
+		"[[policy-definitions]]",
+		"name = \"pol1\"",
+		"[[policy-definitions.statements]]",
+		"name = \"stmt1\"",
+		"[policy-definitions.statements.actions.bgp-actions]",
+		"set-med = \"+10\"",
+		"set-route-origin = \"incomplete\"",
+		"[policy-definitions.statements.actions.bgp-actions.set-as-path-prepend]",
+		"as = \"65001\"",
+		"repeat-n = 3",
+		"[policy-definitions.statements.actions.bgp-actions.set-community]",
+		"options = \"add\"",
+		"[policy-definitions.statements.actions.bgp-actions.set-community.set-community-method]",
+		"communities-list = [\"no-export\"]",
+		"[policy-definitions.statements.actions.bgp-actions.set-ext-community]",
+		"options = \"replace\"",
+		"[policy-definitions.statements.actions.bgp-actions.set-ext-community.set-ext-community-method]",
+		"communities-list = [\"rt:65001:10\"]",
+	}
+
+	policy := openconfig.NewRoutingPolicy()
+	if err := makeRoutingPolicy(policy, xpaths); err != nil {
+		t.Errorf("makeRoutingPolicy error. %s", err)
+	}
+
+	p := NewConfigProcessor()
+	p.SetDefinedSets(policy.DefinedSets)
+	if err := openconfig.ProcessPolicyDefinition(p, false, "pol1", policy.Definitions["pol1"]); err != nil {
+		t.Errorf("ProcessPolicyDefinition error. %s", err)
+	}
+
+	items := p.Items()
+	if err := cmpLines(items, d); err != nil {
+		t.Errorf("cmpLines error. %s", err)
+	}
+
+	// ext-community-set not found.
+	p = NewConfigProcessor()
+	if err := openconfig.ProcessPolicyDefinition(p, false, "pol1", policy.Definitions["pol1"]); err == nil {
+		t.Errorf("ProcessPolicyDefinition must be error.")
+	}
+}
+
+func TestProcessPolicyBgpActions_Prepend(t *testing.T) {
+	xpaths := map[string]string{
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/name":                                                                                  "pol1",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/name":                                               "stmt1",
+		"/routing-policy/policy-definitions/policy-definition[name='pol1']/statements/statement[name='stmt1']/actions/bgp-actions/set-as-path-prepend/config/asn": "65001",
+	}
+
+	d := []string{
+		"[[policy-definitions]]",
+		"name = \"pol1\"",
+		"[[policy-definitions.statements]]",
+		"name = \"stmt1\"",
+		"[policy-definitions.statements.actions.bgp-actions.set-as-path-prepend]",
+		"as = \"65001\"",
+		"repeat-n = 1",
+	}
+
+	policy := openconfig.NewRoutingPolicy()
+	if err := makeRoutingPolicy(policy, xpaths); err != nil {
+		t.Errorf("makeRoutingPolicy error. %s", err)
+	}
+
+	p := NewConfigProcessor()
+	if err := openconfig.ProcessPolicyDefinition(p, false, "pol1", policy.Definitions["pol1"]); err != nil {
+		t.Errorf("ProcessPolicyDefinition error. %s", err)
+	}
+
+	items := p.Items()
+	if err := cmpLines(items, d); err != nil {
+		t.Errorf("cmpLines error. %s", err)
+	}
+}
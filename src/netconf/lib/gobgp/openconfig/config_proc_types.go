@@ -35,6 +35,14 @@ func QString(i interface{}) string {
 	return fmt.Sprintf("\"%v\"", i)
 }
 
+func Uint32List(vs []uint32) string {
+	strs := make([]string, len(vs))
+	for index, v := range vs {
+		strs[index] = fmt.Sprintf("%d", v)
+	}
+	return fmt.Sprintf("[%s]", strings.Join(strs, ","))
+}
+
 var bgpAfiSafiType = map[openconfig.BgpAfiSafiType]string{
 	openconfig.BGP_AFI_SAFI_TYPE:                 "",
 	openconfig.BGP_AFI_SAFI_IPV4_UNICAST:         "ipv4-unicast",
@@ -56,6 +64,14 @@ func BgpAfiSafiType(t openconfig.BgpAfiSafiType) string {
 	return fmt.Sprintf("Invalid bgpAfiSafiType(%d)", t)
 }
 
+func BgpAfiSafiTypes(types []openconfig.BgpAfiSafiType) []string {
+	ss := make([]string, len(types))
+	for index, t := range types {
+		ss[index] = BgpAfiSafiType(t)
+	}
+	return ss
+}
+
 var installProtocolTypes = map[openconfig.InstallProtocolType]string{
 	openconfig.INSTALL_PROTOCOL_BGP:                "bgp",
 	openconfig.INSTALL_PROTOCOL_ISIS:               "isis",
@@ -104,3 +120,106 @@ func PolicyDefaultType(t openconfig.PolicyDefaultType) string {
 	}
 	return fmt.Sprintf("PolicyDefaultType(%d)", t)
 }
+
+var policyMatchSetOptionsTypes = map[openconfig.PolicyMatchSetOptionsType]string{
+	openconfig.POLICY_MATCH_SET_OPTIONS_ANY:    "any",
+	openconfig.POLICY_MATCH_SET_OPTIONS_ALL:    "all",
+	openconfig.POLICY_MATCH_SET_OPTIONS_INVERT: "invert",
+}
+
+func PolicyMatchSetOptionsType(t openconfig.PolicyMatchSetOptionsType) string {
+	if s, ok := policyMatchSetOptionsTypes[t]; ok {
+		return s
+	}
+	return fmt.Sprintf("PolicyMatchSetOptionsType(%d)", t)
+}
+
+var policyAttrComparisonTypes = map[openconfig.PolicyAttrComparisonType]string{
+	openconfig.POLICY_ATTR_EQ: "eq",
+	openconfig.POLICY_ATTR_GE: "ge",
+	openconfig.POLICY_ATTR_LE: "le",
+}
+
+func PolicyAttrComparisonType(t openconfig.PolicyAttrComparisonType) string {
+	if s, ok := policyAttrComparisonTypes[t]; ok {
+		return s
+	}
+	return fmt.Sprintf("PolicyAttrComparisonType(%d)", t)
+}
+
+var bgpOriginAttrTypes = map[openconfig.BgpOriginAttrType]string{
+	openconfig.BGP_ORIGIN_ATTR_IGP:        "igp",
+	openconfig.BGP_ORIGIN_ATTR_EGP:        "egp",
+	openconfig.BGP_ORIGIN_ATTR_INCOMPLETE: "incomplete",
+}
+
+func BgpOriginAttrType(t openconfig.BgpOriginAttrType) string {
+	if s, ok := bgpOriginAttrTypes[t]; ok {
+		return s
+	}
+	return fmt.Sprintf("BgpOriginAttrType(%d)", t)
+}
+
+var bgpSetCommunityOptionTypes = map[openconfig.BgpSetCommunityOptionType]string{
+	openconfig.BGP_SET_COMMUNITY_OPTION_ADD:     "add",
+	openconfig.BGP_SET_COMMUNITY_OPTION_REMOVE:  "remove",
+	openconfig.BGP_SET_COMMUNITY_OPTION_REPLACE: "replace",
+}
+
+func BgpSetCommunityOptionType(t openconfig.BgpSetCommunityOptionType) string {
+	if s, ok := bgpSetCommunityOptionTypes[t]; ok {
+		return s
+	}
+	return fmt.Sprintf("BgpSetCommunityOptionType(%d)", t)
+}
+
+var bgpWellKnownCommunities = map[string]string{
+	"NO_EXPORT":           "no-export",
+	"NO_ADVERTISE":        "no-advertise",
+	"NO_EXPORT_SUBCONFED": "no-export-subconfed",
+	"NOPEER":              "no-peer",
+}
+
+//
+// BgpCommunity converts openconfig community (e.g. NO_EXPORT) to gobgp format.
+//
+func BgpCommunity(s string) string {
+	if c, ok := bgpWellKnownCommunities[s]; ok {
+		return c
+	}
+	return s
+}
+
+func BgpCommunities(ss []string) []string {
+	cs := make([]string, len(ss))
+	for index, s := range ss {
+		cs[index] = BgpCommunity(s)
+	}
+	return cs
+}
+
+var bgpExtCommunityTypes = map[string]string{
+	"route-target:": "rt:",
+	"route-origin:": "soo:",
+}
+
+//
+// BgpExtCommunity converts openconfig extended community
+// (e.g. route-target:65001:10) to gobgp format (e.g. rt:65001:10).
+//
+func BgpExtCommunity(s string) string {
+	for ocType, gobgpType := range bgpExtCommunityTypes {
+		if strings.HasPrefix(s, ocType) {
+			return gobgpType + strings.TrimPrefix(s, ocType)
+		}
+	}
+	return s
+}
+
+func BgpExtCommunities(ss []string) []string {
+	cs := make([]string, len(ss))
+	for index, s := range ss {
+		cs[index] = BgpExtCommunity(s)
+	}
+	return cs
+}
@@ -37,6 +37,8 @@ const (
 	IANAifType_ddnX25         = IANAifType("ddnX25")
 	IANAifType_rfc877x25      = IANAifType("rfc877x25")
 	IANAifType_ethernetCsmacd = IANAifType("ethernetCsmacd")
+	IANAifType_ieee8023adLag  = IANAifType("ieee8023adLag")
+	IANAifType_bridge         = IANAifType("bridge")
 )
 
 var IANAifType_Values = map[IANAifType]uint32{
@@ -46,15 +48,19 @@ var IANAifType_Values = map[IANAifType]uint32{
 	IANAifType_ddnX25:         4,
 	IANAifType_rfc877x25:      5,
 	IANAifType_ethernetCsmacd: 6,
+	IANAifType_ieee8023adLag:  161,
+	IANAifType_bridge:         209,
 }
 
 var IANAifType_Names = map[uint32]IANAifType{
-	1: IANAifType_other,
-	2: IANAifType_regular1822,
-	3: IANAifType_hdh1822,
-	4: IANAifType_ddnX25,
-	5: IANAifType_rfc877x25,
-	6: IANAifType_ethernetCsmacd,
+	1:   IANAifType_other,
+	2:   IANAifType_regular1822,
+	3:   IANAifType_hdh1822,
+	4:   IANAifType_ddnX25,
+	5:   IANAifType_rfc877x25,
+	6:   IANAifType_ethernetCsmacd,
+	161: IANAifType_ieee8023adLag,
+	209: IANAifType_bridge,
 }
 
 func ParseIANAifType(s string) (IANAifType, error) {
@@ -69,9 +69,10 @@ func (c *Client) CreateOrUpdateProfile(name string, profile *api.ProfilePut) err
 	})
 }
 
-func (c *Client) InitializeProfile(name string, logdir, mntIf, bridgeIf string) (*api.Profile, string, error) {
+func (c *Client) InitializeProfile(name string, logdir, mntIf, bridgeIf, listen, connect string) (*api.Profile, string, error) {
 	profile := NewDefaultProfile(mntIf, bridgeIf)
 	SetMontToProfle(profile, "/var/log", logdir)
+	SetProxyToProfile(profile, "cfgd", listen, connect)
 
 	if err := c.CreateOrUpdateProfile(name, profile); err != nil {
 		return nil, "", err
@@ -135,3 +135,14 @@ func SetMontToProfle(profile *api.ProfilePut, path string, source string) {
 		}
 	}
 }
+
+func SetProxyToProfile(profile *api.ProfilePut, name string, listen string, connect string) {
+	if len(listen) > 0 && len(connect) > 0 {
+		profile.Devices[name] = map[string]string{
+			"listen":  listen,
+			"connect": connect,
+			"bind":    "host",
+			"type":    "proxy",
+		}
+	}
+}
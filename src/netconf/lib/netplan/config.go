@@ -20,13 +20,21 @@ package ncnplib
 const YAML_VERSION = 2
 
 type Device struct {
-	Dhcp4     bool     `yaml:"dhcp4"`
-	Dhcp6     bool     `yaml:"dhcp6"`
-	Addresses []string `yaml:"addresses"`
-	Gateway4  string   `yaml:"gateway4,omitempty"`
-	Gateway6  string   `yaml:"gateway6,omitempty"`
-	Mtu       uint16   `yaml:"mtu,omitempty"`
-	// AcceptRA  bool     `yaml:"accept-ra"`
+	Dhcp4         bool             `yaml:"dhcp4"`
+	Dhcp6         bool             `yaml:"dhcp6"`
+	Addresses     []string         `yaml:"addresses"`
+	Gateway4      string           `yaml:"gateway4,omitempty"`
+	Gateway6      string           `yaml:"gateway6,omitempty"`
+	Mtu           uint16           `yaml:"mtu,omitempty"`
+	AcceptRA      *bool            `yaml:"accept-ra,omitempty"`
+	MacAddress    string           `yaml:"macaddress,omitempty"`
+	Optional      *bool            `yaml:"optional,omitempty"`
+	Nameservers   Nameservers      `yaml:"nameservers,omitempty"`
+	Routes        []*Route         `yaml:"routes,omitempty"`
+	RoutingPolicy []*RoutingPolicy `yaml:"routing-policy,omitempty"`
+	Description   string           `yaml:"-"` // written as the comment line.
+	Macvlans      []*Macvlan       `yaml:"-"` // written as the '#+ macvlan' comment line.
+	LinkDown      bool             `yaml:"-"` // written as the '#+ link down' comment line.
 }
 type Ethernet struct {
 	Device `yaml:",inline"`
@@ -53,31 +61,31 @@ func NewVlan(device *Device, link string, id uint32) *Vlan {
 }
 
 type BondParams struct {
-	Mode                  BondMode                  `yaml:"mode"`
-	LacpRate              uint32                    `yaml:"lacp-rate"`
-	MiiMonitorInterval    uint32                    `yaml:"mii-monitor-interval"`
-	MinLinks              uint32                    `yaml:"min-links"`
-	TransmitHashPolicy    BondTransmitHashPolicy    `yaml:"transmit-hash-policy"`
-	AdSelect              BondAdSelect              `yaml:"ad-select"`
-	AllSlavesActive       bool                      `yaml:"all-slaves-active"`
-	ARPInterval           uint32                    `yaml:"arp-interval"`
-	ARPIpTargets          []string                  `yaml:"arp-ip-targets"`
-	ARPValidate           BondARPValidate           `yaml:"arp-validate"`
-	ARPAllTargets         BondARPAllTargets         `yaml:"arp-all-targets"`
-	UpDelay               uint32                    `yaml:"up-delay"`
-	DownDelay             uint32                    `yaml:"down-delay"`
-	FailOverMACPolicy     BondFailOverMACPolicy     `yaml:"fail-over-mac-policy"`
-	GratuitiousARP        uint32                    `yaml:"gratuitious-arp"`
-	PacketsPerSlave       uint16                    `yaml:"packets-per-slave"`
-	PrimaryReselectPolicy BondPrimaryReselectPolicy `yaml:"primary-reselect-policy"`
-	LearnPacketInterval   uint32                    `yaml:"learn-packet-interval"`
-	Primary               string                    `yaml:"primary"`
+	Mode                  BondMode                  `yaml:"mode,omitempty"`
+	LacpRate              BondLacpRate              `yaml:"lacp-rate,omitempty"`
+	MiiMonitorInterval    uint32                    `yaml:"mii-monitor-interval,omitempty"`
+	MinLinks              uint32                    `yaml:"min-links,omitempty"`
+	TransmitHashPolicy    BondTransmitHashPolicy    `yaml:"transmit-hash-policy,omitempty"`
+	AdSelect              BondAdSelect              `yaml:"ad-select,omitempty"`
+	AllSlavesActive       bool                      `yaml:"all-slaves-active,omitempty"`
+	ARPInterval           uint32                    `yaml:"arp-interval,omitempty"`
+	ARPIpTargets          []string                  `yaml:"arp-ip-targets,omitempty"`
+	ARPValidate           BondARPValidate           `yaml:"arp-validate,omitempty"`
+	ARPAllTargets         BondARPAllTargets         `yaml:"arp-all-targets,omitempty"`
+	UpDelay               uint32                    `yaml:"up-delay,omitempty"`
+	DownDelay             uint32                    `yaml:"down-delay,omitempty"`
+	FailOverMACPolicy     BondFailOverMACPolicy     `yaml:"fail-over-mac-policy,omitempty"`
+	GratuitiousARP        uint32                    `yaml:"gratuitious-arp,omitempty"`
+	PacketsPerSlave       uint16                    `yaml:"packets-per-slave,omitempty"`
+	PrimaryReselectPolicy BondPrimaryReselectPolicy `yaml:"primary-reselect-policy,omitempty"`
+	LearnPacketInterval   uint32                    `yaml:"learn-packet-interval,omitempty"`
+	Primary               string                    `yaml:"primary,omitempty"`
 }
 
 type Bond struct {
 	Device     `yaml:",inline"`
 	Interfaces []string   `yaml:"interfaces"`
-	Params     BondParams `yaml:"parameters"`
+	Params     BondParams `yaml:"parameters,omitempty"`
 }
 
 func NewBond(device *Device, ifaces []string, params *BondParams) *Bond {
@@ -88,12 +96,38 @@ func NewBond(device *Device, ifaces []string, params *BondParams) *Bond {
 	}
 }
 
+type BridgeParams struct {
+	AgeingTime   uint32            `yaml:"ageing-time,omitempty"`
+	Priority     *uint32           `yaml:"priority,omitempty"`
+	PortPriority map[string]uint32 `yaml:"port-priority,omitempty"`
+	ForwardDelay uint32            `yaml:"forward-delay,omitempty"`
+	HelloTime    uint32            `yaml:"hello-time,omitempty"`
+	MaxAge       uint32            `yaml:"max-age,omitempty"`
+	PathCost     map[string]uint32 `yaml:"path-cost,omitempty"`
+	Stp          *bool             `yaml:"stp,omitempty"`
+}
+
+type Bridge struct {
+	Device     `yaml:",inline"`
+	Interfaces []string     `yaml:"interfaces"`
+	Params     BridgeParams `yaml:"parameters,omitempty"`
+}
+
+func NewBridge(device *Device, ifaces []string, params *BridgeParams) *Bridge {
+	return &Bridge{
+		Device:     *device,
+		Interfaces: ifaces,
+		Params:     *params,
+	}
+}
+
 type Network struct {
 	Version   uint32               `yaml:"version"`
 	Renderer  string               `yaml:"renderer,omitempty"`
 	Ethernets map[string]*Ethernet `yaml:"ethernets"`
 	Vlans     map[string]*Vlan     `yaml:"vlans"`
 	Bonds     map[string]*Bond     `yaml:"bonds"`
+	Bridges   map[string]*Bridge   `yaml:"bridges"`
 }
 
 func NewNetwork() *Network {
@@ -102,6 +136,7 @@ func NewNetwork() *Network {
 		Ethernets: map[string]*Ethernet{},
 		Vlans:     map[string]*Vlan{},
 		Bonds:     map[string]*Bond{},
+		Bridges:   map[string]*Bridge{},
 	}
 }
 
@@ -115,6 +150,34 @@ func (n *Network) Merge(nw *Network) {
 	for ifname, bond := range nw.Bonds {
 		n.Bonds[ifname] = bond
 	}
+	for ifname, bridge := range nw.Bridges {
+		n.Bridges[ifname] = bridge
+	}
+}
+
+//
+// Device returns the device in the section (ethernets, vlans, bonds or bridges).
+//
+func (n *Network) Device(section string, ifname string) *Device {
+	switch section {
+	case "ethernets":
+		if eth, ok := n.Ethernets[ifname]; ok {
+			return &eth.Device
+		}
+	case "vlans":
+		if vlan, ok := n.Vlans[ifname]; ok {
+			return &vlan.Device
+		}
+	case "bonds":
+		if bond, ok := n.Bonds[ifname]; ok {
+			return &bond.Device
+		}
+	case "bridges":
+		if bridge, ok := n.Bridges[ifname]; ok {
+			return &bridge.Device
+		}
+	}
+	return nil
 }
 
 type Config struct {
@@ -0,0 +1,92 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ncnplib
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+//
+// Macvlan is the macvlan device created on the device by netplan+,
+// because netplan has no macvlan field.
+//
+type Macvlan struct {
+	Name       string
+	MacAddress string
+	Addresses  []string
+}
+
+//
+// ParseMacvlan parses "name=<ifname>,macaddress=<mac>[,address=<prefix>...]".
+// address can be specified multiple times, so it does not use parseParams.
+//
+func ParseMacvlan(s string) (*Macvlan, error) {
+	m := &Macvlan{
+		Addresses: []string{},
+	}
+	for _, kv := range strings.Split(s, ",") {
+		if len(kv) == 0 {
+			continue
+		}
+
+		items := strings.SplitN(kv, "=", 2)
+		if len(items) != 2 {
+			return nil, fmt.Errorf("Invalid parameter. %s", kv)
+		}
+
+		key, value := items[0], items[1]
+		switch key {
+		case "name":
+			m.Name = value
+
+		case "macaddress":
+			if _, err := net.ParseMAC(value); err != nil {
+				return nil, err
+			}
+			m.MacAddress = value
+
+		case "address":
+			if _, _, err := net.ParseCIDR(value); err != nil {
+				return nil, err
+			}
+			m.Addresses = append(m.Addresses, value)
+
+		default:
+			return nil, fmt.Errorf("Invalid macvlan parameter. %s", key)
+		}
+	}
+
+	if len(m.Name) == 0 || len(m.MacAddress) == 0 {
+		return nil, fmt.Errorf("Macvlan 'name' or 'macaddress' not specified. %s", s)
+	}
+
+	return m, nil
+}
+
+func (m *Macvlan) String() string {
+	ss := []string{
+		fmt.Sprintf("name=%s", m.Name),
+		fmt.Sprintf("macaddress=%s", m.MacAddress),
+	}
+	for _, address := range m.Addresses {
+		ss = append(ss, fmt.Sprintf("address=%s", address))
+	}
+	return strings.Join(ss, ",")
+}
@@ -0,0 +1,58 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ncnplib
+
+import (
+	"testing"
+)
+
+func TestParseMacvlan(t *testing.T) {
+	m, err := ParseMacvlan("name=vrrp4-eth1-10,macaddress=00:00:5e:00:01:0a,address=10.0.0.1/32,address=10.0.0.2/32")
+	if err != nil {
+		t.Errorf("ParseMacvlan error. %s", err)
+	}
+
+	if v := m.String(); v != "name=vrrp4-eth1-10,macaddress=00:00:5e:00:01:0a,address=10.0.0.1/32,address=10.0.0.2/32" {
+		t.Errorf("ParseMacvlan unmatch. %s", v)
+	}
+
+	m, err = ParseMacvlan("name=vrrp6-eth1-10,macaddress=00:00:5e:00:02:0a")
+	if err != nil {
+		t.Errorf("ParseMacvlan error. %s", err)
+	}
+
+	if v := m.String(); v != "name=vrrp6-eth1-10,macaddress=00:00:5e:00:02:0a" {
+		t.Errorf("ParseMacvlan unmatch. %s", v)
+	}
+}
+
+func TestParseMacvlan_err(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"name=vrrp4-eth1-10",
+		"macaddress=00:00:5e:00:01:0a",
+		"name=vrrp4-eth1-10,macaddress=x",
+		"name=vrrp4-eth1-10,macaddress=00:00:5e:00:01:0a,address=10.0.0.1",
+		"name=vrrp4-eth1-10,macaddress=00:00:5e:00:01:0a,mtu=1500",
+		"name=vrrp4-eth1-10,macaddress=00:00:5e:00:01:0a,address",
+	} {
+		if _, err := ParseMacvlan(s); err == nil {
+			t.Errorf("ParseMacvlan must be error. '%s'", s)
+		}
+	}
+}
@@ -18,11 +18,14 @@
 package ncnplib
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strings"
 
 	yaml "gopkg.in/yaml.v2"
 )
@@ -70,12 +73,13 @@ func ReadConfig(r io.Reader) (*Config, error) {
 		return nil, err
 	}
 
-	config := Config{}
-	if err := yaml.Unmarshal(data, &config); err != nil {
+	config := NewConfig()
+	if err := yaml.Unmarshal(data, config); err != nil {
 		return nil, err
 	}
 
-	return &config, nil
+	readComments(data, config)
+	return config, nil
 }
 
 func WriteConfigFile(path string, c *Config) error {
@@ -93,10 +97,162 @@ func WriteConfig(w io.Writer, c *Config) error {
 		return err
 	}
 
-	_, err = w.Write(data)
+	_, err = w.Write(writeComments(data, c))
 	return err
 }
 
+var (
+	keyLineRe     = regexp.MustCompile(`^( *)([^\s#-][^:]*):(\s.*)?$`)
+	extLineRe     = regexp.MustCompile(`^( *)#\+\s*(\S+)\s*(.*)$`)
+	commentLineRe = regexp.MustCompile(`^( *)#\s?(.*)$`)
+)
+
+const (
+	EXT_MACVLAN = "macvlan"
+	EXT_LINK    = "link"
+)
+
+func unquoteKey(s string) string {
+	return strings.Trim(s, `"'`)
+}
+
+//
+// keyPath is the path of the mapping keys to the current line
+// which is tracked by the indent, so that any indent is accepted.
+//
+type keyPath struct {
+	indents []int
+	keys    []string
+}
+
+func (p *keyPath) push(indent int, key string) {
+	n := len(p.indents)
+	for n > 0 && p.indents[n-1] >= indent {
+		n--
+	}
+	p.indents = append(p.indents[:n], indent)
+	p.keys = append(p.keys[:n], unquoteKey(key))
+}
+
+//
+// device returns section and name if the current line is
+// the key of the device. (network/<section>/<name>)
+//
+func (p *keyPath) device() (string, string, bool) {
+	if len(p.keys) != 3 || p.keys[0] != "network" {
+		return "", "", false
+	}
+	return p.keys[1], p.keys[2], true
+}
+
+type commentLine struct {
+	indent int
+	ext    []string
+	text   string
+}
+
+//
+// readComments sets the comment lines just before the device
+// to the device. '#+ <key> <value>' is the extension of netplan
+// and the others is the description of the device.
+// The comment lines must have the same indent as the device.
+//
+func readComments(data []byte, c *Config) {
+	path := &keyPath{}
+	comments := []*commentLine{}
+	for _, line := range strings.Split(string(data), "\n") {
+		if m := extLineRe.FindStringSubmatch(line); m != nil {
+			comments = append(comments, &commentLine{indent: len(m[1]), ext: m[2:]})
+			continue
+		}
+
+		if m := commentLineRe.FindStringSubmatch(line); m != nil {
+			comments = append(comments, &commentLine{indent: len(m[1]), text: m[2]})
+			continue
+		}
+
+		if m := keyLineRe.FindStringSubmatch(line); m != nil {
+			indent := len(m[1])
+			path.push(indent, m[2])
+			if section, name, ok := path.device(); ok {
+				if device := c.Network.Device(section, name); device != nil {
+					readDeviceComments(comments, indent, device)
+				}
+			}
+		}
+
+		comments = []*commentLine{}
+	}
+}
+
+func readDeviceComments(comments []*commentLine, indent int, device *Device) {
+	exts := [][]string{}
+	for _, comment := range comments {
+		if comment.indent != indent {
+			continue
+		}
+
+		if comment.ext != nil {
+			exts = append(exts, comment.ext)
+		} else {
+			device.Description = comment.text
+		}
+	}
+
+	readExtensions(exts, device)
+}
+
+func readExtensions(exts [][]string, device *Device) {
+	for _, ext := range exts {
+		switch ext[0] {
+		case EXT_MACVLAN:
+			if macvlan, err := ParseMacvlan(ext[1]); err == nil {
+				device.Macvlans = append(device.Macvlans, macvlan)
+			}
+
+		case EXT_LINK:
+			device.LinkDown = ext[1] == "down"
+		}
+	}
+}
+
+//
+// writeComments inserts the description and the extensions of the device
+// as the comment lines just before the device, because netplan has no fields for them.
+//
+func writeComments(data []byte, c *Config) []byte {
+	buf := &bytes.Buffer{}
+	path := &keyPath{}
+	for _, line := range strings.SplitAfter(string(data), "\n") {
+		if m := keyLineRe.FindStringSubmatch(strings.TrimRight(line, "\n")); m != nil {
+			path.push(len(m[1]), m[2])
+			if section, name, ok := path.device(); ok {
+				if device := c.Network.Device(section, name); device != nil {
+					writeDeviceComments(buf, m[1], device)
+				}
+			}
+		}
+
+		buf.WriteString(line)
+	}
+	return buf.Bytes()
+}
+
+func writeDeviceComments(buf *bytes.Buffer, indent string, device *Device) {
+	if len(device.Description) != 0 {
+		desc := strings.Replace(device.Description, "\n", " ", -1)
+		fmt.Fprintf(buf, "%s# %s\n", indent, desc)
+	}
+
+	for _, macvlan := range device.Macvlans {
+		fmt.Fprintf(buf, "%s#+ %s %s\n", indent, EXT_MACVLAN, macvlan)
+	}
+
+	if device.LinkDown {
+		fmt.Fprintf(buf, "%s#+ %s down\n", indent, EXT_LINK)
+	}
+}
+
 type BondMode string
 
 const (
@@ -127,6 +283,26 @@ func ParseBondMode(s string) (BondMode, error) {
 	return "", fmt.Errorf("Invalid BondMode. %s", s)
 }
 
+type BondLacpRate string
+
+const (
+	BOND_LACP_RATE_SLOW BondLacpRate = "slow"
+	BOND_LACP_RATE_FAST BondLacpRate = "fast"
+)
+
+var bondLacpRateNames = map[BondLacpRate]interface{}{
+	BOND_LACP_RATE_SLOW: nil,
+	BOND_LACP_RATE_FAST: nil,
+}
+
+func ParseBondLacpRate(s string) (BondLacpRate, error) {
+	v := BondLacpRate(s)
+	if _, ok := bondLacpRateNames[v]; ok {
+		return v, nil
+	}
+	return "", fmt.Errorf("Invalid BondLacpRate. %s", s)
+}
+
 type BondTransmitHashPolicy string
 
 const (
@@ -20,6 +20,7 @@ package ncnplib
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -39,3 +40,202 @@ func TestRead(t *testing.T) {
 
 	fmt.Println(string(b.Bytes()))
 }
+
+func TestDescription(t *testing.T) {
+	c, err := ReadConfigFile("netplan_test.yaml")
+	if err != nil {
+		t.Errorf("Read error. %s", err)
+	}
+
+	if v := c.Network.Ethernets["eth1"].Description; v != "uplink to core" {
+		t.Errorf("Description unmatch. %s", v)
+	}
+
+	if v := c.Network.Vlans["eth1.10"].Description; v != "" {
+		t.Errorf("Description unmatch. %s", v)
+	}
+
+	c.Network.Vlans["eth1.10"].Description = "vlan 10"
+
+	b := &bytes.Buffer{}
+	if err := WriteConfig(b, c); err != nil {
+		t.Errorf("Write error. %s", err)
+	}
+
+	c2, err := ReadConfig(b)
+	if err != nil {
+		t.Errorf("Read error. %s", err)
+	}
+
+	if v := c2.Network.Ethernets["eth1"].Description; v != "uplink to core" {
+		t.Errorf("Description unmatch. %s", v)
+	}
+
+	if v := c2.Network.Vlans["eth1.10"].Description; v != "vlan 10" {
+		t.Errorf("Description unmatch. %s", v)
+	}
+
+	if v := c2.Network.Bonds["bond0"].Description; v != "" {
+		t.Errorf("Description unmatch. %s", v)
+	}
+}
+
+func TestMacvlan(t *testing.T) {
+	c, err := ReadConfigFile("netplan_test.yaml")
+	if err != nil {
+		t.Errorf("Read error. %s", err)
+	}
+
+	macvlans := c.Network.Vlans["eth1.10"].Macvlans
+	if len(macvlans) != 1 {
+		t.Fatalf("Macvlans unmatch. %v", macvlans)
+	}
+
+	if v := macvlans[0].String(); v != "name=vrrp4-eth1.10-1,macaddress=00:00:5e:00:01:01,address=20.0.1.254/32" {
+		t.Errorf("Macvlan unmatch. %s", v)
+	}
+
+	if v := c.Network.Vlans["eth1.10"].Description; v != "" {
+		t.Errorf("Description unmatch. %s", v)
+	}
+
+	c.Network.Ethernets["eth1"].Macvlans = []*Macvlan{
+		{Name: "vrrp6-eth1-2", MacAddress: "00:00:5e:00:02:02", Addresses: []string{"2001:db8::1/128"}},
+	}
+
+	b := &bytes.Buffer{}
+	if err := WriteConfig(b, c); err != nil {
+		t.Errorf("Write error. %s", err)
+	}
+
+	c2, err := ReadConfig(b)
+	if err != nil {
+		t.Errorf("Read error. %s", err)
+	}
+
+	if v := c2.Network.Ethernets["eth1"].Description; v != "uplink to core" {
+		t.Errorf("Description unmatch. %s", v)
+	}
+
+	if v := c2.Network.Ethernets["eth1"].Macvlans; len(v) != 1 || v[0].Name != "vrrp6-eth1-2" {
+		t.Errorf("Macvlans unmatch. %v", v)
+	}
+
+	if v := c2.Network.Vlans["eth1.10"].Macvlans; len(v) != 1 || v[0].Name != "vrrp4-eth1.10-1" {
+		t.Errorf("Macvlans unmatch. %v", v)
+	}
+}
+
+func TestLinkDown(t *testing.T) {
+	c, err := ReadConfigFile("netplan_test.yaml")
+	if err != nil {
+		t.Errorf("Read error. %s", err)
+	}
+
+	if v := c.Network.Bonds["bond0"].LinkDown; !v {
+		t.Errorf("LinkDown unmatch. %t", v)
+	}
+
+	if v := c.Network.Ethernets["eth1"].LinkDown; v {
+		t.Errorf("LinkDown unmatch. %t", v)
+	}
+
+	c.Network.Bonds["bond0"].LinkDown = false
+	c.Network.Ethernets["eth1"].LinkDown = true
+
+	b := &bytes.Buffer{}
+	if err := WriteConfig(b, c); err != nil {
+		t.Errorf("Write error. %s", err)
+	}
+
+	c2, err := ReadConfig(b)
+	if err != nil {
+		t.Errorf("Read error. %s", err)
+	}
+
+	if v := c2.Network.Bonds["bond0"].LinkDown; v {
+		t.Errorf("LinkDown unmatch. %t", v)
+	}
+
+	if v := c2.Network.Ethernets["eth1"].LinkDown; !v {
+		t.Errorf("LinkDown unmatch. %t", v)
+	}
+
+	if v := c2.Network.Ethernets["eth1"].Description; v != "uplink to core" {
+		t.Errorf("Description unmatch. %s", v)
+	}
+}
+
+func TestMacvlan_indent(t *testing.T) {
+	data := `network:
+    version: 2
+    ethernets:
+        #+ macvlan name=vrrp4-eth1-1,macaddress=00:00:5e:00:01:01,address=20.0.1.254/32
+        eth1:
+            addresses:
+                - 20.0.1.1/24
+            #+ macvlan name=vrrp4-eth1-9,macaddress=00:00:5e:00:01:09,address=20.0.9.254/32
+            mtu: 1500
+        eth2:
+            mtu: 1500
+`
+	c, err := ReadConfig(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("Read error. %s", err)
+	}
+
+	macvlans := c.Network.Ethernets["eth1"].Macvlans
+	if len(macvlans) != 1 || macvlans[0].Name != "vrrp4-eth1-1" {
+		t.Errorf("Macvlans unmatch. %v", macvlans)
+	}
+
+	if v := c.Network.Ethernets["eth2"].Macvlans; len(v) != 0 {
+		t.Errorf("Macvlans unmatch. %v", v)
+	}
+}
+
+func TestLinkDown_indent(t *testing.T) {
+	data := `network:
+ version: 2
+ bonds:
+  # lag to spine
+  #+ link down
+  bond0:
+   interfaces:
+    - eth1
+ bridges:
+  br10:
+   interfaces:
+    - bond0
+`
+	c, err := ReadConfig(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("Read error. %s", err)
+	}
+
+	if v := c.Network.Bonds["bond0"].LinkDown; !v {
+		t.Errorf("LinkDown unmatch. %t", v)
+	}
+
+	if v := c.Network.Bonds["bond0"].Description; v != "lag to spine" {
+		t.Errorf("Description unmatch. %s", v)
+	}
+
+	if v := c.Network.Bridges["br10"].LinkDown; v {
+		t.Errorf("LinkDown unmatch. %t", v)
+	}
+
+	b := &bytes.Buffer{}
+	if err := WriteConfig(b, c); err != nil {
+		t.Errorf("Write error. %s", err)
+	}
+
+	c2, err := ReadConfig(b)
+	if err != nil {
+		t.Fatalf("Read error. %s", err)
+	}
+
+	if v := c2.Network.Bonds["bond0"].LinkDown; !v {
+		t.Errorf("LinkDown unmatch. %t", v)
+	}
+}
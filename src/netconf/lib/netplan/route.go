@@ -0,0 +1,232 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ncnplib
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+//
+// parseParams parses "key=value,key=value,..." to map.
+//
+func parseParams(s string) (map[string]string, error) {
+	params := map[string]string{}
+	for _, kv := range strings.Split(s, ",") {
+		if len(kv) == 0 {
+			continue
+		}
+
+		items := strings.SplitN(kv, "=", 2)
+		if len(items) != 2 {
+			return nil, fmt.Errorf("Invalid parameter. %s", kv)
+		}
+
+		params[items[0]] = items[1]
+	}
+	return params, nil
+}
+
+func parseUint32(s string) (uint32, error) {
+	v, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(v), nil
+}
+
+func parseIPOrPrefix(s string) error {
+	if _, _, err := net.ParseCIDR(s); err == nil {
+		return nil
+	}
+	if ip := net.ParseIP(s); ip != nil {
+		return nil
+	}
+	return fmt.Errorf("Invalid address. %s", s)
+}
+
+type Route struct {
+	To     string `yaml:"to"`
+	Via    string `yaml:"via,omitempty"`
+	Metric uint32 `yaml:"metric,omitempty"`
+	Table  uint32 `yaml:"table,omitempty"`
+	OnLink bool   `yaml:"on-link,omitempty"`
+}
+
+//
+// ParseRoute parses "to=<prefix>[,via=<ip>][,metric=<n>][,table=<n>][,on-link=<bool>]".
+//
+func ParseRoute(s string) (*Route, error) {
+	params, err := parseParams(s)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Route{}
+	for key, value := range params {
+		switch key {
+		case "to":
+			if err := parseIPOrPrefix(value); err != nil {
+				return nil, err
+			}
+			r.To = value
+
+		case "via":
+			if ip := net.ParseIP(value); ip == nil {
+				return nil, fmt.Errorf("Invalid via. %s", value)
+			}
+			r.Via = value
+
+		case "metric":
+			if r.Metric, err = parseUint32(value); err != nil {
+				return nil, err
+			}
+
+		case "table":
+			if r.Table, err = parseUint32(value); err != nil {
+				return nil, err
+			}
+
+		case "on-link":
+			if r.OnLink, err = strconv.ParseBool(value); err != nil {
+				return nil, err
+			}
+
+		default:
+			return nil, fmt.Errorf("Invalid route parameter. %s", key)
+		}
+	}
+
+	if len(r.To) == 0 {
+		return nil, fmt.Errorf("Route 'to' not specified. %s", s)
+	}
+
+	return r, nil
+}
+
+func (r *Route) String() string {
+	ss := []string{fmt.Sprintf("to=%s", r.To)}
+	if len(r.Via) != 0 {
+		ss = append(ss, fmt.Sprintf("via=%s", r.Via))
+	}
+	if r.Metric != 0 {
+		ss = append(ss, fmt.Sprintf("metric=%d", r.Metric))
+	}
+	if r.Table != 0 {
+		ss = append(ss, fmt.Sprintf("table=%d", r.Table))
+	}
+	if r.OnLink {
+		ss = append(ss, "on-link=true")
+	}
+	return strings.Join(ss, ",")
+}
+
+type RoutingPolicy struct {
+	From          string `yaml:"from,omitempty"`
+	To            string `yaml:"to,omitempty"`
+	Table         uint32 `yaml:"table,omitempty"`
+	Priority      uint32 `yaml:"priority,omitempty"`
+	Mark          uint32 `yaml:"mark,omitempty"`
+	TypeOfService uint32 `yaml:"type-of-service,omitempty"`
+}
+
+//
+// ParseRoutingPolicy parses "[from=<prefix>][,to=<prefix>][,table=<n>][,priority=<n>][,mark=<n>][,type-of-service=<n>]".
+//
+func ParseRoutingPolicy(s string) (*RoutingPolicy, error) {
+	params, err := parseParams(s)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &RoutingPolicy{}
+	for key, value := range params {
+		switch key {
+		case "from":
+			if err := parseIPOrPrefix(value); err != nil {
+				return nil, err
+			}
+			p.From = value
+
+		case "to":
+			if err := parseIPOrPrefix(value); err != nil {
+				return nil, err
+			}
+			p.To = value
+
+		case "table":
+			if p.Table, err = parseUint32(value); err != nil {
+				return nil, err
+			}
+
+		case "priority":
+			if p.Priority, err = parseUint32(value); err != nil {
+				return nil, err
+			}
+
+		case "mark":
+			if p.Mark, err = parseUint32(value); err != nil {
+				return nil, err
+			}
+
+		case "type-of-service":
+			if p.TypeOfService, err = parseUint32(value); err != nil {
+				return nil, err
+			}
+
+		default:
+			return nil, fmt.Errorf("Invalid routing-policy parameter. %s", key)
+		}
+	}
+
+	if len(p.From) == 0 && len(p.To) == 0 {
+		return nil, fmt.Errorf("Routing-policy 'from' or 'to' not specified. %s", s)
+	}
+
+	return p, nil
+}
+
+func (p *RoutingPolicy) String() string {
+	ss := []string{}
+	if len(p.From) != 0 {
+		ss = append(ss, fmt.Sprintf("from=%s", p.From))
+	}
+	if len(p.To) != 0 {
+		ss = append(ss, fmt.Sprintf("to=%s", p.To))
+	}
+	if p.Table != 0 {
+		ss = append(ss, fmt.Sprintf("table=%d", p.Table))
+	}
+	if p.Priority != 0 {
+		ss = append(ss, fmt.Sprintf("priority=%d", p.Priority))
+	}
+	if p.Mark != 0 {
+		ss = append(ss, fmt.Sprintf("mark=%d", p.Mark))
+	}
+	if p.TypeOfService != 0 {
+		ss = append(ss, fmt.Sprintf("type-of-service=%d", p.TypeOfService))
+	}
+	return strings.Join(ss, ",")
+}
+
+type Nameservers struct {
+	Search    []string `yaml:"search,omitempty"`
+	Addresses []string `yaml:"addresses,omitempty"`
+}
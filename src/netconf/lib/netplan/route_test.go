@@ -0,0 +1,82 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ncnplib
+
+import (
+	"testing"
+)
+
+func TestParseRoute(t *testing.T) {
+	r, err := ParseRoute("to=0.0.0.0/0,via=10.0.0.1,metric=100,table=10,on-link=true")
+	if err != nil {
+		t.Errorf("ParseRoute error. %s", err)
+	}
+
+	if v := r.String(); v != "to=0.0.0.0/0,via=10.0.0.1,metric=100,table=10,on-link=true" {
+		t.Errorf("ParseRoute unmatch. %s", v)
+	}
+
+	r, err = ParseRoute("to=2001:db8::/32")
+	if err != nil {
+		t.Errorf("ParseRoute error. %s", err)
+	}
+
+	if v := r.String(); v != "to=2001:db8::/32" {
+		t.Errorf("ParseRoute unmatch. %s", v)
+	}
+}
+
+func TestParseRoute_err(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"via=10.0.0.1",
+		"to=10.0.0.0/8,via=gw",
+		"to=10.0.0.0/8,metric=x",
+		"to=10.0.0.0/8,mtu=1500",
+		"to",
+	} {
+		if _, err := ParseRoute(s); err == nil {
+			t.Errorf("ParseRoute must be error. '%s'", s)
+		}
+	}
+}
+
+func TestParseRoutingPolicy(t *testing.T) {
+	p, err := ParseRoutingPolicy("from=10.0.0.0/24,to=20.0.0.0/24,table=10,priority=100,mark=1,type-of-service=8")
+	if err != nil {
+		t.Errorf("ParseRoutingPolicy error. %s", err)
+	}
+
+	if v := p.String(); v != "from=10.0.0.0/24,to=20.0.0.0/24,table=10,priority=100,mark=1,type-of-service=8" {
+		t.Errorf("ParseRoutingPolicy unmatch. %s", v)
+	}
+}
+
+func TestParseRoutingPolicy_err(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"table=10",
+		"from=x",
+		"from=10.0.0.0/24,table=x",
+		"from=10.0.0.0/24,via=10.0.0.1",
+	} {
+		if _, err := ParseRoutingPolicy(s); err == nil {
+			t.Errorf("ParseRoutingPolicy must be error. '%s'", s)
+		}
+	}
+}
@@ -0,0 +1,329 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"encoding/xml"
+	"fmt"
+	nclib "netconf/lib"
+	ncxml "netconf/lib/xml"
+	"strconv"
+)
+
+const (
+	BFD_KEY                      = "bfd"
+	BFD_DESIRED_MIN_TX_KEY       = "desired-minimum-tx-interval"
+	BFD_REQUIRED_MIN_RX_KEY      = "required-minimum-receive"
+	BFD_DETECTION_MULTIPLIER_KEY = "detection-multiplier"
+)
+
+const (
+	BFD_INTERVAL_MIN   = 10000    // usec
+	BFD_INTERVAL_MAX   = 60000000 // usec
+	BFD_MULTIPLIER_MIN = 2
+	BFD_MULTIPLIER_MAX = 255
+)
+
+//
+// bfd
+//
+type Bfd struct {
+	nclib.SrChanges `xml:"-"`
+
+	Interfaces BfdInterfaces `xml:"interfaces"`
+}
+
+type BfdProcessor interface {
+	BfdInterfaceProcessor
+}
+
+func NewBfd() *Bfd {
+	return &Bfd{
+		SrChanges:  nclib.NewSrChanges(),
+		Interfaces: NewBfdInterfaces(),
+	}
+}
+
+func (b *Bfd) String() string {
+	return fmt.Sprintf("%s{%s=%v} %s",
+		BFD_KEY,
+		INTERFACES_KEY, b.Interfaces,
+		b.SrChanges,
+	)
+}
+
+func (b *Bfd) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case INTERFACES_KEY:
+		if err := b.Interfaces.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessBfd(p BfdProcessor, reverse bool, name string, bfd *Bfd) error {
+	ifacesFunc := func() error {
+		if bfd.GetChange(INTERFACES_KEY) {
+			return ProcessBfdInterfaces(
+				p.(BfdInterfaceProcessor),
+				reverse,
+				name,
+				bfd.Interfaces,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, ifacesFunc)
+}
+
+//
+// bfd/interfaces
+//
+type BfdInterfaces map[string]*BfdInterface
+
+func NewBfdInterfaces() BfdInterfaces {
+	return BfdInterfaces{}
+}
+
+func (b BfdInterfaces) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	id, ok := nodes[0].Attrs[OC_ID_KEY]
+	if !ok {
+		return fmt.Errorf("%s@%s not found. %s", INTERFACE_KEY, OC_ID_KEY, nodes[0])
+	}
+
+	iface, ok := b[id]
+	if !ok {
+		iface = NewBfdInterface(id)
+		b[id] = iface
+	}
+
+	return iface.Put(nodes[1:], value)
+}
+
+func (b BfdInterfaces) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = INTERFACES_KEY
+	e.EncodeToken(start)
+
+	for _, iface := range b {
+		err := e.EncodeElement(iface, xml.StartElement{Name: xml.Name{Local: INTERFACE_KEY}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+func ProcessBfdInterfaces(p BfdInterfaceProcessor, reverse bool, name string, ifaces BfdInterfaces) error {
+	for ifaceId, iface := range ifaces {
+		if err := ProcessBfdInterface(p, reverse, name, ifaceId, iface); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//
+// bfd/interfaces/interface[id]
+//
+type BfdInterface struct {
+	nclib.SrChanges `xml:"-"`
+
+	Id       string              `xml:"id"`
+	Config   *BfdInterfaceConfig `xml:"config"`
+	IfaceRef *InterfaceRef       `xml:"interface-ref"`
+}
+
+type BfdInterfaceProcessor interface {
+	bfdInterfaceProcessor
+	BfdInterfaceConfigProcessor
+	BfdInterfaceRefProcessor
+}
+
+type bfdInterfaceProcessor interface {
+	BfdInterface(string, string, *BfdInterface) error
+}
+
+func NewBfdInterface(id string) *BfdInterface {
+	return &BfdInterface{
+		SrChanges: nclib.NewSrChanges(),
+		Id:        id,
+		Config:    NewBfdInterfaceConfig(),
+		IfaceRef:  NewInterfaceRef(),
+	}
+}
+
+func (b *BfdInterface) String() string {
+	return fmt.Sprintf("%s{%s='%s', %s, %s} %s",
+		INTERFACE_KEY,
+		OC_ID_KEY, b.Id,
+		b.Config,
+		b.IfaceRef,
+		b.SrChanges,
+	)
+}
+
+func (b *BfdInterface) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_ID_KEY:
+		// b.Id = value // set by NewBfdInterface
+
+	case OC_CONFIG_KEY:
+		if err := b.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case INTERFACE_REF_KEY:
+		if err := b.IfaceRef.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessBfdInterface(p BfdInterfaceProcessor, reverse bool, name string, ifaceId string, iface *BfdInterface) error {
+	ifaceFunc := func() error {
+		if iface.GetChange(OC_ID_KEY) {
+			return p.BfdInterface(name, ifaceId, iface)
+		}
+		return nil
+	}
+
+	configFunc := func() error {
+		if iface.GetChange(OC_CONFIG_KEY) {
+			return p.BfdInterfaceConfig(name, ifaceId, iface.Config)
+		}
+		return nil
+	}
+
+	refFunc := func() error {
+		if iface.GetChange(INTERFACE_REF_KEY) && iface.IfaceRef.GetChange(OC_CONFIG_KEY) {
+			return p.BfdInterfaceRefConfig(name, ifaceId, iface.IfaceRef.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, ifaceFunc, configFunc, refFunc)
+}
+
+//
+// bfd/interfaces/interface[id]/config
+//
+type BfdInterfaceConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Id                  string `xml:"id"`
+	Enabled             bool   `xml:"enabled"`
+	DesiredMinTxInt     uint32 `xml:"desired-minimum-tx-interval"`
+	RequiredMinRx       uint32 `xml:"required-minimum-receive"`
+	DetectionMultiplier uint16 `xml:"detection-multiplier"`
+}
+
+type BfdInterfaceConfigProcessor interface {
+	BfdInterfaceConfig(string, string, *BfdInterfaceConfig) error
+}
+
+func NewBfdInterfaceConfig() *BfdInterfaceConfig {
+	return &BfdInterfaceConfig{
+		SrChanges:           nclib.NewSrChanges(),
+		Id:                  "",
+		Enabled:             true,
+		DesiredMinTxInt:     0,
+		RequiredMinRx:       0,
+		DetectionMultiplier: 0,
+	}
+}
+
+func (c *BfdInterfaceConfig) String() string {
+	return fmt.Sprintf("%s{%s='%s', %s=%t, %s=%d, %s=%d, %s=%d} %s",
+		OC_CONFIG_KEY,
+		OC_ID_KEY, c.Id,
+		OC_ENABLED_KEY, c.Enabled,
+		BFD_DESIRED_MIN_TX_KEY, c.DesiredMinTxInt,
+		BFD_REQUIRED_MIN_RX_KEY, c.RequiredMinRx,
+		BFD_DETECTION_MULTIPLIER_KEY, c.DetectionMultiplier,
+		c.SrChanges,
+	)
+}
+
+func (c *BfdInterfaceConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_ID_KEY:
+		c.Id = value
+
+	case OC_ENABLED_KEY:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		c.Enabled = b
+
+	case BFD_DESIRED_MIN_TX_KEY:
+		v, err := strconv.ParseUint(value, 0, 32)
+		if err != nil {
+			return err
+		}
+		c.DesiredMinTxInt = uint32(v)
+
+	case BFD_REQUIRED_MIN_RX_KEY:
+		v, err := strconv.ParseUint(value, 0, 32)
+		if err != nil {
+			return err
+		}
+		c.RequiredMinRx = uint32(v)
+
+	case BFD_DETECTION_MULTIPLIER_KEY:
+		v, err := strconv.ParseUint(value, 0, 16)
+		if err != nil {
+			return err
+		}
+		c.DetectionMultiplier = uint16(v)
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// bfd/interfaces/interface[id]/interface-ref
+//
+type BfdInterfaceRefProcessor interface {
+	BfdInterfaceRefConfig(string, string, *InterfaceRefConfig) error
+}
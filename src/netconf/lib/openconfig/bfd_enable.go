@@ -0,0 +1,111 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"fmt"
+	nclib "netconf/lib"
+	ncxml "netconf/lib/xml"
+	"strconv"
+)
+
+const (
+	BFD_ENABLE_KEY = "enable-bfd"
+)
+
+//
+// enable-bfd
+//
+type EnableBfd struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *EnableBfdConfig `xml:"config"`
+}
+
+func NewEnableBfd() *EnableBfd {
+	return &EnableBfd{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewEnableBfdConfig(),
+	}
+}
+
+func (b *EnableBfd) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		BFD_ENABLE_KEY,
+		b.Config,
+		b.SrChanges,
+	)
+}
+
+func (b *EnableBfd) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := b.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// enable-bfd/config
+//
+type EnableBfdConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Enabled bool `xml:"enabled"`
+}
+
+func NewEnableBfdConfig() *EnableBfdConfig {
+	return &EnableBfdConfig{
+		SrChanges: nclib.NewSrChanges(),
+		Enabled:   false,
+	}
+}
+
+func (c *EnableBfdConfig) String() string {
+	return fmt.Sprintf("%s{%s=%t} %s",
+		OC_CONFIG_KEY,
+		OC_ENABLED_KEY, c.Enabled,
+		c.SrChanges,
+	)
+}
+
+func (c *EnableBfdConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_ENABLED_KEY:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		c.Enabled = b
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
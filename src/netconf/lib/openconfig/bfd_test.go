@@ -0,0 +1,178 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"fmt"
+	srlib "netconf/lib/sysrepo"
+	"testing"
+)
+
+func makeBfd(datas [][2]string) (*Bfd, error) {
+	bfd := NewBfd()
+	for _, data := range datas {
+		xpath, value := data[0], data[1]
+		nodes := srlib.ParseXPath(xpath)
+		if err := bfd.Put(nodes[1:], value); err != nil {
+			return nil, err
+		}
+	}
+	return bfd, nil
+}
+
+type testBfdProcessor struct {
+	calls []string
+}
+
+func (p *testBfdProcessor) BfdInterface(name string, ifaceId string, iface *BfdInterface) error {
+	p.calls = append(p.calls, ifaceId)
+	return nil
+}
+
+func (p *testBfdProcessor) BfdInterfaceConfig(name string, ifaceId string, config *BfdInterfaceConfig) error {
+	p.calls = append(p.calls, fmt.Sprintf("%s/config/%d", ifaceId, config.DetectionMultiplier))
+	return nil
+}
+
+func (p *testBfdProcessor) BfdInterfaceRefConfig(name string, ifaceId string, config *InterfaceRefConfig) error {
+	p.calls = append(p.calls, fmt.Sprintf("%s/ref/%s", ifaceId, config.IFName()))
+	return nil
+}
+
+func TestBfdInterface(t *testing.T) {
+	bfd, err := makeBfd([][2]string{
+		{"/bfd/interfaces/interface[id='eth1']/id", "eth1"},
+		{"/bfd/interfaces/interface[id='eth1']/config/id", "eth1"},
+		{"/bfd/interfaces/interface[id='eth1']/config/enabled", "false"},
+		{"/bfd/interfaces/interface[id='eth1']/config/desired-minimum-tx-interval", "300000"},
+		{"/bfd/interfaces/interface[id='eth1']/config/required-minimum-receive", "200000"},
+		{"/bfd/interfaces/interface[id='eth1']/config/detection-multiplier", "5"},
+		{"/bfd/interfaces/interface[id='eth1']/interface-ref/config/interface", "eth1"},
+	})
+
+	if err != nil {
+		t.Fatalf("bfd.Put error. %s", err)
+	}
+
+	if v := bfd.Compare(INTERFACES_KEY); !v {
+		t.Errorf("bfd.Put unmatch. cmp=%t", v)
+	}
+
+	iface, ok := bfd.Interfaces["eth1"]
+	if !ok {
+		t.Fatalf("bfd.Put unmatch. %v", bfd.Interfaces)
+	}
+
+	if v := iface.Compare(OC_ID_KEY, OC_CONFIG_KEY, INTERFACE_REF_KEY); !v {
+		t.Errorf("bfd.Put unmatch. cmp=%t", v)
+	}
+
+	config := iface.Config
+	if v := config.Compare(OC_ID_KEY, OC_ENABLED_KEY, BFD_DESIRED_MIN_TX_KEY, BFD_REQUIRED_MIN_RX_KEY, BFD_DETECTION_MULTIPLIER_KEY); !v {
+		t.Errorf("bfd.Put unmatch. cmp=%t", v)
+	}
+
+	if v := config.Id; v != "eth1" {
+		t.Errorf("bfd.Put unmatch. id=%s", v)
+	}
+
+	if v := config.Enabled; v {
+		t.Errorf("bfd.Put unmatch. enabled=%t", v)
+	}
+
+	if v := config.DesiredMinTxInt; v != 300000 {
+		t.Errorf("bfd.Put unmatch. desired-minimum-tx-interval=%d", v)
+	}
+
+	if v := config.RequiredMinRx; v != 200000 {
+		t.Errorf("bfd.Put unmatch. required-minimum-receive=%d", v)
+	}
+
+	if v := config.DetectionMultiplier; v != 5 {
+		t.Errorf("bfd.Put unmatch. detection-multiplier=%d", v)
+	}
+
+	if v := iface.IfaceRef.Config.IFName(); v != "eth1" {
+		t.Errorf("bfd.Put unmatch. interface-ref=%s", v)
+	}
+}
+
+func TestBfdInterfaceConfig_default(t *testing.T) {
+	bfd, err := makeBfd([][2]string{
+		{"/bfd/interfaces/interface[id='eth1']/config/id", "eth1"},
+	})
+
+	if err != nil {
+		t.Fatalf("bfd.Put error. %s", err)
+	}
+
+	config := bfd.Interfaces["eth1"].Config
+	if v := config.Compare(OC_ID_KEY); !v {
+		t.Errorf("bfd.Put unmatch. cmp=%t", v)
+	}
+
+	if v := config.Enabled; !v {
+		t.Errorf("bfd.Put unmatch. enabled=%t", v)
+	}
+}
+
+func TestBfdProcess(t *testing.T) {
+	bfd, err := makeBfd([][2]string{
+		{"/bfd/interfaces/interface[id='eth1']/id", "eth1"},
+		{"/bfd/interfaces/interface[id='eth1']/config/detection-multiplier", "3"},
+		{"/bfd/interfaces/interface[id='eth1']/interface-ref/config/interface", "eth1"},
+	})
+
+	if err != nil {
+		t.Fatalf("bfd.Put error. %s", err)
+	}
+
+	p := &testBfdProcessor{}
+	if err := ProcessBfd(p, false, "PE1", bfd); err != nil {
+		t.Errorf("ProcessBfd error. %s", err)
+	}
+
+	if v := fmt.Sprintf("%v", p.calls); v != "[eth1 eth1/config/3 eth1/ref/eth1]" {
+		t.Errorf("ProcessBfd unmatch. %s", v)
+	}
+
+	p = &testBfdProcessor{}
+	if err := ProcessBfd(p, true, "PE1", bfd); err != nil {
+		t.Errorf("ProcessBfd error. %s", err)
+	}
+
+	if v := fmt.Sprintf("%v", p.calls); v != "[eth1/ref/eth1 eth1/config/3 eth1]" {
+		t.Errorf("ProcessBfd unmatch. %s", v)
+	}
+}
+
+func TestBfdInterface_invalid(t *testing.T) {
+	datas := [][2]string{
+		{"/bfd/interfaces/interface[id='eth1']/config/enabled", "yes"},
+		{"/bfd/interfaces/interface[id='eth1']/config/desired-minimum-tx-interval", "-1"},
+		{"/bfd/interfaces/interface[id='eth1']/config/required-minimum-receive", "4294967296"},
+		{"/bfd/interfaces/interface[id='eth1']/config/detection-multiplier", "65536"},
+		{"/bfd/interfaces/interface/config/detection-multiplier", "3"},
+	}
+
+	for _, data := range datas {
+		if _, err := makeBfd([][2]string{data}); err == nil {
+			t.Errorf("bfd.Put must be error. %v", data)
+		}
+	}
+}
@@ -32,6 +32,9 @@ const (
 	BGP_NEIGHBORS_KEY          = "neighbors"
 	BGP_NEIGHBOR_KEY           = "neighbor"
 	BGP_NEIGHBOR_ADDR_KEY      = "neighbor-address"
+	BGP_PEERGROUPS_KEY         = "peer-groups"
+	BGP_PEERGROUP_KEY          = "peer-group"
+	BGP_PEERGROUP_NAME_KEY     = "peer-group-name"
 	BGP_TIMERS_KEY             = "timers"
 	BGP_TRANSPORT_KEY          = "transport"
 	BGP_HOLDTIME_KEY           = "hold-time"
@@ -57,15 +60,17 @@ const (
 type Bgp struct {
 	nclib.SrChanges `xml:"-"`
 
-	Global    *BgpGlobal   `xml:"global"`
-	Zebra     *BgpZebra    `xml:"zebra"`
-	Neighbors BgpNeighbors `xml:"neighbors"`
+	Global     *BgpGlobal    `xml:"global"`
+	Zebra      *BgpZebra     `xml:"zebra"`
+	PeerGroups BgpPeerGroups `xml:"peer-groups"`
+	Neighbors  BgpNeighbors  `xml:"neighbors"`
 }
 
 type BgpProcessor interface {
 	bgpProcessor
 	BgpGlobalProcessor
 	BgpZebraProcessor
+	BgpPeerGroupProcessor
 	BgpNeighborProcessor
 }
 
@@ -75,10 +80,11 @@ type bgpProcessor interface {
 
 func NewBgp() *Bgp {
 	return &Bgp{
-		SrChanges: nclib.NewSrChanges(),
-		Global:    NewBgpGlobal(),
-		Zebra:     NewBgpZebra(),
-		Neighbors: NewBgpNeighbors(),
+		SrChanges:  nclib.NewSrChanges(),
+		Global:     NewBgpGlobal(),
+		Zebra:      NewBgpZebra(),
+		PeerGroups: NewBgpPeerGroups(),
+		Neighbors:  NewBgpNeighbors(),
 	}
 }
 
@@ -107,6 +113,11 @@ func (b *Bgp) Put(nodes []*ncxml.XPathNode, value string) error {
 			return err
 		}
 
+	case BGP_PEERGROUPS_KEY:
+		if err := b.PeerGroups.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
 	case BGP_NEIGHBORS_KEY:
 		if err := b.Neighbors.Put(nodes[1:], value); err != nil {
 			return err
@@ -148,6 +159,19 @@ func ProcessBgp(p BgpProcessor, reverse bool, name string, key *NetworkInstanceP
 		return nil
 	}
 
+	pgFunc := func() error {
+		if bgp.GetChange(BGP_PEERGROUPS_KEY) {
+			return ProcessBgpPeerGroups(
+				p.(BgpPeerGroupProcessor),
+				reverse,
+				name,
+				key,
+				bgp.PeerGroups,
+			)
+		}
+		return nil
+	}
+
 	neighFunc := func() error {
 		if bgp.GetChange(BGP_NEIGHBORS_KEY) {
 			return ProcessBgpNeighbors(
@@ -161,5 +185,5 @@ func ProcessBgp(p BgpProcessor, reverse bool, name string, key *NetworkInstanceP
 		return nil
 	}
 
-	return nclib.CallFunctions(reverse, bgpFunc, globalFunc, zebraFunc, neighFunc)
+	return nclib.CallFunctions(reverse, bgpFunc, globalFunc, zebraFunc, pgFunc, neighFunc)
 }
@@ -22,6 +22,14 @@ import (
 	"fmt"
 	nclib "netconf/lib"
 	ncxml "netconf/lib/xml"
+	"strconv"
+)
+
+const (
+	BGP_PREFIX_LIMIT_KEY  = "prefix-limit"
+	BGP_MAX_PREFIXES_KEY  = "max-prefixes"
+	BGP_SHUTDOWN_PCT_KEY  = "shutdown-threshold-pct"
+	BGP_RESTART_TIMER_KEY = "restart-timer"
 )
 
 //
@@ -73,23 +81,32 @@ func (b BgpAfiSafis) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 type BgpAfiSafi struct {
 	nclib.SrChanges `xml:"-"`
 
-	AfiSafiName string            `xml:"afi-safi-name"`
-	Config      *BgpAfiSafiConfig `xml:"config"`
+	AfiSafiName     string                              `xml:"afi-safi-name"`
+	Config          *BgpAfiSafiConfig                   `xml:"config"`
+	GracefulRestart *BgpAfiSafiGracefulRestart          `xml:"graceful-restart"`
+	LongLivedGR     *BgpAfiSafiLongLivedGracefulRestart `xml:"long-lived-graceful-restart"`
+	PrefixLimit     *BgpAfiSafiPrefixLimit              `xml:"prefix-limit"`
 }
 
 func NewBgpAfiSafi(afiSafiName string) *BgpAfiSafi {
 	return &BgpAfiSafi{
-		SrChanges:   nclib.NewSrChanges(),
-		AfiSafiName: afiSafiName,
-		Config:      NewBgpAfiSafiConfig(),
+		SrChanges:       nclib.NewSrChanges(),
+		AfiSafiName:     afiSafiName,
+		Config:          NewBgpAfiSafiConfig(),
+		GracefulRestart: NewBgpAfiSafiGracefulRestart(),
+		LongLivedGR:     NewBgpAfiSafiLongLivedGracefulRestart(),
+		PrefixLimit:     NewBgpAfiSafiPrefixLimit(),
 	}
 }
 
 func (b *BgpAfiSafi) String() string {
-	return fmt.Sprintf("%s{%s=%s, %s} %s",
+	return fmt.Sprintf("%s{%s=%s, %s, %s, %s, %s} %s",
 		BGP_AFISAFI_KEY,
 		BGP_AFISAFI_NAME_KEY, b.AfiSafiName,
 		b.Config,
+		b.GracefulRestart,
+		b.LongLivedGR,
+		b.PrefixLimit,
 		b.SrChanges,
 	)
 }
@@ -107,6 +124,21 @@ func (b *BgpAfiSafi) Put(nodes []*ncxml.XPathNode, value string) error {
 		if err := b.Config.Put(nodes[1:], value); err != nil {
 			return err
 		}
+
+	case BGP_GRACEFUL_RESTART_KEY:
+		if err := b.GracefulRestart.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_LLGR_KEY:
+		if err := b.LongLivedGR.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_PREFIX_LIMIT_KEY:
+		if err := b.PrefixLimit.Put(nodes[1:], value); err != nil {
+			return err
+		}
 	}
 
 	b.SetChange(nodes[0].Name)
@@ -120,19 +152,22 @@ type BgpAfiSafiConfig struct {
 	nclib.SrChanges `xml:"-"`
 
 	AfiSafiName BgpAfiSafiType `xml:"afi-safi-name"`
+	Enabled     bool           `xml:"enabled"`
 }
 
 func NewBgpAfiSafiConfig() *BgpAfiSafiConfig {
 	return &BgpAfiSafiConfig{
 		SrChanges:   nclib.NewSrChanges(),
 		AfiSafiName: BGP_AFI_SAFI_TYPE,
+		Enabled:     false,
 	}
 }
 
 func (b *BgpAfiSafiConfig) String() string {
-	return fmt.Sprintf("%s{%s=%s} %s",
+	return fmt.Sprintf("%s{%s=%s, %s=%t} %s",
 		OC_CONFIG_KEY,
 		BGP_AFISAFI_NAME_KEY, b.AfiSafiName,
+		OC_ENABLED_KEY, b.Enabled,
 		b.SrChanges,
 	)
 }
@@ -149,6 +184,292 @@ func (b *BgpAfiSafiConfig) Put(nodes []*ncxml.XPathNode, value string) error {
 			return err
 		}
 		b.AfiSafiName = name
+
+	case OC_ENABLED_KEY:
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		b.Enabled = enabled
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// afi-safis/afi-safi[afi-safi-name]/graceful-restart
+//
+type BgpAfiSafiGracefulRestart struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *BgpAfiSafiGracefulRestartConfig `xml:"config"`
+}
+
+func NewBgpAfiSafiGracefulRestart() *BgpAfiSafiGracefulRestart {
+	return &BgpAfiSafiGracefulRestart{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewBgpAfiSafiGracefulRestartConfig(),
+	}
+}
+
+func (b *BgpAfiSafiGracefulRestart) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		BGP_GRACEFUL_RESTART_KEY,
+		b.Config,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpAfiSafiGracefulRestart) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := b.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// afi-safis/afi-safi[afi-safi-name]/graceful-restart/config
+//
+type BgpAfiSafiGracefulRestartConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Enabled bool `xml:"enabled"`
+}
+
+func NewBgpAfiSafiGracefulRestartConfig() *BgpAfiSafiGracefulRestartConfig {
+	return &BgpAfiSafiGracefulRestartConfig{
+		SrChanges: nclib.NewSrChanges(),
+		Enabled:   false,
+	}
+}
+
+func (b *BgpAfiSafiGracefulRestartConfig) String() string {
+	return fmt.Sprintf("%s{%s=%t} %s",
+		OC_CONFIG_KEY,
+		OC_ENABLED_KEY, b.Enabled,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpAfiSafiGracefulRestartConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_ENABLED_KEY:
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		b.Enabled = enabled
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// afi-safis/afi-safi[afi-safi-name]/long-lived-graceful-restart
+//
+type BgpAfiSafiLongLivedGracefulRestart struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *BgpAfiSafiLongLivedGracefulRestartConfig `xml:"config"`
+}
+
+func NewBgpAfiSafiLongLivedGracefulRestart() *BgpAfiSafiLongLivedGracefulRestart {
+	return &BgpAfiSafiLongLivedGracefulRestart{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewBgpAfiSafiLongLivedGracefulRestartConfig(),
+	}
+}
+
+func (b *BgpAfiSafiLongLivedGracefulRestart) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		BGP_LLGR_KEY,
+		b.Config,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpAfiSafiLongLivedGracefulRestart) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := b.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// afi-safis/afi-safi[afi-safi-name]/long-lived-graceful-restart/config
+//
+type BgpAfiSafiLongLivedGracefulRestartConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Enabled     bool   `xml:"enabled"`
+	RestartTime uint32 `xml:"restart-time"`
+}
+
+func NewBgpAfiSafiLongLivedGracefulRestartConfig() *BgpAfiSafiLongLivedGracefulRestartConfig {
+	return &BgpAfiSafiLongLivedGracefulRestartConfig{
+		SrChanges:   nclib.NewSrChanges(),
+		Enabled:     false,
+		RestartTime: 0,
+	}
+}
+
+func (b *BgpAfiSafiLongLivedGracefulRestartConfig) String() string {
+	return fmt.Sprintf("%s{%s=%t, %s=%d} %s",
+		OC_CONFIG_KEY,
+		OC_ENABLED_KEY, b.Enabled,
+		BGP_RESTART_TIME_KEY, b.RestartTime,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpAfiSafiLongLivedGracefulRestartConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_ENABLED_KEY:
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		b.Enabled = enabled
+
+	case BGP_RESTART_TIME_KEY:
+		t, err := strconv.ParseUint(value, 0, 32)
+		if err != nil {
+			return err
+		}
+		b.RestartTime = uint32(t)
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// afi-safis/afi-safi[afi-safi-name]/prefix-limit
+//
+type BgpAfiSafiPrefixLimit struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *BgpAfiSafiPrefixLimitConfig `xml:"config"`
+}
+
+func NewBgpAfiSafiPrefixLimit() *BgpAfiSafiPrefixLimit {
+	return &BgpAfiSafiPrefixLimit{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewBgpAfiSafiPrefixLimitConfig(),
+	}
+}
+
+func (b *BgpAfiSafiPrefixLimit) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		BGP_PREFIX_LIMIT_KEY,
+		b.Config,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpAfiSafiPrefixLimit) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := b.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// afi-safis/afi-safi[afi-safi-name]/prefix-limit/config
+//
+type BgpAfiSafiPrefixLimitConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	MaxPrefixes  uint32  `xml:"max-prefixes"`
+	ShutdownPct  uint8   `xml:"shutdown-threshold-pct"`
+	RestartTimer float64 `xml:"restart-timer"`
+}
+
+func NewBgpAfiSafiPrefixLimitConfig() *BgpAfiSafiPrefixLimitConfig {
+	return &BgpAfiSafiPrefixLimitConfig{
+		SrChanges:    nclib.NewSrChanges(),
+		MaxPrefixes:  0,
+		ShutdownPct:  0,
+		RestartTimer: 0,
+	}
+}
+
+func (b *BgpAfiSafiPrefixLimitConfig) String() string {
+	return fmt.Sprintf("%s{%s=%d, %s=%d, %s=%f} %s",
+		OC_CONFIG_KEY,
+		BGP_MAX_PREFIXES_KEY, b.MaxPrefixes,
+		BGP_SHUTDOWN_PCT_KEY, b.ShutdownPct,
+		BGP_RESTART_TIMER_KEY, b.RestartTimer,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpAfiSafiPrefixLimitConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_MAX_PREFIXES_KEY:
+		n, err := strconv.ParseUint(value, 0, 32)
+		if err != nil {
+			return err
+		}
+		b.MaxPrefixes = uint32(n)
+
+	case BGP_SHUTDOWN_PCT_KEY:
+		pct, err := strconv.ParseUint(value, 0, 8)
+		if err != nil {
+			return err
+		}
+		if pct > 100 {
+			return fmt.Errorf("Invalid %s. %s", BGP_SHUTDOWN_PCT_KEY, value)
+		}
+		b.ShutdownPct = uint8(pct)
+
+	case BGP_RESTART_TIMER_KEY:
+		t, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		b.RestartTimer = t
 	}
 
 	b.SetChange(nodes[0].Name)
@@ -0,0 +1,147 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"fmt"
+	nclib "netconf/lib"
+	ncxml "netconf/lib/xml"
+	"strconv"
+)
+
+const (
+	BGP_CONFEDERATION_KEY = "confederation"
+	BGP_MEMBER_AS_KEY     = "member-as"
+)
+
+//
+// global/confederation
+//
+type BgpGlobalConfederation struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *BgpGlobalConfederationConfig `xml:"config"`
+}
+
+type BgpGlobalConfederationProcessor interface {
+	BgpGlobalConfederationConfig(string, *NetworkInstanceProtocolKey, *BgpGlobalConfederationConfig) error
+}
+
+func NewBgpGlobalConfederation() *BgpGlobalConfederation {
+	return &BgpGlobalConfederation{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewBgpGlobalConfederationConfig(),
+	}
+}
+
+func (b *BgpGlobalConfederation) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		BGP_CONFEDERATION_KEY,
+		b.Config,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpGlobalConfederation) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := b.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessBgpGlobalConfederation(p BgpGlobalConfederationProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, confed *BgpGlobalConfederation) error {
+	configFunc := func() error {
+		if confed.GetChange(OC_CONFIG_KEY) {
+			return p.BgpGlobalConfederationConfig(name, key, confed.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// global/confederation/config
+//
+type BgpGlobalConfederationConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Enabled    bool     `xml:"enabled"`
+	Identifier uint32   `xml:"identifier"`
+	MemberAs   []uint32 `xml:"member-as"`
+}
+
+func NewBgpGlobalConfederationConfig() *BgpGlobalConfederationConfig {
+	return &BgpGlobalConfederationConfig{
+		SrChanges:  nclib.NewSrChanges(),
+		Enabled:    false,
+		Identifier: 0,
+		MemberAs:   []uint32{},
+	}
+}
+
+func (b *BgpGlobalConfederationConfig) String() string {
+	return fmt.Sprintf("%s{%s=%t, %s=%d, %s=%v} %s",
+		OC_CONFIG_KEY,
+		OC_ENABLED_KEY, b.Enabled,
+		OC_IDENT_KEY, b.Identifier,
+		BGP_MEMBER_AS_KEY, b.MemberAs,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpGlobalConfederationConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_ENABLED_KEY:
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		b.Enabled = enabled
+
+	case OC_IDENT_KEY:
+		as, err := strconv.ParseUint(value, 0, 32)
+		if err != nil {
+			return fmt.Errorf("Invalid AS. %s", value)
+		}
+		b.Identifier = uint32(as)
+
+	case BGP_MEMBER_AS_KEY:
+		as, err := strconv.ParseUint(value, 0, 32)
+		if err != nil {
+			return fmt.Errorf("Invalid AS. %s", value)
+		}
+		b.MemberAs = append(b.MemberAs, uint32(as))
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
@@ -0,0 +1,200 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net"
+	nclib "netconf/lib"
+	ncxml "netconf/lib/xml"
+)
+
+const (
+	BGP_DYNAMIC_NEIGHBORS_KEY = "dynamic-neighbors"
+	BGP_DYNAMIC_NEIGHBOR_KEY  = "dynamic-neighbor"
+	BGP_DYNAMIC_PREFIX_KEY    = "prefix"
+)
+
+//
+// global/dynamic-neighbors
+//
+type BgpDynamicNeighbors map[string]*BgpDynamicNeighbor
+
+func NewBgpDynamicNeighbors() BgpDynamicNeighbors {
+	return BgpDynamicNeighbors{}
+}
+
+func (b BgpDynamicNeighbors) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	prefix, ok := nodes[0].Attrs[BGP_DYNAMIC_PREFIX_KEY]
+	if !ok {
+		return fmt.Errorf("%s@%s not found. %s", BGP_DYNAMIC_NEIGHBOR_KEY, BGP_DYNAMIC_PREFIX_KEY, nodes[0])
+	}
+
+	neigh, ok := b[prefix]
+	if !ok {
+		neigh = NewBgpDynamicNeighbor(prefix)
+		b[prefix] = neigh
+	}
+
+	return neigh.Put(nodes[1:], value)
+}
+
+func (b BgpDynamicNeighbors) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = BGP_DYNAMIC_NEIGHBORS_KEY
+	e.EncodeToken(start)
+
+	for _, neigh := range b {
+		err := e.EncodeElement(neigh, xml.StartElement{Name: xml.Name{Local: BGP_DYNAMIC_NEIGHBOR_KEY}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+func ProcessBgpGlobalDynamicNeighbors(p BgpGlobalDynamicNeighborProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, neighs BgpDynamicNeighbors) error {
+	for prefix, neigh := range neighs {
+		if err := ProcessBgpGlobalDynamicNeighbor(p, reverse, name, key, prefix, neigh); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//
+// global/dynamic-neighbors/dynamic-neighbor[prefix]
+//
+type BgpDynamicNeighbor struct {
+	nclib.SrChanges `xml:"-"`
+
+	Prefix string                    `xml:"prefix"`
+	Config *BgpDynamicNeighborConfig `xml:"config"`
+}
+
+type BgpGlobalDynamicNeighborProcessor interface {
+	BgpGlobalDynamicNeighbor(string, *NetworkInstanceProtocolKey, string, *BgpDynamicNeighbor) error
+	BgpGlobalDynamicNeighborConfig(string, *NetworkInstanceProtocolKey, string, *BgpDynamicNeighborConfig) error
+}
+
+func NewBgpDynamicNeighbor(prefix string) *BgpDynamicNeighbor {
+	return &BgpDynamicNeighbor{
+		SrChanges: nclib.NewSrChanges(),
+		Prefix:    prefix,
+		Config:    NewBgpDynamicNeighborConfig(),
+	}
+}
+
+func (b *BgpDynamicNeighbor) String() string {
+	return fmt.Sprintf("%s{%s='%s', %s} %s",
+		BGP_DYNAMIC_NEIGHBOR_KEY,
+		BGP_DYNAMIC_PREFIX_KEY, b.Prefix,
+		b.Config,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpDynamicNeighbor) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_DYNAMIC_PREFIX_KEY:
+		// b.Prefix = value // set by NewBgpDynamicNeighbor
+
+	case OC_CONFIG_KEY:
+		if err := b.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessBgpGlobalDynamicNeighbor(p BgpGlobalDynamicNeighborProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, prefix string, neigh *BgpDynamicNeighbor) error {
+	neighFunc := func() error {
+		if neigh.GetChange(BGP_DYNAMIC_PREFIX_KEY) {
+			return p.BgpGlobalDynamicNeighbor(name, key, prefix, neigh)
+		}
+		return nil
+	}
+
+	configFunc := func() error {
+		if neigh.GetChange(OC_CONFIG_KEY) {
+			return p.BgpGlobalDynamicNeighborConfig(name, key, prefix, neigh.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, neighFunc, configFunc)
+}
+
+//
+// global/dynamic-neighbors/dynamic-neighbor[prefix]/config
+//
+type BgpDynamicNeighborConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Prefix    *net.IPNet `xml:"prefix"`
+	PeerGroup string     `xml:"peer-group"`
+}
+
+func NewBgpDynamicNeighborConfig() *BgpDynamicNeighborConfig {
+	return &BgpDynamicNeighborConfig{
+		SrChanges: nclib.NewSrChanges(),
+		Prefix:    nil,
+		PeerGroup: "",
+	}
+}
+
+func (b *BgpDynamicNeighborConfig) String() string {
+	return fmt.Sprintf("%s{%s=%s, %s='%s'} %s",
+		OC_CONFIG_KEY,
+		BGP_DYNAMIC_PREFIX_KEY, b.Prefix,
+		BGP_PEERGROUP_KEY, b.PeerGroup,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpDynamicNeighborConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_DYNAMIC_PREFIX_KEY:
+		_, nw, err := net.ParseCIDR(value)
+		if err != nil {
+			return err
+		}
+		b.Prefix = nw
+
+	case BGP_PEERGROUP_KEY:
+		b.PeerGroup = value
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
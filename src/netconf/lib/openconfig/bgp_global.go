@@ -27,24 +27,48 @@ import (
 type BgpGlobal struct {
 	nclib.SrChanges `xml:"-"`
 
-	Config *BgpGlobalConfig `xml:"config"`
+	Config           *BgpGlobalConfig          `xml:"config"`
+	Confederation    *BgpGlobalConfederation   `xml:"confederation"`
+	GracefulRestart  *BgpGracefulRestart       `xml:"graceful-restart"`
+	UseMultiplePaths *BgpUseMultiplePaths      `xml:"use-multiple-paths"`
+	RouteSelection   *BgpRouteSelectionOptions `xml:"route-selection-options"`
+	AfiSafis         BgpAfiSafis               `xml:"afi-safis"`
+	DynamicNeighbors BgpDynamicNeighbors       `xml:"dynamic-neighbors"`
 }
 
 type BgpGlobalProcessor interface {
 	BgpGlobalConfigProcessor
+	BgpGlobalConfederationProcessor
+	BgpGlobalGracefulRestartProcessor
+	BgpGlobalUseMultiplePathsProcessor
+	BgpGlobalRouteSelectionOptionsProcessor
+	BgpGlobalAfiSafiProcessor
+	BgpGlobalDynamicNeighborProcessor
 }
 
 func NewBgpGlobal() *BgpGlobal {
 	return &BgpGlobal{
-		SrChanges: nclib.NewSrChanges(),
-		Config:    NewBgpGlobalConfig(),
+		SrChanges:        nclib.NewSrChanges(),
+		Config:           NewBgpGlobalConfig(),
+		Confederation:    NewBgpGlobalConfederation(),
+		GracefulRestart:  NewBgpGracefulRestart(),
+		UseMultiplePaths: NewBgpUseMultiplePaths(),
+		RouteSelection:   NewBgpRouteSelectionOptions(),
+		AfiSafis:         NewBgpAfiSafis(),
+		DynamicNeighbors: NewBgpDynamicNeighbors(),
 	}
 }
 
 func (b *BgpGlobal) String() string {
-	return fmt.Sprintf("%s{%s} %s",
+	return fmt.Sprintf("%s{%s, %s, %s, %s, %s, %s, %s} %s",
 		OC_GLOBAL_KEY,
 		b.Config,
+		b.Confederation,
+		b.GracefulRestart,
+		b.UseMultiplePaths,
+		b.RouteSelection,
+		b.AfiSafis,
+		b.DynamicNeighbors,
 		b.SrChanges,
 	)
 }
@@ -59,6 +83,36 @@ func (b *BgpGlobal) Put(nodes []*ncxml.XPathNode, value string) error {
 		if err := b.Config.Put(nodes[1:], value); err != nil {
 			return err
 		}
+
+	case BGP_CONFEDERATION_KEY:
+		if err := b.Confederation.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_GRACEFUL_RESTART_KEY:
+		if err := b.GracefulRestart.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_USE_MULTIPLE_PATHS_KEY:
+		if err := b.UseMultiplePaths.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_ROUTE_SELECTION_KEY:
+		if err := b.RouteSelection.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_AFISAFIS_KEY:
+		if err := b.AfiSafis.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_DYNAMIC_NEIGHBORS_KEY:
+		if err := b.DynamicNeighbors.Put(nodes[1:], value); err != nil {
+			return err
+		}
 	}
 
 	b.SetChange(nodes[0].Name)
@@ -79,7 +133,85 @@ func ProcessBgpGlobal(p BgpGlobalProcessor, reverse bool, name string, key *Netw
 		return nil
 	}
 
-	return nclib.CallFunctions(reverse, configFunc)
+	confedFunc := func() error {
+		if global.GetChange(BGP_CONFEDERATION_KEY) {
+			return ProcessBgpGlobalConfederation(
+				p.(BgpGlobalConfederationProcessor),
+				reverse,
+				name,
+				key,
+				global.Confederation,
+			)
+		}
+		return nil
+	}
+
+	grFunc := func() error {
+		if global.GetChange(BGP_GRACEFUL_RESTART_KEY) {
+			return ProcessBgpGlobalGracefulRestart(
+				p.(BgpGlobalGracefulRestartProcessor),
+				reverse,
+				name,
+				key,
+				global.GracefulRestart,
+			)
+		}
+		return nil
+	}
+
+	mpFunc := func() error {
+		if global.GetChange(BGP_USE_MULTIPLE_PATHS_KEY) {
+			return ProcessBgpGlobalUseMultiplePaths(
+				p.(BgpGlobalUseMultiplePathsProcessor),
+				reverse,
+				name,
+				key,
+				global.UseMultiplePaths,
+			)
+		}
+		return nil
+	}
+
+	rsFunc := func() error {
+		if global.GetChange(BGP_ROUTE_SELECTION_KEY) {
+			return ProcessBgpGlobalRouteSelectionOptions(
+				p.(BgpGlobalRouteSelectionOptionsProcessor),
+				reverse,
+				name,
+				key,
+				global.RouteSelection,
+			)
+		}
+		return nil
+	}
+
+	afiSafiFunc := func() error {
+		if global.GetChange(BGP_AFISAFIS_KEY) {
+			return ProcessBgpGlobalAfiSafis(
+				p.(BgpGlobalAfiSafiProcessor),
+				reverse,
+				name,
+				key,
+				global.AfiSafis,
+			)
+		}
+		return nil
+	}
+
+	dynNeighFunc := func() error {
+		if global.GetChange(BGP_DYNAMIC_NEIGHBORS_KEY) {
+			return ProcessBgpGlobalDynamicNeighbors(
+				p.(BgpGlobalDynamicNeighborProcessor),
+				reverse,
+				name,
+				key,
+				global.DynamicNeighbors,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc, confedFunc, grFunc, mpFunc, rsFunc, afiSafiFunc, dynNeighFunc)
 }
 
 type BgpGlobalConfig struct {
@@ -131,10 +263,48 @@ func (b *BgpGlobalConfig) Put(nodes []*ncxml.XPathNode, value string) error {
 	return nil
 }
 
-func ProcessBgpGlobalConfig(p BgpGlobalProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, config *BgpGlobalConfig) error {
+func ProcessBgpGlobalConfig(p BgpGlobalConfigProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, config *BgpGlobalConfig) error {
 	globalFunc := func() error {
 		return p.BgpGlobalConfig(name, key, config)
 	}
 
 	return nclib.CallFunctions(reverse, globalFunc)
 }
+
+//
+// global/afi-safis
+//
+func ProcessBgpGlobalAfiSafis(p BgpGlobalAfiSafiProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, afisafis BgpAfiSafis) error {
+	for afiSafiName, afisafi := range afisafis {
+		if err := ProcessBgpGlobalAfiSafi(p, reverse, name, key, afiSafiName, afisafi); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//
+// global/afi-safis/afi-safi[afi-safi-name]
+//
+type BgpGlobalAfiSafiProcessor interface {
+	BgpGlobalAfiSafi(string, *NetworkInstanceProtocolKey, string, *BgpAfiSafi) error
+	BgpGlobalAfiSafiConfig(string, *NetworkInstanceProtocolKey, string, *BgpAfiSafiConfig) error
+}
+
+func ProcessBgpGlobalAfiSafi(p BgpGlobalAfiSafiProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, afiSafiName string, afiSafi *BgpAfiSafi) error {
+	afisafiFunc := func() error {
+		if afiSafi.GetChange(BGP_AFISAFI_NAME_KEY) {
+			return p.BgpGlobalAfiSafi(name, key, afiSafiName, afiSafi)
+		}
+		return nil
+	}
+
+	configFunc := func() error {
+		if afiSafi.GetChange(OC_CONFIG_KEY) {
+			return p.BgpGlobalAfiSafiConfig(name, key, afiSafiName, afiSafi.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, afisafiFunc, configFunc)
+}
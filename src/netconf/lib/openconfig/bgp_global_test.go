@@ -0,0 +1,122 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	srlib "netconf/lib/sysrepo"
+	"testing"
+)
+
+func makeBgpGlobal(datas [][2]string) (*BgpGlobal, error) {
+	global := NewBgpGlobal()
+	for _, data := range datas {
+		xpath, value := data[0], data[1]
+		nodes := srlib.ParseXPath(xpath)
+		if err := global.Put(nodes[1:], value); err != nil {
+			return global, err
+		}
+	}
+
+	return global, nil
+}
+
+func TestBgpGlobal_use_multiple_paths(t *testing.T) {
+	global, err := makeBgpGlobal([][2]string{
+		{"/global/use-multiple-paths/config/enabled", "true"},
+		{"/global/use-multiple-paths/ebgp/config/maximum-paths", "8"},
+		{"/global/use-multiple-paths/ibgp/config/maximum-paths", "4"},
+	})
+
+	if err != nil {
+		t.Errorf("BgpGlobal.Put error. %s", err)
+	}
+
+	mp := global.UseMultiplePaths
+	t.Log(mp)
+
+	if v := global.Compare(BGP_USE_MULTIPLE_PATHS_KEY); !v {
+		t.Errorf("BgpGlobal.Put unmatch. cmp=%t", v)
+	}
+	if v := mp.Config.Enabled; !v {
+		t.Errorf("BgpGlobal.Put unmatch. enabled=%t", v)
+	}
+	if v := mp.Ebgp.Config.MaximumPaths; v != 8 {
+		t.Errorf("BgpGlobal.Put unmatch. ebgp maximum-paths=%d", v)
+	}
+	if v := mp.Ibgp.Config.MaximumPaths; v != 4 {
+		t.Errorf("BgpGlobal.Put unmatch. ibgp maximum-paths=%d", v)
+	}
+
+	if _, err := makeBgpGlobal([][2]string{
+		{"/global/use-multiple-paths/ebgp/config/maximum-paths", "0"},
+	}); err == nil {
+		t.Errorf("BgpGlobal.Put must be error.")
+	}
+}
+
+func TestBgpGlobal_afisafi(t *testing.T) {
+	global, err := makeBgpGlobal([][2]string{
+		{"/global/afi-safis/afi-safi[afi-safi-name='IPV6_UNICAST']/config/afi-safi-name", "IPV6_UNICAST"},
+		{"/global/afi-safis/afi-safi[afi-safi-name='IPV6_UNICAST']/config/enabled", "true"},
+	})
+
+	if err != nil {
+		t.Errorf("BgpGlobal.Put error. %s", err)
+	}
+
+	afisafi, ok := global.AfiSafis["IPV6_UNICAST"]
+	if !ok {
+		t.Fatalf("BgpGlobal.Put unmatch. %s", global.AfiSafis)
+	}
+
+	if v := afisafi.Config.AfiSafiName; v != BGP_AFI_SAFI_IPV6_UNICAST {
+		t.Errorf("BgpGlobal.Put unmatch. afi-safi-name=%s", v)
+	}
+	if v := afisafi.Config.Enabled; !v {
+		t.Errorf("BgpGlobal.Put unmatch. enabled=%t", v)
+	}
+}
+
+func TestBgpGlobal_dynamic_neighbor(t *testing.T) {
+	global, err := makeBgpGlobal([][2]string{
+		{"/global/dynamic-neighbors/dynamic-neighbor[prefix='10.0.0.0/24']/config/prefix", "10.0.0.0/24"},
+		{"/global/dynamic-neighbors/dynamic-neighbor[prefix='10.0.0.0/24']/config/peer-group", "pg1"},
+	})
+
+	if err != nil {
+		t.Errorf("BgpGlobal.Put error. %s", err)
+	}
+
+	neigh, ok := global.DynamicNeighbors["10.0.0.0/24"]
+	if !ok {
+		t.Fatalf("BgpGlobal.Put unmatch. %s", global.DynamicNeighbors)
+	}
+
+	if v := neigh.Config.Prefix.String(); v != "10.0.0.0/24" {
+		t.Errorf("BgpGlobal.Put unmatch. prefix=%s", v)
+	}
+	if v := neigh.Config.PeerGroup; v != "pg1" {
+		t.Errorf("BgpGlobal.Put unmatch. peer-group=%s", v)
+	}
+
+	if _, err := makeBgpGlobal([][2]string{
+		{"/global/dynamic-neighbors/dynamic-neighbor[prefix='10.0.0.0']/config/prefix", "10.0.0.0"},
+	}); err == nil {
+		t.Errorf("BgpGlobal.Put must be error.")
+	}
+}
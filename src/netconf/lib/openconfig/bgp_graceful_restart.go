@@ -0,0 +1,210 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"fmt"
+	nclib "netconf/lib"
+	ncxml "netconf/lib/xml"
+	"strconv"
+)
+
+const (
+	BGP_GRACEFUL_RESTART_KEY = "graceful-restart"
+	BGP_LLGR_KEY             = "long-lived-graceful-restart"
+	BGP_RESTART_TIME_KEY     = "restart-time"
+	BGP_STALE_TIME_KEY       = "stale-routes-time"
+	BGP_HELPER_ONLY_KEY      = "helper-only"
+	BGP_LLGR_ENABLED_KEY     = "long-lived-enabled"
+)
+
+//
+// graceful-restart
+//
+type BgpGracefulRestart struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *BgpGracefulRestartConfig `xml:"config"`
+}
+
+func NewBgpGracefulRestart() *BgpGracefulRestart {
+	return &BgpGracefulRestart{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewBgpGracefulRestartConfig(),
+	}
+}
+
+func (b *BgpGracefulRestart) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		BGP_GRACEFUL_RESTART_KEY,
+		b.Config,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpGracefulRestart) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := b.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// global/graceful-restart
+//
+type BgpGlobalGracefulRestartProcessor interface {
+	BgpGlobalGracefulRestartConfig(string, *NetworkInstanceProtocolKey, *BgpGracefulRestartConfig) error
+}
+
+func ProcessBgpGlobalGracefulRestart(p BgpGlobalGracefulRestartProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, gr *BgpGracefulRestart) error {
+	configFunc := func() error {
+		if gr.GetChange(OC_CONFIG_KEY) {
+			return p.BgpGlobalGracefulRestartConfig(name, key, gr.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// neighbors/neighbor[neighbor-address]/graceful-restart
+//
+type BgpNeighborGracefulRestartProcessor interface {
+	BgpNeighborGracefulRestartConfig(string, *NetworkInstanceProtocolKey, string, *BgpGracefulRestartConfig) error
+}
+
+func ProcessBgpNeighborGracefulRestart(p BgpNeighborGracefulRestartProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, addr string, gr *BgpGracefulRestart) error {
+	configFunc := func() error {
+		if gr.GetChange(OC_CONFIG_KEY) {
+			return p.BgpNeighborGracefulRestartConfig(name, key, addr, gr.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// peer-groups/peer-group[peer-group-name]/graceful-restart
+//
+type BgpPeerGroupGracefulRestartProcessor interface {
+	BgpPeerGroupGracefulRestartConfig(string, *NetworkInstanceProtocolKey, string, *BgpGracefulRestartConfig) error
+}
+
+func ProcessBgpPeerGroupGracefulRestart(p BgpPeerGroupGracefulRestartProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, pgName string, gr *BgpGracefulRestart) error {
+	configFunc := func() error {
+		if gr.GetChange(OC_CONFIG_KEY) {
+			return p.BgpPeerGroupGracefulRestartConfig(name, key, pgName, gr.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// graceful-restart/config
+//
+type BgpGracefulRestartConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Enabled          bool    `xml:"enabled"`
+	RestartTime      uint16  `xml:"restart-time"`
+	StaleRoutesTime  float64 `xml:"stale-routes-time"`
+	HelperOnly       bool    `xml:"helper-only"`
+	LongLivedEnabled bool    `xml:"long-lived-enabled"`
+}
+
+func NewBgpGracefulRestartConfig() *BgpGracefulRestartConfig {
+	return &BgpGracefulRestartConfig{
+		SrChanges:        nclib.NewSrChanges(),
+		Enabled:          false,
+		RestartTime:      0,
+		StaleRoutesTime:  0,
+		HelperOnly:       false,
+		LongLivedEnabled: false,
+	}
+}
+
+func (b *BgpGracefulRestartConfig) String() string {
+	return fmt.Sprintf("%s{%s=%t, %s=%d, %s=%f, %s=%t, %s=%t} %s",
+		OC_CONFIG_KEY,
+		OC_ENABLED_KEY, b.Enabled,
+		BGP_RESTART_TIME_KEY, b.RestartTime,
+		BGP_STALE_TIME_KEY, b.StaleRoutesTime,
+		BGP_HELPER_ONLY_KEY, b.HelperOnly,
+		BGP_LLGR_ENABLED_KEY, b.LongLivedEnabled,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpGracefulRestartConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_ENABLED_KEY:
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		b.Enabled = enabled
+
+	case BGP_RESTART_TIME_KEY:
+		t, err := strconv.ParseUint(value, 0, 16)
+		if err != nil {
+			return err
+		}
+		b.RestartTime = uint16(t)
+
+	case BGP_STALE_TIME_KEY:
+		t, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		b.StaleRoutesTime = t
+
+	case BGP_HELPER_ONLY_KEY:
+		helper, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		b.HelperOnly = helper
+
+	case BGP_LLGR_ENABLED_KEY:
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		b.LongLivedEnabled = enabled
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
@@ -0,0 +1,355 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"fmt"
+	nclib "netconf/lib"
+	ncxml "netconf/lib/xml"
+	"strconv"
+)
+
+const (
+	BGP_USE_MULTIPLE_PATHS_KEY = "use-multiple-paths"
+	BGP_EBGP_KEY               = "ebgp"
+	BGP_IBGP_KEY               = "ibgp"
+	BGP_ALLOW_MULTIPLE_AS_KEY  = "allow-multiple-as"
+	BGP_MAXIMUM_PATHS_KEY      = "maximum-paths"
+)
+
+//
+// use-multiple-paths
+//
+type BgpUseMultiplePaths struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *BgpUseMultiplePathsConfig `xml:"config"`
+	Ebgp   *BgpUseMultiplePathsEbgp   `xml:"ebgp"`
+	Ibgp   *BgpUseMultiplePathsIbgp   `xml:"ibgp"`
+}
+
+func NewBgpUseMultiplePaths() *BgpUseMultiplePaths {
+	return &BgpUseMultiplePaths{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewBgpUseMultiplePathsConfig(),
+		Ebgp:      NewBgpUseMultiplePathsEbgp(),
+		Ibgp:      NewBgpUseMultiplePathsIbgp(),
+	}
+}
+
+func (b *BgpUseMultiplePaths) String() string {
+	return fmt.Sprintf("%s{%s, %s, %s} %s",
+		BGP_USE_MULTIPLE_PATHS_KEY,
+		b.Config,
+		b.Ebgp,
+		b.Ibgp,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpUseMultiplePaths) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := b.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_EBGP_KEY:
+		if err := b.Ebgp.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_IBGP_KEY:
+		if err := b.Ibgp.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// global/use-multiple-paths
+//
+type BgpGlobalUseMultiplePathsProcessor interface {
+	BgpGlobalUseMultiplePathsConfig(string, *NetworkInstanceProtocolKey, *BgpUseMultiplePathsConfig) error
+	BgpGlobalUseMultiplePathsEbgpConfig(string, *NetworkInstanceProtocolKey, *BgpUseMultiplePathsEbgpConfig) error
+	BgpGlobalUseMultiplePathsIbgpConfig(string, *NetworkInstanceProtocolKey, *BgpUseMultiplePathsIbgpConfig) error
+}
+
+func ProcessBgpGlobalUseMultiplePaths(p BgpGlobalUseMultiplePathsProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, mp *BgpUseMultiplePaths) error {
+	configFunc := func() error {
+		if mp.GetChange(OC_CONFIG_KEY) {
+			return p.BgpGlobalUseMultiplePathsConfig(name, key, mp.Config)
+		}
+		return nil
+	}
+
+	ebgpFunc := func() error {
+		if mp.GetChange(BGP_EBGP_KEY) && mp.Ebgp.GetChange(OC_CONFIG_KEY) {
+			return p.BgpGlobalUseMultiplePathsEbgpConfig(name, key, mp.Ebgp.Config)
+		}
+		return nil
+	}
+
+	ibgpFunc := func() error {
+		if mp.GetChange(BGP_IBGP_KEY) && mp.Ibgp.GetChange(OC_CONFIG_KEY) {
+			return p.BgpGlobalUseMultiplePathsIbgpConfig(name, key, mp.Ibgp.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc, ebgpFunc, ibgpFunc)
+}
+
+//
+// use-multiple-paths/config
+//
+type BgpUseMultiplePathsConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Enabled bool `xml:"enabled"`
+}
+
+func NewBgpUseMultiplePathsConfig() *BgpUseMultiplePathsConfig {
+	return &BgpUseMultiplePathsConfig{
+		SrChanges: nclib.NewSrChanges(),
+		Enabled:   false,
+	}
+}
+
+func (b *BgpUseMultiplePathsConfig) String() string {
+	return fmt.Sprintf("%s{%s=%t} %s",
+		OC_CONFIG_KEY,
+		OC_ENABLED_KEY, b.Enabled,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpUseMultiplePathsConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_ENABLED_KEY:
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		b.Enabled = enabled
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// use-multiple-paths/ebgp
+//
+type BgpUseMultiplePathsEbgp struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *BgpUseMultiplePathsEbgpConfig `xml:"config"`
+}
+
+func NewBgpUseMultiplePathsEbgp() *BgpUseMultiplePathsEbgp {
+	return &BgpUseMultiplePathsEbgp{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewBgpUseMultiplePathsEbgpConfig(),
+	}
+}
+
+func (b *BgpUseMultiplePathsEbgp) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		BGP_EBGP_KEY,
+		b.Config,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpUseMultiplePathsEbgp) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := b.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// use-multiple-paths/ebgp/config
+//
+type BgpUseMultiplePathsEbgpConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	AllowMultipleAs bool   `xml:"allow-multiple-as"`
+	MaximumPaths    uint32 `xml:"maximum-paths"`
+}
+
+func NewBgpUseMultiplePathsEbgpConfig() *BgpUseMultiplePathsEbgpConfig {
+	return &BgpUseMultiplePathsEbgpConfig{
+		SrChanges:       nclib.NewSrChanges(),
+		AllowMultipleAs: false,
+		MaximumPaths:    1,
+	}
+}
+
+func (b *BgpUseMultiplePathsEbgpConfig) String() string {
+	return fmt.Sprintf("%s{%s=%t, %s=%d} %s",
+		OC_CONFIG_KEY,
+		BGP_ALLOW_MULTIPLE_AS_KEY, b.AllowMultipleAs,
+		BGP_MAXIMUM_PATHS_KEY, b.MaximumPaths,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpUseMultiplePathsEbgpConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_ALLOW_MULTIPLE_AS_KEY:
+		allow, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		b.AllowMultipleAs = allow
+
+	case BGP_MAXIMUM_PATHS_KEY:
+		paths, err := ParseBgpMaximumPaths(value)
+		if err != nil {
+			return err
+		}
+		b.MaximumPaths = paths
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// use-multiple-paths/ibgp
+//
+type BgpUseMultiplePathsIbgp struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *BgpUseMultiplePathsIbgpConfig `xml:"config"`
+}
+
+func NewBgpUseMultiplePathsIbgp() *BgpUseMultiplePathsIbgp {
+	return &BgpUseMultiplePathsIbgp{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewBgpUseMultiplePathsIbgpConfig(),
+	}
+}
+
+func (b *BgpUseMultiplePathsIbgp) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		BGP_IBGP_KEY,
+		b.Config,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpUseMultiplePathsIbgp) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := b.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// use-multiple-paths/ibgp/config
+//
+type BgpUseMultiplePathsIbgpConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	MaximumPaths uint32 `xml:"maximum-paths"`
+}
+
+func NewBgpUseMultiplePathsIbgpConfig() *BgpUseMultiplePathsIbgpConfig {
+	return &BgpUseMultiplePathsIbgpConfig{
+		SrChanges:    nclib.NewSrChanges(),
+		MaximumPaths: 1,
+	}
+}
+
+func (b *BgpUseMultiplePathsIbgpConfig) String() string {
+	return fmt.Sprintf("%s{%s=%d} %s",
+		OC_CONFIG_KEY,
+		BGP_MAXIMUM_PATHS_KEY, b.MaximumPaths,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpUseMultiplePathsIbgpConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_MAXIMUM_PATHS_KEY:
+		paths, err := ParseBgpMaximumPaths(value)
+		if err != nil {
+			return err
+		}
+		b.MaximumPaths = paths
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// ParseBgpMaximumPaths parses maximum-paths. 0 is not allowed.
+//
+func ParseBgpMaximumPaths(s string) (uint32, error) {
+	paths, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	if paths == 0 {
+		return 0, fmt.Errorf("Invalid %s. %s", BGP_MAXIMUM_PATHS_KEY, s)
+	}
+
+	return uint32(paths), nil
+}
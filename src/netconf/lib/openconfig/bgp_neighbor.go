@@ -77,12 +77,18 @@ func (b BgpNeighbors) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 type BgpNeighbor struct {
 	nclib.SrChanges `xml:"-"`
 
-	Address     string                `xml:"address"`
-	Config      *BgpNeighborConfig    `xml:"config"`
-	Timers      *BgpNeighborTimers    `xml:"timers"`
-	Transport   *BgpNeighborTransport `xml:"transport"`
-	AfiSafis    BgpAfiSafis           `xml:"afi-safis"`
-	ApplyPolicy *PolicyApply          `xml:"apply-policy"`
+	Address         string                `xml:"address" yang:"neighbor-address"`
+	Config          *BgpNeighborConfig    `xml:"config"`
+	Timers          *BgpNeighborTimers    `xml:"timers"`
+	Transport       *BgpNeighborTransport `xml:"transport"`
+	RouteReflector  *BgpRouteReflector    `xml:"route-reflector"`
+	GracefulRestart *BgpGracefulRestart   `xml:"graceful-restart"`
+	AddPaths        *BgpAddPaths          `xml:"add-paths"`
+	EbgpMultihop    *BgpEbgpMultihop      `xml:"ebgp-multihop"`
+	TtlSecurity     *BgpTtlSecurity       `xml:"ttl-security"`
+	AfiSafis        BgpAfiSafis           `xml:"afi-safis"`
+	ApplyPolicy     *PolicyApply          `xml:"apply-policy"`
+	EnableBfd       *EnableBfd            `xml:"enable-bfd"`
 }
 
 type BgpNeighborProcessor interface {
@@ -91,7 +97,13 @@ type BgpNeighborProcessor interface {
 	BgpNeighborTimersProcessor
 	BgpNeighborTransportProcessor
 	BgpNeighborAfiSafiProcessor
+	BgpNeighborRouteReflectorProcessor
+	BgpNeighborGracefulRestartProcessor
+	BgpNeighborAddPathsProcessor
+	BgpNeighborEbgpMultihopProcessor
+	BgpNeighborTtlSecurityProcessor
 	BgpNeighborApplyPolicyProcessor
+	BgpNeighborEnableBfdProcessor
 }
 
 type bgpNeighborProcessor interface {
@@ -100,25 +112,37 @@ type bgpNeighborProcessor interface {
 
 func NewBgpNeighbor(addr string) *BgpNeighbor {
 	return &BgpNeighbor{
-		SrChanges:   nclib.NewSrChanges(),
-		Address:     addr,
-		Config:      NewBgpNeighborConfig(),
-		Timers:      NewBgpNeighborTimers(),
-		Transport:   NewBgpNeighborTransport(),
-		AfiSafis:    NewBgpAfiSafis(),
-		ApplyPolicy: NewPolicyApply(),
+		SrChanges:       nclib.NewSrChanges(),
+		Address:         addr,
+		Config:          NewBgpNeighborConfig(),
+		Timers:          NewBgpNeighborTimers(),
+		Transport:       NewBgpNeighborTransport(),
+		RouteReflector:  NewBgpRouteReflector(),
+		GracefulRestart: NewBgpGracefulRestart(),
+		AddPaths:        NewBgpAddPaths(),
+		EbgpMultihop:    NewBgpEbgpMultihop(),
+		TtlSecurity:     NewBgpTtlSecurity(),
+		AfiSafis:        NewBgpAfiSafis(),
+		ApplyPolicy:     NewPolicyApply(),
+		EnableBfd:       NewEnableBfd(),
 	}
 }
 
 func (b *BgpNeighbor) String() string {
-	return fmt.Sprintf("%s{%s='%s', %s, %s, %s, %s, %s} %s",
+	return fmt.Sprintf("%s{%s='%s', %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s} %s",
 		BGP_NEIGHBOR_KEY,
 		BGP_NEIGHBOR_ADDR_KEY, b.Address,
 		b.Config,
 		b.Timers,
 		b.Transport,
+		b.RouteReflector,
+		b.GracefulRestart,
+		b.AddPaths,
+		b.EbgpMultihop,
+		b.TtlSecurity,
 		b.AfiSafis,
 		b.ApplyPolicy,
+		b.EnableBfd,
 		b.SrChanges,
 	)
 }
@@ -147,11 +171,41 @@ func (b *BgpNeighbor) Put(nodes []*ncxml.XPathNode, value string) error {
 			return err
 		}
 
+	case BGP_ROUTEREFLECTOR_KEY:
+		if err := b.RouteReflector.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_GRACEFUL_RESTART_KEY:
+		if err := b.GracefulRestart.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_ADDPATHS_KEY:
+		if err := b.AddPaths.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_EBGP_MULTIHOP_KEY:
+		if err := b.EbgpMultihop.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_TTL_SECURITY_KEY:
+		if err := b.TtlSecurity.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
 	case POLICYAPPLY_KEY:
 		if err := b.ApplyPolicy.Put(nodes[1:], value); err != nil {
 			return err
 		}
 
+	case BFD_ENABLE_KEY:
+		if err := b.EnableBfd.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
 	case BGP_AFISAFIS_KEY:
 		if err := b.AfiSafis.Put(nodes[1:], value); err != nil {
 			return err
@@ -212,6 +266,76 @@ func ProcessBgpNeighbor(p BgpNeighborProcessor, reverse bool, name string, key *
 		return nil
 	}
 
+	rrFunc := func() error {
+		if neigh.GetChange(BGP_ROUTEREFLECTOR_KEY) {
+			return ProcessBgpNeighborRouteReflector(
+				p.(BgpNeighborRouteReflectorProcessor),
+				reverse,
+				name,
+				key,
+				addr,
+				neigh.RouteReflector,
+			)
+		}
+		return nil
+	}
+
+	grFunc := func() error {
+		if neigh.GetChange(BGP_GRACEFUL_RESTART_KEY) {
+			return ProcessBgpNeighborGracefulRestart(
+				p.(BgpNeighborGracefulRestartProcessor),
+				reverse,
+				name,
+				key,
+				addr,
+				neigh.GracefulRestart,
+			)
+		}
+		return nil
+	}
+
+	addPathsFunc := func() error {
+		if neigh.GetChange(BGP_ADDPATHS_KEY) {
+			return ProcessBgpNeighborAddPaths(
+				p.(BgpNeighborAddPathsProcessor),
+				reverse,
+				name,
+				key,
+				addr,
+				neigh.AddPaths,
+			)
+		}
+		return nil
+	}
+
+	multihopFunc := func() error {
+		if neigh.GetChange(BGP_EBGP_MULTIHOP_KEY) {
+			return ProcessBgpNeighborEbgpMultihop(
+				p.(BgpNeighborEbgpMultihopProcessor),
+				reverse,
+				name,
+				key,
+				addr,
+				neigh.EbgpMultihop,
+			)
+		}
+		return nil
+	}
+
+	ttlSecFunc := func() error {
+		if neigh.GetChange(BGP_TTL_SECURITY_KEY) {
+			return ProcessBgpNeighborTtlSecurity(
+				p.(BgpNeighborTtlSecurityProcessor),
+				reverse,
+				name,
+				key,
+				addr,
+				neigh.TtlSecurity,
+			)
+		}
+		return nil
+	}
+
 	applyPolFunc := func() error {
 		if neigh.GetChange(POLICYAPPLY_KEY) {
 			return ProcessBgpNeighborApplyPolicy(
@@ -226,6 +350,20 @@ func ProcessBgpNeighbor(p BgpNeighborProcessor, reverse bool, name string, key *
 		return nil
 	}
 
+	bfdFunc := func() error {
+		if neigh.GetChange(BFD_ENABLE_KEY) {
+			return ProcessBgpNeighborEnableBfd(
+				p.(BgpNeighborEnableBfdProcessor),
+				reverse,
+				name,
+				key,
+				addr,
+				neigh.EnableBfd,
+			)
+		}
+		return nil
+	}
+
 	afiSafisFunc := func() error {
 		if neigh.GetChange(BGP_AFISAFIS_KEY) {
 			return ProcessBgpNeighborAfiSafis(
@@ -240,16 +378,18 @@ func ProcessBgpNeighbor(p BgpNeighborProcessor, reverse bool, name string, key *
 		return nil
 	}
 
-	return nclib.CallFunctions(reverse, neighFunc, configFunc, timersFunc, transFunc, applyPolFunc, afiSafisFunc)
+	return nclib.CallFunctions(reverse, neighFunc, configFunc, timersFunc, transFunc, rrFunc, grFunc, addPathsFunc, multihopFunc, ttlSecFunc, applyPolFunc, bfdFunc, afiSafisFunc)
 }
 
 type BgpNeighborConfig struct {
 	nclib.SrChanges `xml:"-"`
 
-	Address net.IP `xml:"address"`
-	PeerAs  uint32 `xml:"peer-as"`
-	LocalAs uint32 `xml:"local-as"`
-	Desc    string `xml:"description"`
+	Address   net.IP `xml:"address" yang:"neighbor-address"`
+	PeerAs    uint32 `xml:"peer-as"`
+	LocalAs   uint32 `xml:"local-as"`
+	Desc      string `xml:"description"`
+	PeerGroup string `xml:"peer-group"`
+	AuthPass  string `xml:"auth-password"`
 }
 
 type BgpNeighborConfigProcessor interface {
@@ -263,16 +403,19 @@ func NewBgpNeighborConfig() *BgpNeighborConfig {
 		PeerAs:    0,
 		LocalAs:   0,
 		Desc:      "",
+		PeerGroup: "",
+		AuthPass:  "",
 	}
 }
 
 func (b *BgpNeighborConfig) String() string {
-	return fmt.Sprintf("%s{%s='%s', %s=%d, %s=%d, %s='%s'} %s",
+	return fmt.Sprintf("%s{%s='%s', %s=%d, %s=%d, %s='%s', %s='%s'} %s",
 		OC_CONFIG_KEY,
 		BGP_NEIGHBOR_ADDR_KEY, b.Address,
 		BGP_PEERAS_KEY, b.PeerAs,
 		BGP_LOCALAS_KEY, b.LocalAs,
 		OC_DESCRIPTION_KEY, b.Desc,
+		BGP_PEERGROUP_KEY, b.PeerGroup,
 		b.SrChanges,
 	)
 }
@@ -306,6 +449,12 @@ func (b *BgpNeighborConfig) Put(nodes []*ncxml.XPathNode, value string) error {
 
 	case OC_DESCRIPTION_KEY:
 		b.Desc = value
+
+	case BGP_PEERGROUP_KEY:
+		b.PeerGroup = value
+
+	case BGP_AUTH_PASSWORD_KEY:
+		b.AuthPass = value
 	}
 
 	b.SetChange(nodes[0].Name)
@@ -386,7 +535,7 @@ type BgpNeighborTimersConfig struct {
 	nclib.SrChanges `xml:"-"`
 
 	HoldTime  uint64 `xml:"hold-time"`
-	KeepAlive uint64 `xml:"keep-alive"`
+	KeepAlive uint64 `xml:"keep-alive" yang:"keepalive-interval"`
 }
 
 type BgpNeighborTimersConfigProcessor interface {
@@ -505,7 +654,8 @@ func ProcessBgpNeighborTransport(p BgpNeighborTransportProcessor, reverse bool,
 type BgpNeighborTransportConfig struct {
 	nclib.SrChanges `xml:"-"`
 
-	LocalAddr net.IP `xml:"local-address"`
+	LocalAddr   net.IP `xml:"local-address"`
+	PassiveMode bool   `xml:"passive-mode"`
 }
 
 type BgpNeighborTransportConfigProcessor interface {
@@ -514,15 +664,17 @@ type BgpNeighborTransportConfigProcessor interface {
 
 func NewBgpNeighborTransportConfig() *BgpNeighborTransportConfig {
 	return &BgpNeighborTransportConfig{
-		SrChanges: nclib.NewSrChanges(),
-		LocalAddr: nil,
+		SrChanges:   nclib.NewSrChanges(),
+		LocalAddr:   nil,
+		PassiveMode: false,
 	}
 }
 
 func (b *BgpNeighborTransportConfig) String() string {
-	return fmt.Sprintf("%s{%s=%s} %s",
+	return fmt.Sprintf("%s{%s=%s, %s=%t} %s",
 		OC_CONFIG_KEY,
 		BGP_LOCAL_ADDR_KEY, b.LocalAddr,
+		BGP_PASSIVE_MODE_KEY, b.PassiveMode,
 		b.SrChanges,
 	)
 }
@@ -539,6 +691,13 @@ func (b *BgpNeighborTransportConfig) Put(nodes []*ncxml.XPathNode, value string)
 			return fmt.Errorf("Invaid %s. %s", BGP_LOCAL_ADDR_KEY, value)
 		}
 		b.LocalAddr = addr
+
+	case BGP_PASSIVE_MODE_KEY:
+		passive, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		b.PassiveMode = passive
 	}
 
 	b.SetChange(nodes[0].Name)
@@ -571,6 +730,24 @@ func ProcessBgpNeighborApplyPolicy(p BgpNeighborApplyPolicyProcessor, reverse bo
 	return nclib.CallFunctions(reverse, configFunc)
 }
 
+//
+// bgp/neighbors/neighbor[addr]/enable-bfd
+//
+type BgpNeighborEnableBfdProcessor interface {
+	BgpNeighborEnableBfdConfig(string, *NetworkInstanceProtocolKey, string, *EnableBfdConfig) error
+}
+
+func ProcessBgpNeighborEnableBfd(p BgpNeighborEnableBfdProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, addr string, bfd *EnableBfd) error {
+	configFunc := func() error {
+		if bfd.GetChange(OC_CONFIG_KEY) {
+			return p.BgpNeighborEnableBfdConfig(name, key, addr, bfd.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
 //
 // bgp/neighbors/neighbor[addr]/afi-safis
 //
@@ -589,6 +766,9 @@ func ProcessBgpNeighborAfiSafis(p BgpNeighborAfiSafiProcessor, reverse bool, nam
 type BgpNeighborAfiSafiProcessor interface {
 	BgpNeighborAfiSafi(string, *NetworkInstanceProtocolKey, string, string, *BgpAfiSafi) error
 	BgpNeighborAfiSafiConfig(string, *NetworkInstanceProtocolKey, string, string, *BgpAfiSafiConfig) error
+	BgpNeighborAfiSafiGracefulRestartConfig(string, *NetworkInstanceProtocolKey, string, string, *BgpAfiSafiGracefulRestartConfig) error
+	BgpNeighborAfiSafiLongLivedGracefulRestartConfig(string, *NetworkInstanceProtocolKey, string, string, *BgpAfiSafiLongLivedGracefulRestartConfig) error
+	BgpNeighborAfiSafiPrefixLimitConfig(string, *NetworkInstanceProtocolKey, string, string, *BgpAfiSafiPrefixLimitConfig) error
 }
 
 func ProcessBgpNeighborAfiSafi(p BgpNeighborAfiSafiProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, addr string, afiSafiName string, afiSafi *BgpAfiSafi) error {
@@ -606,5 +786,26 @@ func ProcessBgpNeighborAfiSafi(p BgpNeighborAfiSafiProcessor, reverse bool, name
 		return nil
 	}
 
-	return nclib.CallFunctions(reverse, afisafiFunc, configFunc)
+	grFunc := func() error {
+		if afiSafi.GetChange(BGP_GRACEFUL_RESTART_KEY) && afiSafi.GracefulRestart.GetChange(OC_CONFIG_KEY) {
+			return p.BgpNeighborAfiSafiGracefulRestartConfig(name, key, addr, afiSafiName, afiSafi.GracefulRestart.Config)
+		}
+		return nil
+	}
+
+	llgrFunc := func() error {
+		if afiSafi.GetChange(BGP_LLGR_KEY) && afiSafi.LongLivedGR.GetChange(OC_CONFIG_KEY) {
+			return p.BgpNeighborAfiSafiLongLivedGracefulRestartConfig(name, key, addr, afiSafiName, afiSafi.LongLivedGR.Config)
+		}
+		return nil
+	}
+
+	prefixLimitFunc := func() error {
+		if afiSafi.GetChange(BGP_PREFIX_LIMIT_KEY) && afiSafi.PrefixLimit.GetChange(OC_CONFIG_KEY) {
+			return p.BgpNeighborAfiSafiPrefixLimitConfig(name, key, addr, afiSafiName, afiSafi.PrefixLimit.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, afisafiFunc, configFunc, grFunc, llgrFunc, prefixLimitFunc)
 }
@@ -0,0 +1,548 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	nclib "netconf/lib"
+	ncxml "netconf/lib/xml"
+	"strconv"
+)
+
+const (
+	BGP_ROUTEREFLECTOR_KEY = "route-reflector"
+	BGP_RR_CLUSTER_ID_KEY  = "route-reflector-cluster-id"
+	BGP_RR_CLIENT_KEY      = "route-reflector-client"
+	BGP_ADDPATHS_KEY       = "add-paths"
+	BGP_ADDPATHS_RECV_KEY  = "receive"
+	BGP_ADDPATHS_SEND_KEY  = "send-max"
+	BGP_EBGP_MULTIHOP_KEY  = "ebgp-multihop"
+	BGP_MULTIHOP_TTL_KEY   = "multihop-ttl"
+	BGP_TTL_SECURITY_KEY   = "ttl-security"
+	BGP_TTL_MIN_KEY        = "ttl-min"
+	BGP_AUTH_PASSWORD_KEY  = "auth-password"
+	BGP_PASSIVE_MODE_KEY   = "passive-mode"
+)
+
+//
+// route-reflector
+//
+type BgpRouteReflector struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *BgpRouteReflectorConfig `xml:"config"`
+}
+
+func NewBgpRouteReflector() *BgpRouteReflector {
+	return &BgpRouteReflector{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewBgpRouteReflectorConfig(),
+	}
+}
+
+func (b *BgpRouteReflector) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		BGP_ROUTEREFLECTOR_KEY,
+		b.Config,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpRouteReflector) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := b.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+type BgpNeighborRouteReflectorProcessor interface {
+	BgpNeighborRouteReflectorConfig(string, *NetworkInstanceProtocolKey, string, *BgpRouteReflectorConfig) error
+}
+
+func ProcessBgpNeighborRouteReflector(p BgpNeighborRouteReflectorProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, addr string, rr *BgpRouteReflector) error {
+	configFunc := func() error {
+		if rr.GetChange(OC_CONFIG_KEY) {
+			return p.BgpNeighborRouteReflectorConfig(name, key, addr, rr.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+type BgpPeerGroupRouteReflectorProcessor interface {
+	BgpPeerGroupRouteReflectorConfig(string, *NetworkInstanceProtocolKey, string, *BgpRouteReflectorConfig) error
+}
+
+func ProcessBgpPeerGroupRouteReflector(p BgpPeerGroupRouteReflectorProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, pgName string, rr *BgpRouteReflector) error {
+	configFunc := func() error {
+		if rr.GetChange(OC_CONFIG_KEY) {
+			return p.BgpPeerGroupRouteReflectorConfig(name, key, pgName, rr.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// route-reflector/config
+//
+type BgpRouteReflectorConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	ClusterId string `xml:"route-reflector-cluster-id"`
+	Client    bool   `xml:"route-reflector-client"`
+}
+
+func NewBgpRouteReflectorConfig() *BgpRouteReflectorConfig {
+	return &BgpRouteReflectorConfig{
+		SrChanges: nclib.NewSrChanges(),
+		ClusterId: "",
+		Client:    false,
+	}
+}
+
+func (b *BgpRouteReflectorConfig) String() string {
+	return fmt.Sprintf("%s{%s='%s', %s=%t} %s",
+		OC_CONFIG_KEY,
+		BGP_RR_CLUSTER_ID_KEY, b.ClusterId,
+		BGP_RR_CLIENT_KEY, b.Client,
+		b.SrChanges,
+	)
+}
+
+//
+// ParseBgpClusterId converts cluster-id (uint32 or dotted-quad) to dotted-quad.
+//
+func ParseBgpClusterId(s string) (string, error) {
+	if ip := net.ParseIP(s); ip != nil && ip.To4() != nil {
+		return ip.To4().String(), nil
+	}
+
+	v, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return "", fmt.Errorf("Invalid %s. %s", BGP_RR_CLUSTER_ID_KEY, s)
+	}
+
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, uint32(v))
+	return ip.String(), nil
+}
+
+func (b *BgpRouteReflectorConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_RR_CLUSTER_ID_KEY:
+		id, err := ParseBgpClusterId(value)
+		if err != nil {
+			return err
+		}
+		b.ClusterId = id
+
+	case BGP_RR_CLIENT_KEY:
+		client, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		b.Client = client
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// add-paths
+//
+type BgpAddPaths struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *BgpAddPathsConfig `xml:"config"`
+}
+
+func NewBgpAddPaths() *BgpAddPaths {
+	return &BgpAddPaths{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewBgpAddPathsConfig(),
+	}
+}
+
+func (b *BgpAddPaths) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		BGP_ADDPATHS_KEY,
+		b.Config,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpAddPaths) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := b.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+type BgpNeighborAddPathsProcessor interface {
+	BgpNeighborAddPathsConfig(string, *NetworkInstanceProtocolKey, string, *BgpAddPathsConfig) error
+}
+
+func ProcessBgpNeighborAddPaths(p BgpNeighborAddPathsProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, addr string, addPaths *BgpAddPaths) error {
+	configFunc := func() error {
+		if addPaths.GetChange(OC_CONFIG_KEY) {
+			return p.BgpNeighborAddPathsConfig(name, key, addr, addPaths.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+type BgpPeerGroupAddPathsProcessor interface {
+	BgpPeerGroupAddPathsConfig(string, *NetworkInstanceProtocolKey, string, *BgpAddPathsConfig) error
+}
+
+func ProcessBgpPeerGroupAddPaths(p BgpPeerGroupAddPathsProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, pgName string, addPaths *BgpAddPaths) error {
+	configFunc := func() error {
+		if addPaths.GetChange(OC_CONFIG_KEY) {
+			return p.BgpPeerGroupAddPathsConfig(name, key, pgName, addPaths.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// add-paths/config
+//
+type BgpAddPathsConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Receive bool  `xml:"receive"`
+	SendMax uint8 `xml:"send-max"`
+}
+
+func NewBgpAddPathsConfig() *BgpAddPathsConfig {
+	return &BgpAddPathsConfig{
+		SrChanges: nclib.NewSrChanges(),
+		Receive:   false,
+		SendMax:   0,
+	}
+}
+
+func (b *BgpAddPathsConfig) String() string {
+	return fmt.Sprintf("%s{%s=%t, %s=%d} %s",
+		OC_CONFIG_KEY,
+		BGP_ADDPATHS_RECV_KEY, b.Receive,
+		BGP_ADDPATHS_SEND_KEY, b.SendMax,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpAddPathsConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_ADDPATHS_RECV_KEY:
+		recv, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		b.Receive = recv
+
+	case BGP_ADDPATHS_SEND_KEY:
+		n, err := strconv.ParseUint(value, 0, 8)
+		if err != nil {
+			return err
+		}
+		b.SendMax = uint8(n)
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// ebgp-multihop
+//
+type BgpEbgpMultihop struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *BgpEbgpMultihopConfig `xml:"config"`
+}
+
+func NewBgpEbgpMultihop() *BgpEbgpMultihop {
+	return &BgpEbgpMultihop{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewBgpEbgpMultihopConfig(),
+	}
+}
+
+func (b *BgpEbgpMultihop) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		BGP_EBGP_MULTIHOP_KEY,
+		b.Config,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpEbgpMultihop) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := b.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+type BgpNeighborEbgpMultihopProcessor interface {
+	BgpNeighborEbgpMultihopConfig(string, *NetworkInstanceProtocolKey, string, *BgpEbgpMultihopConfig) error
+}
+
+func ProcessBgpNeighborEbgpMultihop(p BgpNeighborEbgpMultihopProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, addr string, multihop *BgpEbgpMultihop) error {
+	configFunc := func() error {
+		if multihop.GetChange(OC_CONFIG_KEY) {
+			return p.BgpNeighborEbgpMultihopConfig(name, key, addr, multihop.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+type BgpPeerGroupEbgpMultihopProcessor interface {
+	BgpPeerGroupEbgpMultihopConfig(string, *NetworkInstanceProtocolKey, string, *BgpEbgpMultihopConfig) error
+}
+
+func ProcessBgpPeerGroupEbgpMultihop(p BgpPeerGroupEbgpMultihopProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, pgName string, multihop *BgpEbgpMultihop) error {
+	configFunc := func() error {
+		if multihop.GetChange(OC_CONFIG_KEY) {
+			return p.BgpPeerGroupEbgpMultihopConfig(name, key, pgName, multihop.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// ebgp-multihop/config
+//
+type BgpEbgpMultihopConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Enabled     bool  `xml:"enabled"`
+	MultihopTtl uint8 `xml:"multihop-ttl"`
+}
+
+func NewBgpEbgpMultihopConfig() *BgpEbgpMultihopConfig {
+	return &BgpEbgpMultihopConfig{
+		SrChanges:   nclib.NewSrChanges(),
+		Enabled:     false,
+		MultihopTtl: 0,
+	}
+}
+
+func (b *BgpEbgpMultihopConfig) String() string {
+	return fmt.Sprintf("%s{%s=%t, %s=%d} %s",
+		OC_CONFIG_KEY,
+		OC_ENABLED_KEY, b.Enabled,
+		BGP_MULTIHOP_TTL_KEY, b.MultihopTtl,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpEbgpMultihopConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_ENABLED_KEY:
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		b.Enabled = enabled
+
+	case BGP_MULTIHOP_TTL_KEY:
+		ttl, err := strconv.ParseUint(value, 0, 8)
+		if err != nil {
+			return err
+		}
+		b.MultihopTtl = uint8(ttl)
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// ttl-security
+//
+type BgpTtlSecurity struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *BgpTtlSecurityConfig `xml:"config"`
+}
+
+func NewBgpTtlSecurity() *BgpTtlSecurity {
+	return &BgpTtlSecurity{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewBgpTtlSecurityConfig(),
+	}
+}
+
+func (b *BgpTtlSecurity) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		BGP_TTL_SECURITY_KEY,
+		b.Config,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpTtlSecurity) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := b.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+type BgpNeighborTtlSecurityProcessor interface {
+	BgpNeighborTtlSecurityConfig(string, *NetworkInstanceProtocolKey, string, *BgpTtlSecurityConfig) error
+}
+
+func ProcessBgpNeighborTtlSecurity(p BgpNeighborTtlSecurityProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, addr string, ttlSec *BgpTtlSecurity) error {
+	configFunc := func() error {
+		if ttlSec.GetChange(OC_CONFIG_KEY) {
+			return p.BgpNeighborTtlSecurityConfig(name, key, addr, ttlSec.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+type BgpPeerGroupTtlSecurityProcessor interface {
+	BgpPeerGroupTtlSecurityConfig(string, *NetworkInstanceProtocolKey, string, *BgpTtlSecurityConfig) error
+}
+
+func ProcessBgpPeerGroupTtlSecurity(p BgpPeerGroupTtlSecurityProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, pgName string, ttlSec *BgpTtlSecurity) error {
+	configFunc := func() error {
+		if ttlSec.GetChange(OC_CONFIG_KEY) {
+			return p.BgpPeerGroupTtlSecurityConfig(name, key, pgName, ttlSec.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// ttl-security/config
+//
+type BgpTtlSecurityConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Enabled bool  `xml:"enabled"`
+	TtlMin  uint8 `xml:"ttl-min"`
+}
+
+func NewBgpTtlSecurityConfig() *BgpTtlSecurityConfig {
+	return &BgpTtlSecurityConfig{
+		SrChanges: nclib.NewSrChanges(),
+		Enabled:   false,
+		TtlMin:    0,
+	}
+}
+
+func (b *BgpTtlSecurityConfig) String() string {
+	return fmt.Sprintf("%s{%s=%t, %s=%d} %s",
+		OC_CONFIG_KEY,
+		OC_ENABLED_KEY, b.Enabled,
+		BGP_TTL_MIN_KEY, b.TtlMin,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpTtlSecurityConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_ENABLED_KEY:
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		b.Enabled = enabled
+
+	case BGP_TTL_MIN_KEY:
+		ttl, err := strconv.ParseUint(value, 0, 8)
+		if err != nil {
+			return err
+		}
+		b.TtlMin = uint8(ttl)
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
@@ -0,0 +1,95 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"testing"
+)
+
+func TestParseBgpClusterId(t *testing.T) {
+	datas := [][2]string{
+		{"10.0.0.1", "10.0.0.1"},
+		{"1", "0.0.0.1"},
+		{"167772161", "10.0.0.1"},
+	}
+
+	for _, data := range datas {
+		id, err := ParseBgpClusterId(data[0])
+		if err != nil {
+			t.Errorf("ParseBgpClusterId error. %s", err)
+		}
+		if id != data[1] {
+			t.Errorf("ParseBgpClusterId unmatch. %s %s", id, data[1])
+		}
+	}
+
+	if _, err := ParseBgpClusterId("2001:db8::1"); err == nil {
+		t.Errorf("ParseBgpClusterId must be error.")
+	}
+}
+
+func TestBgpNeighbors_route_reflector(t *testing.T) {
+	neighs, err := makeBgpNeighbors([][2]string{
+		{"/neighbors/neighbor[neighbor-address='10.0.0.1']/route-reflector/config/route-reflector-client", "true"},
+		{"/neighbors/neighbor[neighbor-address='10.0.0.1']/route-reflector/config/route-reflector-cluster-id", "10.0.0.100"},
+	})
+
+	if err != nil {
+		t.Errorf("BgpNeighbors.Put error. %s", err)
+	}
+
+	neigh := neighs["10.0.0.1"]
+	t.Log(neigh)
+
+	if v := neigh.Compare(BGP_ROUTEREFLECTOR_KEY); !v {
+		t.Errorf("BgpNeighbors.Put unmatch. cmp=%t", v)
+	}
+	if v := neigh.RouteReflector.Config.Compare(BGP_RR_CLIENT_KEY, BGP_RR_CLUSTER_ID_KEY); !v {
+		t.Errorf("BgpNeighbors.Put unmatch. config cmp=%t", v)
+	}
+	if v := neigh.RouteReflector.Config.Client; !v {
+		t.Errorf("BgpNeighbors.Put unmatch. client=%t", v)
+	}
+}
+
+func TestBgpNeighbors_prefix_limit(t *testing.T) {
+	neighs, err := makeBgpNeighbors([][2]string{
+		{"/neighbors/neighbor[neighbor-address='10.0.0.1']/afi-safis/afi-safi[afi-safi-name='IPV4_UNICAST']/prefix-limit/config/max-prefixes", "100"},
+		{"/neighbors/neighbor[neighbor-address='10.0.0.1']/afi-safis/afi-safi[afi-safi-name='IPV4_UNICAST']/prefix-limit/config/shutdown-threshold-pct", "80"},
+	})
+
+	if err != nil {
+		t.Errorf("BgpNeighbors.Put error. %s", err)
+	}
+
+	config := neighs["10.0.0.1"].AfiSafis["IPV4_UNICAST"].PrefixLimit.Config
+	t.Log(config)
+
+	if v := config.MaxPrefixes; v != 100 {
+		t.Errorf("BgpNeighbors.Put unmatch. max-prefixes=%d", v)
+	}
+	if v := config.ShutdownPct; v != 80 {
+		t.Errorf("BgpNeighbors.Put unmatch. shutdown-threshold-pct=%d", v)
+	}
+
+	if _, err := makeBgpNeighbors([][2]string{
+		{"/neighbors/neighbor[neighbor-address='10.0.0.1']/afi-safis/afi-safi[afi-safi-name='IPV4_UNICAST']/prefix-limit/config/shutdown-threshold-pct", "101"},
+	}); err == nil {
+		t.Errorf("BgpNeighbors.Put must be error.")
+	}
+}
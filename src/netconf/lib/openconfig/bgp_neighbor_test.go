@@ -226,3 +226,27 @@ func TestBgpNeighbors_afisafi_config(t *testing.T) {
 		t.Errorf("BgpNeighbors.Put unmatch. afisafi.config cmp=%t", v)
 	}
 }
+
+func TestBgpNeighbors_enable_bfd(t *testing.T) {
+	neighs, err := makeBgpNeighbors([][2]string{
+		{"/neighbors/neighbor[neighbor-address='10.0.0.1']/enable-bfd/config/enabled", "true"},
+	})
+
+	if err != nil {
+		t.Errorf("BgpNeighbors.Put error. %s", err)
+	}
+
+	neigh := neighs["10.0.0.1"]
+
+	if v := neigh.Compare(BFD_ENABLE_KEY); !v {
+		t.Errorf("BgpNeighbors.Put unmatch. cmp=%t", v)
+	}
+
+	if v := neigh.EnableBfd.Compare(OC_CONFIG_KEY); !v {
+		t.Errorf("BgpNeighbors.Put unmatch. cmp=%t", v)
+	}
+
+	if v := neigh.EnableBfd.Config.Enabled; !v {
+		t.Errorf("BgpNeighbors.Put unmatch. enabled=%t", v)
+	}
+}
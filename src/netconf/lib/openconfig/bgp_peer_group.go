@@ -0,0 +1,561 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"encoding/xml"
+	"fmt"
+	nclib "netconf/lib"
+	ncxml "netconf/lib/xml"
+	"strconv"
+)
+
+//
+// bgp/peer-groups
+//
+type BgpPeerGroups map[string]*BgpPeerGroup
+
+func NewBgpPeerGroups() BgpPeerGroups {
+	return BgpPeerGroups{}
+}
+
+func (b BgpPeerGroups) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	pgName, ok := nodes[0].Attrs[BGP_PEERGROUP_NAME_KEY]
+	if !ok {
+		return fmt.Errorf("%s@%s not found. %s", BGP_PEERGROUP_KEY, BGP_PEERGROUP_NAME_KEY, nodes[0])
+	}
+
+	pg, ok := b[pgName]
+	if !ok {
+		pg = NewBgpPeerGroup(pgName)
+		b[pgName] = pg
+	}
+
+	return pg.Put(nodes[1:], value)
+}
+
+func ProcessBgpPeerGroups(p BgpPeerGroupProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, pgs BgpPeerGroups) error {
+	for pgName, pg := range pgs {
+		if err := ProcessBgpPeerGroup(p, reverse, name, key, pgName, pg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b BgpPeerGroups) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = BGP_PEERGROUPS_KEY
+	e.EncodeToken(start)
+
+	for _, pg := range b {
+		err := e.EncodeElement(pg, xml.StartElement{Name: xml.Name{Local: BGP_PEERGROUP_KEY}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+//
+// bgp/peer-groups/peer-group[peer-group-name]
+//
+type BgpPeerGroup struct {
+	nclib.SrChanges `xml:"-"`
+
+	Name            string                `xml:"peer-group-name"`
+	Config          *BgpPeerGroupConfig   `xml:"config"`
+	Timers          *BgpNeighborTimers    `xml:"timers"`
+	Transport       *BgpNeighborTransport `xml:"transport"`
+	RouteReflector  *BgpRouteReflector    `xml:"route-reflector"`
+	GracefulRestart *BgpGracefulRestart   `xml:"graceful-restart"`
+	AddPaths        *BgpAddPaths          `xml:"add-paths"`
+	EbgpMultihop    *BgpEbgpMultihop      `xml:"ebgp-multihop"`
+	TtlSecurity     *BgpTtlSecurity       `xml:"ttl-security"`
+	AfiSafis        BgpAfiSafis           `xml:"afi-safis"`
+	ApplyPolicy     *PolicyApply          `xml:"apply-policy"`
+}
+
+type BgpPeerGroupProcessor interface {
+	bgpPeerGroupProcessor
+	BgpPeerGroupConfigProcessor
+	BgpPeerGroupTimersProcessor
+	BgpPeerGroupTransportProcessor
+	BgpPeerGroupAfiSafiProcessor
+	BgpPeerGroupRouteReflectorProcessor
+	BgpPeerGroupGracefulRestartProcessor
+	BgpPeerGroupAddPathsProcessor
+	BgpPeerGroupEbgpMultihopProcessor
+	BgpPeerGroupTtlSecurityProcessor
+	BgpPeerGroupApplyPolicyProcessor
+}
+
+type bgpPeerGroupProcessor interface {
+	BgpPeerGroup(string, *NetworkInstanceProtocolKey, string, *BgpPeerGroup) error
+}
+
+func NewBgpPeerGroup(pgName string) *BgpPeerGroup {
+	return &BgpPeerGroup{
+		SrChanges:       nclib.NewSrChanges(),
+		Name:            pgName,
+		Config:          NewBgpPeerGroupConfig(),
+		Timers:          NewBgpNeighborTimers(),
+		Transport:       NewBgpNeighborTransport(),
+		RouteReflector:  NewBgpRouteReflector(),
+		GracefulRestart: NewBgpGracefulRestart(),
+		AddPaths:        NewBgpAddPaths(),
+		EbgpMultihop:    NewBgpEbgpMultihop(),
+		TtlSecurity:     NewBgpTtlSecurity(),
+		AfiSafis:        NewBgpAfiSafis(),
+		ApplyPolicy:     NewPolicyApply(),
+	}
+}
+
+func (b *BgpPeerGroup) String() string {
+	return fmt.Sprintf("%s{%s='%s', %s, %s, %s, %s, %s, %s, %s, %s, %s, %s} %s",
+		BGP_PEERGROUP_KEY,
+		BGP_PEERGROUP_NAME_KEY, b.Name,
+		b.Config,
+		b.Timers,
+		b.Transport,
+		b.RouteReflector,
+		b.GracefulRestart,
+		b.AddPaths,
+		b.EbgpMultihop,
+		b.TtlSecurity,
+		b.AfiSafis,
+		b.ApplyPolicy,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpPeerGroup) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_PEERGROUP_NAME_KEY:
+		// b.Name = value // set by NewBgpPeerGroup:
+
+	case OC_CONFIG_KEY:
+		if err := b.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_TIMERS_KEY:
+		if err := b.Timers.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_TRANSPORT_KEY:
+		if err := b.Transport.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_ROUTEREFLECTOR_KEY:
+		if err := b.RouteReflector.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_GRACEFUL_RESTART_KEY:
+		if err := b.GracefulRestart.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_ADDPATHS_KEY:
+		if err := b.AddPaths.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_EBGP_MULTIHOP_KEY:
+		if err := b.EbgpMultihop.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_TTL_SECURITY_KEY:
+		if err := b.TtlSecurity.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case POLICYAPPLY_KEY:
+		if err := b.ApplyPolicy.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_AFISAFIS_KEY:
+		if err := b.AfiSafis.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessBgpPeerGroup(p BgpPeerGroupProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, pgName string, pg *BgpPeerGroup) error {
+	pgFunc := func() error {
+		if pg.GetChange(BGP_PEERGROUP_NAME_KEY) {
+			return p.BgpPeerGroup(name, key, pgName, pg)
+		}
+		return nil
+	}
+
+	configFunc := func() error {
+		if pg.GetChange(OC_CONFIG_KEY) {
+			return ProcessBgpPeerGroupConfig(
+				p.(BgpPeerGroupConfigProcessor),
+				reverse,
+				name,
+				key,
+				pgName,
+				pg.Config,
+			)
+		}
+		return nil
+	}
+
+	timersFunc := func() error {
+		if pg.GetChange(BGP_TIMERS_KEY) {
+			return ProcessBgpPeerGroupTimers(
+				p.(BgpPeerGroupTimersProcessor),
+				reverse,
+				name,
+				key,
+				pgName,
+				pg.Timers,
+			)
+		}
+		return nil
+	}
+
+	transFunc := func() error {
+		if pg.GetChange(BGP_TRANSPORT_KEY) {
+			return ProcessBgpPeerGroupTransport(
+				p.(BgpPeerGroupTransportProcessor),
+				reverse,
+				name,
+				key,
+				pgName,
+				pg.Transport,
+			)
+		}
+		return nil
+	}
+
+	rrFunc := func() error {
+		if pg.GetChange(BGP_ROUTEREFLECTOR_KEY) {
+			return ProcessBgpPeerGroupRouteReflector(
+				p.(BgpPeerGroupRouteReflectorProcessor),
+				reverse,
+				name,
+				key,
+				pgName,
+				pg.RouteReflector,
+			)
+		}
+		return nil
+	}
+
+	grFunc := func() error {
+		if pg.GetChange(BGP_GRACEFUL_RESTART_KEY) {
+			return ProcessBgpPeerGroupGracefulRestart(
+				p.(BgpPeerGroupGracefulRestartProcessor),
+				reverse,
+				name,
+				key,
+				pgName,
+				pg.GracefulRestart,
+			)
+		}
+		return nil
+	}
+
+	addPathsFunc := func() error {
+		if pg.GetChange(BGP_ADDPATHS_KEY) {
+			return ProcessBgpPeerGroupAddPaths(
+				p.(BgpPeerGroupAddPathsProcessor),
+				reverse,
+				name,
+				key,
+				pgName,
+				pg.AddPaths,
+			)
+		}
+		return nil
+	}
+
+	multihopFunc := func() error {
+		if pg.GetChange(BGP_EBGP_MULTIHOP_KEY) {
+			return ProcessBgpPeerGroupEbgpMultihop(
+				p.(BgpPeerGroupEbgpMultihopProcessor),
+				reverse,
+				name,
+				key,
+				pgName,
+				pg.EbgpMultihop,
+			)
+		}
+		return nil
+	}
+
+	ttlSecFunc := func() error {
+		if pg.GetChange(BGP_TTL_SECURITY_KEY) {
+			return ProcessBgpPeerGroupTtlSecurity(
+				p.(BgpPeerGroupTtlSecurityProcessor),
+				reverse,
+				name,
+				key,
+				pgName,
+				pg.TtlSecurity,
+			)
+		}
+		return nil
+	}
+
+	applyPolFunc := func() error {
+		if pg.GetChange(POLICYAPPLY_KEY) {
+			return ProcessBgpPeerGroupApplyPolicy(
+				p.(BgpPeerGroupApplyPolicyProcessor),
+				reverse,
+				name,
+				key,
+				pgName,
+				pg.ApplyPolicy,
+			)
+		}
+		return nil
+	}
+
+	afiSafisFunc := func() error {
+		if pg.GetChange(BGP_AFISAFIS_KEY) {
+			return ProcessBgpPeerGroupAfiSafis(
+				p.(BgpPeerGroupAfiSafiProcessor),
+				reverse,
+				name,
+				key,
+				pgName,
+				pg.AfiSafis,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, pgFunc, configFunc, timersFunc, transFunc, rrFunc, grFunc, addPathsFunc, multihopFunc, ttlSecFunc, applyPolFunc, afiSafisFunc)
+}
+
+//
+// bgp/peer-groups/peer-group[peer-group-name]/config
+//
+type BgpPeerGroupConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Name     string `xml:"peer-group-name"`
+	PeerAs   uint32 `xml:"peer-as"`
+	LocalAs  uint32 `xml:"local-as"`
+	Desc     string `xml:"description"`
+	AuthPass string `xml:"auth-password"`
+}
+
+type BgpPeerGroupConfigProcessor interface {
+	BgpPeerGroupConfig(string, *NetworkInstanceProtocolKey, string, *BgpPeerGroupConfig) error
+}
+
+func NewBgpPeerGroupConfig() *BgpPeerGroupConfig {
+	return &BgpPeerGroupConfig{
+		SrChanges: nclib.NewSrChanges(),
+		Name:      "",
+		PeerAs:    0,
+		LocalAs:   0,
+		Desc:      "",
+		AuthPass:  "",
+	}
+}
+
+func (b *BgpPeerGroupConfig) String() string {
+	return fmt.Sprintf("%s{%s='%s', %s=%d, %s=%d, %s='%s'} %s",
+		OC_CONFIG_KEY,
+		BGP_PEERGROUP_NAME_KEY, b.Name,
+		BGP_PEERAS_KEY, b.PeerAs,
+		BGP_LOCALAS_KEY, b.LocalAs,
+		OC_DESCRIPTION_KEY, b.Desc,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpPeerGroupConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_PEERGROUP_NAME_KEY:
+		b.Name = value
+
+	case BGP_PEERAS_KEY:
+		as, err := strconv.ParseUint(value, 0, 32)
+		if err != nil {
+			return err
+		}
+		b.PeerAs = uint32(as)
+
+	case BGP_LOCALAS_KEY:
+		as, err := strconv.ParseUint(value, 0, 32)
+		if err != nil {
+			return err
+		}
+		b.LocalAs = uint32(as)
+
+	case OC_DESCRIPTION_KEY:
+		b.Desc = value
+
+	case BGP_AUTH_PASSWORD_KEY:
+		b.AuthPass = value
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessBgpPeerGroupConfig(p BgpPeerGroupConfigProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, pgName string, config *BgpPeerGroupConfig) error {
+	configFunc := func() error {
+		return p.BgpPeerGroupConfig(name, key, pgName, config)
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// bgp/peer-groups/peer-group[peer-group-name]/timers
+//
+type BgpPeerGroupTimersProcessor interface {
+	BgpPeerGroupTimersConfig(string, *NetworkInstanceProtocolKey, string, *BgpNeighborTimersConfig) error
+}
+
+func ProcessBgpPeerGroupTimers(p BgpPeerGroupTimersProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, pgName string, timers *BgpNeighborTimers) error {
+	configFunc := func() error {
+		if timers.GetChange(OC_CONFIG_KEY) {
+			return p.BgpPeerGroupTimersConfig(name, key, pgName, timers.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// bgp/peer-groups/peer-group[peer-group-name]/transport
+//
+type BgpPeerGroupTransportProcessor interface {
+	BgpPeerGroupTransportConfig(string, *NetworkInstanceProtocolKey, string, *BgpNeighborTransportConfig) error
+}
+
+func ProcessBgpPeerGroupTransport(p BgpPeerGroupTransportProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, pgName string, trans *BgpNeighborTransport) error {
+	configFunc := func() error {
+		if trans.GetChange(OC_CONFIG_KEY) {
+			return p.BgpPeerGroupTransportConfig(name, key, pgName, trans.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// bgp/peer-groups/peer-group[peer-group-name]/apply-policy
+//
+type BgpPeerGroupApplyPolicyProcessor interface {
+	BgpPeerGroupApplyPolicyConfig(string, *NetworkInstanceProtocolKey, string, *PolicyApplyConfig) error
+}
+
+func ProcessBgpPeerGroupApplyPolicy(p BgpPeerGroupApplyPolicyProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, pgName string, policy *PolicyApply) error {
+	configFunc := func() error {
+		if policy.GetChange(OC_CONFIG_KEY) {
+			return p.BgpPeerGroupApplyPolicyConfig(name, key, pgName, policy.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// bgp/peer-groups/peer-group[peer-group-name]/afi-safis
+//
+func ProcessBgpPeerGroupAfiSafis(p BgpPeerGroupAfiSafiProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, pgName string, afisafis BgpAfiSafis) error {
+	for afiSafiName, afisafi := range afisafis {
+		if err := ProcessBgpPeerGroupAfiSafi(p, reverse, name, key, pgName, afiSafiName, afisafi); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//
+// bgp/peer-groups/peer-group[peer-group-name]/afi-safis/afi-safi[afi-safi-name]
+//
+type BgpPeerGroupAfiSafiProcessor interface {
+	BgpPeerGroupAfiSafi(string, *NetworkInstanceProtocolKey, string, string, *BgpAfiSafi) error
+	BgpPeerGroupAfiSafiConfig(string, *NetworkInstanceProtocolKey, string, string, *BgpAfiSafiConfig) error
+	BgpPeerGroupAfiSafiGracefulRestartConfig(string, *NetworkInstanceProtocolKey, string, string, *BgpAfiSafiGracefulRestartConfig) error
+	BgpPeerGroupAfiSafiLongLivedGracefulRestartConfig(string, *NetworkInstanceProtocolKey, string, string, *BgpAfiSafiLongLivedGracefulRestartConfig) error
+	BgpPeerGroupAfiSafiPrefixLimitConfig(string, *NetworkInstanceProtocolKey, string, string, *BgpAfiSafiPrefixLimitConfig) error
+}
+
+func ProcessBgpPeerGroupAfiSafi(p BgpPeerGroupAfiSafiProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, pgName string, afiSafiName string, afiSafi *BgpAfiSafi) error {
+	afisafiFunc := func() error {
+		if afiSafi.GetChange(BGP_AFISAFI_NAME_KEY) {
+			return p.BgpPeerGroupAfiSafi(name, key, pgName, afiSafiName, afiSafi)
+		}
+		return nil
+	}
+
+	configFunc := func() error {
+		if afiSafi.GetChange(OC_CONFIG_KEY) {
+			return p.BgpPeerGroupAfiSafiConfig(name, key, pgName, afiSafiName, afiSafi.Config)
+		}
+		return nil
+	}
+
+	grFunc := func() error {
+		if afiSafi.GetChange(BGP_GRACEFUL_RESTART_KEY) && afiSafi.GracefulRestart.GetChange(OC_CONFIG_KEY) {
+			return p.BgpPeerGroupAfiSafiGracefulRestartConfig(name, key, pgName, afiSafiName, afiSafi.GracefulRestart.Config)
+		}
+		return nil
+	}
+
+	llgrFunc := func() error {
+		if afiSafi.GetChange(BGP_LLGR_KEY) && afiSafi.LongLivedGR.GetChange(OC_CONFIG_KEY) {
+			return p.BgpPeerGroupAfiSafiLongLivedGracefulRestartConfig(name, key, pgName, afiSafiName, afiSafi.LongLivedGR.Config)
+		}
+		return nil
+	}
+
+	prefixLimitFunc := func() error {
+		if afiSafi.GetChange(BGP_PREFIX_LIMIT_KEY) && afiSafi.PrefixLimit.GetChange(OC_CONFIG_KEY) {
+			return p.BgpPeerGroupAfiSafiPrefixLimitConfig(name, key, pgName, afiSafiName, afiSafi.PrefixLimit.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, afisafiFunc, configFunc, grFunc, llgrFunc, prefixLimitFunc)
+}
@@ -0,0 +1,91 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	srlib "netconf/lib/sysrepo"
+	"testing"
+)
+
+func makeBgpPeerGroups(datas [][2]string) (BgpPeerGroups, error) {
+	pgs := NewBgpPeerGroups()
+	for _, data := range datas {
+		xpath, value := data[0], data[1]
+		nodes := srlib.ParseXPath(xpath)
+		if err := pgs.Put(nodes[1:], value); err != nil {
+			return pgs, err
+		}
+	}
+
+	return pgs, nil
+}
+
+func TestBgpPeerGroups_config_all(t *testing.T) {
+	pgs, err := makeBgpPeerGroups([][2]string{
+		{"/peer-groups/peer-group[peer-group-name='pg1']", ""},
+		{"/peer-groups/peer-group[peer-group-name='pg1']/peer-group-name", "pg1"},
+		{"/peer-groups/peer-group[peer-group-name='pg1']/config", ""},
+		{"/peer-groups/peer-group[peer-group-name='pg1']/config/peer-group-name", "pg1"},
+		{"/peer-groups/peer-group[peer-group-name='pg1']/config/peer-as", "65001"},
+		{"/peer-groups/peer-group[peer-group-name='pg1']/config/local-as", "65000"},
+		{"/peer-groups/peer-group[peer-group-name='pg1']/config/description", "test"},
+	})
+
+	if err != nil {
+		t.Errorf("BgpPeerGroups.Put error. %s", err)
+	}
+
+	pg := pgs["pg1"]
+	t.Log(pg)
+
+	if v := pg.Compare(BGP_PEERGROUP_NAME_KEY, OC_CONFIG_KEY); !v {
+		t.Errorf("BgpPeerGroups.Put unmatch. cmp=%t", v)
+	}
+	if v := pg.Config.Compare(BGP_PEERGROUP_NAME_KEY, BGP_PEERAS_KEY, BGP_LOCALAS_KEY, OC_DESCRIPTION_KEY); !v {
+		t.Errorf("BgpPeerGroups.Put unmatch. config cmp=%t", v)
+	}
+	if v := pg.Config.Name; v != "pg1" {
+		t.Errorf("BgpPeerGroups.Put unmatch. name=%s", v)
+	}
+	if v := pg.Config.PeerAs; v != 65001 {
+		t.Errorf("BgpPeerGroups.Put unmatch. peer-as=%d", v)
+	}
+	if v := pg.Config.LocalAs; v != 65000 {
+		t.Errorf("BgpPeerGroups.Put unmatch. local-as=%d", v)
+	}
+}
+
+func TestBgpPeerGroups_neighbor(t *testing.T) {
+	neighs, err := makeBgpNeighbors([][2]string{
+		{"/neighbors/neighbor[neighbor-address='10.0.0.1']/config/peer-group", "pg1"},
+	})
+
+	if err != nil {
+		t.Errorf("BgpNeighbors.Put error. %s", err)
+	}
+
+	neigh := neighs["10.0.0.1"]
+	t.Log(neigh)
+
+	if v := neigh.Config.Compare(BGP_PEERGROUP_KEY); !v {
+		t.Errorf("BgpNeighbors.Put unmatch. config cmp=%t", v)
+	}
+	if v := neigh.Config.PeerGroup; v != "pg1" {
+		t.Errorf("BgpNeighbors.Put unmatch. peer-group=%s", v)
+	}
+}
@@ -29,6 +29,46 @@ const (
 	BGP_ACTIONS_KEY               = "bgp-actions"
 	BGP_ACTIONS_SET_LOCALPREF_KEY = "set-local-pref"
 	BGP_ACTIONS_SET_NEXTHOP_KEY   = "set-next-hop"
+	BGP_DEFSETS_KEY               = "bgp-defined-sets"
+	BGP_COMMSETS_KEY              = "community-sets"
+	BGP_COMMSET_KEY               = "community-set"
+	BGP_COMMSET_NAME_KEY          = "community-set-name"
+	BGP_COMMSET_MEMBER_KEY        = "community-member"
+	BGP_EXTCOMMSETS_KEY           = "ext-community-sets"
+	BGP_EXTCOMMSET_KEY            = "ext-community-set"
+	BGP_EXTCOMMSET_NAME_KEY       = "ext-community-set-name"
+	BGP_EXTCOMMSET_MEMBER_KEY     = "ext-community-member"
+	BGP_ASPATHSETS_KEY            = "as-path-sets"
+	BGP_ASPATHSET_KEY             = "as-path-set"
+	BGP_ASPATHSET_NAME_KEY        = "as-path-set-name"
+	BGP_ASPATHSET_MEMBER_KEY      = "as-path-set-member"
+	BGP_CONDS_KEY                 = "bgp-conditions"
+	BGP_CONDS_MEDEQ_KEY           = "med-eq"
+	BGP_CONDS_ORIGINEQ_KEY        = "origin-eq"
+	BGP_CONDS_AFISAFIIN_KEY       = "afi-safi-in"
+	BGP_CONDS_ASPATHLEN_KEY       = "as-path-length"
+	BGP_CONDS_OPERATOR_KEY        = "operator"
+	BGP_CONDS_VALUE_KEY           = "value"
+	BGP_MATCH_COMMSET_KEY         = "match-community-set"
+	BGP_MATCH_EXTCOMMSET_KEY      = "match-ext-community-set"
+	BGP_MATCH_ASPATHSET_KEY       = "match-as-path-set"
+)
+
+const (
+	BGP_ACTIONS_SET_MED_KEY            = "set-med"
+	BGP_ACTIONS_SET_ORIGIN_KEY         = "set-route-origin"
+	BGP_ACTIONS_SET_ASPATH_PREPEND_KEY = "set-as-path-prepend"
+	BGP_ACTIONS_REPEATN_KEY            = "repeat-n"
+	BGP_ACTIONS_ASN_KEY                = "asn"
+	BGP_ACTIONS_SET_COMM_KEY           = "set-community"
+	BGP_ACTIONS_SET_EXTCOMM_KEY        = "set-ext-community"
+	BGP_ACTIONS_METHOD_KEY             = "method"
+	BGP_ACTIONS_OPTIONS_KEY            = "options"
+	BGP_ACTIONS_INLINE_KEY             = "inline"
+	BGP_ACTIONS_REFERENCE_KEY          = "reference"
+	BGP_ACTIONS_COMMUNITIES_KEY        = "communities"
+	BGP_ACTIONS_COMMSET_REF_KEY        = "community-set-ref"
+	BGP_ACTIONS_EXTCOMMSET_REF_KEY     = "ext-community-set-ref"
 )
 
 //
@@ -37,7 +77,10 @@ const (
 type PolicyBgpActions struct {
 	nclib.SrChanges `xml:"-"`
 
-	Config *PolicyBgpActionsConfig `xml:"config"`
+	Config           *PolicyBgpActionsConfig    `xml:"config"`
+	SetAsPathPrepend *PolicyBgpSetAsPathPrepend `xml:"set-as-path-prepend"`
+	SetCommunity     *PolicyBgpSetCommunity     `xml:"set-community"`
+	SetExtCommunity  *PolicyBgpSetExtCommunity  `xml:"set-ext-community"`
 }
 
 func (b *PolicyBgpActions) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
@@ -46,24 +89,42 @@ func (b *PolicyBgpActions) MarshalXML(e *xml.Encoder, start xml.StartElement) er
 	if err := e.EncodeElement(b.Config, xml.StartElement{Name: xml.Name{Local: OC_CONFIG_KEY}}); err != nil {
 		return err
 	}
+	if err := e.EncodeElement(b.SetAsPathPrepend, xml.StartElement{Name: xml.Name{Local: BGP_ACTIONS_SET_ASPATH_PREPEND_KEY}}); err != nil {
+		return err
+	}
+	if err := e.EncodeElement(b.SetCommunity, xml.StartElement{Name: xml.Name{Local: BGP_ACTIONS_SET_COMM_KEY}}); err != nil {
+		return err
+	}
+	if err := e.EncodeElement(b.SetExtCommunity, xml.StartElement{Name: xml.Name{Local: BGP_ACTIONS_SET_EXTCOMM_KEY}}); err != nil {
+		return err
+	}
 	return e.EncodeToken(start.End())
 }
 
 type PolicyBgpActionsProcessor interface {
 	PolicyBgpActionsConfigProcessor
+	PolicyBgpSetAsPathPrependProcessor
+	PolicyBgpSetCommunityProcessor
+	PolicyBgpSetExtCommunityProcessor
 }
 
 func NewPolicyBgpActions() *PolicyBgpActions {
 	return &PolicyBgpActions{
-		SrChanges: nclib.NewSrChanges(),
-		Config:    NewPolicyBgpActionsConfig(),
+		SrChanges:        nclib.NewSrChanges(),
+		Config:           NewPolicyBgpActionsConfig(),
+		SetAsPathPrepend: NewPolicyBgpSetAsPathPrepend(),
+		SetCommunity:     NewPolicyBgpSetCommunity(),
+		SetExtCommunity:  NewPolicyBgpSetExtCommunity(),
 	}
 }
 
 func (b *PolicyBgpActions) String() string {
-	return fmt.Sprintf("%s{%s} %s",
+	return fmt.Sprintf("%s{%s, %s, %s, %s} %s",
 		BGP_ACTIONS_KEY,
 		b.Config,
+		b.SetAsPathPrepend,
+		b.SetCommunity,
+		b.SetExtCommunity,
 		b.SrChanges,
 	)
 }
@@ -78,6 +139,21 @@ func (b *PolicyBgpActions) Put(nodes []*ncxml.XPathNode, value string) error {
 		if err := b.Config.Put(nodes[1:], value); err != nil {
 			return err
 		}
+
+	case BGP_ACTIONS_SET_ASPATH_PREPEND_KEY:
+		if err := b.SetAsPathPrepend.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_ACTIONS_SET_COMM_KEY:
+		if err := b.SetCommunity.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_ACTIONS_SET_EXTCOMM_KEY:
+		if err := b.SetExtCommunity.Put(nodes[1:], value); err != nil {
+			return err
+		}
 	}
 
 	b.SetChange(nodes[0].Name)
@@ -98,7 +174,46 @@ func ProcessPolicyBgpActions(p PolicyBgpActionsProcessor, reverse bool, name str
 		return nil
 	}
 
-	return nclib.CallFunctions(reverse, configFunc)
+	prependFunc := func() error {
+		if actions.GetChange(BGP_ACTIONS_SET_ASPATH_PREPEND_KEY) {
+			return ProcessPolicyBgpSetAsPathPrepend(
+				p.(PolicyBgpSetAsPathPrependProcessor),
+				reverse,
+				name,
+				stmtName,
+				actions.SetAsPathPrepend,
+			)
+		}
+		return nil
+	}
+
+	commFunc := func() error {
+		if actions.GetChange(BGP_ACTIONS_SET_COMM_KEY) {
+			return ProcessPolicyBgpSetCommunity(
+				p.(PolicyBgpSetCommunityProcessor),
+				reverse,
+				name,
+				stmtName,
+				actions.SetCommunity,
+			)
+		}
+		return nil
+	}
+
+	extCommFunc := func() error {
+		if actions.GetChange(BGP_ACTIONS_SET_EXTCOMM_KEY) {
+			return ProcessPolicyBgpSetExtCommunity(
+				p.(PolicyBgpSetExtCommunityProcessor),
+				reverse,
+				name,
+				stmtName,
+				actions.SetExtCommunity,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc, prependFunc, commFunc, extCommFunc)
 }
 
 //
@@ -107,8 +222,10 @@ func ProcessPolicyBgpActions(p PolicyBgpActionsProcessor, reverse bool, name str
 type PolicyBgpActionsConfig struct {
 	nclib.SrChanges `xml:"-"`
 
-	SetLocalPref uint32         `xml:"set-local-pref"`
-	SetNexthop   BgpNexthopType `xml:"set-next-hop"`
+	SetLocalPref   uint32            `xml:"set-local-pref"`
+	SetNexthop     BgpNexthopType    `xml:"set-next-hop"`
+	SetMed         BgpSetMedType     `xml:"set-med"`
+	SetRouteOrigin BgpOriginAttrType `xml:"set-route-origin"`
 }
 
 type PolicyBgpActionsConfigProcessor interface {
@@ -117,17 +234,21 @@ type PolicyBgpActionsConfigProcessor interface {
 
 func NewPolicyBgpActionsConfig() *PolicyBgpActionsConfig {
 	return &PolicyBgpActionsConfig{
-		SrChanges:    nclib.NewSrChanges(),
-		SetLocalPref: 0,
-		SetNexthop:   BGP_NEXTHOP_TYPE,
+		SrChanges:      nclib.NewSrChanges(),
+		SetLocalPref:   0,
+		SetNexthop:     BGP_NEXTHOP_TYPE,
+		SetMed:         BGP_SET_MED_TYPE,
+		SetRouteOrigin: BGP_ORIGIN_ATTR_TYPE,
 	}
 }
 
 func (c *PolicyBgpActionsConfig) String() string {
-	return fmt.Sprintf("%s{%s=%d, %s='%s'} %s",
+	return fmt.Sprintf("%s{%s=%d, %s='%s', %s='%s', %s=%s} %s",
 		OC_CONFIG_KEY,
 		BGP_ACTIONS_SET_LOCALPREF_KEY, c.SetLocalPref,
 		BGP_ACTIONS_SET_NEXTHOP_KEY, c.SetNexthop,
+		BGP_ACTIONS_SET_MED_KEY, c.SetMed,
+		BGP_ACTIONS_SET_ORIGIN_KEY, c.SetRouteOrigin,
 		c.SrChanges,
 	)
 }
@@ -151,6 +272,20 @@ func (c *PolicyBgpActionsConfig) Put(nodes []*ncxml.XPathNode, value string) err
 			return err
 		}
 		c.SetNexthop = nh
+
+	case BGP_ACTIONS_SET_MED_KEY:
+		med, err := ParseBgpSetMedType(value)
+		if err != nil {
+			return err
+		}
+		c.SetMed = med
+
+	case BGP_ACTIONS_SET_ORIGIN_KEY:
+		origin, err := ParseBgpOriginAttrType(value)
+		if err != nil {
+			return err
+		}
+		c.SetRouteOrigin = origin
 	}
 
 	c.SetChange(nodes[0].Name)
@@ -0,0 +1,932 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"fmt"
+	nclib "netconf/lib"
+	ncxml "netconf/lib/xml"
+	"strconv"
+)
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/actions/bgp-actions/set-as-path-prepend
+//
+type PolicyBgpSetAsPathPrepend struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *PolicyBgpSetAsPathPrependConfig `xml:"config"`
+}
+
+type PolicyBgpSetAsPathPrependProcessor interface {
+	PolicyBgpSetAsPathPrependConfigProcessor
+}
+
+func NewPolicyBgpSetAsPathPrepend() *PolicyBgpSetAsPathPrepend {
+	return &PolicyBgpSetAsPathPrepend{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewPolicyBgpSetAsPathPrependConfig(),
+	}
+}
+
+func (a *PolicyBgpSetAsPathPrepend) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		BGP_ACTIONS_SET_ASPATH_PREPEND_KEY,
+		a.Config,
+		a.SrChanges,
+	)
+}
+
+func (a *PolicyBgpSetAsPathPrepend) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := a.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	a.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpSetAsPathPrepend(p PolicyBgpSetAsPathPrependProcessor, reverse bool, name string, stmtName string, prepend *PolicyBgpSetAsPathPrepend) error {
+	configFunc := func() error {
+		if prepend.GetChange(OC_CONFIG_KEY) {
+			return ProcessPolicyBgpSetAsPathPrependConfig(
+				p.(PolicyBgpSetAsPathPrependConfigProcessor),
+				reverse,
+				name,
+				stmtName,
+				prepend.Config,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/actions/bgp-actions/set-as-path-prepend/config
+//
+type PolicyBgpSetAsPathPrependConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	RepeatN uint8  `xml:"repeat-n"`
+	Asn     uint32 `xml:"asn"`
+}
+
+type PolicyBgpSetAsPathPrependConfigProcessor interface {
+	PolicyBgpSetAsPathPrependConfig(string, string, *PolicyBgpSetAsPathPrependConfig) error
+}
+
+func NewPolicyBgpSetAsPathPrependConfig() *PolicyBgpSetAsPathPrependConfig {
+	return &PolicyBgpSetAsPathPrependConfig{
+		SrChanges: nclib.NewSrChanges(),
+		RepeatN:   1,
+		Asn:       0,
+	}
+}
+
+func (c *PolicyBgpSetAsPathPrependConfig) String() string {
+	return fmt.Sprintf("%s{%s=%d, %s=%d} %s",
+		OC_CONFIG_KEY,
+		BGP_ACTIONS_REPEATN_KEY, c.RepeatN,
+		BGP_ACTIONS_ASN_KEY, c.Asn,
+		c.SrChanges,
+	)
+}
+
+func (c *PolicyBgpSetAsPathPrependConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_ACTIONS_REPEATN_KEY:
+		n, err := strconv.ParseUint(value, 0, 8)
+		if err != nil {
+			return err
+		}
+		c.RepeatN = uint8(n)
+
+	case BGP_ACTIONS_ASN_KEY:
+		asn, err := strconv.ParseUint(value, 0, 32)
+		if err != nil {
+			return err
+		}
+		c.Asn = uint32(asn)
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpSetAsPathPrependConfig(p PolicyBgpSetAsPathPrependConfigProcessor, reverse bool, name string, stmtName string, config *PolicyBgpSetAsPathPrependConfig) error {
+	configFunc := func() error {
+		return p.PolicyBgpSetAsPathPrependConfig(name, stmtName, config)
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/actions/bgp-actions/set-community
+//
+type PolicyBgpSetCommunity struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config    *PolicyBgpSetCommunityConfig    `xml:"config"`
+	Inline    *PolicyBgpSetCommunityInline    `xml:"inline"`
+	Reference *PolicyBgpSetCommunityReference `xml:"reference"`
+}
+
+type PolicyBgpSetCommunityProcessor interface {
+	PolicyBgpSetCommunityConfigProcessor
+	PolicyBgpSetCommunityInlineProcessor
+	PolicyBgpSetCommunityReferenceProcessor
+}
+
+func NewPolicyBgpSetCommunity() *PolicyBgpSetCommunity {
+	return &PolicyBgpSetCommunity{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewPolicyBgpSetCommunityConfig(),
+		Inline:    NewPolicyBgpSetCommunityInline(),
+		Reference: NewPolicyBgpSetCommunityReference(),
+	}
+}
+
+func (c *PolicyBgpSetCommunity) String() string {
+	return fmt.Sprintf("%s{%s, %s, %s} %s",
+		BGP_ACTIONS_SET_COMM_KEY,
+		c.Config,
+		c.Inline,
+		c.Reference,
+		c.SrChanges,
+	)
+}
+
+func (c *PolicyBgpSetCommunity) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := c.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_ACTIONS_INLINE_KEY:
+		if err := c.Inline.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_ACTIONS_REFERENCE_KEY:
+		if err := c.Reference.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpSetCommunity(p PolicyBgpSetCommunityProcessor, reverse bool, name string, stmtName string, comm *PolicyBgpSetCommunity) error {
+	configFunc := func() error {
+		if comm.GetChange(OC_CONFIG_KEY) {
+			return ProcessPolicyBgpSetCommunityConfig(
+				p.(PolicyBgpSetCommunityConfigProcessor),
+				reverse,
+				name,
+				stmtName,
+				comm.Config,
+			)
+		}
+		return nil
+	}
+
+	inlineFunc := func() error {
+		if comm.GetChange(BGP_ACTIONS_INLINE_KEY) {
+			return ProcessPolicyBgpSetCommunityInline(
+				p.(PolicyBgpSetCommunityInlineProcessor),
+				reverse,
+				name,
+				stmtName,
+				comm.Inline,
+			)
+		}
+		return nil
+	}
+
+	refFunc := func() error {
+		if comm.GetChange(BGP_ACTIONS_REFERENCE_KEY) {
+			return ProcessPolicyBgpSetCommunityReference(
+				p.(PolicyBgpSetCommunityReferenceProcessor),
+				reverse,
+				name,
+				stmtName,
+				comm.Reference,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc, inlineFunc, refFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/actions/bgp-actions/set-community/config
+//
+type PolicyBgpSetCommunityConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Method  BgpSetCommunityMethodType `xml:"method"`
+	Options BgpSetCommunityOptionType `xml:"options"`
+}
+
+type PolicyBgpSetCommunityConfigProcessor interface {
+	PolicyBgpSetCommunityConfig(string, string, *PolicyBgpSetCommunityConfig) error
+}
+
+func NewPolicyBgpSetCommunityConfig() *PolicyBgpSetCommunityConfig {
+	return &PolicyBgpSetCommunityConfig{
+		SrChanges: nclib.NewSrChanges(),
+		Method:    BGP_SET_COMMUNITY_METHOD_TYPE,
+		Options:   BGP_SET_COMMUNITY_OPTION_TYPE,
+	}
+}
+
+func (c *PolicyBgpSetCommunityConfig) String() string {
+	return fmt.Sprintf("%s{%s=%s, %s=%s} %s",
+		OC_CONFIG_KEY,
+		BGP_ACTIONS_METHOD_KEY, c.Method,
+		BGP_ACTIONS_OPTIONS_KEY, c.Options,
+		c.SrChanges,
+	)
+}
+
+func (c *PolicyBgpSetCommunityConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_ACTIONS_METHOD_KEY:
+		method, err := ParseBgpSetCommunityMethodType(value)
+		if err != nil {
+			return err
+		}
+		c.Method = method
+
+	case BGP_ACTIONS_OPTIONS_KEY:
+		opts, err := ParseBgpSetCommunityOptionType(value)
+		if err != nil {
+			return err
+		}
+		c.Options = opts
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpSetCommunityConfig(p PolicyBgpSetCommunityConfigProcessor, reverse bool, name string, stmtName string, config *PolicyBgpSetCommunityConfig) error {
+	configFunc := func() error {
+		return p.PolicyBgpSetCommunityConfig(name, stmtName, config)
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/actions/bgp-actions/set-community/inline
+//
+type PolicyBgpSetCommunityInline struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *PolicyBgpSetCommunityInlineConfig `xml:"config"`
+}
+
+type PolicyBgpSetCommunityInlineProcessor interface {
+	PolicyBgpSetCommunityInlineConfigProcessor
+}
+
+func NewPolicyBgpSetCommunityInline() *PolicyBgpSetCommunityInline {
+	return &PolicyBgpSetCommunityInline{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewPolicyBgpSetCommunityInlineConfig(),
+	}
+}
+
+func (i *PolicyBgpSetCommunityInline) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		BGP_ACTIONS_INLINE_KEY,
+		i.Config,
+		i.SrChanges,
+	)
+}
+
+func (i *PolicyBgpSetCommunityInline) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := i.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	i.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpSetCommunityInline(p PolicyBgpSetCommunityInlineProcessor, reverse bool, name string, stmtName string, inline *PolicyBgpSetCommunityInline) error {
+	configFunc := func() error {
+		if inline.GetChange(OC_CONFIG_KEY) {
+			return ProcessPolicyBgpSetCommunityInlineConfig(
+				p.(PolicyBgpSetCommunityInlineConfigProcessor),
+				reverse,
+				name,
+				stmtName,
+				inline.Config,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/actions/bgp-actions/set-community/inline/config
+//
+type PolicyBgpSetCommunityInlineConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Communities []string `xml:"communities"`
+}
+
+type PolicyBgpSetCommunityInlineConfigProcessor interface {
+	PolicyBgpSetCommunityInlineConfig(string, string, *PolicyBgpSetCommunityInlineConfig) error
+}
+
+func NewPolicyBgpSetCommunityInlineConfig() *PolicyBgpSetCommunityInlineConfig {
+	return &PolicyBgpSetCommunityInlineConfig{
+		SrChanges:   nclib.NewSrChanges(),
+		Communities: []string{},
+	}
+}
+
+func (c *PolicyBgpSetCommunityInlineConfig) String() string {
+	return fmt.Sprintf("%s{%s=%v} %s",
+		OC_CONFIG_KEY,
+		BGP_ACTIONS_COMMUNITIES_KEY, c.Communities,
+		c.SrChanges,
+	)
+}
+
+func (c *PolicyBgpSetCommunityInlineConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_ACTIONS_COMMUNITIES_KEY:
+		c.Communities = append(c.Communities, value)
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpSetCommunityInlineConfig(p PolicyBgpSetCommunityInlineConfigProcessor, reverse bool, name string, stmtName string, config *PolicyBgpSetCommunityInlineConfig) error {
+	configFunc := func() error {
+		return p.PolicyBgpSetCommunityInlineConfig(name, stmtName, config)
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/actions/bgp-actions/set-community/reference
+//
+type PolicyBgpSetCommunityReference struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *PolicyBgpSetCommunityReferenceConfig `xml:"config"`
+}
+
+type PolicyBgpSetCommunityReferenceProcessor interface {
+	PolicyBgpSetCommunityReferenceConfigProcessor
+}
+
+func NewPolicyBgpSetCommunityReference() *PolicyBgpSetCommunityReference {
+	return &PolicyBgpSetCommunityReference{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewPolicyBgpSetCommunityReferenceConfig(),
+	}
+}
+
+func (r *PolicyBgpSetCommunityReference) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		BGP_ACTIONS_REFERENCE_KEY,
+		r.Config,
+		r.SrChanges,
+	)
+}
+
+func (r *PolicyBgpSetCommunityReference) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := r.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	r.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpSetCommunityReference(p PolicyBgpSetCommunityReferenceProcessor, reverse bool, name string, stmtName string, ref *PolicyBgpSetCommunityReference) error {
+	configFunc := func() error {
+		if ref.GetChange(OC_CONFIG_KEY) {
+			return ProcessPolicyBgpSetCommunityReferenceConfig(
+				p.(PolicyBgpSetCommunityReferenceConfigProcessor),
+				reverse,
+				name,
+				stmtName,
+				ref.Config,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/actions/bgp-actions/set-community/reference/config
+//
+type PolicyBgpSetCommunityReferenceConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	CommunitySetRef string `xml:"community-set-ref"`
+}
+
+type PolicyBgpSetCommunityReferenceConfigProcessor interface {
+	PolicyBgpSetCommunityReferenceConfig(string, string, *PolicyBgpSetCommunityReferenceConfig) error
+}
+
+func NewPolicyBgpSetCommunityReferenceConfig() *PolicyBgpSetCommunityReferenceConfig {
+	return &PolicyBgpSetCommunityReferenceConfig{
+		SrChanges:       nclib.NewSrChanges(),
+		CommunitySetRef: "",
+	}
+}
+
+func (c *PolicyBgpSetCommunityReferenceConfig) String() string {
+	return fmt.Sprintf("%s{%s='%s'} %s",
+		OC_CONFIG_KEY,
+		BGP_ACTIONS_COMMSET_REF_KEY, c.CommunitySetRef,
+		c.SrChanges,
+	)
+}
+
+func (c *PolicyBgpSetCommunityReferenceConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_ACTIONS_COMMSET_REF_KEY:
+		c.CommunitySetRef = value
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpSetCommunityReferenceConfig(p PolicyBgpSetCommunityReferenceConfigProcessor, reverse bool, name string, stmtName string, config *PolicyBgpSetCommunityReferenceConfig) error {
+	configFunc := func() error {
+		return p.PolicyBgpSetCommunityReferenceConfig(name, stmtName, config)
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/actions/bgp-actions/set-ext-community
+//
+type PolicyBgpSetExtCommunity struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config    *PolicyBgpSetExtCommunityConfig    `xml:"config"`
+	Inline    *PolicyBgpSetExtCommunityInline    `xml:"inline"`
+	Reference *PolicyBgpSetExtCommunityReference `xml:"reference"`
+}
+
+type PolicyBgpSetExtCommunityProcessor interface {
+	PolicyBgpSetExtCommunityConfigProcessor
+	PolicyBgpSetExtCommunityInlineProcessor
+	PolicyBgpSetExtCommunityReferenceProcessor
+}
+
+func NewPolicyBgpSetExtCommunity() *PolicyBgpSetExtCommunity {
+	return &PolicyBgpSetExtCommunity{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewPolicyBgpSetExtCommunityConfig(),
+		Inline:    NewPolicyBgpSetExtCommunityInline(),
+		Reference: NewPolicyBgpSetExtCommunityReference(),
+	}
+}
+
+func (c *PolicyBgpSetExtCommunity) String() string {
+	return fmt.Sprintf("%s{%s, %s, %s} %s",
+		BGP_ACTIONS_SET_EXTCOMM_KEY,
+		c.Config,
+		c.Inline,
+		c.Reference,
+		c.SrChanges,
+	)
+}
+
+func (c *PolicyBgpSetExtCommunity) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := c.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_ACTIONS_INLINE_KEY:
+		if err := c.Inline.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_ACTIONS_REFERENCE_KEY:
+		if err := c.Reference.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpSetExtCommunity(p PolicyBgpSetExtCommunityProcessor, reverse bool, name string, stmtName string, comm *PolicyBgpSetExtCommunity) error {
+	configFunc := func() error {
+		if comm.GetChange(OC_CONFIG_KEY) {
+			return ProcessPolicyBgpSetExtCommunityConfig(
+				p.(PolicyBgpSetExtCommunityConfigProcessor),
+				reverse,
+				name,
+				stmtName,
+				comm.Config,
+			)
+		}
+		return nil
+	}
+
+	inlineFunc := func() error {
+		if comm.GetChange(BGP_ACTIONS_INLINE_KEY) {
+			return ProcessPolicyBgpSetExtCommunityInline(
+				p.(PolicyBgpSetExtCommunityInlineProcessor),
+				reverse,
+				name,
+				stmtName,
+				comm.Inline,
+			)
+		}
+		return nil
+	}
+
+	refFunc := func() error {
+		if comm.GetChange(BGP_ACTIONS_REFERENCE_KEY) {
+			return ProcessPolicyBgpSetExtCommunityReference(
+				p.(PolicyBgpSetExtCommunityReferenceProcessor),
+				reverse,
+				name,
+				stmtName,
+				comm.Reference,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc, inlineFunc, refFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/actions/bgp-actions/set-ext-community/config
+//
+type PolicyBgpSetExtCommunityConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Method  BgpSetCommunityMethodType `xml:"method"`
+	Options BgpSetCommunityOptionType `xml:"options"`
+}
+
+type PolicyBgpSetExtCommunityConfigProcessor interface {
+	PolicyBgpSetExtCommunityConfig(string, string, *PolicyBgpSetExtCommunityConfig) error
+}
+
+func NewPolicyBgpSetExtCommunityConfig() *PolicyBgpSetExtCommunityConfig {
+	return &PolicyBgpSetExtCommunityConfig{
+		SrChanges: nclib.NewSrChanges(),
+		Method:    BGP_SET_COMMUNITY_METHOD_TYPE,
+		Options:   BGP_SET_COMMUNITY_OPTION_TYPE,
+	}
+}
+
+func (c *PolicyBgpSetExtCommunityConfig) String() string {
+	return fmt.Sprintf("%s{%s=%s, %s=%s} %s",
+		OC_CONFIG_KEY,
+		BGP_ACTIONS_METHOD_KEY, c.Method,
+		BGP_ACTIONS_OPTIONS_KEY, c.Options,
+		c.SrChanges,
+	)
+}
+
+func (c *PolicyBgpSetExtCommunityConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_ACTIONS_METHOD_KEY:
+		method, err := ParseBgpSetCommunityMethodType(value)
+		if err != nil {
+			return err
+		}
+		c.Method = method
+
+	case BGP_ACTIONS_OPTIONS_KEY:
+		opts, err := ParseBgpSetCommunityOptionType(value)
+		if err != nil {
+			return err
+		}
+		c.Options = opts
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpSetExtCommunityConfig(p PolicyBgpSetExtCommunityConfigProcessor, reverse bool, name string, stmtName string, config *PolicyBgpSetExtCommunityConfig) error {
+	configFunc := func() error {
+		return p.PolicyBgpSetExtCommunityConfig(name, stmtName, config)
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/actions/bgp-actions/set-ext-community/inline
+//
+type PolicyBgpSetExtCommunityInline struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *PolicyBgpSetExtCommunityInlineConfig `xml:"config"`
+}
+
+type PolicyBgpSetExtCommunityInlineProcessor interface {
+	PolicyBgpSetExtCommunityInlineConfigProcessor
+}
+
+func NewPolicyBgpSetExtCommunityInline() *PolicyBgpSetExtCommunityInline {
+	return &PolicyBgpSetExtCommunityInline{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewPolicyBgpSetExtCommunityInlineConfig(),
+	}
+}
+
+func (i *PolicyBgpSetExtCommunityInline) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		BGP_ACTIONS_INLINE_KEY,
+		i.Config,
+		i.SrChanges,
+	)
+}
+
+func (i *PolicyBgpSetExtCommunityInline) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := i.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	i.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpSetExtCommunityInline(p PolicyBgpSetExtCommunityInlineProcessor, reverse bool, name string, stmtName string, inline *PolicyBgpSetExtCommunityInline) error {
+	configFunc := func() error {
+		if inline.GetChange(OC_CONFIG_KEY) {
+			return ProcessPolicyBgpSetExtCommunityInlineConfig(
+				p.(PolicyBgpSetExtCommunityInlineConfigProcessor),
+				reverse,
+				name,
+				stmtName,
+				inline.Config,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/actions/bgp-actions/set-ext-community/inline/config
+//
+type PolicyBgpSetExtCommunityInlineConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Communities []string `xml:"communities"`
+}
+
+type PolicyBgpSetExtCommunityInlineConfigProcessor interface {
+	PolicyBgpSetExtCommunityInlineConfig(string, string, *PolicyBgpSetExtCommunityInlineConfig) error
+}
+
+func NewPolicyBgpSetExtCommunityInlineConfig() *PolicyBgpSetExtCommunityInlineConfig {
+	return &PolicyBgpSetExtCommunityInlineConfig{
+		SrChanges:   nclib.NewSrChanges(),
+		Communities: []string{},
+	}
+}
+
+func (c *PolicyBgpSetExtCommunityInlineConfig) String() string {
+	return fmt.Sprintf("%s{%s=%v} %s",
+		OC_CONFIG_KEY,
+		BGP_ACTIONS_COMMUNITIES_KEY, c.Communities,
+		c.SrChanges,
+	)
+}
+
+func (c *PolicyBgpSetExtCommunityInlineConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_ACTIONS_COMMUNITIES_KEY:
+		c.Communities = append(c.Communities, value)
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpSetExtCommunityInlineConfig(p PolicyBgpSetExtCommunityInlineConfigProcessor, reverse bool, name string, stmtName string, config *PolicyBgpSetExtCommunityInlineConfig) error {
+	configFunc := func() error {
+		return p.PolicyBgpSetExtCommunityInlineConfig(name, stmtName, config)
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/actions/bgp-actions/set-ext-community/reference
+//
+type PolicyBgpSetExtCommunityReference struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *PolicyBgpSetExtCommunityReferenceConfig `xml:"config"`
+}
+
+type PolicyBgpSetExtCommunityReferenceProcessor interface {
+	PolicyBgpSetExtCommunityReferenceConfigProcessor
+}
+
+func NewPolicyBgpSetExtCommunityReference() *PolicyBgpSetExtCommunityReference {
+	return &PolicyBgpSetExtCommunityReference{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewPolicyBgpSetExtCommunityReferenceConfig(),
+	}
+}
+
+func (r *PolicyBgpSetExtCommunityReference) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		BGP_ACTIONS_REFERENCE_KEY,
+		r.Config,
+		r.SrChanges,
+	)
+}
+
+func (r *PolicyBgpSetExtCommunityReference) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := r.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	r.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpSetExtCommunityReference(p PolicyBgpSetExtCommunityReferenceProcessor, reverse bool, name string, stmtName string, ref *PolicyBgpSetExtCommunityReference) error {
+	configFunc := func() error {
+		if ref.GetChange(OC_CONFIG_KEY) {
+			return ProcessPolicyBgpSetExtCommunityReferenceConfig(
+				p.(PolicyBgpSetExtCommunityReferenceConfigProcessor),
+				reverse,
+				name,
+				stmtName,
+				ref.Config,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/actions/bgp-actions/set-ext-community/reference/config
+//
+type PolicyBgpSetExtCommunityReferenceConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	ExtCommunitySetRef string `xml:"ext-community-set-ref"`
+}
+
+type PolicyBgpSetExtCommunityReferenceConfigProcessor interface {
+	PolicyBgpSetExtCommunityReferenceConfig(string, string, *PolicyBgpSetExtCommunityReferenceConfig) error
+}
+
+func NewPolicyBgpSetExtCommunityReferenceConfig() *PolicyBgpSetExtCommunityReferenceConfig {
+	return &PolicyBgpSetExtCommunityReferenceConfig{
+		SrChanges:          nclib.NewSrChanges(),
+		ExtCommunitySetRef: "",
+	}
+}
+
+func (c *PolicyBgpSetExtCommunityReferenceConfig) String() string {
+	return fmt.Sprintf("%s{%s='%s'} %s",
+		OC_CONFIG_KEY,
+		BGP_ACTIONS_EXTCOMMSET_REF_KEY, c.ExtCommunitySetRef,
+		c.SrChanges,
+	)
+}
+
+func (c *PolicyBgpSetExtCommunityReferenceConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_ACTIONS_EXTCOMMSET_REF_KEY:
+		c.ExtCommunitySetRef = value
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpSetExtCommunityReferenceConfig(p PolicyBgpSetExtCommunityReferenceConfigProcessor, reverse bool, name string, stmtName string, config *PolicyBgpSetExtCommunityReferenceConfig) error {
+	configFunc := func() error {
+		return p.PolicyBgpSetExtCommunityReferenceConfig(name, stmtName, config)
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
@@ -0,0 +1,758 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"encoding/xml"
+	"fmt"
+	nclib "netconf/lib"
+	ncxml "netconf/lib/xml"
+	"strconv"
+)
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/conditions/bgp-conditions
+//
+type PolicyBgpConditions struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config               *PolicyBgpConditionsConfig     `xml:"config"`
+	AsPathLength         *PolicyBgpAsPathLength         `xml:"as-path-length"`
+	MatchCommunitySet    *PolicyBgpMatchCommunitySet    `xml:"match-community-set"`
+	MatchExtCommunitySet *PolicyBgpMatchExtCommunitySet `xml:"match-ext-community-set"`
+	MatchAsPathSet       *PolicyBgpMatchAsPathSet       `xml:"match-as-path-set"`
+}
+
+func (c *PolicyBgpConditions) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Space = BGP_POLICY_XMLNS
+	e.EncodeToken(start)
+	if err := e.EncodeElement(c.Config, xml.StartElement{Name: xml.Name{Local: OC_CONFIG_KEY}}); err != nil {
+		return err
+	}
+	if err := e.EncodeElement(c.AsPathLength, xml.StartElement{Name: xml.Name{Local: BGP_CONDS_ASPATHLEN_KEY}}); err != nil {
+		return err
+	}
+	if err := e.EncodeElement(c.MatchCommunitySet, xml.StartElement{Name: xml.Name{Local: BGP_MATCH_COMMSET_KEY}}); err != nil {
+		return err
+	}
+	if err := e.EncodeElement(c.MatchExtCommunitySet, xml.StartElement{Name: xml.Name{Local: BGP_MATCH_EXTCOMMSET_KEY}}); err != nil {
+		return err
+	}
+	if err := e.EncodeElement(c.MatchAsPathSet, xml.StartElement{Name: xml.Name{Local: BGP_MATCH_ASPATHSET_KEY}}); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+type PolicyBgpConditionsProcessor interface {
+	PolicyBgpConditionsConfigProcessor
+	PolicyBgpAsPathLengthProcessor
+	PolicyBgpMatchCommunitySetProcessor
+	PolicyBgpMatchExtCommunitySetProcessor
+	PolicyBgpMatchAsPathSetProcessor
+}
+
+func NewPolicyBgpConditions() *PolicyBgpConditions {
+	return &PolicyBgpConditions{
+		SrChanges:            nclib.NewSrChanges(),
+		Config:               NewPolicyBgpConditionsConfig(),
+		AsPathLength:         NewPolicyBgpAsPathLength(),
+		MatchCommunitySet:    NewPolicyBgpMatchCommunitySet(),
+		MatchExtCommunitySet: NewPolicyBgpMatchExtCommunitySet(),
+		MatchAsPathSet:       NewPolicyBgpMatchAsPathSet(),
+	}
+}
+
+func (c *PolicyBgpConditions) String() string {
+	return fmt.Sprintf("%s{%s, %s, %s, %s, %s} %s",
+		BGP_CONDS_KEY,
+		c.Config,
+		c.AsPathLength,
+		c.MatchCommunitySet,
+		c.MatchExtCommunitySet,
+		c.MatchAsPathSet,
+		c.SrChanges,
+	)
+}
+
+func (c *PolicyBgpConditions) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := c.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_CONDS_ASPATHLEN_KEY:
+		if err := c.AsPathLength.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_MATCH_COMMSET_KEY:
+		if err := c.MatchCommunitySet.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_MATCH_EXTCOMMSET_KEY:
+		if err := c.MatchExtCommunitySet.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_MATCH_ASPATHSET_KEY:
+		if err := c.MatchAsPathSet.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpConditions(p PolicyBgpConditionsProcessor, reverse bool, name string, stmtName string, conds *PolicyBgpConditions) error {
+	configFunc := func() error {
+		if conds.GetChange(OC_CONFIG_KEY) {
+			return ProcessPolicyBgpConditionsConfig(
+				p.(PolicyBgpConditionsConfigProcessor),
+				reverse,
+				name,
+				stmtName,
+				conds.Config,
+			)
+		}
+		return nil
+	}
+
+	asPathLenFunc := func() error {
+		if conds.GetChange(BGP_CONDS_ASPATHLEN_KEY) {
+			return ProcessPolicyBgpAsPathLength(
+				p.(PolicyBgpAsPathLengthProcessor),
+				reverse,
+				name,
+				stmtName,
+				conds.AsPathLength,
+			)
+		}
+		return nil
+	}
+
+	commFunc := func() error {
+		if conds.GetChange(BGP_MATCH_COMMSET_KEY) {
+			return ProcessPolicyBgpMatchCommunitySet(
+				p.(PolicyBgpMatchCommunitySetProcessor),
+				reverse,
+				name,
+				stmtName,
+				conds.MatchCommunitySet,
+			)
+		}
+		return nil
+	}
+
+	extCommFunc := func() error {
+		if conds.GetChange(BGP_MATCH_EXTCOMMSET_KEY) {
+			return ProcessPolicyBgpMatchExtCommunitySet(
+				p.(PolicyBgpMatchExtCommunitySetProcessor),
+				reverse,
+				name,
+				stmtName,
+				conds.MatchExtCommunitySet,
+			)
+		}
+		return nil
+	}
+
+	asPathFunc := func() error {
+		if conds.GetChange(BGP_MATCH_ASPATHSET_KEY) {
+			return ProcessPolicyBgpMatchAsPathSet(
+				p.(PolicyBgpMatchAsPathSetProcessor),
+				reverse,
+				name,
+				stmtName,
+				conds.MatchAsPathSet,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc, asPathLenFunc, commFunc, extCommFunc, asPathFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/conditions/bgp-conditions/config
+//
+type PolicyBgpConditionsConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	MedEq     uint32            `xml:"med-eq"`
+	OriginEq  BgpOriginAttrType `xml:"origin-eq"`
+	AfiSafiIn []BgpAfiSafiType  `xml:"afi-safi-in"`
+}
+
+type PolicyBgpConditionsConfigProcessor interface {
+	PolicyBgpConditionsConfig(string, string, *PolicyBgpConditionsConfig) error
+}
+
+func NewPolicyBgpConditionsConfig() *PolicyBgpConditionsConfig {
+	return &PolicyBgpConditionsConfig{
+		SrChanges: nclib.NewSrChanges(),
+		MedEq:     0,
+		OriginEq:  BGP_ORIGIN_ATTR_TYPE,
+		AfiSafiIn: []BgpAfiSafiType{},
+	}
+}
+
+func (c *PolicyBgpConditionsConfig) String() string {
+	return fmt.Sprintf("%s{%s=%d, %s=%s, %s=%v} %s",
+		OC_CONFIG_KEY,
+		BGP_CONDS_MEDEQ_KEY, c.MedEq,
+		BGP_CONDS_ORIGINEQ_KEY, c.OriginEq,
+		BGP_CONDS_AFISAFIIN_KEY, c.AfiSafiIn,
+		c.SrChanges,
+	)
+}
+
+func (c *PolicyBgpConditionsConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_CONDS_MEDEQ_KEY:
+		med, err := strconv.ParseUint(value, 0, 32)
+		if err != nil {
+			return err
+		}
+		c.MedEq = uint32(med)
+
+	case BGP_CONDS_ORIGINEQ_KEY:
+		origin, err := ParseBgpOriginAttrType(value)
+		if err != nil {
+			return err
+		}
+		c.OriginEq = origin
+
+	case BGP_CONDS_AFISAFIIN_KEY:
+		afisafi, err := ParseBgpAfiSafiType(value)
+		if err != nil {
+			return err
+		}
+		c.AfiSafiIn = append(c.AfiSafiIn, afisafi)
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpConditionsConfig(p PolicyBgpConditionsConfigProcessor, reverse bool, name string, stmtName string, config *PolicyBgpConditionsConfig) error {
+	configFunc := func() error {
+		return p.PolicyBgpConditionsConfig(name, stmtName, config)
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/conditions/bgp-conditions/as-path-length
+//
+type PolicyBgpAsPathLength struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *PolicyBgpAsPathLengthConfig `xml:"config"`
+}
+
+type PolicyBgpAsPathLengthProcessor interface {
+	PolicyBgpAsPathLengthConfigProcessor
+}
+
+func NewPolicyBgpAsPathLength() *PolicyBgpAsPathLength {
+	return &PolicyBgpAsPathLength{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewPolicyBgpAsPathLengthConfig(),
+	}
+}
+
+func (l *PolicyBgpAsPathLength) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		BGP_CONDS_ASPATHLEN_KEY,
+		l.Config,
+		l.SrChanges,
+	)
+}
+
+func (l *PolicyBgpAsPathLength) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := l.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	l.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpAsPathLength(p PolicyBgpAsPathLengthProcessor, reverse bool, name string, stmtName string, length *PolicyBgpAsPathLength) error {
+	configFunc := func() error {
+		if length.GetChange(OC_CONFIG_KEY) {
+			return ProcessPolicyBgpAsPathLengthConfig(
+				p.(PolicyBgpAsPathLengthConfigProcessor),
+				reverse,
+				name,
+				stmtName,
+				length.Config,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/conditions/bgp-conditions/as-path-length/config
+//
+type PolicyBgpAsPathLengthConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Operator PolicyAttrComparisonType `xml:"operator"`
+	Value    uint32                   `xml:"value"`
+}
+
+type PolicyBgpAsPathLengthConfigProcessor interface {
+	PolicyBgpAsPathLengthConfig(string, string, *PolicyBgpAsPathLengthConfig) error
+}
+
+func NewPolicyBgpAsPathLengthConfig() *PolicyBgpAsPathLengthConfig {
+	return &PolicyBgpAsPathLengthConfig{
+		SrChanges: nclib.NewSrChanges(),
+		Operator:  POLICY_ATTR_COMPARISON_TYPE,
+		Value:     0,
+	}
+}
+
+func (c *PolicyBgpAsPathLengthConfig) String() string {
+	return fmt.Sprintf("%s{%s=%s, %s=%d} %s",
+		OC_CONFIG_KEY,
+		BGP_CONDS_OPERATOR_KEY, c.Operator,
+		BGP_CONDS_VALUE_KEY, c.Value,
+		c.SrChanges,
+	)
+}
+
+func (c *PolicyBgpAsPathLengthConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_CONDS_OPERATOR_KEY:
+		op, err := ParsePolicyAttrComparisonType(value)
+		if err != nil {
+			return err
+		}
+		c.Operator = op
+
+	case BGP_CONDS_VALUE_KEY:
+		v, err := strconv.ParseUint(value, 0, 32)
+		if err != nil {
+			return err
+		}
+		c.Value = uint32(v)
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpAsPathLengthConfig(p PolicyBgpAsPathLengthConfigProcessor, reverse bool, name string, stmtName string, config *PolicyBgpAsPathLengthConfig) error {
+	configFunc := func() error {
+		return p.PolicyBgpAsPathLengthConfig(name, stmtName, config)
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/conditions/bgp-conditions/match-community-set
+//
+type PolicyBgpMatchCommunitySet struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *PolicyBgpMatchCommunitySetConfig `xml:"config"`
+}
+
+type PolicyBgpMatchCommunitySetProcessor interface {
+	PolicyBgpMatchCommunitySetConfigProcessor
+}
+
+func NewPolicyBgpMatchCommunitySet() *PolicyBgpMatchCommunitySet {
+	return &PolicyBgpMatchCommunitySet{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewPolicyBgpMatchCommunitySetConfig(),
+	}
+}
+
+func (m *PolicyBgpMatchCommunitySet) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		BGP_MATCH_COMMSET_KEY,
+		m.Config,
+		m.SrChanges,
+	)
+}
+
+func (m *PolicyBgpMatchCommunitySet) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := m.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	m.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpMatchCommunitySet(p PolicyBgpMatchCommunitySetProcessor, reverse bool, name string, stmtName string, match *PolicyBgpMatchCommunitySet) error {
+	configFunc := func() error {
+		if match.GetChange(OC_CONFIG_KEY) {
+			return ProcessPolicyBgpMatchCommunitySetConfig(
+				p.(PolicyBgpMatchCommunitySetConfigProcessor),
+				reverse,
+				name,
+				stmtName,
+				match.Config,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/conditions/bgp-conditions/match-community-set/config
+//
+type PolicyBgpMatchCommunitySetConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	CommunitySet    string                    `xml:"community-set"`
+	MatchSetOptions PolicyMatchSetOptionsType `xml:"match-set-options"`
+}
+
+type PolicyBgpMatchCommunitySetConfigProcessor interface {
+	PolicyBgpMatchCommunitySetConfig(string, string, *PolicyBgpMatchCommunitySetConfig) error
+}
+
+func NewPolicyBgpMatchCommunitySetConfig() *PolicyBgpMatchCommunitySetConfig {
+	return &PolicyBgpMatchCommunitySetConfig{
+		SrChanges:       nclib.NewSrChanges(),
+		CommunitySet:    "",
+		MatchSetOptions: POLICY_MATCH_SET_OPTIONS_TYPE,
+	}
+}
+
+func (c *PolicyBgpMatchCommunitySetConfig) String() string {
+	return fmt.Sprintf("%s{%s='%s', %s=%s} %s",
+		OC_CONFIG_KEY,
+		BGP_COMMSET_KEY, c.CommunitySet,
+		POLICYMATCH_SETOPTS_KEY, c.MatchSetOptions,
+		c.SrChanges,
+	)
+}
+
+func (c *PolicyBgpMatchCommunitySetConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_COMMSET_KEY:
+		c.CommunitySet = value
+
+	case POLICYMATCH_SETOPTS_KEY:
+		opts, err := ParsePolicyMatchSetOptionsType(value)
+		if err != nil {
+			return err
+		}
+		c.MatchSetOptions = opts
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpMatchCommunitySetConfig(p PolicyBgpMatchCommunitySetConfigProcessor, reverse bool, name string, stmtName string, config *PolicyBgpMatchCommunitySetConfig) error {
+	configFunc := func() error {
+		return p.PolicyBgpMatchCommunitySetConfig(name, stmtName, config)
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/conditions/bgp-conditions/match-ext-community-set
+//
+type PolicyBgpMatchExtCommunitySet struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *PolicyBgpMatchExtCommunitySetConfig `xml:"config"`
+}
+
+type PolicyBgpMatchExtCommunitySetProcessor interface {
+	PolicyBgpMatchExtCommunitySetConfigProcessor
+}
+
+func NewPolicyBgpMatchExtCommunitySet() *PolicyBgpMatchExtCommunitySet {
+	return &PolicyBgpMatchExtCommunitySet{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewPolicyBgpMatchExtCommunitySetConfig(),
+	}
+}
+
+func (m *PolicyBgpMatchExtCommunitySet) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		BGP_MATCH_EXTCOMMSET_KEY,
+		m.Config,
+		m.SrChanges,
+	)
+}
+
+func (m *PolicyBgpMatchExtCommunitySet) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := m.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	m.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpMatchExtCommunitySet(p PolicyBgpMatchExtCommunitySetProcessor, reverse bool, name string, stmtName string, match *PolicyBgpMatchExtCommunitySet) error {
+	configFunc := func() error {
+		if match.GetChange(OC_CONFIG_KEY) {
+			return ProcessPolicyBgpMatchExtCommunitySetConfig(
+				p.(PolicyBgpMatchExtCommunitySetConfigProcessor),
+				reverse,
+				name,
+				stmtName,
+				match.Config,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/conditions/bgp-conditions/match-ext-community-set/config
+//
+type PolicyBgpMatchExtCommunitySetConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	ExtCommunitySet string                    `xml:"ext-community-set"`
+	MatchSetOptions PolicyMatchSetOptionsType `xml:"match-set-options"`
+}
+
+type PolicyBgpMatchExtCommunitySetConfigProcessor interface {
+	PolicyBgpMatchExtCommunitySetConfig(string, string, *PolicyBgpMatchExtCommunitySetConfig) error
+}
+
+func NewPolicyBgpMatchExtCommunitySetConfig() *PolicyBgpMatchExtCommunitySetConfig {
+	return &PolicyBgpMatchExtCommunitySetConfig{
+		SrChanges:       nclib.NewSrChanges(),
+		ExtCommunitySet: "",
+		MatchSetOptions: POLICY_MATCH_SET_OPTIONS_TYPE,
+	}
+}
+
+func (c *PolicyBgpMatchExtCommunitySetConfig) String() string {
+	return fmt.Sprintf("%s{%s='%s', %s=%s} %s",
+		OC_CONFIG_KEY,
+		BGP_EXTCOMMSET_KEY, c.ExtCommunitySet,
+		POLICYMATCH_SETOPTS_KEY, c.MatchSetOptions,
+		c.SrChanges,
+	)
+}
+
+func (c *PolicyBgpMatchExtCommunitySetConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_EXTCOMMSET_KEY:
+		c.ExtCommunitySet = value
+
+	case POLICYMATCH_SETOPTS_KEY:
+		opts, err := ParsePolicyMatchSetOptionsType(value)
+		if err != nil {
+			return err
+		}
+		c.MatchSetOptions = opts
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpMatchExtCommunitySetConfig(p PolicyBgpMatchExtCommunitySetConfigProcessor, reverse bool, name string, stmtName string, config *PolicyBgpMatchExtCommunitySetConfig) error {
+	configFunc := func() error {
+		return p.PolicyBgpMatchExtCommunitySetConfig(name, stmtName, config)
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/conditions/bgp-conditions/match-as-path-set
+//
+type PolicyBgpMatchAsPathSet struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *PolicyBgpMatchAsPathSetConfig `xml:"config"`
+}
+
+type PolicyBgpMatchAsPathSetProcessor interface {
+	PolicyBgpMatchAsPathSetConfigProcessor
+}
+
+func NewPolicyBgpMatchAsPathSet() *PolicyBgpMatchAsPathSet {
+	return &PolicyBgpMatchAsPathSet{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewPolicyBgpMatchAsPathSetConfig(),
+	}
+}
+
+func (m *PolicyBgpMatchAsPathSet) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		BGP_MATCH_ASPATHSET_KEY,
+		m.Config,
+		m.SrChanges,
+	)
+}
+
+func (m *PolicyBgpMatchAsPathSet) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := m.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	m.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpMatchAsPathSet(p PolicyBgpMatchAsPathSetProcessor, reverse bool, name string, stmtName string, match *PolicyBgpMatchAsPathSet) error {
+	configFunc := func() error {
+		if match.GetChange(OC_CONFIG_KEY) {
+			return ProcessPolicyBgpMatchAsPathSetConfig(
+				p.(PolicyBgpMatchAsPathSetConfigProcessor),
+				reverse,
+				name,
+				stmtName,
+				match.Config,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/conditions/bgp-conditions/match-as-path-set/config
+//
+type PolicyBgpMatchAsPathSetConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	AsPathSet       string                    `xml:"as-path-set"`
+	MatchSetOptions PolicyMatchSetOptionsType `xml:"match-set-options"`
+}
+
+type PolicyBgpMatchAsPathSetConfigProcessor interface {
+	PolicyBgpMatchAsPathSetConfig(string, string, *PolicyBgpMatchAsPathSetConfig) error
+}
+
+func NewPolicyBgpMatchAsPathSetConfig() *PolicyBgpMatchAsPathSetConfig {
+	return &PolicyBgpMatchAsPathSetConfig{
+		SrChanges:       nclib.NewSrChanges(),
+		AsPathSet:       "",
+		MatchSetOptions: POLICY_MATCH_SET_OPTIONS_TYPE,
+	}
+}
+
+func (c *PolicyBgpMatchAsPathSetConfig) String() string {
+	return fmt.Sprintf("%s{%s='%s', %s=%s} %s",
+		OC_CONFIG_KEY,
+		BGP_ASPATHSET_KEY, c.AsPathSet,
+		POLICYMATCH_SETOPTS_KEY, c.MatchSetOptions,
+		c.SrChanges,
+	)
+}
+
+func (c *PolicyBgpMatchAsPathSetConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_ASPATHSET_KEY:
+		c.AsPathSet = value
+
+	case POLICYMATCH_SETOPTS_KEY:
+		opts, err := ParsePolicyMatchSetOptionsType(value)
+		if err != nil {
+			return err
+		}
+		c.MatchSetOptions = opts
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpMatchAsPathSetConfig(p PolicyBgpMatchAsPathSetConfigProcessor, reverse bool, name string, stmtName string, config *PolicyBgpMatchAsPathSetConfig) error {
+	configFunc := func() error {
+		return p.PolicyBgpMatchAsPathSetConfig(name, stmtName, config)
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
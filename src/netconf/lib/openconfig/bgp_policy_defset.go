@@ -0,0 +1,682 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"encoding/xml"
+	"fmt"
+	nclib "netconf/lib"
+	ncxml "netconf/lib/xml"
+)
+
+//
+// routing-policy/defined-sets/bgp-defined-sets
+//
+type PolicyBgpDefinedSets struct {
+	nclib.SrChanges `xml:"-"`
+
+	CommunitySets    PolicyBgpCommunitySets    `xml:"community-sets"`
+	ExtCommunitySets PolicyBgpExtCommunitySets `xml:"ext-community-sets"`
+	AsPathSets       PolicyBgpAsPathSets       `xml:"as-path-sets"`
+}
+
+func (d *PolicyBgpDefinedSets) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Space = BGP_POLICY_XMLNS
+	e.EncodeToken(start)
+	if err := e.Encode(d.CommunitySets); err != nil {
+		return err
+	}
+	if err := e.Encode(d.ExtCommunitySets); err != nil {
+		return err
+	}
+	if err := e.Encode(d.AsPathSets); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+type PolicyBgpDefinedSetsProcessor interface {
+	PolicyBgpCommunitySetProcessor
+	PolicyBgpExtCommunitySetProcessor
+	PolicyBgpAsPathSetProcessor
+}
+
+func NewPolicyBgpDefinedSets() *PolicyBgpDefinedSets {
+	return &PolicyBgpDefinedSets{
+		SrChanges:        nclib.NewSrChanges(),
+		CommunitySets:    NewPolicyBgpCommunitySets(),
+		ExtCommunitySets: NewPolicyBgpExtCommunitySets(),
+		AsPathSets:       NewPolicyBgpAsPathSets(),
+	}
+}
+
+func (d *PolicyBgpDefinedSets) String() string {
+	return fmt.Sprintf("%s{%s, %s, %s} %s",
+		BGP_DEFSETS_KEY,
+		d.CommunitySets,
+		d.ExtCommunitySets,
+		d.AsPathSets,
+		d.SrChanges,
+	)
+}
+
+func (d *PolicyBgpDefinedSets) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_COMMSETS_KEY:
+		if err := d.CommunitySets.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_EXTCOMMSETS_KEY:
+		if err := d.ExtCommunitySets.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_ASPATHSETS_KEY:
+		if err := d.AsPathSets.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	d.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpDefinedSets(p PolicyBgpDefinedSetsProcessor, reverse bool, sets *PolicyBgpDefinedSets) error {
+	commFunc := func() error {
+		if sets.GetChange(BGP_COMMSETS_KEY) {
+			return ProcessPolicyBgpCommunitySets(
+				p.(PolicyBgpCommunitySetProcessor),
+				reverse,
+				sets.CommunitySets,
+			)
+		}
+		return nil
+	}
+
+	extCommFunc := func() error {
+		if sets.GetChange(BGP_EXTCOMMSETS_KEY) {
+			return ProcessPolicyBgpExtCommunitySets(
+				p.(PolicyBgpExtCommunitySetProcessor),
+				reverse,
+				sets.ExtCommunitySets,
+			)
+		}
+		return nil
+	}
+
+	asPathFunc := func() error {
+		if sets.GetChange(BGP_ASPATHSETS_KEY) {
+			return ProcessPolicyBgpAsPathSets(
+				p.(PolicyBgpAsPathSetProcessor),
+				reverse,
+				sets.AsPathSets,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, commFunc, extCommFunc, asPathFunc)
+}
+
+//
+// routing-policy/defined-sets/bgp-defined-sets/community-sets
+//
+type PolicyBgpCommunitySets map[string]*PolicyBgpCommunitySet
+
+func NewPolicyBgpCommunitySets() PolicyBgpCommunitySets {
+	return PolicyBgpCommunitySets{}
+}
+
+func (s PolicyBgpCommunitySets) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	name, ok := nodes[0].Attrs[BGP_COMMSET_NAME_KEY]
+	if !ok {
+		return fmt.Errorf("%s@%s not found. %s", BGP_COMMSET_KEY, BGP_COMMSET_NAME_KEY, nodes[0])
+	}
+
+	set, ok := s[name]
+	if !ok {
+		set = NewPolicyBgpCommunitySet(name)
+		s[name] = set
+	}
+
+	return set.Put(nodes[1:], value)
+}
+
+func ProcessPolicyBgpCommunitySets(p PolicyBgpCommunitySetProcessor, reverse bool, sets PolicyBgpCommunitySets) error {
+	for name, set := range sets {
+		if err := ProcessPolicyBgpCommunitySet(p, reverse, name, set); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s PolicyBgpCommunitySets) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = BGP_COMMSETS_KEY
+	e.EncodeToken(start)
+
+	for _, set := range s {
+		err := e.EncodeElement(set, xml.StartElement{Name: xml.Name{Local: BGP_COMMSET_KEY}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+//
+// routing-policy/defined-sets/bgp-defined-sets/community-sets/community-set[community-set-name]
+//
+type PolicyBgpCommunitySet struct {
+	nclib.SrChanges `xml:"-"`
+
+	Name   string                       `xml:"community-set-name"`
+	Config *PolicyBgpCommunitySetConfig `xml:"config"`
+}
+
+type PolicyBgpCommunitySetProcessor interface {
+	PolicyBgpCommunitySet(string, *PolicyBgpCommunitySet) error
+	PolicyBgpCommunitySetConfigProcessor
+}
+
+func NewPolicyBgpCommunitySet(name string) *PolicyBgpCommunitySet {
+	return &PolicyBgpCommunitySet{
+		SrChanges: nclib.NewSrChanges(),
+		Name:      name,
+		Config:    NewPolicyBgpCommunitySetConfig(),
+	}
+}
+
+func (s *PolicyBgpCommunitySet) String() string {
+	return fmt.Sprintf("%s{%s='%s', %s} %s",
+		BGP_COMMSET_KEY,
+		BGP_COMMSET_NAME_KEY, s.Name,
+		s.Config,
+		s.SrChanges,
+	)
+}
+
+func (s *PolicyBgpCommunitySet) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_COMMSET_NAME_KEY:
+		// s.Name = value // set by NewPolicyBgpCommunitySet
+
+	case OC_CONFIG_KEY:
+		if err := s.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	s.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpCommunitySet(p PolicyBgpCommunitySetProcessor, reverse bool, name string, set *PolicyBgpCommunitySet) error {
+	setFunc := func() error {
+		if set.GetChange(BGP_COMMSET_NAME_KEY) {
+			return p.PolicyBgpCommunitySet(name, set)
+		}
+		return nil
+	}
+
+	configFunc := func() error {
+		if set.GetChange(OC_CONFIG_KEY) {
+			return ProcessPolicyBgpCommunitySetConfig(
+				p.(PolicyBgpCommunitySetConfigProcessor),
+				reverse,
+				name,
+				set.Config,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, setFunc, configFunc)
+}
+
+//
+// routing-policy/defined-sets/bgp-defined-sets/community-sets/community-set[community-set-name]/config
+//
+type PolicyBgpCommunitySetConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Name    string   `xml:"community-set-name"`
+	Members []string `xml:"community-member"`
+}
+
+type PolicyBgpCommunitySetConfigProcessor interface {
+	PolicyBgpCommunitySetConfig(string, *PolicyBgpCommunitySetConfig) error
+}
+
+func NewPolicyBgpCommunitySetConfig() *PolicyBgpCommunitySetConfig {
+	return &PolicyBgpCommunitySetConfig{
+		SrChanges: nclib.NewSrChanges(),
+		Name:      "",
+		Members:   []string{},
+	}
+}
+
+func (c *PolicyBgpCommunitySetConfig) String() string {
+	return fmt.Sprintf("%s{%s='%s', %s=%v} %s",
+		OC_CONFIG_KEY,
+		BGP_COMMSET_NAME_KEY, c.Name,
+		BGP_COMMSET_MEMBER_KEY, c.Members,
+		c.SrChanges,
+	)
+}
+
+func (c *PolicyBgpCommunitySetConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_COMMSET_NAME_KEY:
+		c.Name = value
+
+	case BGP_COMMSET_MEMBER_KEY:
+		c.Members = append(c.Members, value)
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpCommunitySetConfig(p PolicyBgpCommunitySetConfigProcessor, reverse bool, name string, config *PolicyBgpCommunitySetConfig) error {
+	configFunc := func() error {
+		return p.PolicyBgpCommunitySetConfig(name, config)
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/defined-sets/bgp-defined-sets/ext-community-sets
+//
+type PolicyBgpExtCommunitySets map[string]*PolicyBgpExtCommunitySet
+
+func NewPolicyBgpExtCommunitySets() PolicyBgpExtCommunitySets {
+	return PolicyBgpExtCommunitySets{}
+}
+
+func (s PolicyBgpExtCommunitySets) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	name, ok := nodes[0].Attrs[BGP_EXTCOMMSET_NAME_KEY]
+	if !ok {
+		return fmt.Errorf("%s@%s not found. %s", BGP_EXTCOMMSET_KEY, BGP_EXTCOMMSET_NAME_KEY, nodes[0])
+	}
+
+	set, ok := s[name]
+	if !ok {
+		set = NewPolicyBgpExtCommunitySet(name)
+		s[name] = set
+	}
+
+	return set.Put(nodes[1:], value)
+}
+
+func ProcessPolicyBgpExtCommunitySets(p PolicyBgpExtCommunitySetProcessor, reverse bool, sets PolicyBgpExtCommunitySets) error {
+	for name, set := range sets {
+		if err := ProcessPolicyBgpExtCommunitySet(p, reverse, name, set); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s PolicyBgpExtCommunitySets) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = BGP_EXTCOMMSETS_KEY
+	e.EncodeToken(start)
+
+	for _, set := range s {
+		err := e.EncodeElement(set, xml.StartElement{Name: xml.Name{Local: BGP_EXTCOMMSET_KEY}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+//
+// routing-policy/defined-sets/bgp-defined-sets/ext-community-sets/ext-community-set[ext-community-set-name]
+//
+type PolicyBgpExtCommunitySet struct {
+	nclib.SrChanges `xml:"-"`
+
+	Name   string                          `xml:"ext-community-set-name"`
+	Config *PolicyBgpExtCommunitySetConfig `xml:"config"`
+}
+
+type PolicyBgpExtCommunitySetProcessor interface {
+	PolicyBgpExtCommunitySet(string, *PolicyBgpExtCommunitySet) error
+	PolicyBgpExtCommunitySetConfigProcessor
+}
+
+func NewPolicyBgpExtCommunitySet(name string) *PolicyBgpExtCommunitySet {
+	return &PolicyBgpExtCommunitySet{
+		SrChanges: nclib.NewSrChanges(),
+		Name:      name,
+		Config:    NewPolicyBgpExtCommunitySetConfig(),
+	}
+}
+
+func (s *PolicyBgpExtCommunitySet) String() string {
+	return fmt.Sprintf("%s{%s='%s', %s} %s",
+		BGP_EXTCOMMSET_KEY,
+		BGP_EXTCOMMSET_NAME_KEY, s.Name,
+		s.Config,
+		s.SrChanges,
+	)
+}
+
+func (s *PolicyBgpExtCommunitySet) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_EXTCOMMSET_NAME_KEY:
+		// s.Name = value // set by NewPolicyBgpExtCommunitySet
+
+	case OC_CONFIG_KEY:
+		if err := s.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	s.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpExtCommunitySet(p PolicyBgpExtCommunitySetProcessor, reverse bool, name string, set *PolicyBgpExtCommunitySet) error {
+	setFunc := func() error {
+		if set.GetChange(BGP_EXTCOMMSET_NAME_KEY) {
+			return p.PolicyBgpExtCommunitySet(name, set)
+		}
+		return nil
+	}
+
+	configFunc := func() error {
+		if set.GetChange(OC_CONFIG_KEY) {
+			return ProcessPolicyBgpExtCommunitySetConfig(
+				p.(PolicyBgpExtCommunitySetConfigProcessor),
+				reverse,
+				name,
+				set.Config,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, setFunc, configFunc)
+}
+
+//
+// routing-policy/defined-sets/bgp-defined-sets/ext-community-sets/ext-community-set[ext-community-set-name]/config
+//
+type PolicyBgpExtCommunitySetConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Name    string   `xml:"ext-community-set-name"`
+	Members []string `xml:"ext-community-member"`
+}
+
+type PolicyBgpExtCommunitySetConfigProcessor interface {
+	PolicyBgpExtCommunitySetConfig(string, *PolicyBgpExtCommunitySetConfig) error
+}
+
+func NewPolicyBgpExtCommunitySetConfig() *PolicyBgpExtCommunitySetConfig {
+	return &PolicyBgpExtCommunitySetConfig{
+		SrChanges: nclib.NewSrChanges(),
+		Name:      "",
+		Members:   []string{},
+	}
+}
+
+func (c *PolicyBgpExtCommunitySetConfig) String() string {
+	return fmt.Sprintf("%s{%s='%s', %s=%v} %s",
+		OC_CONFIG_KEY,
+		BGP_EXTCOMMSET_NAME_KEY, c.Name,
+		BGP_EXTCOMMSET_MEMBER_KEY, c.Members,
+		c.SrChanges,
+	)
+}
+
+func (c *PolicyBgpExtCommunitySetConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_EXTCOMMSET_NAME_KEY:
+		c.Name = value
+
+	case BGP_EXTCOMMSET_MEMBER_KEY:
+		c.Members = append(c.Members, value)
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpExtCommunitySetConfig(p PolicyBgpExtCommunitySetConfigProcessor, reverse bool, name string, config *PolicyBgpExtCommunitySetConfig) error {
+	configFunc := func() error {
+		return p.PolicyBgpExtCommunitySetConfig(name, config)
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/defined-sets/bgp-defined-sets/as-path-sets
+//
+type PolicyBgpAsPathSets map[string]*PolicyBgpAsPathSet
+
+func NewPolicyBgpAsPathSets() PolicyBgpAsPathSets {
+	return PolicyBgpAsPathSets{}
+}
+
+func (s PolicyBgpAsPathSets) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	name, ok := nodes[0].Attrs[BGP_ASPATHSET_NAME_KEY]
+	if !ok {
+		return fmt.Errorf("%s@%s not found. %s", BGP_ASPATHSET_KEY, BGP_ASPATHSET_NAME_KEY, nodes[0])
+	}
+
+	set, ok := s[name]
+	if !ok {
+		set = NewPolicyBgpAsPathSet(name)
+		s[name] = set
+	}
+
+	return set.Put(nodes[1:], value)
+}
+
+func ProcessPolicyBgpAsPathSets(p PolicyBgpAsPathSetProcessor, reverse bool, sets PolicyBgpAsPathSets) error {
+	for name, set := range sets {
+		if err := ProcessPolicyBgpAsPathSet(p, reverse, name, set); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s PolicyBgpAsPathSets) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = BGP_ASPATHSETS_KEY
+	e.EncodeToken(start)
+
+	for _, set := range s {
+		err := e.EncodeElement(set, xml.StartElement{Name: xml.Name{Local: BGP_ASPATHSET_KEY}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+//
+// routing-policy/defined-sets/bgp-defined-sets/as-path-sets/as-path-set[as-path-set-name]
+//
+type PolicyBgpAsPathSet struct {
+	nclib.SrChanges `xml:"-"`
+
+	Name   string                    `xml:"as-path-set-name"`
+	Config *PolicyBgpAsPathSetConfig `xml:"config"`
+}
+
+type PolicyBgpAsPathSetProcessor interface {
+	PolicyBgpAsPathSet(string, *PolicyBgpAsPathSet) error
+	PolicyBgpAsPathSetConfigProcessor
+}
+
+func NewPolicyBgpAsPathSet(name string) *PolicyBgpAsPathSet {
+	return &PolicyBgpAsPathSet{
+		SrChanges: nclib.NewSrChanges(),
+		Name:      name,
+		Config:    NewPolicyBgpAsPathSetConfig(),
+	}
+}
+
+func (s *PolicyBgpAsPathSet) String() string {
+	return fmt.Sprintf("%s{%s='%s', %s} %s",
+		BGP_ASPATHSET_KEY,
+		BGP_ASPATHSET_NAME_KEY, s.Name,
+		s.Config,
+		s.SrChanges,
+	)
+}
+
+func (s *PolicyBgpAsPathSet) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_ASPATHSET_NAME_KEY:
+		// s.Name = value // set by NewPolicyBgpAsPathSet
+
+	case OC_CONFIG_KEY:
+		if err := s.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	s.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpAsPathSet(p PolicyBgpAsPathSetProcessor, reverse bool, name string, set *PolicyBgpAsPathSet) error {
+	setFunc := func() error {
+		if set.GetChange(BGP_ASPATHSET_NAME_KEY) {
+			return p.PolicyBgpAsPathSet(name, set)
+		}
+		return nil
+	}
+
+	configFunc := func() error {
+		if set.GetChange(OC_CONFIG_KEY) {
+			return ProcessPolicyBgpAsPathSetConfig(
+				p.(PolicyBgpAsPathSetConfigProcessor),
+				reverse,
+				name,
+				set.Config,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, setFunc, configFunc)
+}
+
+//
+// routing-policy/defined-sets/bgp-defined-sets/as-path-sets/as-path-set[as-path-set-name]/config
+//
+type PolicyBgpAsPathSetConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Name    string   `xml:"as-path-set-name"`
+	Members []string `xml:"as-path-set-member"`
+}
+
+type PolicyBgpAsPathSetConfigProcessor interface {
+	PolicyBgpAsPathSetConfig(string, *PolicyBgpAsPathSetConfig) error
+}
+
+func NewPolicyBgpAsPathSetConfig() *PolicyBgpAsPathSetConfig {
+	return &PolicyBgpAsPathSetConfig{
+		SrChanges: nclib.NewSrChanges(),
+		Name:      "",
+		Members:   []string{},
+	}
+}
+
+func (c *PolicyBgpAsPathSetConfig) String() string {
+	return fmt.Sprintf("%s{%s='%s', %s=%v} %s",
+		OC_CONFIG_KEY,
+		BGP_ASPATHSET_NAME_KEY, c.Name,
+		BGP_ASPATHSET_MEMBER_KEY, c.Members,
+		c.SrChanges,
+	)
+}
+
+func (c *PolicyBgpAsPathSetConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case BGP_ASPATHSET_NAME_KEY:
+		c.Name = value
+
+	case BGP_ASPATHSET_MEMBER_KEY:
+		c.Members = append(c.Members, value)
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyBgpAsPathSetConfig(p PolicyBgpAsPathSetConfigProcessor, reverse bool, name string, config *PolicyBgpAsPathSetConfig) error {
+	configFunc := func() error {
+		return p.PolicyBgpAsPathSetConfig(name, config)
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
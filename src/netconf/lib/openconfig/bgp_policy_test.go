@@ -114,3 +114,66 @@ func TestBgpActions_config_set_nexthop_self_error(t *testing.T) {
 		t.Errorf("BgpActions_put must be error. %s", err)
 	}
 }
+
+func TestBgpActions_config_set_med(t *testing.T) {
+	a := makeBgpActions([][2]string{
+		{"/beluganos-bgp-policy:bgp-actions/config/set-med", "-20"},
+	})
+
+	if v := a.Config.Compare("set-med"); !v {
+		t.Errorf("BgpActions_put unmatch. config.compare=%t", v)
+	}
+	if v := a.Config.SetMed; v != "-20" {
+		t.Errorf("BgpActions_put unmatch. config.set-med=%s", v)
+	}
+}
+
+func TestBgpActions_config_set_med_error(t *testing.T) {
+	xpath := "/beluganos-bgp-policy:bgp-actions/config/set-med"
+	for _, value := range []string{"", "+", "*10", "abc"} {
+		a := NewPolicyBgpActions()
+		nodes := srlib.ParseXPath(xpath)
+
+		if err := a.Put(nodes[1:], value); err == nil {
+			t.Errorf("BgpActions_put must be error. %s", value)
+		}
+	}
+}
+
+func TestBgpActions_set_as_path_prepend(t *testing.T) {
+	a := makeBgpActions([][2]string{
+		{"/beluganos-bgp-policy:bgp-actions/set-as-path-prepend/config/repeat-n", "2"},
+		{"/beluganos-bgp-policy:bgp-actions/set-as-path-prepend/config/asn", "65002"},
+	})
+
+	if v := a.Compare("set-as-path-prepend"); !v {
+		t.Errorf("BgpActions_put unmatch. compare=%t", v)
+	}
+	if v := a.SetAsPathPrepend.Config.RepeatN; v != 2 {
+		t.Errorf("BgpActions_put unmatch. repeat-n=%d", v)
+	}
+	if v := a.SetAsPathPrepend.Config.Asn; v != 65002 {
+		t.Errorf("BgpActions_put unmatch. asn=%d", v)
+	}
+}
+
+func TestBgpActions_set_community_reference(t *testing.T) {
+	a := makeBgpActions([][2]string{
+		{"/beluganos-bgp-policy:bgp-actions/set-community/config/method", "REFERENCE"},
+		{"/beluganos-bgp-policy:bgp-actions/set-community/config/options", "REMOVE"},
+		{"/beluganos-bgp-policy:bgp-actions/set-community/reference/config/community-set-ref", "cs1"},
+	})
+
+	if v := a.SetCommunity.Compare("config", "reference"); !v {
+		t.Errorf("BgpActions_put unmatch. compare=%t", v)
+	}
+	if v := a.SetCommunity.Config.Method; v != BGP_SET_COMMUNITY_METHOD_REFERENCE {
+		t.Errorf("BgpActions_put unmatch. method=%s", v)
+	}
+	if v := a.SetCommunity.Config.Options; v != BGP_SET_COMMUNITY_OPTION_REMOVE {
+		t.Errorf("BgpActions_put unmatch. options=%s", v)
+	}
+	if v := a.SetCommunity.Reference.Config.CommunitySetRef; v != "cs1" {
+		t.Errorf("BgpActions_put unmatch. community-set-ref=%s", v)
+	}
+}
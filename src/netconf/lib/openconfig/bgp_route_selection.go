@@ -0,0 +1,167 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"fmt"
+	nclib "netconf/lib"
+	ncxml "netconf/lib/xml"
+	"strconv"
+)
+
+const (
+	BGP_ROUTE_SELECTION_KEY           = "route-selection-options"
+	BGP_ALWAYS_COMPARE_MED_KEY        = "always-compare-med"
+	BGP_IGNORE_AS_PATH_LEN_KEY        = "ignore-as-path-length"
+	BGP_EXT_COMPARE_ROUTERID_KEY      = "external-compare-router-id"
+	BGP_ADVERTISE_INACTIVE_KEY        = "advertise-inactive-routes"
+	BGP_ENABLE_AIGP_KEY               = "enable-aigp"
+	BGP_IGNORE_NEXTHOP_IGP_METRIC_KEY = "ignore-next-hop-igp-metric"
+)
+
+//
+// global/route-selection-options
+//
+type BgpRouteSelectionOptions struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *BgpRouteSelectionOptionsConfig `xml:"config"`
+}
+
+type BgpGlobalRouteSelectionOptionsProcessor interface {
+	BgpGlobalRouteSelectionOptionsConfig(string, *NetworkInstanceProtocolKey, *BgpRouteSelectionOptionsConfig) error
+}
+
+func NewBgpRouteSelectionOptions() *BgpRouteSelectionOptions {
+	return &BgpRouteSelectionOptions{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewBgpRouteSelectionOptionsConfig(),
+	}
+}
+
+func (b *BgpRouteSelectionOptions) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		BGP_ROUTE_SELECTION_KEY,
+		b.Config,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpRouteSelectionOptions) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := b.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessBgpGlobalRouteSelectionOptions(p BgpGlobalRouteSelectionOptionsProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, opts *BgpRouteSelectionOptions) error {
+	configFunc := func() error {
+		if opts.GetChange(OC_CONFIG_KEY) {
+			return p.BgpGlobalRouteSelectionOptionsConfig(name, key, opts.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// global/route-selection-options/config
+//
+type BgpRouteSelectionOptionsConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	AlwaysCompareMed        bool `xml:"always-compare-med"`
+	IgnoreAsPathLength      bool `xml:"ignore-as-path-length"`
+	ExternalCompareRouterId bool `xml:"external-compare-router-id"`
+	AdvertiseInactiveRoutes bool `xml:"advertise-inactive-routes"`
+	EnableAigp              bool `xml:"enable-aigp"`
+	IgnoreNextHopIgpMetric  bool `xml:"ignore-next-hop-igp-metric"`
+}
+
+func NewBgpRouteSelectionOptionsConfig() *BgpRouteSelectionOptionsConfig {
+	return &BgpRouteSelectionOptionsConfig{
+		SrChanges:               nclib.NewSrChanges(),
+		AlwaysCompareMed:        false,
+		IgnoreAsPathLength:      false,
+		ExternalCompareRouterId: true,
+		AdvertiseInactiveRoutes: false,
+		EnableAigp:              false,
+		IgnoreNextHopIgpMetric:  false,
+	}
+}
+
+func (b *BgpRouteSelectionOptionsConfig) String() string {
+	return fmt.Sprintf("%s{%s=%t, %s=%t, %s=%t, %s=%t, %s=%t, %s=%t} %s",
+		OC_CONFIG_KEY,
+		BGP_ALWAYS_COMPARE_MED_KEY, b.AlwaysCompareMed,
+		BGP_IGNORE_AS_PATH_LEN_KEY, b.IgnoreAsPathLength,
+		BGP_EXT_COMPARE_ROUTERID_KEY, b.ExternalCompareRouterId,
+		BGP_ADVERTISE_INACTIVE_KEY, b.AdvertiseInactiveRoutes,
+		BGP_ENABLE_AIGP_KEY, b.EnableAigp,
+		BGP_IGNORE_NEXTHOP_IGP_METRIC_KEY, b.IgnoreNextHopIgpMetric,
+		b.SrChanges,
+	)
+}
+
+func (b *BgpRouteSelectionOptionsConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	var v *bool
+	switch nodes[0].Name {
+	case BGP_ALWAYS_COMPARE_MED_KEY:
+		v = &b.AlwaysCompareMed
+
+	case BGP_IGNORE_AS_PATH_LEN_KEY:
+		v = &b.IgnoreAsPathLength
+
+	case BGP_EXT_COMPARE_ROUTERID_KEY:
+		v = &b.ExternalCompareRouterId
+
+	case BGP_ADVERTISE_INACTIVE_KEY:
+		v = &b.AdvertiseInactiveRoutes
+
+	case BGP_ENABLE_AIGP_KEY:
+		v = &b.EnableAigp
+
+	case BGP_IGNORE_NEXTHOP_IGP_METRIC_KEY:
+		v = &b.IgnoreNextHopIgpMetric
+	}
+
+	if v != nil {
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		*v = enabled
+	}
+
+	b.SetChange(nodes[0].Name)
+	return nil
+}
@@ -22,6 +22,8 @@ import (
 	"fmt"
 	"net"
 	ncxml "netconf/lib/xml"
+	"strconv"
+	"strings"
 )
 
 type BgpNexthopType string
@@ -145,3 +147,155 @@ func (b BgpAfiSafiType) MarshalXML(e *xml.Encoder, start xml.StartElement) error
 	start.Attr = append(start.Attr, attr)
 	return e.EncodeElement(text, start)
 }
+
+type BgpOriginAttrType int
+
+const (
+	BGP_ORIGIN_ATTR_TYPE BgpOriginAttrType = iota
+	BGP_ORIGIN_ATTR_IGP
+	BGP_ORIGIN_ATTR_EGP
+	BGP_ORIGIN_ATTR_INCOMPLETE
+)
+
+var bgpOriginAttrTypeNames = map[BgpOriginAttrType]string{
+	BGP_ORIGIN_ATTR_TYPE:       "BGP_ORIGIN_ATTR_TYPE",
+	BGP_ORIGIN_ATTR_IGP:        "IGP",
+	BGP_ORIGIN_ATTR_EGP:        "EGP",
+	BGP_ORIGIN_ATTR_INCOMPLETE: "INCOMPLETE",
+}
+
+var bgpOriginAttrTypeValues = map[string]BgpOriginAttrType{
+	"BGP_ORIGIN_ATTR_TYPE": BGP_ORIGIN_ATTR_TYPE,
+	"IGP":                  BGP_ORIGIN_ATTR_IGP,
+	"EGP":                  BGP_ORIGIN_ATTR_EGP,
+	"INCOMPLETE":           BGP_ORIGIN_ATTR_INCOMPLETE,
+}
+
+func (v BgpOriginAttrType) String() string {
+	if s, ok := bgpOriginAttrTypeNames[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("BgpOriginAttrType(%d)", v)
+}
+
+func ParseBgpOriginAttrType(s string) (BgpOriginAttrType, error) {
+	if v, ok := bgpOriginAttrTypeValues[s]; ok {
+		return v, nil
+	}
+	return BGP_ORIGIN_ATTR_TYPE, fmt.Errorf("Invalid BgpOriginAttrType. %s", s)
+}
+
+type BgpSetMedType string
+
+const (
+	BGP_SET_MED_TYPE BgpSetMedType = "BGP_SET_MED_TYPE"
+	BGP_SET_MED_IGP  BgpSetMedType = "IGP"
+)
+
+func ParseBgpSetMedType(s string) (BgpSetMedType, error) {
+	_, ss := ncxml.ParseXPathName(s)
+	b := BgpSetMedType(ss)
+	if _, _, err := b.Values(); err != nil {
+		return BGP_SET_MED_TYPE, err
+	}
+	return b, nil
+}
+
+//
+// Values returns med value and operator.
+// operator is "+" or "-" if med is relative value,
+// "" if med is absolute value.
+//
+func (b BgpSetMedType) Values() (uint32, string, error) {
+	if b == BGP_SET_MED_IGP {
+		return 0, "", nil
+	}
+
+	s := string(b)
+	op := ""
+	if strings.HasPrefix(s, "+") || strings.HasPrefix(s, "-") {
+		op, s = s[:1], s[1:]
+	}
+
+	med, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, "", fmt.Errorf("Invalid Bgp Set Med. %s", b)
+	}
+
+	return uint32(med), op, nil
+}
+
+func (b BgpSetMedType) String() string {
+	return string(b)
+}
+
+type BgpSetCommunityOptionType int
+
+const (
+	BGP_SET_COMMUNITY_OPTION_TYPE BgpSetCommunityOptionType = iota
+	BGP_SET_COMMUNITY_OPTION_ADD
+	BGP_SET_COMMUNITY_OPTION_REMOVE
+	BGP_SET_COMMUNITY_OPTION_REPLACE
+)
+
+var bgpSetCommunityOptionTypeNames = map[BgpSetCommunityOptionType]string{
+	BGP_SET_COMMUNITY_OPTION_TYPE:    "BGP_SET_COMMUNITY_OPTION_TYPE",
+	BGP_SET_COMMUNITY_OPTION_ADD:     "ADD",
+	BGP_SET_COMMUNITY_OPTION_REMOVE:  "REMOVE",
+	BGP_SET_COMMUNITY_OPTION_REPLACE: "REPLACE",
+}
+
+var bgpSetCommunityOptionTypeValues = map[string]BgpSetCommunityOptionType{
+	"BGP_SET_COMMUNITY_OPTION_TYPE": BGP_SET_COMMUNITY_OPTION_TYPE,
+	"ADD":                           BGP_SET_COMMUNITY_OPTION_ADD,
+	"REMOVE":                        BGP_SET_COMMUNITY_OPTION_REMOVE,
+	"REPLACE":                       BGP_SET_COMMUNITY_OPTION_REPLACE,
+}
+
+func (v BgpSetCommunityOptionType) String() string {
+	if s, ok := bgpSetCommunityOptionTypeNames[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("BgpSetCommunityOptionType(%d)", v)
+}
+
+func ParseBgpSetCommunityOptionType(s string) (BgpSetCommunityOptionType, error) {
+	if v, ok := bgpSetCommunityOptionTypeValues[s]; ok {
+		return v, nil
+	}
+	return BGP_SET_COMMUNITY_OPTION_TYPE, fmt.Errorf("Invalid BgpSetCommunityOptionType. %s", s)
+}
+
+type BgpSetCommunityMethodType int
+
+const (
+	BGP_SET_COMMUNITY_METHOD_TYPE BgpSetCommunityMethodType = iota
+	BGP_SET_COMMUNITY_METHOD_INLINE
+	BGP_SET_COMMUNITY_METHOD_REFERENCE
+)
+
+var bgpSetCommunityMethodTypeNames = map[BgpSetCommunityMethodType]string{
+	BGP_SET_COMMUNITY_METHOD_TYPE:      "BGP_SET_COMMUNITY_METHOD_TYPE",
+	BGP_SET_COMMUNITY_METHOD_INLINE:    "INLINE",
+	BGP_SET_COMMUNITY_METHOD_REFERENCE: "REFERENCE",
+}
+
+var bgpSetCommunityMethodTypeValues = map[string]BgpSetCommunityMethodType{
+	"BGP_SET_COMMUNITY_METHOD_TYPE": BGP_SET_COMMUNITY_METHOD_TYPE,
+	"INLINE":                        BGP_SET_COMMUNITY_METHOD_INLINE,
+	"REFERENCE":                     BGP_SET_COMMUNITY_METHOD_REFERENCE,
+}
+
+func (v BgpSetCommunityMethodType) String() string {
+	if s, ok := bgpSetCommunityMethodTypeNames[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("BgpSetCommunityMethodType(%d)", v)
+}
+
+func ParseBgpSetCommunityMethodType(s string) (BgpSetCommunityMethodType, error) {
+	if v, ok := bgpSetCommunityMethodTypeValues[s]; ok {
+		return v, nil
+	}
+	return BGP_SET_COMMUNITY_METHOD_TYPE, fmt.Errorf("Invalid BgpSetCommunityMethodType. %s", s)
+}
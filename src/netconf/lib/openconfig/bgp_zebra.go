@@ -89,7 +89,7 @@ type BgpZebraConfig struct {
 	Enabled      bool                  `xml:"enabled"`
 	Version      uint32                `xml:"version"`
 	Url          string                `xml:"url"`
-	RedistRoutes []InstallProtocolType `xml:"redistribute"`
+	RedistRoutes []InstallProtocolType `xml:"redistribute" yang:"redistribute-routes"`
 }
 
 type BgpZebraConfigProcessor interface {
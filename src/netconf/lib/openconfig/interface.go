@@ -18,6 +18,7 @@
 package openconfig
 
 import (
+	"encoding/json"
 	"encoding/xml"
 	"fmt"
 	nclib "netconf/lib"
@@ -27,17 +28,30 @@ import (
 )
 
 const (
-	INTERFACES_XMLNS          = "https://github.com/beluganos/beluganos/yang/interfaces"
-	INTERFACES_MODULE         = "beluganos-interfaces"
-	INTERFACES_KEY            = "interfaces"
-	INTERFACE_KEY             = "interface"
-	INTERFACE_TYPE_KEY        = "type"
-	INTERFACE_MTU_KEY         = "mtu"
-	INTERFACE_ID_KEY          = "interface-id"
-	INTERFACE_REF_KEY         = "interface-ref"
-	INTERFACE_ETH_MODULE      = "beluganos-if-ethernet"
-	INTERFACE_ETH_KEY         = "ethernet"
-	INTERFACE_ETH_MACADDR_KEY = "mac-address"
+	INTERFACES_XMLNS                   = "https://github.com/beluganos/beluganos/yang/interfaces"
+	INTERFACES_MODULE                  = "beluganos-interfaces"
+	INTERFACES_KEY                     = "interfaces"
+	INTERFACE_KEY                      = "interface"
+	INTERFACE_TYPE_KEY                 = "type"
+	INTERFACE_MTU_KEY                  = "mtu"
+	INTERFACE_ID_KEY                   = "interface-id"
+	INTERFACE_REF_KEY                  = "interface-ref"
+	INTERFACE_ETH_MODULE               = "beluganos-if-ethernet"
+	INTERFACE_ETH_KEY                  = "ethernet"
+	INTERFACE_ETH_MACADDR_KEY          = "mac-address"
+	INTERFACE_AGG_MODULE               = "beluganos-if-aggregate"
+	INTERFACE_AGG_KEY                  = "aggregation"
+	INTERFACE_AGG_LAGTYPE_KEY          = "lag-type"
+	INTERFACE_AGG_MINLINKS_KEY         = "min-links"
+	INTERFACE_AGG_ID_KEY               = "aggregate-id"
+	INTERFACE_BRIDGE_MODULE            = "beluganos-if-bridge"
+	INTERFACE_BRIDGE_KEY               = "bridge"
+	INTERFACE_BRIDGE_MEMBER_KEY        = "member"
+	INTERFACE_BRIDGE_STP_KEY           = "stp"
+	INTERFACE_BRIDGE_PRIORITY_KEY      = "priority"
+	INTERFACE_BRIDGE_FORWARD_DELAY_KEY = "forward-delay"
+	INTERFACE_BRIDGE_HELLO_TIME_KEY    = "hello-time"
+	INTERFACE_BRIDGE_MAX_AGE_KEY       = "max-age"
 )
 
 //
@@ -92,16 +106,47 @@ func (i Interfaces) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	return e.EncodeToken(start.End())
 }
 
+func (i *Interfaces) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if *i == nil {
+		*i = NewInterfaces()
+	}
+	return ncxml.DecodeXPath(d, start, *i)
+}
+
+func (i Interfaces) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newOcListRoot(INTERFACES_MODULE, INTERFACES_KEY, i))
+}
+
+func (i *Interfaces) UnmarshalJSON(data []byte) error {
+	if *i == nil {
+		*i = NewInterfaces()
+	}
+	return decodeJSON(data, INTERFACES_KEY, *i)
+}
+
+func (i Interfaces) MarshalYAML() (interface{}, error) {
+	return newOcListRoot(INTERFACES_MODULE, INTERFACES_KEY, i).MarshalYAML()
+}
+
+func (i *Interfaces) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if *i == nil {
+		*i = NewInterfaces()
+	}
+	return decodeYAML(unmarshal, INTERFACES_KEY, *i)
+}
+
 //
 // /interfaces/interface[name]
 //
 type Interface struct {
 	nclib.SrChanges `xml:"-"`
 
-	Name          string             `xml:"name"`
-	Config        *InterfaceConfig   `xml:"config"`
-	Ethernet      *InterfaceEthernet `xml:"ethernet"`
-	Subinterfaces Subinterfaces      `xml:"subinterfaces"`
+	Name          string                `xml:"name"`
+	Config        *InterfaceConfig      `xml:"config"`
+	Ethernet      *InterfaceEthernet    `xml:"ethernet"`
+	Aggregation   *InterfaceAggregation `xml:"aggregation"`
+	Bridge        *InterfaceBridge      `xml:"bridge"`
+	Subinterfaces Subinterfaces         `xml:"subinterfaces"`
 }
 
 type interfaceProcessor interface {
@@ -112,6 +157,8 @@ type InterfaceProcessor interface {
 	interfaceProcessor
 	InterfaceConfigProcessor
 	InterfaceEthernetProcessor
+	InterfaceAggregationProcessor
+	InterfaceBridgeProcessor
 	SubinterfaceProcessor
 }
 
@@ -121,16 +168,20 @@ func NewInterface(name string) *Interface {
 		Name:          name,
 		Config:        NewInterfaceConfig(),
 		Ethernet:      NewInterfaceEthernet(),
+		Aggregation:   NewInterfaceAggregation(),
+		Bridge:        NewInterfaceBridge(),
 		Subinterfaces: NewSubinterfaces(),
 	}
 }
 
 func (i *Interface) String() string {
-	return fmt.Sprintf("%s{%s:'%s', %s, %s} %s",
+	return fmt.Sprintf("%s{%s:'%s', %s, %s, %s, %s} %s",
 		INTERFACE_KEY,
 		OC_NAME_KEY, i.Name,
 		i.Config,
 		i.Ethernet,
+		i.Aggregation,
+		i.Bridge,
 		i.SrChanges,
 	)
 }
@@ -151,6 +202,16 @@ func (i *Interface) SetEthernet(ethernet *InterfaceEthernet) {
 	i.SetChange(INTERFACE_ETH_KEY)
 }
 
+func (i *Interface) SetAggregation(aggregation *InterfaceAggregation) {
+	i.Aggregation = aggregation
+	i.SetChange(INTERFACE_AGG_KEY)
+}
+
+func (i *Interface) SetBridge(bridge *InterfaceBridge) {
+	i.Bridge = bridge
+	i.SetChange(INTERFACE_BRIDGE_KEY)
+}
+
 func (i *Interface) SetSubinterfaces(subifs Subinterfaces) {
 	i.Subinterfaces = subifs
 	i.SetChange(SUBINTERFACES_KEY)
@@ -175,6 +236,16 @@ func (i *Interface) Put(nodes []*ncxml.XPathNode, value string) error {
 			return err
 		}
 
+	case INTERFACE_AGG_KEY:
+		if err := i.Aggregation.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case INTERFACE_BRIDGE_KEY:
+		if err := i.Bridge.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
 	case SUBINTERFACES_KEY:
 		if err := i.Subinterfaces.Put(nodes[1:], value); err != nil {
 			return err
@@ -217,6 +288,30 @@ func ProcessInterface(p interfaceProcessor, reverse bool, name string, iface *In
 		return nil
 	}
 
+	ifAggregation := func() error {
+		if iface.GetChange(INTERFACE_AGG_KEY) {
+			return ProcessInterfaceAggregation(
+				p.(InterfaceAggregationProcessor),
+				reverse,
+				name,
+				iface.Aggregation,
+			)
+		}
+		return nil
+	}
+
+	ifBridge := func() error {
+		if iface.GetChange(INTERFACE_BRIDGE_KEY) {
+			return ProcessInterfaceBridge(
+				p.(InterfaceBridgeProcessor),
+				reverse,
+				name,
+				iface.Bridge,
+			)
+		}
+		return nil
+	}
+
 	subifChange := func() error {
 		if iface.GetChange(SUBINTERFACES_KEY) {
 			return ProcessSubinterfaces(
@@ -229,7 +324,7 @@ func ProcessInterface(p interfaceProcessor, reverse bool, name string, iface *In
 		return nil
 	}
 
-	return nclib.CallFunctions(reverse, ifName, ifConfig, ifEthernet, subifChange)
+	return nclib.CallFunctions(reverse, ifName, ifConfig, ifEthernet, ifAggregation, ifBridge, subifChange)
 }
 
 //
@@ -0,0 +1,205 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"encoding/xml"
+	"fmt"
+	nclib "netconf/lib"
+	ncxml "netconf/lib/xml"
+	"strconv"
+)
+
+//
+// LAG type (lag-type)
+//
+type AggregationType int
+
+const (
+	AGGREGATION_TYPE AggregationType = iota
+	AGGREGATION_LACP
+	AGGREGATION_STATIC
+)
+
+var aggregationTypeNames = map[AggregationType]string{
+	AGGREGATION_TYPE:   "AGGREGATION_TYPE",
+	AGGREGATION_LACP:   "LACP",
+	AGGREGATION_STATIC: "STATIC",
+}
+
+var aggregationTypeValues = map[string]AggregationType{
+	"AGGREGATION_TYPE": AGGREGATION_TYPE,
+	"LACP":             AGGREGATION_LACP,
+	"STATIC":           AGGREGATION_STATIC,
+}
+
+func (v AggregationType) String() string {
+	if s, ok := aggregationTypeNames[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("AggregationType(%d)", v)
+}
+
+func ParseAggregationType(s string) (AggregationType, error) {
+	_, name := ncxml.ParseXPathName(s)
+	if v, ok := aggregationTypeValues[name]; ok {
+		return v, nil
+	}
+	return AGGREGATION_TYPE, fmt.Errorf("Invalid AggregationType. %s", s)
+}
+
+//
+// interface[name]/aggregation
+//
+type InterfaceAggregation struct {
+	nclib.SrChanges `xml:"-"`
+
+	XMLName xml.Name                    `xml:"https://github.com/beluganos/beluganos/yang/interfaces/aggregate aggregation"`
+	Config  *InterfaceAggregationConfig `xml:"config"`
+}
+
+type InterfaceAggregationProcessor interface {
+	InterfaceAggregationConfigProcessor
+}
+
+func NewInterfaceAggregation() *InterfaceAggregation {
+	return &InterfaceAggregation{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewInterfaceAggregationConfig(),
+	}
+}
+
+func (i *InterfaceAggregation) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		INTERFACE_AGG_KEY,
+		i.Config,
+		i.SrChanges,
+	)
+}
+
+func (i *InterfaceAggregation) SetConfig(config *InterfaceAggregationConfig) {
+	i.Config = config
+	i.SetChange(OC_CONFIG_KEY)
+}
+
+func (i *InterfaceAggregation) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := i.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	i.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessInterfaceAggregation(p InterfaceAggregationProcessor, reverse bool, name string, aggregation *InterfaceAggregation) error {
+
+	configFunc := func() error {
+		if aggregation.GetChange(OC_CONFIG_KEY) {
+			return ProcessInterfaceAggregationConfig(
+				p.(InterfaceAggregationConfigProcessor),
+				reverse,
+				name,
+				aggregation.Config,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// interface[name]/aggregation/config
+//
+type InterfaceAggregationConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	LagType  AggregationType `xml:"lag-type"`
+	MinLinks uint16          `xml:"min-links"`
+}
+
+type InterfaceAggregationConfigProcessor interface {
+	InterfaceAggregationConfig(string, *InterfaceAggregationConfig) error
+}
+
+func NewInterfaceAggregationConfig() *InterfaceAggregationConfig {
+	return &InterfaceAggregationConfig{
+		SrChanges: nclib.NewSrChanges(),
+		LagType:   AGGREGATION_LACP,
+		MinLinks:  0,
+	}
+}
+
+func (c *InterfaceAggregationConfig) String() string {
+	return fmt.Sprintf("%s{%s=%s, %s=%d} %s",
+		OC_CONFIG_KEY,
+		INTERFACE_AGG_LAGTYPE_KEY, c.LagType,
+		INTERFACE_AGG_MINLINKS_KEY, c.MinLinks,
+		c.SrChanges,
+	)
+}
+
+func (c *InterfaceAggregationConfig) SetLagType(lagType AggregationType) {
+	c.LagType = lagType
+	c.SetChange(INTERFACE_AGG_LAGTYPE_KEY)
+}
+
+func (c *InterfaceAggregationConfig) SetMinLinks(minLinks uint16) {
+	c.MinLinks = minLinks
+	c.SetChange(INTERFACE_AGG_MINLINKS_KEY)
+}
+
+func (c *InterfaceAggregationConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case INTERFACE_AGG_LAGTYPE_KEY:
+		lagType, err := ParseAggregationType(value)
+		if err != nil {
+			return err
+		}
+		c.LagType = lagType
+
+	case INTERFACE_AGG_MINLINKS_KEY:
+		minLinks, err := strconv.ParseUint(value, 0, 16)
+		if err != nil {
+			return err
+		}
+		c.MinLinks = uint16(minLinks)
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessInterfaceAggregationConfig(p InterfaceAggregationConfigProcessor, reverse bool, name string, config *InterfaceAggregationConfig) error {
+	configFunc := func() error {
+		return p.InterfaceAggregationConfig(name, config)
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
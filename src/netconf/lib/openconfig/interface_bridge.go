@@ -0,0 +1,230 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"encoding/xml"
+	"fmt"
+	nclib "netconf/lib"
+	ncxml "netconf/lib/xml"
+	"strconv"
+)
+
+const (
+	INTERFACE_BRIDGE_PRIORITY_DEFAULT      = 32768
+	INTERFACE_BRIDGE_FORWARD_DELAY_DEFAULT = 15
+	INTERFACE_BRIDGE_HELLO_TIME_DEFAULT    = 2
+	INTERFACE_BRIDGE_MAX_AGE_DEFAULT       = 20
+)
+
+//
+// interface[name]/bridge
+//
+type InterfaceBridge struct {
+	nclib.SrChanges `xml:"-"`
+
+	XMLName xml.Name               `xml:"https://github.com/beluganos/beluganos/yang/interfaces/bridge bridge"`
+	Config  *InterfaceBridgeConfig `xml:"config"`
+}
+
+type InterfaceBridgeProcessor interface {
+	InterfaceBridgeConfigProcessor
+}
+
+func NewInterfaceBridge() *InterfaceBridge {
+	return &InterfaceBridge{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewInterfaceBridgeConfig(),
+	}
+}
+
+func (i *InterfaceBridge) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		INTERFACE_BRIDGE_KEY,
+		i.Config,
+		i.SrChanges,
+	)
+}
+
+func (i *InterfaceBridge) SetConfig(config *InterfaceBridgeConfig) {
+	i.Config = config
+	i.SetChange(OC_CONFIG_KEY)
+}
+
+func (i *InterfaceBridge) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := i.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	i.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessInterfaceBridge(p InterfaceBridgeProcessor, reverse bool, name string, bridge *InterfaceBridge) error {
+
+	configFunc := func() error {
+		if bridge.GetChange(OC_CONFIG_KEY) {
+			return ProcessInterfaceBridgeConfig(
+				p.(InterfaceBridgeConfigProcessor),
+				reverse,
+				name,
+				bridge.Config,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// interface[name]/bridge/config
+//
+type InterfaceBridgeConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Members      []string `xml:"member"`
+	Stp          bool     `xml:"stp"`
+	Priority     uint16   `xml:"priority"`
+	ForwardDelay uint8    `xml:"forward-delay"`
+	HelloTime    uint8    `xml:"hello-time"`
+	MaxAge       uint8    `xml:"max-age"`
+}
+
+type InterfaceBridgeConfigProcessor interface {
+	InterfaceBridgeConfig(string, *InterfaceBridgeConfig) error
+}
+
+func NewInterfaceBridgeConfig() *InterfaceBridgeConfig {
+	return &InterfaceBridgeConfig{
+		SrChanges:    nclib.NewSrChanges(),
+		Members:      []string{},
+		Stp:          true,
+		Priority:     INTERFACE_BRIDGE_PRIORITY_DEFAULT,
+		ForwardDelay: INTERFACE_BRIDGE_FORWARD_DELAY_DEFAULT,
+		HelloTime:    INTERFACE_BRIDGE_HELLO_TIME_DEFAULT,
+		MaxAge:       INTERFACE_BRIDGE_MAX_AGE_DEFAULT,
+	}
+}
+
+func (c *InterfaceBridgeConfig) String() string {
+	return fmt.Sprintf("%s{%s=%v, %s=%t, %s=%d, %s=%d, %s=%d, %s=%d} %s",
+		OC_CONFIG_KEY,
+		INTERFACE_BRIDGE_MEMBER_KEY, c.Members,
+		INTERFACE_BRIDGE_STP_KEY, c.Stp,
+		INTERFACE_BRIDGE_PRIORITY_KEY, c.Priority,
+		INTERFACE_BRIDGE_FORWARD_DELAY_KEY, c.ForwardDelay,
+		INTERFACE_BRIDGE_HELLO_TIME_KEY, c.HelloTime,
+		INTERFACE_BRIDGE_MAX_AGE_KEY, c.MaxAge,
+		c.SrChanges,
+	)
+}
+
+func (c *InterfaceBridgeConfig) AddMember(member string) {
+	c.Members = append(c.Members, member)
+	c.SetChange(INTERFACE_BRIDGE_MEMBER_KEY)
+}
+
+func (c *InterfaceBridgeConfig) SetStp(stp bool) {
+	c.Stp = stp
+	c.SetChange(INTERFACE_BRIDGE_STP_KEY)
+}
+
+func (c *InterfaceBridgeConfig) SetPriority(priority uint16) {
+	c.Priority = priority
+	c.SetChange(INTERFACE_BRIDGE_PRIORITY_KEY)
+}
+
+func (c *InterfaceBridgeConfig) SetForwardDelay(forwardDelay uint8) {
+	c.ForwardDelay = forwardDelay
+	c.SetChange(INTERFACE_BRIDGE_FORWARD_DELAY_KEY)
+}
+
+func (c *InterfaceBridgeConfig) SetHelloTime(helloTime uint8) {
+	c.HelloTime = helloTime
+	c.SetChange(INTERFACE_BRIDGE_HELLO_TIME_KEY)
+}
+
+func (c *InterfaceBridgeConfig) SetMaxAge(maxAge uint8) {
+	c.MaxAge = maxAge
+	c.SetChange(INTERFACE_BRIDGE_MAX_AGE_KEY)
+}
+
+func (c *InterfaceBridgeConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case INTERFACE_BRIDGE_MEMBER_KEY:
+		c.Members = append(c.Members, value)
+
+	case INTERFACE_BRIDGE_STP_KEY:
+		stp, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		c.Stp = stp
+
+	case INTERFACE_BRIDGE_PRIORITY_KEY:
+		priority, err := strconv.ParseUint(value, 0, 16)
+		if err != nil {
+			return err
+		}
+		c.Priority = uint16(priority)
+
+	case INTERFACE_BRIDGE_FORWARD_DELAY_KEY:
+		forwardDelay, err := strconv.ParseUint(value, 0, 8)
+		if err != nil {
+			return err
+		}
+		c.ForwardDelay = uint8(forwardDelay)
+
+	case INTERFACE_BRIDGE_HELLO_TIME_KEY:
+		helloTime, err := strconv.ParseUint(value, 0, 8)
+		if err != nil {
+			return err
+		}
+		c.HelloTime = uint8(helloTime)
+
+	case INTERFACE_BRIDGE_MAX_AGE_KEY:
+		maxAge, err := strconv.ParseUint(value, 0, 8)
+		if err != nil {
+			return err
+		}
+		c.MaxAge = uint8(maxAge)
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessInterfaceBridgeConfig(p InterfaceBridgeConfigProcessor, reverse bool, name string, config *InterfaceBridgeConfig) error {
+	configFunc := func() error {
+		return p.InterfaceBridgeConfig(name, config)
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
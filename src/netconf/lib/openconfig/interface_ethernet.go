@@ -98,7 +98,8 @@ func ProcessInterfaceEthernet(p InterfaceEthernetProcessor, reverse bool, name s
 type InterfaceEthernetConfig struct {
 	nclib.SrChanges `xml:"-"`
 
-	MacAddr net.HardwareAddr `xml:"mac-address,omitempty"`
+	MacAddr     net.HardwareAddr `xml:"mac-address,omitempty"`
+	AggregateId string           `xml:"https://github.com/beluganos/beluganos/yang/interfaces/aggregate aggregate-id,omitempty"`
 }
 
 type InterfaceEthernetConfigProcessor interface {
@@ -107,15 +108,17 @@ type InterfaceEthernetConfigProcessor interface {
 
 func NewInterfaceEthernetConfig() *InterfaceEthernetConfig {
 	return &InterfaceEthernetConfig{
-		SrChanges: nclib.NewSrChanges(),
-		MacAddr:   net.HardwareAddr{},
+		SrChanges:   nclib.NewSrChanges(),
+		MacAddr:     net.HardwareAddr{},
+		AggregateId: "",
 	}
 }
 
 func (c *InterfaceEthernetConfig) String() string {
-	return fmt.Sprintf("%s{%s='%s'} %s",
+	return fmt.Sprintf("%s{%s='%s', %s='%s'} %s",
 		OC_CONFIG_KEY,
 		INTERFACE_ETH_MACADDR_KEY, c.MacAddr,
+		INTERFACE_AGG_ID_KEY, c.AggregateId,
 		c.SrChanges,
 	)
 }
@@ -125,6 +128,11 @@ func (c *InterfaceEthernetConfig) SetMacAddr(macAddr net.HardwareAddr) {
 	c.SetChange(INTERFACE_ETH_MACADDR_KEY)
 }
 
+func (c *InterfaceEthernetConfig) SetAggregateId(aggregateId string) {
+	c.AggregateId = aggregateId
+	c.SetChange(INTERFACE_AGG_ID_KEY)
+}
+
 func (c *InterfaceEthernetConfig) Put(nodes []*ncxml.XPathNode, value string) error {
 	if len(nodes) == 0 {
 		return nil
@@ -137,6 +145,9 @@ func (c *InterfaceEthernetConfig) Put(nodes []*ncxml.XPathNode, value string) er
 			return err
 		}
 		c.MacAddr = mac
+
+	case INTERFACE_AGG_ID_KEY:
+		c.AggregateId = value
 	}
 
 	c.SetChange(nodes[0].Name)
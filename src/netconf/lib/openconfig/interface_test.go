@@ -21,6 +21,7 @@ import (
 	"fmt"
 	ncianalib "netconf/lib/iana"
 	srlib "netconf/lib/sysrepo"
+	"path/filepath"
 	"testing"
 )
 
@@ -227,6 +228,171 @@ func TestInterface_config_desc(t *testing.T) {
 	}
 }
 
+func TestInterface_aggregation(t *testing.T) {
+	ifaces := makeIfaces([][2]string{
+		{"/openconfig-interfaces:interfaces", ""},
+		{"/openconfig-interfaces:interfaces/interface[name='bond0']", ""},
+		{"/openconfig-interfaces:interfaces/interface[name='bond0']/aggregation", ""},
+		{"/openconfig-interfaces:interfaces/interface[name='bond0']/aggregation/config", ""},
+		{"/openconfig-interfaces:interfaces/interface[name='bond0']/aggregation/config/lag-type", "STATIC"},
+		{"/openconfig-interfaces:interfaces/interface[name='bond0']/aggregation/config/min-links", "2"},
+	})
+
+	t.Log(ifaces)
+
+	iface := ifaces["bond0"]
+
+	if v := iface.Compare(INTERFACE_AGG_KEY); !v {
+		t.Errorf("ifaces.Put unmatch. iface.cmp=%t", v)
+	}
+	if v := iface.Aggregation.Config.Compare(INTERFACE_AGG_LAGTYPE_KEY, INTERFACE_AGG_MINLINKS_KEY); !v {
+		t.Errorf("ifaces.Put unmatch. iface.aggregation.config.cmp=%t", v)
+	}
+	if v := iface.Aggregation.Config.LagType; v != AGGREGATION_STATIC {
+		t.Errorf("ifaces.Put unmatch. iface.aggregation.config.lag-type=%s", v)
+	}
+	if v := iface.Aggregation.Config.MinLinks; v != 2 {
+		t.Errorf("ifaces.Put unmatch. iface.aggregation.config.min-links=%d", v)
+	}
+}
+
+func TestInterface_aggregation_default(t *testing.T) {
+	ifaces := makeIfaces([][2]string{
+		{"/openconfig-interfaces:interfaces", ""},
+		{"/openconfig-interfaces:interfaces/interface[name='bond0']", ""},
+		{"/openconfig-interfaces:interfaces/interface[name='bond0']/aggregation", ""},
+		{"/openconfig-interfaces:interfaces/interface[name='bond0']/aggregation/config", ""},
+	})
+
+	t.Log(ifaces)
+
+	iface := ifaces["bond0"]
+
+	if v := iface.Aggregation.Config.LagType; v != AGGREGATION_LACP {
+		t.Errorf("ifaces.Put unmatch. iface.aggregation.config.lag-type=%s", v)
+	}
+	if v := iface.Aggregation.Config.MinLinks; v != 0 {
+		t.Errorf("ifaces.Put unmatch. iface.aggregation.config.min-links=%d", v)
+	}
+}
+
+func TestInterface_aggregation_err(t *testing.T) {
+	for _, data := range [][2]string{
+		{"/openconfig-interfaces:interfaces/interface[name='bond0']/aggregation/config/lag-type", "LACP_FAST"},
+		{"/openconfig-interfaces:interfaces/interface[name='bond0']/aggregation/config/min-links", "65536"},
+	} {
+		ifaces := NewInterfaces()
+		nodes := srlib.ParseXPath(data[0])
+		if err := ifaces.Put(nodes[1:], data[1]); err == nil {
+			t.Errorf("ifaces.Put must be error. %s=%s", data[0], data[1])
+		}
+	}
+}
+
+func TestInterface_ethernet_aggregate_id(t *testing.T) {
+	ifaces := makeIfaces([][2]string{
+		{"/openconfig-interfaces:interfaces", ""},
+		{"/openconfig-interfaces:interfaces/interface[name='eth1']", ""},
+		{"/openconfig-interfaces:interfaces/interface[name='eth1']/ethernet", ""},
+		{"/openconfig-interfaces:interfaces/interface[name='eth1']/ethernet/config", ""},
+		{"/openconfig-interfaces:interfaces/interface[name='eth1']/ethernet/config/beluganos-if-aggregate:aggregate-id", "bond0"},
+	})
+
+	t.Log(ifaces)
+
+	iface := ifaces["eth1"]
+
+	if v := iface.Ethernet.Config.Compare(INTERFACE_AGG_ID_KEY); !v {
+		t.Errorf("ifaces.Put unmatch. iface.ethernet.config.cmp=%t", v)
+	}
+	if v := iface.Ethernet.Config.AggregateId; v != "bond0" {
+		t.Errorf("ifaces.Put unmatch. iface.ethernet.config.aggregate-id=%s", v)
+	}
+}
+
+func TestInterface_bridge(t *testing.T) {
+	ifaces := makeIfaces([][2]string{
+		{"/openconfig-interfaces:interfaces", ""},
+		{"/openconfig-interfaces:interfaces/interface[name='br10']", ""},
+		{"/openconfig-interfaces:interfaces/interface[name='br10']/bridge", ""},
+		{"/openconfig-interfaces:interfaces/interface[name='br10']/bridge/config", ""},
+		{"/openconfig-interfaces:interfaces/interface[name='br10']/bridge/config/member", "eth1.10"},
+		{"/openconfig-interfaces:interfaces/interface[name='br10']/bridge/config/member", "eth2.10"},
+		{"/openconfig-interfaces:interfaces/interface[name='br10']/bridge/config/stp", "false"},
+		{"/openconfig-interfaces:interfaces/interface[name='br10']/bridge/config/priority", "4096"},
+		{"/openconfig-interfaces:interfaces/interface[name='br10']/bridge/config/forward-delay", "4"},
+		{"/openconfig-interfaces:interfaces/interface[name='br10']/bridge/config/hello-time", "1"},
+		{"/openconfig-interfaces:interfaces/interface[name='br10']/bridge/config/max-age", "10"},
+	})
+
+	t.Log(ifaces)
+
+	iface := ifaces["br10"]
+
+	if v := iface.Compare(INTERFACE_BRIDGE_KEY); !v {
+		t.Errorf("ifaces.Put unmatch. iface.cmp=%t", v)
+	}
+
+	config := iface.Bridge.Config
+
+	if v := len(config.Members); v != 2 {
+		t.Fatalf("ifaces.Put unmatch. iface.bridge.config.member=%v", config.Members)
+	}
+	if v := config.Members[0]; v != "eth1.10" {
+		t.Errorf("ifaces.Put unmatch. iface.bridge.config.member[0]=%s", v)
+	}
+	if v := config.Members[1]; v != "eth2.10" {
+		t.Errorf("ifaces.Put unmatch. iface.bridge.config.member[1]=%s", v)
+	}
+	if v := config.Stp; v {
+		t.Errorf("ifaces.Put unmatch. iface.bridge.config.stp=%t", v)
+	}
+	if v := config.Priority; v != 4096 {
+		t.Errorf("ifaces.Put unmatch. iface.bridge.config.priority=%d", v)
+	}
+	if v := config.ForwardDelay; v != 4 {
+		t.Errorf("ifaces.Put unmatch. iface.bridge.config.forward-delay=%d", v)
+	}
+	if v := config.HelloTime; v != 1 {
+		t.Errorf("ifaces.Put unmatch. iface.bridge.config.hello-time=%d", v)
+	}
+	if v := config.MaxAge; v != 10 {
+		t.Errorf("ifaces.Put unmatch. iface.bridge.config.max-age=%d", v)
+	}
+}
+
+func TestInterface_bridge_default(t *testing.T) {
+	ifaces := makeIfaces([][2]string{
+		{"/openconfig-interfaces:interfaces", ""},
+		{"/openconfig-interfaces:interfaces/interface[name='br10']", ""},
+		{"/openconfig-interfaces:interfaces/interface[name='br10']/bridge", ""},
+		{"/openconfig-interfaces:interfaces/interface[name='br10']/bridge/config", ""},
+	})
+
+	t.Log(ifaces)
+
+	config := ifaces["br10"].Bridge.Config
+
+	if v := len(config.Members); v != 0 {
+		t.Errorf("ifaces.Put unmatch. iface.bridge.config.member=%v", config.Members)
+	}
+	if v := config.Stp; !v {
+		t.Errorf("ifaces.Put unmatch. iface.bridge.config.stp=%t", v)
+	}
+	if v := config.Priority; v != INTERFACE_BRIDGE_PRIORITY_DEFAULT {
+		t.Errorf("ifaces.Put unmatch. iface.bridge.config.priority=%d", v)
+	}
+	if v := config.ForwardDelay; v != INTERFACE_BRIDGE_FORWARD_DELAY_DEFAULT {
+		t.Errorf("ifaces.Put unmatch. iface.bridge.config.forward-delay=%d", v)
+	}
+	if v := config.HelloTime; v != INTERFACE_BRIDGE_HELLO_TIME_DEFAULT {
+		t.Errorf("ifaces.Put unmatch. iface.bridge.config.hello-time=%d", v)
+	}
+	if v := config.MaxAge; v != INTERFACE_BRIDGE_MAX_AGE_DEFAULT {
+		t.Errorf("ifaces.Put unmatch. iface.bridge.config.max-age=%d", v)
+	}
+}
+
 func TestInterface_config_subiface_x0(t *testing.T) {
 	ifaces := makeIfaces([][2]string{
 		{"/openconfig-interfaces:interfaces", ""},
@@ -307,3 +473,113 @@ func TestInterface_config_subiface_x2(t *testing.T) {
 		t.Errorf("ifaces.Put unmatch. iface.subiface[11]=%t", ok)
 	}
 }
+
+func TestInterfaces_UnmarshalXML(t *testing.T) {
+	files := testXMLFiles(t,
+		filepath.Join(TEST_XML_DIR, "beluganos-interfaces-*.xml"),
+		filepath.Join(TEST_EXAMPLES_DIR, "examples*", "*interfaces*.xml"),
+	)
+
+	for _, file := range files {
+		ifaces := NewInterfaces()
+		unmarshalXMLFile(t, file, &ifaces)
+		t.Log(file, ifaces)
+	}
+}
+
+func TestInterfaces_UnmarshalXML_St(t *testing.T) {
+	ifaces := Interfaces(nil)
+	unmarshalXMLFile(t, filepath.Join(TEST_XML_DIR, "beluganos-interfaces-st.xml"), &ifaces)
+
+	if v := len(ifaces); v != 4 {
+		t.Errorf("Interfaces.UnmarshalXML unmatch. #ifaces=%d", v)
+	}
+
+	iface, ok := ifaces["eth4"]
+	if !ok {
+		t.Fatalf("Interfaces.UnmarshalXML unmatch. %v", ifaces)
+	}
+
+	if v := iface.Config.Mtu; v != 1500 {
+		t.Errorf("Interfaces.UnmarshalXML unmatch. mtu=%d", v)
+	}
+
+	subif, ok := iface.Subinterfaces[0]
+	if !ok {
+		t.Fatalf("Interfaces.UnmarshalXML unmatch. %v", iface.Subinterfaces)
+	}
+
+	if v := len(subif.IPv4.Addresses); v != 1 {
+		t.Errorf("Interfaces.UnmarshalXML unmatch. #addrs=%d", v)
+	}
+}
+
+func TestInterfaces_JSON(t *testing.T) {
+	files := testXMLFiles(t,
+		filepath.Join(TEST_XML_DIR, "beluganos-interfaces-*.xml"),
+		filepath.Join(TEST_EXAMPLES_DIR, "examples*", "*interfaces*.xml"),
+	)
+
+	for _, file := range files {
+		ifaces := NewInterfaces()
+		unmarshalXMLFile(t, file, &ifaces)
+
+		decoded := Interfaces(nil)
+		testJSONRoundTrip(t, file, &ifaces, &decoded)
+	}
+}
+
+func TestInterfaces_YAML(t *testing.T) {
+	files := testXMLFiles(t,
+		filepath.Join(TEST_XML_DIR, "beluganos-interfaces-*.xml"),
+		filepath.Join(TEST_EXAMPLES_DIR, "examples*", "*interfaces*.xml"),
+	)
+
+	for _, file := range files {
+		ifaces := NewInterfaces()
+		unmarshalXMLFile(t, file, &ifaces)
+
+		decoded := Interfaces(nil)
+		testYAMLRoundTrip(t, file, &ifaces, &decoded)
+	}
+}
+
+func TestDiffInterfaces(t *testing.T) {
+	path := "/beluganos-interfaces:interfaces/interface[name='eth1']"
+	src := NewInterfaces()
+	dst := NewInterfaces()
+	for _, data := range [][3]string{
+		{path + "/config/mtu", "1500", "9000"},
+		{path + "/subinterfaces/subinterface[index='10']/config/description", "sub10", "sub10"},
+	} {
+		nodes := srlib.ParseXPath(data[0])
+		if err := src.Put(nodes[1:], data[1]); err != nil {
+			t.Fatalf("Interfaces.Put error. %s", err)
+		}
+		if err := dst.Put(nodes[1:], data[2]); err != nil {
+			t.Fatalf("Interfaces.Put error. %s", err)
+		}
+	}
+
+	cre, mod, del := NewInterfaces(), NewInterfaces(), NewInterfaces()
+	if err := DiffInterfaces(src, dst, cre, mod, del); err != nil {
+		t.Fatalf("DiffInterfaces error. %s", err)
+	}
+
+	if len(cre) != 0 || len(del) != 0 {
+		t.Errorf("DiffInterfaces unmatch. created=%s deleted=%s", cre, del)
+	}
+
+	iface, ok := mod["eth1"]
+	if !ok {
+		t.Fatalf("DiffInterfaces unmatch. modified=%s", mod)
+	}
+
+	if v := iface.Config; v.Mtu != 9000 || !v.Compare(INTERFACE_MTU_KEY) {
+		t.Errorf("DiffInterfaces unmatch. modified=%s", v)
+	}
+
+	if v := len(iface.Subinterfaces); v != 0 {
+		t.Errorf("DiffInterfaces unmatch. #subifs=%d", v)
+	}
+}
@@ -0,0 +1,583 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"encoding/xml"
+	"fmt"
+	nclib "netconf/lib"
+	ncxml "netconf/lib/xml"
+	"strconv"
+)
+
+const (
+	ISIS_KEY                  = "isis"
+	ISIS_NET_KEY              = "net"
+	ISIS_LEVEL_CAPABILITY_KEY = "level-capability"
+	ISIS_LEVELS_KEY           = "levels"
+	ISIS_LEVEL_KEY            = "level"
+	ISIS_LEVEL_NUMBER_KEY     = "level-number"
+	ISIS_AUTH_KEY             = "authentication"
+	ISIS_AUTH_MODE_KEY        = "auth-mode"
+	ISIS_AUTH_PASSWORD_KEY    = "auth-password"
+	ISIS_INTERFACE_ID_KEY     = "interface-id"
+	ISIS_PASSIVE_KEY          = "passive"
+	ISIS_CIRCUIT_TYPE_KEY     = "circuit-type"
+	ISIS_METRIC_KEY           = "metric"
+	ISIS_TIMERS_KEY           = "timers"
+	ISIS_HELLO_INTERVAL_KEY   = "hello-interval"
+	ISIS_HELLO_MULTIPLIER_KEY = "hello-multiplier"
+	ISIS_AFISAFI_KEY          = "afi-safi"
+	ISIS_AF_KEY               = "af"
+	ISIS_AFI_NAME_KEY         = "afi-name"
+	ISIS_METRIC_MAX           = 16777215
+	ISIS_HELLO_INTERVAL_MIN   = 1
+	ISIS_HELLO_INTERVAL_MAX   = 600
+	ISIS_HELLO_MULTIPLIER_MIN = 2
+	ISIS_HELLO_MULTIPLIER_MAX = 100
+	ISIS_LEVEL_NUMBER_MIN     = 1
+	ISIS_LEVEL_NUMBER_MAX     = 2
+)
+
+//
+// isis
+//
+type Isis struct {
+	nclib.SrChanges `xml:"-"`
+
+	Global     *IsisGlobal    `xml:"global"`
+	Levels     IsisLevels     `xml:"levels"`
+	Interfaces IsisInterfaces `xml:"interfaces"`
+}
+
+type IsisProcessor interface {
+	isisProcessor
+	IsisGlobalProcessor
+	IsisLevelProcessor
+	IsisInterfaceProcessor
+}
+
+type isisProcessor interface {
+	Isis(string, *NetworkInstanceProtocolKey, *Isis) error
+}
+
+func NewIsis() *Isis {
+	return &Isis{
+		SrChanges:  nclib.NewSrChanges(),
+		Global:     NewIsisGlobal(),
+		Levels:     NewIsisLevels(),
+		Interfaces: NewIsisInterfaces(),
+	}
+}
+
+func (i *Isis) String() string {
+	return fmt.Sprintf("%s{%s, %s=%v, %s=%v} %s",
+		ISIS_KEY,
+		i.Global,
+		ISIS_LEVELS_KEY, i.Levels,
+		INTERFACES_KEY, i.Interfaces,
+		i.SrChanges,
+	)
+}
+
+func (i *Isis) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_GLOBAL_KEY:
+		if err := i.Global.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case ISIS_LEVELS_KEY:
+		if err := i.Levels.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case INTERFACES_KEY:
+		if err := i.Interfaces.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	i.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessIsis(p IsisProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, isis *Isis) error {
+	isisFunc := func() error {
+		return p.Isis(name, key, isis)
+	}
+
+	globalFunc := func() error {
+		if isis.GetChange(OC_GLOBAL_KEY) {
+			return ProcessIsisGlobal(
+				p.(IsisGlobalProcessor),
+				reverse,
+				name,
+				key,
+				isis.Global,
+			)
+		}
+		return nil
+	}
+
+	levelsFunc := func() error {
+		if isis.GetChange(ISIS_LEVELS_KEY) {
+			return ProcessIsisLevels(
+				p.(IsisLevelProcessor),
+				reverse,
+				name,
+				key,
+				isis.Levels,
+			)
+		}
+		return nil
+	}
+
+	ifacesFunc := func() error {
+		if isis.GetChange(INTERFACES_KEY) {
+			return ProcessIsisInterfaces(
+				p.(IsisInterfaceProcessor),
+				reverse,
+				name,
+				key,
+				isis.Interfaces,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, isisFunc, globalFunc, levelsFunc, ifacesFunc)
+}
+
+//
+// isis/global
+//
+type IsisGlobal struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config         *IsisGlobalConfig `xml:"config"`
+	SegmentRouting *SegmentRouting   `xml:"segment-routing"`
+}
+
+type IsisGlobalProcessor interface {
+	IsisGlobalConfig(string, *NetworkInstanceProtocolKey, *IsisGlobalConfig) error
+}
+
+func NewIsisGlobal() *IsisGlobal {
+	return &IsisGlobal{
+		SrChanges:      nclib.NewSrChanges(),
+		Config:         NewIsisGlobalConfig(),
+		SegmentRouting: NewSegmentRouting(),
+	}
+}
+
+func (i *IsisGlobal) String() string {
+	return fmt.Sprintf("%s{%s, %s} %s",
+		OC_GLOBAL_KEY,
+		i.Config,
+		i.SegmentRouting,
+		i.SrChanges,
+	)
+}
+
+func (i *IsisGlobal) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := i.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case SR_KEY:
+		if err := i.SegmentRouting.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	i.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessIsisGlobal(p IsisGlobalProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, global *IsisGlobal) error {
+	configFunc := func() error {
+		if global.GetChange(OC_CONFIG_KEY) {
+			return p.IsisGlobalConfig(name, key, global.Config)
+		}
+		return nil
+	}
+
+	srFunc := func() error {
+		if global.GetChange(SR_KEY) {
+			return ProcessSegmentRouting(
+				p.(SegmentRoutingProcessor),
+				reverse,
+				name,
+				key,
+				global.SegmentRouting,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc, srFunc)
+}
+
+//
+// isis/global/config
+//
+type IsisGlobalConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Net             []string      `xml:"net"`
+	LevelCapability IsisLevelType `xml:"level-capability"`
+}
+
+func NewIsisGlobalConfig() *IsisGlobalConfig {
+	return &IsisGlobalConfig{
+		SrChanges:       nclib.NewSrChanges(),
+		Net:             []string{},
+		LevelCapability: ISIS_LEVEL_1_2,
+	}
+}
+
+func (c *IsisGlobalConfig) String() string {
+	return fmt.Sprintf("%s{%s=%v, %s=%s} %s",
+		OC_CONFIG_KEY,
+		ISIS_NET_KEY, c.Net,
+		ISIS_LEVEL_CAPABILITY_KEY, c.LevelCapability,
+		c.SrChanges,
+	)
+}
+
+func (c *IsisGlobalConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case ISIS_NET_KEY:
+		net, err := ParseIsisNet(value)
+		if err != nil {
+			return err
+		}
+		c.Net = append(c.Net, net)
+
+	case ISIS_LEVEL_CAPABILITY_KEY:
+		level, err := ParseIsisLevelType(value)
+		if err != nil {
+			return err
+		}
+		c.LevelCapability = level
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// isis/levels
+//
+type IsisLevels map[string]*IsisLevel
+
+func NewIsisLevels() IsisLevels {
+	return IsisLevels{}
+}
+
+func (i IsisLevels) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	levelNum, ok := nodes[0].Attrs[ISIS_LEVEL_NUMBER_KEY]
+	if !ok {
+		return fmt.Errorf("%s@%s not found. %s", ISIS_LEVEL_KEY, ISIS_LEVEL_NUMBER_KEY, nodes[0])
+	}
+
+	level, ok := i[levelNum]
+	if !ok {
+		level = NewIsisLevel(levelNum)
+		i[levelNum] = level
+	}
+
+	return level.Put(nodes[1:], value)
+}
+
+func ProcessIsisLevels(p IsisLevelProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, levels IsisLevels) error {
+	for levelNum, level := range levels {
+		if err := ProcessIsisLevel(p, reverse, name, key, levelNum, level); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i IsisLevels) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = ISIS_LEVELS_KEY
+	e.EncodeToken(start)
+
+	for _, level := range i {
+		err := e.EncodeElement(level, xml.StartElement{Name: xml.Name{Local: ISIS_LEVEL_KEY}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+//
+// isis/levels/level[level-number]
+//
+type IsisLevel struct {
+	nclib.SrChanges `xml:"-"`
+
+	LevelNumber    string              `xml:"level-number"`
+	Config         *IsisLevelConfig    `xml:"config"`
+	Authentication *IsisAuthentication `xml:"authentication"`
+}
+
+type IsisLevelProcessor interface {
+	isisLevelProcessor
+	IsisLevelAuthenticationProcessor
+}
+
+type isisLevelProcessor interface {
+	IsisLevel(string, *NetworkInstanceProtocolKey, string, *IsisLevel) error
+}
+
+type IsisLevelAuthenticationProcessor interface {
+	IsisLevelAuthenticationConfig(string, *NetworkInstanceProtocolKey, string, *IsisAuthenticationConfig) error
+}
+
+func NewIsisLevel(levelNum string) *IsisLevel {
+	return &IsisLevel{
+		SrChanges:      nclib.NewSrChanges(),
+		LevelNumber:    levelNum,
+		Config:         NewIsisLevelConfig(),
+		Authentication: NewIsisAuthentication(),
+	}
+}
+
+func (i *IsisLevel) String() string {
+	return fmt.Sprintf("%s{%s=%s, %s, %s} %s",
+		ISIS_LEVEL_KEY,
+		ISIS_LEVEL_NUMBER_KEY, i.LevelNumber,
+		i.Config,
+		i.Authentication,
+		i.SrChanges,
+	)
+}
+
+func (i *IsisLevel) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case ISIS_LEVEL_NUMBER_KEY:
+		// i.LevelNumber = value // set by NewIsisLevel
+
+	case OC_CONFIG_KEY:
+		if err := i.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case ISIS_AUTH_KEY:
+		if err := i.Authentication.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	i.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessIsisLevel(p IsisLevelProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, levelNum string, level *IsisLevel) error {
+	levelFunc := func() error {
+		if level.GetChange(ISIS_LEVEL_NUMBER_KEY) {
+			return p.IsisLevel(name, key, levelNum, level)
+		}
+		return nil
+	}
+
+	authFunc := func() error {
+		if level.GetChange(ISIS_AUTH_KEY) && level.Authentication.GetChange(OC_CONFIG_KEY) {
+			return p.IsisLevelAuthenticationConfig(name, key, levelNum, level.Authentication.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, levelFunc, authFunc)
+}
+
+//
+// isis/levels/level[level-number]/config
+//
+type IsisLevelConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	LevelNumber uint8 `xml:"level-number"`
+}
+
+func NewIsisLevelConfig() *IsisLevelConfig {
+	return &IsisLevelConfig{
+		SrChanges:   nclib.NewSrChanges(),
+		LevelNumber: 0,
+	}
+}
+
+func (c *IsisLevelConfig) String() string {
+	return fmt.Sprintf("%s{%s=%d} %s",
+		OC_CONFIG_KEY,
+		ISIS_LEVEL_NUMBER_KEY, c.LevelNumber,
+		c.SrChanges,
+	)
+}
+
+func (c *IsisLevelConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case ISIS_LEVEL_NUMBER_KEY:
+		levelNum, err := ParseIsisLevelNumber(value)
+		if err != nil {
+			return err
+		}
+		c.LevelNumber = levelNum
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ParseIsisLevelNumber(s string) (uint8, error) {
+	levelNum, err := strconv.ParseUint(s, 0, 8)
+	if err != nil {
+		return 0, err
+	}
+	if levelNum < ISIS_LEVEL_NUMBER_MIN || levelNum > ISIS_LEVEL_NUMBER_MAX {
+		return 0, fmt.Errorf("Invalid IS-IS level-number. %s", s)
+	}
+	return uint8(levelNum), nil
+}
+
+//
+// authentication (levels/level, interfaces/interface)
+//
+type IsisAuthentication struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *IsisAuthenticationConfig `xml:"config"`
+}
+
+func NewIsisAuthentication() *IsisAuthentication {
+	return &IsisAuthentication{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewIsisAuthenticationConfig(),
+	}
+}
+
+func (i *IsisAuthentication) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		ISIS_AUTH_KEY,
+		i.Config,
+		i.SrChanges,
+	)
+}
+
+func (i *IsisAuthentication) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := i.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	i.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// authentication/config
+//
+type IsisAuthenticationConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Enabled      bool         `xml:"enabled"`
+	AuthMode     IsisAuthMode `xml:"auth-mode"`
+	AuthPassword string       `xml:"auth-password"`
+}
+
+func NewIsisAuthenticationConfig() *IsisAuthenticationConfig {
+	return &IsisAuthenticationConfig{
+		SrChanges:    nclib.NewSrChanges(),
+		Enabled:      false,
+		AuthMode:     ISIS_AUTH_TEXT,
+		AuthPassword: "",
+	}
+}
+
+func (c *IsisAuthenticationConfig) String() string {
+	return fmt.Sprintf("%s{%s=%t, %s=%s} %s",
+		OC_CONFIG_KEY,
+		OC_ENABLED_KEY, c.Enabled,
+		ISIS_AUTH_MODE_KEY, c.AuthMode,
+		c.SrChanges,
+	)
+}
+
+func (c *IsisAuthenticationConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_ENABLED_KEY:
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		c.Enabled = enabled
+
+	case ISIS_AUTH_MODE_KEY:
+		mode, err := ParseIsisAuthMode(value)
+		if err != nil {
+			return err
+		}
+		c.AuthMode = mode
+
+	case ISIS_AUTH_PASSWORD_KEY:
+		c.AuthPassword = value
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
@@ -0,0 +1,564 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"encoding/xml"
+	"fmt"
+	nclib "netconf/lib"
+	ncxml "netconf/lib/xml"
+	"strconv"
+)
+
+//
+// isis/interfaces
+//
+type IsisInterfaces map[string]*IsisInterface
+
+func NewIsisInterfaces() IsisInterfaces {
+	return IsisInterfaces{}
+}
+
+func (i IsisInterfaces) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	id, ok := nodes[0].Attrs[ISIS_INTERFACE_ID_KEY]
+	if !ok {
+		return fmt.Errorf("%s@%s not found. %s", INTERFACE_KEY, ISIS_INTERFACE_ID_KEY, nodes[0])
+	}
+
+	iface, ok := i[id]
+	if !ok {
+		iface = NewIsisInterface(id)
+		i[id] = iface
+	}
+
+	return iface.Put(nodes[1:], value)
+}
+
+func ProcessIsisInterfaces(p IsisInterfaceProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, ifaces IsisInterfaces) error {
+	for ifaceId, iface := range ifaces {
+		if err := ProcessIsisInterface(p, reverse, name, key, ifaceId, iface); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i IsisInterfaces) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = INTERFACES_KEY
+	e.EncodeToken(start)
+
+	for _, iface := range i {
+		err := e.EncodeElement(iface, xml.StartElement{Name: xml.Name{Local: INTERFACE_KEY}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+//
+// isis/interfaces/interface[interface-id]
+//
+type IsisInterface struct {
+	nclib.SrChanges `xml:"-"`
+
+	InterfaceId    string               `xml:"interface-id"`
+	Config         *IsisInterfaceConfig `xml:"config"`
+	InterfaceRef   *InterfaceRef        `xml:"interface-ref"`
+	Timers         *IsisInterfaceTimers `xml:"timers"`
+	AfiSafi        IsisInterfaceAfs     `xml:"afi-safi"`
+	Authentication *IsisAuthentication  `xml:"authentication"`
+}
+
+type IsisInterfaceProcessor interface {
+	isisInterfaceProcessor
+	IsisInterfaceConfigProcessor
+	IsisInterfaceRefProcessor
+	IsisInterfaceTimersProcessor
+	IsisInterfaceAfProcessor
+	IsisInterfaceAuthenticationProcessor
+}
+
+type isisInterfaceProcessor interface {
+	IsisInterface(string, *NetworkInstanceProtocolKey, string, *IsisInterface) error
+}
+
+type IsisInterfaceConfigProcessor interface {
+	IsisInterfaceConfig(string, *NetworkInstanceProtocolKey, string, *IsisInterfaceConfig) error
+}
+
+type IsisInterfaceRefProcessor interface {
+	IsisInterfaceRefConfig(string, *NetworkInstanceProtocolKey, string, *InterfaceRefConfig) error
+}
+
+type IsisInterfaceTimersProcessor interface {
+	IsisInterfaceTimersConfig(string, *NetworkInstanceProtocolKey, string, *IsisInterfaceTimersConfig) error
+}
+
+type IsisInterfaceAuthenticationProcessor interface {
+	IsisInterfaceAuthenticationConfig(string, *NetworkInstanceProtocolKey, string, *IsisAuthenticationConfig) error
+}
+
+func NewIsisInterface(id string) *IsisInterface {
+	return &IsisInterface{
+		SrChanges:      nclib.NewSrChanges(),
+		InterfaceId:    id,
+		Config:         NewIsisInterfaceConfig(),
+		InterfaceRef:   NewInterfaceRef(),
+		Timers:         NewIsisInterfaceTimers(),
+		AfiSafi:        NewIsisInterfaceAfs(),
+		Authentication: NewIsisAuthentication(),
+	}
+}
+
+func (i *IsisInterface) String() string {
+	return fmt.Sprintf("%s{%s=%s, %s, %s, %s, %s=%v, %s} %s",
+		INTERFACE_KEY,
+		ISIS_INTERFACE_ID_KEY, i.InterfaceId,
+		i.Config,
+		i.InterfaceRef,
+		i.Timers,
+		ISIS_AFISAFI_KEY, i.AfiSafi,
+		i.Authentication,
+		i.SrChanges,
+	)
+}
+
+func (i *IsisInterface) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case ISIS_INTERFACE_ID_KEY:
+		// i.InterfaceId = value // set by NewIsisInterface
+
+	case OC_CONFIG_KEY:
+		if err := i.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case INTERFACE_REF_KEY:
+		if err := i.InterfaceRef.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case ISIS_TIMERS_KEY:
+		if err := i.Timers.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case ISIS_AFISAFI_KEY:
+		if err := i.AfiSafi.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case ISIS_AUTH_KEY:
+		if err := i.Authentication.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	i.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessIsisInterface(p IsisInterfaceProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, ifaceId string, iface *IsisInterface) error {
+	ifaceFunc := func() error {
+		if iface.GetChange(ISIS_INTERFACE_ID_KEY) {
+			return p.IsisInterface(name, key, ifaceId, iface)
+		}
+		return nil
+	}
+
+	configFunc := func() error {
+		if iface.GetChange(OC_CONFIG_KEY) {
+			return p.IsisInterfaceConfig(name, key, ifaceId, iface.Config)
+		}
+		return nil
+	}
+
+	ifrefFunc := func() error {
+		if iface.GetChange(INTERFACE_REF_KEY) && iface.InterfaceRef.GetChange(OC_CONFIG_KEY) {
+			return p.IsisInterfaceRefConfig(name, key, ifaceId, iface.InterfaceRef.Config)
+		}
+		return nil
+	}
+
+	timersFunc := func() error {
+		if iface.GetChange(ISIS_TIMERS_KEY) && iface.Timers.GetChange(OC_CONFIG_KEY) {
+			return p.IsisInterfaceTimersConfig(name, key, ifaceId, iface.Timers.Config)
+		}
+		return nil
+	}
+
+	afsFunc := func() error {
+		if iface.GetChange(ISIS_AFISAFI_KEY) {
+			return ProcessIsisInterfaceAfs(p, reverse, name, key, ifaceId, iface.AfiSafi)
+		}
+		return nil
+	}
+
+	authFunc := func() error {
+		if iface.GetChange(ISIS_AUTH_KEY) && iface.Authentication.GetChange(OC_CONFIG_KEY) {
+			return p.IsisInterfaceAuthenticationConfig(name, key, ifaceId, iface.Authentication.Config)
+		}
+		return nil
+	}
+
+	// isisd requires 'ip router isis' before other 'isis' commands of the interface.
+	return nclib.CallFunctions(reverse, ifaceFunc, ifrefFunc, afsFunc, configFunc, timersFunc, authFunc)
+}
+
+//
+// isis/interfaces/interface[interface-id]/config
+//
+type IsisInterfaceConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	InterfaceId string        `xml:"interface-id"`
+	Passive     bool          `xml:"passive"`
+	CircuitType IsisLevelType `xml:"circuit-type"`
+	Metric      uint32        `xml:"metric"`
+}
+
+func NewIsisInterfaceConfig() *IsisInterfaceConfig {
+	return &IsisInterfaceConfig{
+		SrChanges:   nclib.NewSrChanges(),
+		InterfaceId: "",
+		Passive:     false,
+		CircuitType: ISIS_LEVEL_1_2,
+		Metric:      0,
+	}
+}
+
+func (c *IsisInterfaceConfig) String() string {
+	return fmt.Sprintf("%s{%s=%s, %s=%t, %s=%s, %s=%d} %s",
+		OC_CONFIG_KEY,
+		ISIS_INTERFACE_ID_KEY, c.InterfaceId,
+		ISIS_PASSIVE_KEY, c.Passive,
+		ISIS_CIRCUIT_TYPE_KEY, c.CircuitType,
+		ISIS_METRIC_KEY, c.Metric,
+		c.SrChanges,
+	)
+}
+
+func (c *IsisInterfaceConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case ISIS_INTERFACE_ID_KEY:
+		c.InterfaceId = value
+
+	case ISIS_PASSIVE_KEY:
+		passive, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		c.Passive = passive
+
+	case ISIS_CIRCUIT_TYPE_KEY:
+		level, err := ParseIsisLevelType(value)
+		if err != nil {
+			return err
+		}
+		c.CircuitType = level
+
+	case ISIS_METRIC_KEY:
+		metric, err := strconv.ParseUint(value, 0, 32)
+		if err != nil {
+			return err
+		}
+		if metric > ISIS_METRIC_MAX {
+			return fmt.Errorf("Invalid IS-IS metric. %s", value)
+		}
+		c.Metric = uint32(metric)
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// isis/interfaces/interface[interface-id]/timers
+//
+type IsisInterfaceTimers struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *IsisInterfaceTimersConfig `xml:"config"`
+}
+
+func NewIsisInterfaceTimers() *IsisInterfaceTimers {
+	return &IsisInterfaceTimers{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewIsisInterfaceTimersConfig(),
+	}
+}
+
+func (i *IsisInterfaceTimers) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		ISIS_TIMERS_KEY,
+		i.Config,
+		i.SrChanges,
+	)
+}
+
+func (i *IsisInterfaceTimers) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := i.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	i.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// isis/interfaces/interface[interface-id]/timers/config
+//
+type IsisInterfaceTimersConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	HelloInterval   uint32 `xml:"hello-interval"`
+	HelloMultiplier uint8  `xml:"hello-multiplier"`
+}
+
+func NewIsisInterfaceTimersConfig() *IsisInterfaceTimersConfig {
+	return &IsisInterfaceTimersConfig{
+		SrChanges:       nclib.NewSrChanges(),
+		HelloInterval:   0,
+		HelloMultiplier: 0,
+	}
+}
+
+func (c *IsisInterfaceTimersConfig) String() string {
+	return fmt.Sprintf("%s{%s=%d, %s=%d} %s",
+		OC_CONFIG_KEY,
+		ISIS_HELLO_INTERVAL_KEY, c.HelloInterval,
+		ISIS_HELLO_MULTIPLIER_KEY, c.HelloMultiplier,
+		c.SrChanges,
+	)
+}
+
+func (c *IsisInterfaceTimersConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case ISIS_HELLO_INTERVAL_KEY:
+		interval, err := strconv.ParseUint(value, 0, 32)
+		if err != nil {
+			return err
+		}
+		if interval < ISIS_HELLO_INTERVAL_MIN || interval > ISIS_HELLO_INTERVAL_MAX {
+			return fmt.Errorf("Invalid IS-IS hello-interval. %s", value)
+		}
+		c.HelloInterval = uint32(interval)
+
+	case ISIS_HELLO_MULTIPLIER_KEY:
+		multiplier, err := strconv.ParseUint(value, 0, 8)
+		if err != nil {
+			return err
+		}
+		if multiplier < ISIS_HELLO_MULTIPLIER_MIN || multiplier > ISIS_HELLO_MULTIPLIER_MAX {
+			return fmt.Errorf("Invalid IS-IS hello-multiplier. %s", value)
+		}
+		c.HelloMultiplier = uint8(multiplier)
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// isis/interfaces/interface[interface-id]/afi-safi
+//
+type IsisInterfaceAfs map[string]*IsisInterfaceAf
+
+func NewIsisInterfaceAfs() IsisInterfaceAfs {
+	return IsisInterfaceAfs{}
+}
+
+func (i IsisInterfaceAfs) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	afiName, ok := nodes[0].Attrs[ISIS_AFI_NAME_KEY]
+	if !ok {
+		return fmt.Errorf("%s@%s not found. %s", ISIS_AF_KEY, ISIS_AFI_NAME_KEY, nodes[0])
+	}
+
+	af, ok := i[afiName]
+	if !ok {
+		af = NewIsisInterfaceAf(afiName)
+		i[afiName] = af
+	}
+
+	return af.Put(nodes[1:], value)
+}
+
+func ProcessIsisInterfaceAfs(p IsisInterfaceAfProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, ifaceId string, afs IsisInterfaceAfs) error {
+	for afiName, af := range afs {
+		if err := ProcessIsisInterfaceAf(p, reverse, name, key, ifaceId, afiName, af); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i IsisInterfaceAfs) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = ISIS_AFISAFI_KEY
+	e.EncodeToken(start)
+
+	for _, af := range i {
+		err := e.EncodeElement(af, xml.StartElement{Name: xml.Name{Local: ISIS_AF_KEY}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+//
+// isis/interfaces/interface[interface-id]/afi-safi/af[afi-name]
+//
+type IsisInterfaceAf struct {
+	nclib.SrChanges `xml:"-"`
+
+	AfiName string                 `xml:"afi-name"`
+	Config  *IsisInterfaceAfConfig `xml:"config"`
+}
+
+type IsisInterfaceAfProcessor interface {
+	IsisInterfaceAfConfig(string, *NetworkInstanceProtocolKey, string, string, *IsisInterfaceAfConfig) error
+}
+
+func NewIsisInterfaceAf(afiName string) *IsisInterfaceAf {
+	return &IsisInterfaceAf{
+		SrChanges: nclib.NewSrChanges(),
+		AfiName:   afiName,
+		Config:    NewIsisInterfaceAfConfig(),
+	}
+}
+
+func (i *IsisInterfaceAf) String() string {
+	return fmt.Sprintf("%s{%s=%s, %s} %s",
+		ISIS_AF_KEY,
+		ISIS_AFI_NAME_KEY, i.AfiName,
+		i.Config,
+		i.SrChanges,
+	)
+}
+
+func (i *IsisInterfaceAf) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case ISIS_AFI_NAME_KEY:
+		// i.AfiName = value // set by NewIsisInterfaceAf
+
+	case OC_CONFIG_KEY:
+		if err := i.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	i.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessIsisInterfaceAf(p IsisInterfaceAfProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, ifaceId string, afiName string, af *IsisInterfaceAf) error {
+	configFunc := func() error {
+		if af.GetChange(OC_CONFIG_KEY) {
+			return p.IsisInterfaceAfConfig(name, key, ifaceId, afiName, af.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// isis/interfaces/interface[interface-id]/afi-safi/af[afi-name]/config
+//
+type IsisInterfaceAfConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	AfiName IsisAfiType `xml:"afi-name"`
+	Enabled bool        `xml:"enabled"`
+}
+
+func NewIsisInterfaceAfConfig() *IsisInterfaceAfConfig {
+	return &IsisInterfaceAfConfig{
+		SrChanges: nclib.NewSrChanges(),
+		AfiName:   ISIS_AFI_TYPE,
+		Enabled:   false,
+	}
+}
+
+func (c *IsisInterfaceAfConfig) String() string {
+	return fmt.Sprintf("%s{%s=%s, %s=%t} %s",
+		OC_CONFIG_KEY,
+		ISIS_AFI_NAME_KEY, c.AfiName,
+		OC_ENABLED_KEY, c.Enabled,
+		c.SrChanges,
+	)
+}
+
+func (c *IsisInterfaceAfConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case ISIS_AFI_NAME_KEY:
+		afi, err := ParseIsisAfiType(value)
+		if err != nil {
+			return err
+		}
+		c.AfiName = afi
+
+	case OC_ENABLED_KEY:
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		c.Enabled = enabled
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
@@ -0,0 +1,193 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	srlib "netconf/lib/sysrepo"
+	"testing"
+)
+
+func makeIsis(datas [][2]string) (*Isis, error) {
+	isis := NewIsis()
+	for _, data := range datas {
+		xpath, value := data[0], data[1]
+		nodes := srlib.ParseXPath(xpath)
+		if err := isis.Put(nodes[1:], value); err != nil {
+			return nil, err
+		}
+	}
+	return isis, nil
+}
+
+func TestIsisGlobal(t *testing.T) {
+	isis, err := makeIsis([][2]string{
+		{"/isis/global/config/net", "49.0001.0100.0000.0001.00"},
+		{"/isis/global/config/net", "49.0002.0100.0000.0001.00"},
+		{"/isis/global/config/level-capability", "boc-isis:LEVEL_2"},
+		{"/isis/levels/level[level-number='2']/level-number", "2"},
+		{"/isis/levels/level[level-number='2']/config/level-number", "2"},
+		{"/isis/levels/level[level-number='2']/authentication/config/enabled", "true"},
+		{"/isis/levels/level[level-number='2']/authentication/config/auth-mode", "boc-isis:MD5"},
+		{"/isis/levels/level[level-number='2']/authentication/config/auth-password", "secret"},
+	})
+
+	if err != nil {
+		t.Errorf("isis.Put error. %s", err)
+	}
+
+	if v := isis.Compare(OC_GLOBAL_KEY, ISIS_LEVELS_KEY); !v {
+		t.Errorf("isis.Put unmatch. cmp=%t", v)
+	}
+
+	config := isis.Global.Config
+	if v := config.Net; len(v) != 2 || v[0] != "49.0001.0100.0000.0001.00" || v[1] != "49.0002.0100.0000.0001.00" {
+		t.Errorf("isis.Put unmatch. net=%v", v)
+	}
+
+	if v := config.LevelCapability; v != ISIS_LEVEL_2 {
+		t.Errorf("isis.Put unmatch. level-capability=%s", v)
+	}
+
+	level, ok := isis.Levels["2"]
+	if !ok {
+		t.Fatalf("isis.Put unmatch. %v", isis.Levels)
+	}
+
+	if v := level.Config.LevelNumber; v != 2 {
+		t.Errorf("isis.Put unmatch. level-number=%d", v)
+	}
+
+	auth := level.Authentication.Config
+	if v := auth.Compare(OC_ENABLED_KEY, ISIS_AUTH_MODE_KEY, ISIS_AUTH_PASSWORD_KEY); !v {
+		t.Errorf("isis.Put unmatch. cmp=%t", v)
+	}
+
+	if v := auth.Enabled; !v {
+		t.Errorf("isis.Put unmatch. enabled=%t", v)
+	}
+
+	if v := auth.AuthMode; v != ISIS_AUTH_MD5 {
+		t.Errorf("isis.Put unmatch. auth-mode=%s", v)
+	}
+
+	if v := auth.AuthPassword; v != "secret" {
+		t.Errorf("isis.Put unmatch. auth-password=%s", v)
+	}
+}
+
+func TestIsisInterface(t *testing.T) {
+	isis, err := makeIsis([][2]string{
+		{"/isis/interfaces/interface[interface-id='eth1.10']/interface-id", "eth1.10"},
+		{"/isis/interfaces/interface[interface-id='eth1.10']/config/interface-id", "eth1.10"},
+		{"/isis/interfaces/interface[interface-id='eth1.10']/config/passive", "true"},
+		{"/isis/interfaces/interface[interface-id='eth1.10']/config/circuit-type", "boc-isis:LEVEL_1"},
+		{"/isis/interfaces/interface[interface-id='eth1.10']/config/metric", "100"},
+		{"/isis/interfaces/interface[interface-id='eth1.10']/interface-ref/config/interface", "eth1"},
+		{"/isis/interfaces/interface[interface-id='eth1.10']/interface-ref/config/subinterface", "10"},
+		{"/isis/interfaces/interface[interface-id='eth1.10']/timers/config/hello-interval", "5"},
+		{"/isis/interfaces/interface[interface-id='eth1.10']/timers/config/hello-multiplier", "4"},
+		{"/isis/interfaces/interface[interface-id='eth1.10']/afi-safi/af[afi-name='boc-isis:IPV6']/afi-name", "boc-isis:IPV6"},
+		{"/isis/interfaces/interface[interface-id='eth1.10']/afi-safi/af[afi-name='boc-isis:IPV6']/config/afi-name", "boc-isis:IPV6"},
+		{"/isis/interfaces/interface[interface-id='eth1.10']/afi-safi/af[afi-name='boc-isis:IPV6']/config/enabled", "true"},
+		{"/isis/interfaces/interface[interface-id='eth1.10']/authentication/config/enabled", "true"},
+		{"/isis/interfaces/interface[interface-id='eth1.10']/authentication/config/auth-password", "secret"},
+	})
+
+	if err != nil {
+		t.Errorf("isis.Put error. %s", err)
+	}
+
+	if v := isis.Compare(INTERFACES_KEY); !v {
+		t.Errorf("isis.Put unmatch. cmp=%t", v)
+	}
+
+	iface, ok := isis.Interfaces["eth1.10"]
+	if !ok {
+		t.Fatalf("isis.Put unmatch. %v", isis.Interfaces)
+	}
+
+	if v := iface.Compare(ISIS_INTERFACE_ID_KEY, OC_CONFIG_KEY, INTERFACE_REF_KEY, ISIS_TIMERS_KEY, ISIS_AFISAFI_KEY, ISIS_AUTH_KEY); !v {
+		t.Errorf("isis.Put unmatch. cmp=%t", v)
+	}
+
+	if v := iface.Config.Passive; !v {
+		t.Errorf("isis.Put unmatch. passive=%t", v)
+	}
+
+	if v := iface.Config.CircuitType; v != ISIS_LEVEL_1 {
+		t.Errorf("isis.Put unmatch. circuit-type=%s", v)
+	}
+
+	if v := iface.Config.Metric; v != 100 {
+		t.Errorf("isis.Put unmatch. metric=%d", v)
+	}
+
+	if v := iface.InterfaceRef.Config.IFName(); v != "eth1.10" {
+		t.Errorf("isis.Put unmatch. interface-ref=%s", v)
+	}
+
+	if v := iface.Timers.Config.HelloInterval; v != 5 {
+		t.Errorf("isis.Put unmatch. hello-interval=%d", v)
+	}
+
+	if v := iface.Timers.Config.HelloMultiplier; v != 4 {
+		t.Errorf("isis.Put unmatch. hello-multiplier=%d", v)
+	}
+
+	af, ok := iface.AfiSafi["boc-isis:IPV6"]
+	if !ok {
+		t.Fatalf("isis.Put unmatch. %v", iface.AfiSafi)
+	}
+
+	if v := af.Config.AfiName; v != ISIS_AFI_IPV6 {
+		t.Errorf("isis.Put unmatch. afi-name=%s", v)
+	}
+
+	if v := af.Config.Enabled; !v {
+		t.Errorf("isis.Put unmatch. enabled=%t", v)
+	}
+
+	auth := iface.Authentication.Config
+	if v := auth.AuthMode; v != ISIS_AUTH_TEXT {
+		t.Errorf("isis.Put unmatch. auth-mode=%s", v)
+	}
+}
+
+func TestIsis_invalid(t *testing.T) {
+	datas := [][2]string{
+		{"/isis/global/config/net", "49.0001.0100.0000.0001"},
+		{"/isis/global/config/net", "49.0001.0100.0000.0001.01"},
+		{"/isis/global/config/net", "4.0001.0100.0000.0001.00"},
+		{"/isis/global/config/level-capability", "boc-isis:LEVEL_3"},
+		{"/isis/levels/level[level-number='3']/config/level-number", "3"},
+		{"/isis/levels/level[level-number='2']/authentication/config/auth-mode", "boc-isis:SHA1"},
+		{"/isis/interfaces/interface[interface-id='eth1']/config/metric", "16777216"},
+		{"/isis/interfaces/interface[interface-id='eth1']/timers/config/hello-interval", "0"},
+		{"/isis/interfaces/interface[interface-id='eth1']/timers/config/hello-interval", "601"},
+		{"/isis/interfaces/interface[interface-id='eth1']/timers/config/hello-multiplier", "1"},
+		{"/isis/interfaces/interface[interface-id='eth1']/timers/config/hello-multiplier", "101"},
+		{"/isis/interfaces/interface[interface-id='eth1']/afi-safi/af[afi-name='boc-isis:IPX']/config/afi-name", "boc-isis:IPX"},
+		{"/isis/interfaces/interface/config/metric", "10"},
+	}
+
+	for _, data := range datas {
+		if _, err := makeIsis([][2]string{data}); err == nil {
+			t.Errorf("isis.Put must be error. %s=%s", data[0], data[1])
+		}
+	}
+}
@@ -0,0 +1,154 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"fmt"
+	ncxml "netconf/lib/xml"
+	"regexp"
+)
+
+//
+// IS-IS level (level-capability, circuit-type)
+//
+type IsisLevelType int
+
+const (
+	ISIS_LEVEL_TYPE IsisLevelType = iota
+	ISIS_LEVEL_1
+	ISIS_LEVEL_2
+	ISIS_LEVEL_1_2
+)
+
+var isisLevelTypeNames = map[IsisLevelType]string{
+	ISIS_LEVEL_TYPE: "ISIS_LEVEL_TYPE",
+	ISIS_LEVEL_1:    "LEVEL_1",
+	ISIS_LEVEL_2:    "LEVEL_2",
+	ISIS_LEVEL_1_2:  "LEVEL_1_2",
+}
+
+var isisLevelTypeValues = map[string]IsisLevelType{
+	"ISIS_LEVEL_TYPE": ISIS_LEVEL_TYPE,
+	"LEVEL_1":         ISIS_LEVEL_1,
+	"LEVEL_2":         ISIS_LEVEL_2,
+	"LEVEL_1_2":       ISIS_LEVEL_1_2,
+}
+
+func (v IsisLevelType) String() string {
+	if s, ok := isisLevelTypeNames[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("IsisLevelType(%d)", v)
+}
+
+func ParseIsisLevelType(s string) (IsisLevelType, error) {
+	_, ss := ncxml.ParseXPathName(s)
+	if v, ok := isisLevelTypeValues[ss]; ok {
+		return v, nil
+	}
+	return ISIS_LEVEL_TYPE, fmt.Errorf("Invalid IsisLevelType. %s", s)
+}
+
+//
+// IS-IS authentication mode
+//
+type IsisAuthMode int
+
+const (
+	ISIS_AUTH_MODE IsisAuthMode = iota
+	ISIS_AUTH_TEXT
+	ISIS_AUTH_MD5
+)
+
+var isisAuthModeNames = map[IsisAuthMode]string{
+	ISIS_AUTH_MODE: "ISIS_AUTH_MODE",
+	ISIS_AUTH_TEXT: "TEXT",
+	ISIS_AUTH_MD5:  "MD5",
+}
+
+var isisAuthModeValues = map[string]IsisAuthMode{
+	"ISIS_AUTH_MODE": ISIS_AUTH_MODE,
+	"TEXT":           ISIS_AUTH_TEXT,
+	"MD5":            ISIS_AUTH_MD5,
+}
+
+func (v IsisAuthMode) String() string {
+	if s, ok := isisAuthModeNames[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("IsisAuthMode(%d)", v)
+}
+
+func ParseIsisAuthMode(s string) (IsisAuthMode, error) {
+	_, ss := ncxml.ParseXPathName(s)
+	if v, ok := isisAuthModeValues[ss]; ok {
+		return v, nil
+	}
+	return ISIS_AUTH_MODE, fmt.Errorf("Invalid IsisAuthMode. %s", s)
+}
+
+//
+// IS-IS address family
+//
+type IsisAfiType int
+
+const (
+	ISIS_AFI_TYPE IsisAfiType = iota
+	ISIS_AFI_IPV4
+	ISIS_AFI_IPV6
+)
+
+var isisAfiTypeNames = map[IsisAfiType]string{
+	ISIS_AFI_TYPE: "ISIS_AFI_TYPE",
+	ISIS_AFI_IPV4: "IPV4",
+	ISIS_AFI_IPV6: "IPV6",
+}
+
+var isisAfiTypeValues = map[string]IsisAfiType{
+	"ISIS_AFI_TYPE": ISIS_AFI_TYPE,
+	"IPV4":          ISIS_AFI_IPV4,
+	"IPV6":          ISIS_AFI_IPV6,
+}
+
+func (v IsisAfiType) String() string {
+	if s, ok := isisAfiTypeNames[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("IsisAfiType(%d)", v)
+}
+
+func ParseIsisAfiType(s string) (IsisAfiType, error) {
+	_, ss := ncxml.ParseXPathName(s)
+	if v, ok := isisAfiTypeValues[ss]; ok {
+		return v, nil
+	}
+	return ISIS_AFI_TYPE, fmt.Errorf("Invalid IsisAfiType. %s", s)
+}
+
+//
+// NET (e.g. 49.0001.1921.6800.1001.00)
+// area-address (1 to 13 octets), system-id (6 octets) and nsel (00).
+//
+var isisNetRegexp = regexp.MustCompile(`^[0-9a-fA-F]{2}(\.[0-9a-fA-F]{4}){3,9}\.00$`)
+
+func ParseIsisNet(s string) (string, error) {
+	if !isisNetRegexp.MatchString(s) {
+		return "", fmt.Errorf("Invalid IS-IS NET. %s", s)
+	}
+	return s, nil
+}
@@ -33,6 +33,10 @@ const (
 	STATICROUTE_PREFIXLEN_KEY = "prefix-length"
 	STATICROUTE_NEXTHOPS_KEY  = "next-hops"
 	STATICROUTE_NEXTHOP_KEY   = "next-hop"
+	STATICROUTE_SETTAG_KEY    = "set-tag"
+	STATICROUTE_DESC_KEY      = "description"
+	STATICROUTE_METRIC_KEY    = "metric"
+	STATICROUTE_RECURSE_KEY   = "recurse"
 )
 
 //
@@ -132,7 +136,7 @@ type StaticRoute struct {
 	IP        string              `xml:"ip"`
 	PrefixLen uint8               `xml:"prefix-length"`
 	Config    *StaticRouteConfig  `xml:"config"`
-	Nexthops  StaticRouteNexthops `xml:"nexthops"`
+	Nexthops  StaticRouteNexthops `xml:"nexthops" yang:"next-hops"`
 }
 
 type StaticRouteProcessor interface {
@@ -238,8 +242,9 @@ func ProcessStaticRoute(p StaticRouteProcessor, reverse bool, name string, key *
 type StaticRouteConfig struct {
 	nclib.SrChanges `xml:"-"`
 
-	Ip        net.IP `xml:"ip"`
-	PrefixLen uint8  `xml:"prefix-length"`
+	Ip          net.IP `xml:"ip"`
+	PrefixLen   uint8  `xml:"prefix-length"`
+	Description string `xml:"description"`
 }
 
 type StaticRouteConfigProcessor interface {
@@ -248,17 +253,19 @@ type StaticRouteConfigProcessor interface {
 
 func NewStaticRouteConfig() *StaticRouteConfig {
 	return &StaticRouteConfig{
-		SrChanges: nclib.NewSrChanges(),
-		Ip:        nil,
-		PrefixLen: 0,
+		SrChanges:   nclib.NewSrChanges(),
+		Ip:          nil,
+		PrefixLen:   0,
+		Description: "",
 	}
 }
 
 func (c *StaticRouteConfig) String() string {
-	return fmt.Sprintf("%s{%s='%s', %s=%d} %s",
+	return fmt.Sprintf("%s{%s='%s', %s=%d, %s='%s'} %s",
 		OC_CONFIG_KEY,
 		STATICROUTE_IP_KEY, c.Ip,
 		STATICROUTE_PREFIXLEN_KEY, c.PrefixLen,
+		STATICROUTE_DESC_KEY, c.Description,
 		c.SrChanges,
 	)
 }
@@ -282,6 +289,9 @@ func (c *StaticRouteConfig) Put(nodes []*ncxml.XPathNode, value string) error {
 			return err
 		}
 		c.PrefixLen = uint8(v)
+
+	case STATICROUTE_DESC_KEY:
+		c.Description = value
 	}
 
 	c.SetChange(nodes[0].Name)
@@ -23,6 +23,7 @@ import (
 	"net"
 	nclib "netconf/lib"
 	ncxml "netconf/lib/xml"
+	"strconv"
 )
 
 //
@@ -82,15 +83,17 @@ func (s StaticRouteNexthops) MarshalXML(e *xml.Encoder, start xml.StartElement)
 type StaticRouteNexthop struct {
 	nclib.SrChanges `xml:"-"`
 
-	Index    string                    `xml:"index"`
-	Config   *StaticRouteNexthopConfig `xml:"config"`
-	IfaceRef *InterfaceRef             `xml:"interface-ref"`
+	Index     string                    `xml:"index"`
+	Config    *StaticRouteNexthopConfig `xml:"config"`
+	IfaceRef  *InterfaceRef             `xml:"interface-ref"`
+	EnableBfd *EnableBfd                `xml:"enable-bfd"`
 }
 
 type StaticRouteNexthopProcessor interface {
 	staticRouteNexthopProcessor
 	StaticRouteNexthopConfigProcessor
 	StaticRouteNexthopIfaceRefProcessor
+	StaticRouteNexthopEnableBfdProcessor
 }
 
 type staticRouteNexthopProcessor interface {
@@ -103,15 +106,17 @@ func NewStaticRouteNexthop(index string) *StaticRouteNexthop {
 		Index:     index,
 		Config:    NewStaticRouteNexthopConfig(),
 		IfaceRef:  NewInterfaceRef(),
+		EnableBfd: NewEnableBfd(),
 	}
 }
 
 func (s *StaticRouteNexthop) String() string {
-	return fmt.Sprintf("%s{%s='%s', %s, %s} %s",
+	return fmt.Sprintf("%s{%s='%s', %s, %s, %s} %s",
 		STATICROUTE_NEXTHOP_KEY,
 		OC_INDEX_KEY, s.Index,
 		s.Config,
 		s.IfaceRef,
+		s.EnableBfd,
 		s.SrChanges,
 	)
 }
@@ -133,6 +138,11 @@ func (s *StaticRouteNexthop) Put(nodes []*ncxml.XPathNode, value string) error {
 		if err := s.IfaceRef.Put(nodes[1:], value); err != nil {
 			return err
 		}
+
+	case BFD_ENABLE_KEY:
+		if err := s.EnableBfd.Put(nodes[1:], value); err != nil {
+			return err
+		}
 	}
 
 	s.SetChanges(nodes[0].Name)
@@ -177,7 +187,22 @@ func ProcessStaticRouteNexthop(p StaticRouteNexthopProcessor, reverse bool, name
 		return nil
 	}
 
-	return nclib.CallFunctions(reverse, nhFunc, configFunc, refFunc)
+	bfdFunc := func() error {
+		if nexthop.GetChange(BFD_ENABLE_KEY) {
+			return ProcessStaticRouteNexthopEnableBfd(
+				p.(StaticRouteNexthopEnableBfdProcessor),
+				reverse,
+				name,
+				key,
+				rtkey,
+				index,
+				nexthop.EnableBfd,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, nhFunc, configFunc, refFunc, bfdFunc)
 }
 
 //
@@ -187,7 +212,10 @@ type StaticRouteNexthopConfig struct {
 	nclib.SrChanges `xml:"-"`
 
 	Index   string `xml:"index"`
-	Nexthop string `xml:"nexthop"`
+	Nexthop string `xml:"nexthop" yang:"next-hop"`
+	Metric  uint8  `xml:"metric"`
+	Recurse bool   `xml:"recurse"`
+	SetTag  uint32 `xml:"set-tag"`
 }
 
 type StaticRouteNexthopConfigProcessor interface {
@@ -199,14 +227,20 @@ func NewStaticRouteNexthopConfig() *StaticRouteNexthopConfig {
 		SrChanges: nclib.NewSrChanges(),
 		Index:     "",
 		Nexthop:   "",
+		Metric:    0,
+		Recurse:   true,
+		SetTag:    0,
 	}
 }
 
 func (c *StaticRouteNexthopConfig) String() string {
-	return fmt.Sprintf("%s{%s='%s', %s='%s'} %s",
+	return fmt.Sprintf("%s{%s='%s', %s='%s', %s=%d, %s=%t, %s=%d} %s",
 		OC_CONFIG_KEY,
 		OC_INDEX_KEY, c.Index,
 		STATICROUTE_NEXTHOP_KEY, c.Nexthop,
+		STATICROUTE_METRIC_KEY, c.Metric,
+		STATICROUTE_RECURSE_KEY, c.Recurse,
+		STATICROUTE_SETTAG_KEY, c.SetTag,
 		c.SrChanges,
 	)
 }
@@ -232,6 +266,33 @@ func (c *StaticRouteNexthopConfig) Put(nodes []*ncxml.XPathNode, value string) e
 			return err
 		}
 		c.Nexthop = value
+
+	case STATICROUTE_METRIC_KEY:
+		v, err := strconv.ParseUint(value, 0, 8)
+		if err != nil {
+			return err
+		}
+		if v == 0 {
+			return fmt.Errorf("Invalid %s. %s", STATICROUTE_METRIC_KEY, value)
+		}
+		c.Metric = uint8(v)
+
+	case STATICROUTE_RECURSE_KEY:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		c.Recurse = b
+
+	case STATICROUTE_SETTAG_KEY:
+		v, err := strconv.ParseUint(value, 0, 32)
+		if err != nil {
+			return err
+		}
+		if v == 0 {
+			return fmt.Errorf("Invalid %s. %s", STATICROUTE_SETTAG_KEY, value)
+		}
+		c.SetTag = uint32(v)
 	}
 
 	c.SetChanges(nodes[0].Name)
@@ -260,3 +321,21 @@ func ProcessStaticRouteNexthopIfaceRef(p StaticRouteNexthopIfaceRefProcessor, re
 
 	return nclib.CallFunctions(reverse, refFunc)
 }
+
+//
+// static-routes/static[prefix]/next-hops/next-hop[index]/enable-bfd
+//
+type StaticRouteNexthopEnableBfdProcessor interface {
+	StaticRouteNexthopEnableBfdConfig(string, *NetworkInstanceProtocolKey, *StaticRouteKey, string, *EnableBfdConfig) error
+}
+
+func ProcessStaticRouteNexthopEnableBfd(p StaticRouteNexthopEnableBfdProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, rtkey *StaticRouteKey, index string, bfd *EnableBfd) error {
+	configFunc := func() error {
+		if bfd.GetChange(OC_CONFIG_KEY) {
+			return p.StaticRouteNexthopEnableBfdConfig(name, key, rtkey, index, bfd.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
@@ -461,6 +461,84 @@ func TestStaticRoute_nexthop_error(t *testing.T) {
 	}
 }
 
+func TestStaticRoute_config_description(t *testing.T) {
+	routes := makeStaticRoutes([][2]string{
+		{"/static-routes/static[ip='192.168.122.0'][prefix-length='24']/config/description", "to customer A"},
+	})
+
+	route := routes[*NewStaticRouteKey("192.168.122.0", 24)]
+
+	if v := route.Config.Compare(STATICROUTE_DESC_KEY); !v {
+		t.Errorf("StaticRoutes.Put unmatch. config.compare=%t", v)
+	}
+	if v := route.Config.Description; v != "to customer A" {
+		t.Errorf("StaticRoutes.Put unmatch. description=%s", v)
+	}
+}
+
+func TestStaticRoute_nexthop_config_attrs(t *testing.T) {
+	routes := makeStaticRoutes([][2]string{
+		{"/static-routes/static[ip='192.168.122.0'][prefix-length='24']/next-hops/next-hop[index='TEST1']/config/metric", "200"},
+		{"/static-routes/static[ip='192.168.122.0'][prefix-length='24']/next-hops/next-hop[index='TEST1']/config/recurse", "false"},
+		{"/static-routes/static[ip='192.168.122.0'][prefix-length='24']/next-hops/next-hop[index='TEST1']/config/set-tag", "4294967295"},
+		{"/static-routes/static[ip='192.168.122.0'][prefix-length='24']/next-hops/next-hop[index='TEST1']/enable-bfd/config/enabled", "true"},
+	})
+
+	route := routes[*NewStaticRouteKey("192.168.122.0", 24)]
+	nh := route.Nexthops["TEST1"]
+
+	if v := nh.Compare(OC_CONFIG_KEY, BFD_ENABLE_KEY); !v {
+		t.Errorf("StaticRoutes.Put unmatch. nexthop.compare=%t", v)
+	}
+	if v := nh.Config.Compare(STATICROUTE_METRIC_KEY, STATICROUTE_RECURSE_KEY, STATICROUTE_SETTAG_KEY); !v {
+		t.Errorf("StaticRoutes.Put unmatch. nexthop.config.compare=%t", v)
+	}
+	if v := nh.Config.Metric; v != 200 {
+		t.Errorf("StaticRoutes.Put unmatch. metric=%d", v)
+	}
+	if v := nh.Config.Recurse; v {
+		t.Errorf("StaticRoutes.Put unmatch. recurse=%t", v)
+	}
+	if v := nh.Config.SetTag; v != 4294967295 {
+		t.Errorf("StaticRoutes.Put unmatch. set-tag=%d", v)
+	}
+	if v := nh.EnableBfd.Config.Enabled; !v {
+		t.Errorf("StaticRoutes.Put unmatch. enable-bfd=%t", v)
+	}
+}
+
+func TestStaticRoute_nexthop_config_attrs_default(t *testing.T) {
+	c := NewStaticRouteNexthopConfig()
+
+	if v := c.Metric; v != 0 {
+		t.Errorf("NewStaticRouteNexthopConfig unmatch. metric=%d", v)
+	}
+	if v := c.Recurse; !v {
+		t.Errorf("NewStaticRouteNexthopConfig unmatch. recurse=%t", v)
+	}
+	if v := c.SetTag; v != 0 {
+		t.Errorf("NewStaticRouteNexthopConfig unmatch. set-tag=%d", v)
+	}
+}
+
+func TestStaticRoute_nexthop_config_attrs_error(t *testing.T) {
+	datas := [][2]string{
+		{"/static-routes/static[ip='192.168.122.0'][prefix-length='24']/next-hops/next-hop[index='TEST1']/config/metric", "0"},
+		{"/static-routes/static[ip='192.168.122.0'][prefix-length='24']/next-hops/next-hop[index='TEST1']/config/metric", "256"},
+		{"/static-routes/static[ip='192.168.122.0'][prefix-length='24']/next-hops/next-hop[index='TEST1']/config/recurse", "yes"},
+		{"/static-routes/static[ip='192.168.122.0'][prefix-length='24']/next-hops/next-hop[index='TEST1']/config/set-tag", "0"},
+		{"/static-routes/static[ip='192.168.122.0'][prefix-length='24']/next-hops/next-hop[index='TEST1']/enable-bfd/config/enabled", "1.0"},
+	}
+
+	for _, data := range datas {
+		routes := NewStaticRoutes()
+		nodes := srlib.ParseXPath(data[0])
+		if err := routes.Put(nodes[1:], data[1]); err == nil {
+			t.Errorf("StaticRoutes.Put must be error. %v", data)
+		}
+	}
+}
+
 func TestStaticRoute_nexthop_config_getnexthop(t *testing.T) {
 	c := NewStaticRouteNexthopConfig()
 
@@ -39,11 +39,13 @@ type Mpls struct {
 
 	Global    *MplsGlobal       `xml:"global"`
 	SigProtos *MplsSigProtocols `xml:"signaling-protocols"`
+	Lsps      *MplsLsps         `xml:"lsps"`
 }
 
 type MplsProcessor interface {
 	MplsGlobalProcessor
 	MplsSigProtocolsProcessor
+	MplsStaticLspProcessor
 }
 
 func NewMpls() *Mpls {
@@ -51,14 +53,16 @@ func NewMpls() *Mpls {
 		SrChanges: nclib.NewSrChanges(),
 		Global:    NewMplsGlobal(),
 		SigProtos: NewMplsSigProtocols(),
+		Lsps:      NewMplsLsps(),
 	}
 }
 
 func (m *Mpls) String() string {
-	return fmt.Sprintf("%s{%s, %s} %s",
+	return fmt.Sprintf("%s{%s, %s, %s} %s",
 		MPLS_KEY,
 		m.Global,
 		m.SigProtos,
+		m.Lsps,
 		m.SrChanges,
 	)
 }
@@ -78,6 +82,11 @@ func (m *Mpls) Put(nodes []*ncxml.XPathNode, value string) error {
 		if err := m.SigProtos.Put(nodes[1:], value); err != nil {
 			return err
 		}
+
+	case MPLS_LSPS_KEY:
+		if err := m.Lsps.Put(nodes[1:], value); err != nil {
+			return err
+		}
 	}
 
 	m.SetChange(nodes[0].Name)
@@ -109,5 +118,17 @@ func ProcessMpls(p MplsProcessor, reverse bool, name string, mpls *Mpls) error {
 		return nil
 	}
 
-	return nclib.CallFunctions(reverse, globalFunc, spFunc)
+	lspsFunc := func() error {
+		if mpls.GetChange(MPLS_LSPS_KEY) {
+			return ProcessMplsLsps(
+				p.(MplsStaticLspProcessor),
+				reverse,
+				name,
+				mpls.Lsps,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, globalFunc, spFunc, lspsFunc)
 }
@@ -25,6 +25,7 @@ import (
 
 const (
 	MPLS_LDP_ROUTERID_KEY                    = "lsr-id"
+	MPLS_LDP_DUALSTACK_PREFER_KEY            = "dual-stack-transport-preference"
 	MPLS_LDP_AF_KEY                          = "address-families"
 	MPLS_LDP_AF_IPV4_KEY                     = "ipv4"
 	MPLS_LDP_AF_IPV6_KEY                     = "ipv6"
@@ -37,6 +38,7 @@ const (
 	MPLS_LDP_DISCOVERY_KEY                   = "discovery"
 	MPLS_LDP_HELLO_HOLDTIME                  = "hello-holdtime"
 	MPLS_LDP_HELLO_INTERVAL                  = "hello-interval"
+	MPLS_LDP_AF_ENABLE_KEY                   = "enable"
 )
 
 //
@@ -209,7 +211,8 @@ func ProcessMplsLdpGlobal(p MplsLdpGlobalProcessor, reverse bool, name string, g
 type MplsLdpConfig struct {
 	nclib.SrChanges `xml:"-"`
 
-	LsrId string `xml:"lsr-id"`
+	LsrId         string                     `xml:"lsr-id"`
+	DualStackPref MplsLdpTransportPreference `xml:"dual-stack-transport-preference"`
 }
 
 type MplsLdpConfigProcessor interface {
@@ -218,15 +221,17 @@ type MplsLdpConfigProcessor interface {
 
 func NewMplsLdpConfig() *MplsLdpConfig {
 	return &MplsLdpConfig{
-		SrChanges: nclib.NewSrChanges(),
-		LsrId:     "",
+		SrChanges:     nclib.NewSrChanges(),
+		LsrId:         "",
+		DualStackPref: MPLS_LDP_TRANSPORT_PREFER_IPV6,
 	}
 }
 
 func (m *MplsLdpConfig) String() string {
-	return fmt.Sprintf("%s{%s='%s'} %s",
+	return fmt.Sprintf("%s{%s='%s', %s=%s} %s",
 		OC_CONFIG_KEY,
 		MPLS_LDP_ROUTERID_KEY, m.LsrId,
+		MPLS_LDP_DUALSTACK_PREFER_KEY, m.DualStackPref,
 		m.SrChanges,
 	)
 }
@@ -239,6 +244,13 @@ func (m *MplsLdpConfig) Put(nodes []*ncxml.XPathNode, value string) error {
 	switch nodes[0].Name {
 	case MPLS_LDP_ROUTERID_KEY:
 		m.LsrId = value
+
+	case MPLS_LDP_DUALSTACK_PREFER_KEY:
+		pref, err := ParseMplsLdpTransportPreference(value)
+		if err != nil {
+			return err
+		}
+		m.DualStackPref = pref
 	}
 
 	m.SetChange(nodes[0].Name)
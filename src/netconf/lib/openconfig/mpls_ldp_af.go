@@ -32,25 +32,27 @@ type MplsLdpAddressFamily struct {
 	nclib.SrChanges `xml:"-"`
 
 	IPv4 *MplsLdpAddressFamilyV4 `xml:"ipv4"`
-	// IPv6 *MplsLdpAddressFamilyV6 `xml:"ipv6"`
+	IPv6 *MplsLdpAddressFamilyV6 `xml:"ipv6"`
 }
 
 type MplsLdpAddressFamilyProcessor interface {
 	MplsLdpAddressFamilyV4Processor
+	MplsLdpAddressFamilyV6Processor
 }
 
 func NewMplsLdpAddressFamily() *MplsLdpAddressFamily {
 	return &MplsLdpAddressFamily{
 		SrChanges: nclib.NewSrChanges(),
 		IPv4:      NewMplsLdpAddressFamilyV4(),
-		// IPv6: NewMplsLdpAddressFamilyV6(),
+		IPv6:      NewMplsLdpAddressFamilyV6(),
 	}
 }
 
 func (m *MplsLdpAddressFamily) String() string {
-	return fmt.Sprintf("%s{%s} %s",
+	return fmt.Sprintf("%s{%s, %s} %s",
 		MPLS_LDP_AF_KEY,
 		m.IPv4,
+		m.IPv6,
 		m.SrChanges,
 	)
 }
@@ -66,11 +68,10 @@ func (m *MplsLdpAddressFamily) Put(nodes []*ncxml.XPathNode, value string) error
 			return err
 		}
 
-		//case MPLS_LDP_AF_IPV6_KEY:
-		//	if err := m.IPv6.Put(nodes[1:], value); err != nil {
-		//		return err
-		//	}
-
+	case MPLS_LDP_AF_IPV6_KEY:
+		if err := m.IPv6.Put(nodes[1:], value); err != nil {
+			return err
+		}
 	}
 
 	m.SetChange(nodes[0].Name)
@@ -90,7 +91,19 @@ func ProcessMplsLdpAddressFamily(p MplsLdpAddressFamilyProcessor, reverse bool,
 		return nil
 	}
 
-	return nclib.CallFunctions(reverse, ipv4Func)
+	ipv6Func := func() error {
+		if af.GetChange(MPLS_LDP_AF_IPV6_KEY) {
+			return ProcessMplsLdpAddressFamilyV6(
+				p.(MplsLdpAddressFamilyV6Processor),
+				reverse,
+				name,
+				af.IPv6,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, ipv4Func, ipv6Func)
 }
 
 //
@@ -216,7 +229,129 @@ func ProcessMplsLdpAddressFamilyV4Config(p MplsLdpAddressFamilyV4ConfigProcessor
 }
 
 //
-// mpls/signaling-protocols/ldp/global/address-families/ipv4/config/label-policy
+// mpls/signaling-protocols/ldp/address-family/ipv6
+//
+type MplsLdpAddressFamilyV6 struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *MplsLdpAddressFamilyV6Config `xml:"config"`
+}
+
+type MplsLdpAddressFamilyV6Processor interface {
+	MplsLdpAddressFamilyV6ConfigProcessor
+}
+
+func NewMplsLdpAddressFamilyV6() *MplsLdpAddressFamilyV6 {
+	return &MplsLdpAddressFamilyV6{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewMplsLdpAddressFamilyV6Config(),
+	}
+}
+
+func (m *MplsLdpAddressFamilyV6) String() string {
+	return fmt.Sprintf("%s{%v} %s",
+		MPLS_LDP_AF_IPV6_KEY,
+		m.Config,
+		m.SrChanges,
+	)
+}
+
+func (m *MplsLdpAddressFamilyV6) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := m.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	m.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessMplsLdpAddressFamilyV6(p MplsLdpAddressFamilyV6Processor, reverse bool, name string, ipv6 *MplsLdpAddressFamilyV6) error {
+	configFunc := func() error {
+		if ipv6.GetChange(OC_CONFIG_KEY) {
+			return ProcessMplsLdpAddressFamilyV6Config(
+				p.(MplsLdpAddressFamilyV6ConfigProcessor),
+				reverse,
+				name,
+				ipv6.Config,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// mpls/signaling-protocols/ldp/address-family/ipv6/config
+//
+type MplsLdpAddressFamilyV6Config struct {
+	nclib.SrChanges `xml:"-"`
+
+	TransportAddr   net.IP                `xml:"transport-address"`
+	SessionHoldTime uint16                `xml:"session-ka-holdtime"`
+	LabelPolicy     *MplsLdpV4LabelPolicy `xml:"label-policy"`
+}
+
+type MplsLdpAddressFamilyV6ConfigProcessor interface {
+	MplsLdpAddressFamilyV6Config(string, *MplsLdpAddressFamilyV6Config) error
+}
+
+func NewMplsLdpAddressFamilyV6Config() *MplsLdpAddressFamilyV6Config {
+	return &MplsLdpAddressFamilyV6Config{
+		SrChanges:       nclib.NewSrChanges(),
+		TransportAddr:   nil,
+		SessionHoldTime: 0,
+		LabelPolicy:     NewMplsLdpV4LabelPolicy(),
+	}
+}
+
+func (m *MplsLdpAddressFamilyV6Config) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case MPLS_LDP_AF_TARNSADDR_KEY:
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return fmt.Errorf("Invalid %s, %s", MPLS_LDP_AF_TARNSADDR_KEY, value)
+		}
+		m.TransportAddr = ip
+
+	case MPLS_LDP_AF_SESSION_HOLDTIME_KEY:
+		ht, err := strconv.ParseUint(value, 0, 16)
+		if err != nil {
+			return err
+		}
+		m.SessionHoldTime = uint16(ht)
+
+	case MPLS_LDP_LABELPOLICY_KEY:
+		if err := m.LabelPolicy.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	m.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessMplsLdpAddressFamilyV6Config(p MplsLdpAddressFamilyV6ConfigProcessor, reverse bool, name string, config *MplsLdpAddressFamilyV6Config) error {
+	configFunc := func() error {
+		return p.MplsLdpAddressFamilyV6Config(name, config)
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// mpls/signaling-protocols/ldp/global/address-families/ipvX/config/label-policy
 //
 type MplsLdpV4LabelPolicy struct {
 	nclib.SrChanges `xml:"-"`
@@ -82,15 +82,17 @@ func (i MplsLdpInterfaces) MarshalXML(e *xml.Encoder, start xml.StartElement) er
 type MplsLdpInterface struct {
 	nclib.SrChanges `xml:"-"`
 
-	IfaceId  string                  `xml:"interface-id"`
-	IfaceRef *InterfaceRef           `xml:"interface-ref"`
-	Config   *MplsLdpInterfaceConfig `xml:"config"`
+	IfaceId       string                         `xml:"interface-id"`
+	IfaceRef      *InterfaceRef                  `xml:"interface-ref"`
+	Config        *MplsLdpInterfaceConfig        `xml:"config"`
+	AddressFamily *MplsLdpInterfaceAddressFamily `xml:"address-families"`
 }
 
 type MplsLdpInterfaceProcessor interface {
 	mplsLdpInterfaceProcessor
 	MplsLdpInterfaceRefProcessor
 	MplsLdpInterfaceConfigProcessor
+	MplsLdpInterfaceAfConfigProcessor
 }
 
 type mplsLdpInterfaceProcessor interface {
@@ -99,19 +101,21 @@ type mplsLdpInterfaceProcessor interface {
 
 func NewMplsLdpInterface(ifid string) *MplsLdpInterface {
 	return &MplsLdpInterface{
-		SrChanges: nclib.NewSrChanges(),
-		IfaceId:   ifid,
-		IfaceRef:  NewInterfaceRef(),
-		Config:    NewMplsLdpInterfaceConfig(),
+		SrChanges:     nclib.NewSrChanges(),
+		IfaceId:       ifid,
+		IfaceRef:      NewInterfaceRef(),
+		Config:        NewMplsLdpInterfaceConfig(),
+		AddressFamily: NewMplsLdpInterfaceAddressFamily(),
 	}
 }
 
 func (m *MplsLdpInterface) String() string {
-	return fmt.Sprintf("%s{%s='%s', %s, %s} %s",
+	return fmt.Sprintf("%s{%s='%s', %s, %s, %s} %s",
 		INTERFACE_KEY,
 		INTERFACE_ID_KEY, m.IfaceId,
 		m.IfaceRef,
 		m.Config,
+		m.AddressFamily,
 		m.SrChanges,
 	)
 }
@@ -134,6 +138,11 @@ func (m *MplsLdpInterface) Put(nodes []*ncxml.XPathNode, value string) error {
 		if err := m.Config.Put(nodes[1:], value); err != nil {
 			return err
 		}
+
+	case MPLS_LDP_AF_KEY:
+		if err := m.AddressFamily.Put(nodes[1:], value); err != nil {
+			return err
+		}
 	}
 
 	m.SetChange(nodes[0].Name)
@@ -162,6 +171,19 @@ func ProcessMplsLdpInterface(p MplsLdpInterfaceProcessor, reverse bool, name str
 		return nil
 	}
 
+	afFunc := func() error {
+		if iface.GetChange(MPLS_LDP_AF_KEY) {
+			return ProcessMplsLdpInterfaceAddressFamily(
+				p.(MplsLdpInterfaceAfConfigProcessor),
+				reverse,
+				name,
+				ifaceId,
+				iface.AddressFamily,
+			)
+		}
+		return nil
+	}
+
 	refFunc := func() error {
 		if iface.GetChange(INTERFACE_REF_KEY) {
 			return ProcessMplsLdpInterfaceRef(
@@ -175,7 +197,7 @@ func ProcessMplsLdpInterface(p MplsLdpInterfaceProcessor, reverse bool, name str
 		return nil
 	}
 
-	return nclib.CallFunctions(reverse, idFunc, configFunc, refFunc)
+	return nclib.CallFunctions(reverse, idFunc, configFunc, afFunc, refFunc)
 }
 
 //
@@ -247,6 +269,154 @@ func ProcessMplsLdpInterfaceConfig(p MplsLdpInterfaceConfigProcessor, reverse bo
 	return nclib.CallFunctions(reverse, configFunc)
 }
 
+//
+// mpls/signaling-protocols/ldp/global/discovery/interfaces/interface[interface-id]/address-families
+//
+type MplsLdpInterfaceAddressFamily struct {
+	nclib.SrChanges `xml:"-"`
+
+	IPv4 *MplsLdpInterfaceAf `xml:"ipv4"`
+	IPv6 *MplsLdpInterfaceAf `xml:"ipv6"`
+}
+
+func NewMplsLdpInterfaceAddressFamily() *MplsLdpInterfaceAddressFamily {
+	return &MplsLdpInterfaceAddressFamily{
+		SrChanges: nclib.NewSrChanges(),
+		IPv4:      NewMplsLdpInterfaceAf(true),
+		IPv6:      NewMplsLdpInterfaceAf(false),
+	}
+}
+
+func (m *MplsLdpInterfaceAddressFamily) String() string {
+	return fmt.Sprintf("%s{%s=%s, %s=%s} %s",
+		MPLS_LDP_AF_KEY,
+		MPLS_LDP_AF_IPV4_KEY, m.IPv4,
+		MPLS_LDP_AF_IPV6_KEY, m.IPv6,
+		m.SrChanges,
+	)
+}
+
+func (m *MplsLdpInterfaceAddressFamily) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case MPLS_LDP_AF_IPV4_KEY:
+		if err := m.IPv4.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case MPLS_LDP_AF_IPV6_KEY:
+		if err := m.IPv6.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	m.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessMplsLdpInterfaceAddressFamily(p MplsLdpInterfaceAfConfigProcessor, reverse bool, name string, ifaceId string, af *MplsLdpInterfaceAddressFamily) error {
+	ipv4Func := func() error {
+		if af.GetChange(MPLS_LDP_AF_IPV4_KEY) && af.IPv4.GetChange(OC_CONFIG_KEY) {
+			return p.MplsLdpInterfaceAfConfig(name, ifaceId, MPLS_LDP_AF_IPV4_KEY, af.IPv4.Config)
+		}
+		return nil
+	}
+
+	ipv6Func := func() error {
+		if af.GetChange(MPLS_LDP_AF_IPV6_KEY) && af.IPv6.GetChange(OC_CONFIG_KEY) {
+			return p.MplsLdpInterfaceAfConfig(name, ifaceId, MPLS_LDP_AF_IPV6_KEY, af.IPv6.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, ipv4Func, ipv6Func)
+}
+
+//
+// mpls/signaling-protocols/ldp/global/discovery/interfaces/interface[interface-id]/address-families/ipvX
+//
+type MplsLdpInterfaceAf struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *MplsLdpInterfaceAfConfig `xml:"config"`
+}
+
+func NewMplsLdpInterfaceAf(enable bool) *MplsLdpInterfaceAf {
+	return &MplsLdpInterfaceAf{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewMplsLdpInterfaceAfConfig(enable),
+	}
+}
+
+func (m *MplsLdpInterfaceAf) String() string {
+	return fmt.Sprintf("{%s} %s", m.Config, m.SrChanges)
+}
+
+func (m *MplsLdpInterfaceAf) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := m.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	m.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// mpls/signaling-protocols/ldp/global/discovery/interfaces/interface[interface-id]/address-families/ipvX/config
+//
+type MplsLdpInterfaceAfConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Enable bool `xml:"enable"`
+}
+
+type MplsLdpInterfaceAfConfigProcessor interface {
+	MplsLdpInterfaceAfConfig(string, string, string, *MplsLdpInterfaceAfConfig) error
+}
+
+func NewMplsLdpInterfaceAfConfig(enable bool) *MplsLdpInterfaceAfConfig {
+	return &MplsLdpInterfaceAfConfig{
+		SrChanges: nclib.NewSrChanges(),
+		Enable:    enable,
+	}
+}
+
+func (m *MplsLdpInterfaceAfConfig) String() string {
+	return fmt.Sprintf("%s{%s=%t} %s",
+		OC_CONFIG_KEY,
+		MPLS_LDP_AF_ENABLE_KEY, m.Enable,
+		m.SrChanges,
+	)
+}
+
+func (m *MplsLdpInterfaceAfConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case MPLS_LDP_AF_ENABLE_KEY:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		m.Enable = b
+	}
+
+	m.SetChange(nodes[0].Name)
+	return nil
+}
+
 //
 // mpls/signaling-protocols/ldp/address-family/ipvX/interfaces/interface[interface-id]/interface-ref
 //
@@ -0,0 +1,148 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	srlib "netconf/lib/sysrepo"
+	"testing"
+)
+
+func makeMplsLdp(datas [][2]string) (*MplsLdp, error) {
+	ldp := NewMplsLdp()
+	for _, data := range datas {
+		xpath, value := data[0], data[1]
+		nodes := srlib.ParseXPath(xpath)
+		if err := ldp.Put(nodes[1:], value); err != nil {
+			return nil, err
+		}
+	}
+	return ldp, nil
+}
+
+func TestMplsLdpGlobal_IPv6(t *testing.T) {
+	ldp, err := makeMplsLdp([][2]string{
+		{"/ldp/global/config/lsr-id", "10.0.0.1"},
+		{"/ldp/global/config/dual-stack-transport-preference", "IPV4"},
+		{"/ldp/global/address-families/ipv6/config/transport-address", "2001:db8::1"},
+		{"/ldp/global/address-families/ipv6/config/session-ka-holdtime", "180"},
+		{"/ldp/global/address-families/ipv6/config/label-policy/advertise/egress-explicit-null/enable", "false"},
+	})
+
+	if err != nil {
+		t.Fatalf("ldp.Put error. %s", err)
+	}
+
+	global := ldp.Global
+	if v := global.Config.DualStackPref; v != MPLS_LDP_TRANSPORT_PREFER_IPV4 {
+		t.Errorf("ldp.Put unmatch. dual-stack=%s", v)
+	}
+
+	af := global.AddressFamily
+	if v := af.Compare(MPLS_LDP_AF_IPV6_KEY); !v {
+		t.Errorf("ldp.Put unmatch. cmp=%t", v)
+	}
+
+	config := af.IPv6.Config
+	if v := config.TransportAddr.String(); v != "2001:db8::1" {
+		t.Errorf("ldp.Put unmatch. transport-address=%s", v)
+	}
+
+	if v := config.SessionHoldTime; v != 180 {
+		t.Errorf("ldp.Put unmatch. session-ka-holdtime=%d", v)
+	}
+
+	if v := config.LabelPolicy.Advertise.EngressExplicitNull.Enable; v {
+		t.Errorf("ldp.Put unmatch. egress-explicit-null=%t", v)
+	}
+
+	if v := af.IPv4.Config.TransportAddr; v != nil {
+		t.Errorf("ldp.Put unmatch. ipv4 transport-address=%s", v)
+	}
+}
+
+func TestMplsLdpInterface_AddressFamily(t *testing.T) {
+	path := "/ldp/global/discovery/interfaces/interface[interface-id='eth1.10']"
+	ldp, err := makeMplsLdp([][2]string{
+		{path + "/interface-id", "eth1.10"},
+		{path + "/config/interface-id", "eth1.10"},
+		{path + "/address-families/ipv6/config/enable", "true"},
+	})
+
+	if err != nil {
+		t.Fatalf("ldp.Put error. %s", err)
+	}
+
+	iface, ok := ldp.Global.Discovery.Interfaces.Interfaces["eth1.10"]
+	if !ok {
+		t.Fatalf("ldp.Put unmatch. %s", ldp)
+	}
+
+	af := iface.AddressFamily
+	if v := af.Compare(MPLS_LDP_AF_IPV6_KEY); !v {
+		t.Errorf("ldp.Put unmatch. cmp=%t", v)
+	}
+
+	if v := af.IPv4.Config.Enable; !v {
+		t.Errorf("ldp.Put unmatch. ipv4 enable=%t", v)
+	}
+
+	if v := af.IPv6.Config.Enable; !v {
+		t.Errorf("ldp.Put unmatch. ipv6 enable=%t", v)
+	}
+}
+
+type testMplsLdpInterfaceAfProcessor struct {
+	afNames []string
+}
+
+func (p *testMplsLdpInterfaceAfProcessor) MplsLdpInterfaceAfConfig(name string, ifaceId string, afName string, config *MplsLdpInterfaceAfConfig) error {
+	p.afNames = append(p.afNames, afName)
+	return nil
+}
+
+func TestProcessMplsLdpInterfaceAddressFamily(t *testing.T) {
+	af := NewMplsLdpInterfaceAddressFamily()
+	nodes := srlib.ParseXPath("/address-families/ipv6/config/enable")
+	if err := af.Put(nodes[1:], "true"); err != nil {
+		t.Fatalf("af.Put error. %s", err)
+	}
+
+	p := &testMplsLdpInterfaceAfProcessor{}
+	if err := ProcessMplsLdpInterfaceAddressFamily(p, false, "PE1", "eth1", af); err != nil {
+		t.Errorf("ProcessMplsLdpInterfaceAddressFamily error. %s", err)
+	}
+
+	if v := p.afNames; len(v) != 1 || v[0] != MPLS_LDP_AF_IPV6_KEY {
+		t.Errorf("ProcessMplsLdpInterfaceAddressFamily unmatch. %v", v)
+	}
+}
+
+func TestMplsLdp_invalid(t *testing.T) {
+	datas := [][2]string{
+		{"/ldp/global/config/dual-stack-transport-preference", "IPV5"},
+		{"/ldp/global/address-families/ipv6/config/transport-address", "2001:db8::x"},
+		{"/ldp/global/address-families/ipv6/config/session-ka-holdtime", "65536"},
+		{"/ldp/global/discovery/interfaces/interface[interface-id='eth1']/address-families/ipv6/config/enable", "yes"},
+	}
+
+	for _, data := range datas {
+		if _, err := makeMplsLdp([][2]string{data}); err == nil {
+			t.Errorf("ldp.Put must be error. %s=%s", data[0], data[1])
+		}
+	}
+}
@@ -0,0 +1,381 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net"
+	nclib "netconf/lib"
+	ncxml "netconf/lib/xml"
+)
+
+const (
+	MPLS_LSPS_KEY               = "lsps"
+	MPLS_STATIC_LSPS_KEY        = "static-lsps"
+	MPLS_STATIC_LSP_KEY         = "static-lsp"
+	MPLS_LSP_INGRESS_KEY        = "ingress"
+	MPLS_LSP_TRANSIT_KEY        = "transit"
+	MPLS_LSP_EGRESS_KEY         = "egress"
+	MPLS_LSP_NEXTHOP_KEY        = "next-hop"
+	MPLS_LSP_INCOMING_LABEL_KEY = "incoming-label"
+	MPLS_LSP_PUSH_LABEL_KEY     = "push-label"
+	MPLS_LSP_DESTINATION_KEY    = "destination"
+)
+
+//
+// mpls/lsps
+//
+type MplsLsps struct {
+	nclib.SrChanges `xml:"-"`
+
+	StaticLsps MplsStaticLsps `xml:"static-lsps"`
+}
+
+func NewMplsLsps() *MplsLsps {
+	return &MplsLsps{
+		SrChanges:  nclib.NewSrChanges(),
+		StaticLsps: NewMplsStaticLsps(),
+	}
+}
+
+func (m *MplsLsps) String() string {
+	return fmt.Sprintf("%s{%s=%v} %s",
+		MPLS_LSPS_KEY,
+		MPLS_STATIC_LSPS_KEY, m.StaticLsps,
+		m.SrChanges,
+	)
+}
+
+func (m *MplsLsps) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case MPLS_STATIC_LSPS_KEY:
+		if err := m.StaticLsps.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	m.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessMplsLsps(p MplsStaticLspProcessor, reverse bool, name string, lsps *MplsLsps) error {
+	staticFunc := func() error {
+		if lsps.GetChange(MPLS_STATIC_LSPS_KEY) {
+			return ProcessMplsStaticLsps(p, reverse, name, lsps.StaticLsps)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, staticFunc)
+}
+
+//
+// mpls/lsps/static-lsps
+//
+type MplsStaticLsps map[string]*MplsStaticLsp
+
+func NewMplsStaticLsps() MplsStaticLsps {
+	return MplsStaticLsps{}
+}
+
+func (m MplsStaticLsps) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	lspName, ok := nodes[0].Attrs[OC_NAME_KEY]
+	if !ok {
+		return fmt.Errorf("%s@%s not found. %s", MPLS_STATIC_LSP_KEY, OC_NAME_KEY, nodes[0])
+	}
+
+	lsp, ok := m[lspName]
+	if !ok {
+		lsp = NewMplsStaticLsp(lspName)
+		m[lspName] = lsp
+	}
+
+	return lsp.Put(nodes[1:], value)
+}
+
+func (m MplsStaticLsps) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = MPLS_STATIC_LSPS_KEY
+	e.EncodeToken(start)
+
+	for _, lsp := range m {
+		err := e.EncodeElement(lsp, xml.StartElement{Name: xml.Name{Local: MPLS_STATIC_LSP_KEY}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+func ProcessMplsStaticLsps(p MplsStaticLspProcessor, reverse bool, name string, lsps MplsStaticLsps) error {
+	for lspName, lsp := range lsps {
+		if err := ProcessMplsStaticLsp(p, reverse, name, lspName, lsp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//
+// mpls/lsps/static-lsps/static-lsp[name]
+//
+type MplsStaticLsp struct {
+	nclib.SrChanges `xml:"-"`
+
+	Name    string               `xml:"name"`
+	Config  *MplsStaticLspConfig `xml:"config"`
+	Ingress *MplsStaticLspPath   `xml:"ingress"`
+	Transit *MplsStaticLspPath   `xml:"transit"`
+	Egress  *MplsStaticLspPath   `xml:"egress"`
+}
+
+type MplsStaticLspProcessor interface {
+	MplsStaticLspPathConfig(string, string, string, *MplsStaticLspPathConfig) error
+}
+
+func NewMplsStaticLsp(name string) *MplsStaticLsp {
+	return &MplsStaticLsp{
+		SrChanges: nclib.NewSrChanges(),
+		Name:      name,
+		Config:    NewMplsStaticLspConfig(),
+		Ingress:   NewMplsStaticLspPath(),
+		Transit:   NewMplsStaticLspPath(),
+		Egress:    NewMplsStaticLspPath(),
+	}
+}
+
+func (m *MplsStaticLsp) String() string {
+	return fmt.Sprintf("%s{%s='%s', %s, %s=%s, %s=%s, %s=%s} %s",
+		MPLS_STATIC_LSP_KEY,
+		OC_NAME_KEY, m.Name,
+		m.Config,
+		MPLS_LSP_INGRESS_KEY, m.Ingress,
+		MPLS_LSP_TRANSIT_KEY, m.Transit,
+		MPLS_LSP_EGRESS_KEY, m.Egress,
+		m.SrChanges,
+	)
+}
+
+func (m *MplsStaticLsp) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_NAME_KEY:
+		// m.Name = value // set by NewMplsStaticLsp
+
+	case OC_CONFIG_KEY:
+		if err := m.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case MPLS_LSP_INGRESS_KEY:
+		if err := m.Ingress.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case MPLS_LSP_TRANSIT_KEY:
+		if err := m.Transit.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case MPLS_LSP_EGRESS_KEY:
+		if err := m.Egress.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	m.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessMplsStaticLsp(p MplsStaticLspProcessor, reverse bool, name string, lspName string, lsp *MplsStaticLsp) error {
+	pathFunc := func(pathName string, path *MplsStaticLspPath) func() error {
+		return func() error {
+			if lsp.GetChange(pathName) && path.GetChange(OC_CONFIG_KEY) {
+				return p.MplsStaticLspPathConfig(name, lspName, pathName, path.Config)
+			}
+			return nil
+		}
+	}
+
+	return nclib.CallFunctions(
+		reverse,
+		pathFunc(MPLS_LSP_INGRESS_KEY, lsp.Ingress),
+		pathFunc(MPLS_LSP_TRANSIT_KEY, lsp.Transit),
+		pathFunc(MPLS_LSP_EGRESS_KEY, lsp.Egress),
+	)
+}
+
+//
+// mpls/lsps/static-lsps/static-lsp[name]/config
+//
+type MplsStaticLspConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Name string `xml:"name"`
+}
+
+func NewMplsStaticLspConfig() *MplsStaticLspConfig {
+	return &MplsStaticLspConfig{
+		SrChanges: nclib.NewSrChanges(),
+		Name:      "",
+	}
+}
+
+func (c *MplsStaticLspConfig) String() string {
+	return fmt.Sprintf("%s{%s='%s'} %s",
+		OC_CONFIG_KEY,
+		OC_NAME_KEY, c.Name,
+		c.SrChanges,
+	)
+}
+
+func (c *MplsStaticLspConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_NAME_KEY:
+		c.Name = value
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// mpls/lsps/static-lsps/static-lsp[name]/ingress
+// mpls/lsps/static-lsps/static-lsp[name]/transit
+// mpls/lsps/static-lsps/static-lsp[name]/egress
+//
+type MplsStaticLspPath struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *MplsStaticLspPathConfig `xml:"config"`
+}
+
+func NewMplsStaticLspPath() *MplsStaticLspPath {
+	return &MplsStaticLspPath{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewMplsStaticLspPathConfig(),
+	}
+}
+
+func (m *MplsStaticLspPath) String() string {
+	return fmt.Sprintf("{%s} %s", m.Config, m.SrChanges)
+}
+
+func (m *MplsStaticLspPath) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := m.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	m.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// mpls/lsps/static-lsps/static-lsp[name]/<ingress|transit|egress>/config
+//
+type MplsStaticLspPathConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	NextHop       net.IP     `xml:"next-hop"`
+	IncomingLabel MplsLabel  `xml:"incoming-label"`
+	PushLabel     MplsLabel  `xml:"push-label"`
+	Destination   *net.IPNet `xml:"destination"` // ingress only
+}
+
+func NewMplsStaticLspPathConfig() *MplsStaticLspPathConfig {
+	return &MplsStaticLspPathConfig{
+		SrChanges:     nclib.NewSrChanges(),
+		NextHop:       nil,
+		IncomingLabel: 0,
+		PushLabel:     0,
+		Destination:   nil,
+	}
+}
+
+func (c *MplsStaticLspPathConfig) String() string {
+	return fmt.Sprintf("%s{%s=%s, %s=%s, %s=%s, %s=%s} %s",
+		OC_CONFIG_KEY,
+		MPLS_LSP_NEXTHOP_KEY, c.NextHop,
+		MPLS_LSP_INCOMING_LABEL_KEY, c.IncomingLabel,
+		MPLS_LSP_PUSH_LABEL_KEY, c.PushLabel,
+		MPLS_LSP_DESTINATION_KEY, c.Destination,
+		c.SrChanges,
+	)
+}
+
+func (c *MplsStaticLspPathConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case MPLS_LSP_NEXTHOP_KEY:
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return fmt.Errorf("Invalid %s. %s", nodes[0].Name, value)
+		}
+		c.NextHop = ip
+
+	case MPLS_LSP_INCOMING_LABEL_KEY:
+		label, err := ParseMplsLabel(value)
+		if err != nil {
+			return err
+		}
+		c.IncomingLabel = label
+
+	case MPLS_LSP_PUSH_LABEL_KEY:
+		label, err := ParseMplsLabel(value)
+		if err != nil {
+			return err
+		}
+		c.PushLabel = label
+
+	case MPLS_LSP_DESTINATION_KEY:
+		_, nw, err := net.ParseCIDR(value)
+		if err != nil {
+			return err
+		}
+		c.Destination = nw
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
@@ -0,0 +1,164 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	srlib "netconf/lib/sysrepo"
+	"testing"
+)
+
+func makeMpls(datas [][2]string) (*Mpls, error) {
+	mpls := NewMpls()
+	for _, data := range datas {
+		xpath, value := data[0], data[1]
+		nodes := srlib.ParseXPath(xpath)
+		if err := mpls.Put(nodes[1:], value); err != nil {
+			return nil, err
+		}
+	}
+	return mpls, nil
+}
+
+func TestMplsStaticLsp(t *testing.T) {
+	mpls, err := makeMpls([][2]string{
+		{"/mpls/lsps/static-lsps/static-lsp[name='lsp1']/name", "lsp1"},
+		{"/mpls/lsps/static-lsps/static-lsp[name='lsp1']/config/name", "lsp1"},
+		{"/mpls/lsps/static-lsps/static-lsp[name='lsp1']/transit/config/next-hop", "10.0.1.2"},
+		{"/mpls/lsps/static-lsps/static-lsp[name='lsp1']/transit/config/incoming-label", "1000"},
+		{"/mpls/lsps/static-lsps/static-lsp[name='lsp1']/transit/config/push-label", "2000"},
+		{"/mpls/lsps/static-lsps/static-lsp[name='lsp2']/egress/config/next-hop", "2001:db8::2"},
+		{"/mpls/lsps/static-lsps/static-lsp[name='lsp2']/egress/config/incoming-label", "1001"},
+		{"/mpls/lsps/static-lsps/static-lsp[name='lsp2']/egress/config/push-label", "IMPLICIT_NULL"},
+		{"/mpls/lsps/static-lsps/static-lsp[name='lsp3']/ingress/config/next-hop", "10.0.1.2"},
+		{"/mpls/lsps/static-lsps/static-lsp[name='lsp3']/ingress/config/push-label", "3000"},
+		{"/mpls/lsps/static-lsps/static-lsp[name='lsp3']/ingress/config/destination", "20.0.0.0/24"},
+	})
+
+	if err != nil {
+		t.Fatalf("mpls.Put error. %s", err)
+	}
+
+	if v := mpls.Compare(MPLS_LSPS_KEY); !v {
+		t.Errorf("mpls.Put unmatch. cmp=%t", v)
+	}
+
+	lsp, ok := mpls.Lsps.StaticLsps["lsp1"]
+	if !ok {
+		t.Fatalf("mpls.Put unmatch. %v", mpls.Lsps.StaticLsps)
+	}
+
+	if v := lsp.Compare(OC_NAME_KEY, OC_CONFIG_KEY, MPLS_LSP_TRANSIT_KEY); !v {
+		t.Errorf("mpls.Put unmatch. cmp=%t", v)
+	}
+
+	config := lsp.Transit.Config
+	if v := config.NextHop.String(); v != "10.0.1.2" {
+		t.Errorf("mpls.Put unmatch. next-hop=%s", v)
+	}
+
+	if v := config.IncomingLabel; v != 1000 {
+		t.Errorf("mpls.Put unmatch. incoming-label=%s", v)
+	}
+
+	if v := config.PushLabel; v != 2000 {
+		t.Errorf("mpls.Put unmatch. push-label=%s", v)
+	}
+
+	lsp, ok = mpls.Lsps.StaticLsps["lsp2"]
+	if !ok {
+		t.Fatalf("mpls.Put unmatch. %v", mpls.Lsps.StaticLsps)
+	}
+
+	if v := lsp.Compare(MPLS_LSP_EGRESS_KEY); !v {
+		t.Errorf("mpls.Put unmatch. cmp=%t", v)
+	}
+
+	config = lsp.Egress.Config
+	if v := config.NextHop.String(); v != "2001:db8::2" {
+		t.Errorf("mpls.Put unmatch. next-hop=%s", v)
+	}
+
+	if v := config.PushLabel; v != MPLS_LABEL_IMPLICIT_NULL {
+		t.Errorf("mpls.Put unmatch. push-label=%s", v)
+	}
+
+	lsp, ok = mpls.Lsps.StaticLsps["lsp3"]
+	if !ok {
+		t.Fatalf("mpls.Put unmatch. %v", mpls.Lsps.StaticLsps)
+	}
+
+	config = lsp.Ingress.Config
+	if v := config.Destination.String(); v != "20.0.0.0/24" {
+		t.Errorf("mpls.Put unmatch. destination=%s", v)
+	}
+
+	if v := config.PushLabel; v != 3000 {
+		t.Errorf("mpls.Put unmatch. push-label=%s", v)
+	}
+}
+
+func TestMplsLabel(t *testing.T) {
+	datas := []struct {
+		s     string
+		label MplsLabel
+		str   string
+		unres bool
+	}{
+		{"IPV4_EXPLICIT_NULL", MPLS_LABEL_IPV4_EXPLICIT_NULL, "IPV4_EXPLICIT_NULL", false},
+		{"oc-mplst:IMPLICIT_NULL", MPLS_LABEL_IMPLICIT_NULL, "IMPLICIT_NULL", false},
+		{"15", 15, "15", false},
+		{"16", 16, "16", true},
+		{"1048575", 1048575, "1048575", true},
+		{"1048576", 1048576, "1048576", false},
+	}
+
+	for _, data := range datas {
+		label, err := ParseMplsLabel(data.s)
+		if err != nil {
+			t.Errorf("ParseMplsLabel error. %s", err)
+		}
+
+		if label != data.label {
+			t.Errorf("ParseMplsLabel unmatch. %d", label)
+		}
+
+		if v := label.String(); v != data.str {
+			t.Errorf("MplsLabel.String unmatch. %s", v)
+		}
+
+		if v := label.IsUnreserved(); v != data.unres {
+			t.Errorf("MplsLabel.IsUnreserved unmatch. %s %t", label, v)
+		}
+	}
+}
+
+func TestMplsStaticLsp_invalid(t *testing.T) {
+	datas := [][2]string{
+		{"/mpls/lsps/static-lsps/static-lsp[name='lsp1']/transit/config/next-hop", "10.0.1"},
+		{"/mpls/lsps/static-lsps/static-lsp[name='lsp1']/transit/config/incoming-label", "-1"},
+		{"/mpls/lsps/static-lsps/static-lsp[name='lsp1']/transit/config/push-label", "NO_LABEL"},
+		{"/mpls/lsps/static-lsps/static-lsp[name='lsp1']/ingress/config/destination", "20.0.0.0"},
+		{"/mpls/lsps/static-lsps/static-lsp/transit/config/push-label", "100"},
+	}
+
+	for _, data := range datas {
+		if _, err := makeMpls([][2]string{data}); err == nil {
+			t.Errorf("mpls.Put must be error. %v", data)
+		}
+	}
+}
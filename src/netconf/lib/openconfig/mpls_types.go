@@ -21,6 +21,7 @@ import (
 	"encoding/xml"
 	"fmt"
 	ncxml "netconf/lib/xml"
+	"strconv"
 )
 
 type MplsNullLabelType int
@@ -72,3 +73,99 @@ func (v MplsNullLabelType) MarshalXML(e *xml.Encoder, start xml.StartElement) er
 	start.Attr = append(start.Attr, attr)
 	return e.EncodeElement(text, start)
 }
+
+//
+// LDP dual-stack transport connection preference
+//
+type MplsLdpTransportPreference int
+
+const (
+	MPLS_LDP_TRANSPORT_PREFERENCE MplsLdpTransportPreference = iota
+	MPLS_LDP_TRANSPORT_PREFER_IPV4
+	MPLS_LDP_TRANSPORT_PREFER_IPV6
+)
+
+var mplsLdpTransportPreferenceNames = map[MplsLdpTransportPreference]string{
+	MPLS_LDP_TRANSPORT_PREFERENCE:  "TRANSPORT_PREFERENCE",
+	MPLS_LDP_TRANSPORT_PREFER_IPV4: "IPV4",
+	MPLS_LDP_TRANSPORT_PREFER_IPV6: "IPV6",
+}
+
+var mplsLdpTransportPreferenceValues = map[string]MplsLdpTransportPreference{
+	"TRANSPORT_PREFERENCE": MPLS_LDP_TRANSPORT_PREFERENCE,
+	"IPV4":                 MPLS_LDP_TRANSPORT_PREFER_IPV4,
+	"IPV6":                 MPLS_LDP_TRANSPORT_PREFER_IPV6,
+}
+
+func (v MplsLdpTransportPreference) String() string {
+	if s, ok := mplsLdpTransportPreferenceNames[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("MplsLdpTransportPreference(%d)", v)
+}
+
+func ParseMplsLdpTransportPreference(s string) (MplsLdpTransportPreference, error) {
+	_, name := ncxml.ParseXPathName(s)
+	if v, ok := mplsLdpTransportPreferenceValues[name]; ok {
+		return v, nil
+	}
+	return MPLS_LDP_TRANSPORT_PREFERENCE, fmt.Errorf("Invalid MplsLdpTransportPreference. %s", s)
+}
+
+//
+// MPLS label (mpls-label)
+// The reserved labels are encoded with their names.
+//
+type MplsLabel uint32
+
+const (
+	MPLS_LABEL_IPV4_EXPLICIT_NULL      MplsLabel = 0
+	MPLS_LABEL_ROUTER_ALERT            MplsLabel = 1
+	MPLS_LABEL_IPV6_EXPLICIT_NULL      MplsLabel = 2
+	MPLS_LABEL_IMPLICIT_NULL           MplsLabel = 3
+	MPLS_LABEL_ENTROPY_LABEL_INDICATOR MplsLabel = 7
+	MPLS_LABEL_MIN                     MplsLabel = 16
+	MPLS_LABEL_MAX                     MplsLabel = 1048575
+)
+
+var mplsLabelNames = map[MplsLabel]string{
+	MPLS_LABEL_IPV4_EXPLICIT_NULL:      "IPV4_EXPLICIT_NULL",
+	MPLS_LABEL_ROUTER_ALERT:            "ROUTER_ALERT",
+	MPLS_LABEL_IPV6_EXPLICIT_NULL:      "IPV6_EXPLICIT_NULL",
+	MPLS_LABEL_IMPLICIT_NULL:           "IMPLICIT_NULL",
+	MPLS_LABEL_ENTROPY_LABEL_INDICATOR: "ENTROPY_LABEL_INDICATOR",
+}
+
+var mplsLabelValues = map[string]MplsLabel{
+	"IPV4_EXPLICIT_NULL":      MPLS_LABEL_IPV4_EXPLICIT_NULL,
+	"ROUTER_ALERT":            MPLS_LABEL_ROUTER_ALERT,
+	"IPV6_EXPLICIT_NULL":      MPLS_LABEL_IPV6_EXPLICIT_NULL,
+	"IMPLICIT_NULL":           MPLS_LABEL_IMPLICIT_NULL,
+	"ENTROPY_LABEL_INDICATOR": MPLS_LABEL_ENTROPY_LABEL_INDICATOR,
+}
+
+func (v MplsLabel) String() string {
+	if s, ok := mplsLabelNames[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("%d", v)
+}
+
+//
+// IsUnreserved returns true if the label can be allocated.
+//
+func (v MplsLabel) IsUnreserved() bool {
+	return v >= MPLS_LABEL_MIN && v <= MPLS_LABEL_MAX
+}
+
+func ParseMplsLabel(s string) (MplsLabel, error) {
+	if v, err := strconv.ParseUint(s, 0, 32); err == nil {
+		return MplsLabel(v), nil
+	}
+
+	_, name := ncxml.ParseXPathName(s)
+	if v, ok := mplsLabelValues[name]; ok {
+		return v, nil
+	}
+	return 0, fmt.Errorf("Invalid MplsLabel. %s", s)
+}
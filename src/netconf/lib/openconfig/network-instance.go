@@ -18,6 +18,7 @@
 package openconfig
 
 import (
+	"encoding/json"
 	"encoding/xml"
 	"fmt"
 	nclib "netconf/lib"
@@ -95,6 +96,35 @@ func (i NetworkInstances) MarshalXML(e *xml.Encoder, start xml.StartElement) err
 	return e.EncodeToken(start.End())
 }
 
+func (n *NetworkInstances) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if *n == nil {
+		*n = NewNetworkInstances()
+	}
+	return ncxml.DecodeXPath(d, start, *n)
+}
+
+func (n NetworkInstances) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newOcListRoot(NETWORKINSTANCES_MODULE, NETWORKINSTANCES_KEY, n))
+}
+
+func (n *NetworkInstances) UnmarshalJSON(data []byte) error {
+	if *n == nil {
+		*n = NewNetworkInstances()
+	}
+	return decodeJSON(data, NETWORKINSTANCES_KEY, *n)
+}
+
+func (n NetworkInstances) MarshalYAML() (interface{}, error) {
+	return newOcListRoot(NETWORKINSTANCES_MODULE, NETWORKINSTANCES_KEY, n).MarshalYAML()
+}
+
+func (n *NetworkInstances) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if *n == nil {
+		*n = NewNetworkInstances()
+	}
+	return decodeYAML(unmarshal, NETWORKINSTANCES_KEY, *n)
+}
+
 //
 // /network-instances/network-instance[name]
 //
@@ -106,7 +136,10 @@ type NetworkInstance struct {
 	Loopbacks  NetworkInstanceLoopbacks  `xml:"loopbacks"`
 	Interfaces NetworkInstanceInterfaces `xml:"interfaces"`
 	Mpls       *Mpls                     `xml:"mpls"`
+	Bfd        *Bfd                      `xml:"bfd"`
+	Evpn       *NetworkInstanceEvpn      `xml:"evpn"`
 	Protocols  NetworkInstanceProtocols  `xml:"protocols"`
+	TableConns TableConnections          `xml:"table-connections"`
 }
 
 type NetworkInstanceProcessor interface {
@@ -115,7 +148,10 @@ type NetworkInstanceProcessor interface {
 	NetworkInstanceLoopbackProcessor
 	NetworkInstanceInterfaceProcessor
 	MplsProcessor
+	BfdProcessor
+	NetworkInstanceEvpnProcessor
 	NetworkInstanceProtocolProcessor
+	TableConnectionProcessor
 }
 
 type networkInstanceProcessor interface {
@@ -130,19 +166,25 @@ func NewNetworkInstance(name string) *NetworkInstance {
 		Loopbacks:  NewNetworkInstanceLoopbacks(),
 		Interfaces: NewNetworkInstanceInterfaces(),
 		Mpls:       NewMpls(),
+		Bfd:        NewBfd(),
+		Evpn:       NewNetworkInstanceEvpn(),
 		Protocols:  NewNetworkInstanceProtocols(),
+		TableConns: NewTableConnections(),
 	}
 }
 
 func (n *NetworkInstance) String() string {
-	return fmt.Sprintf("%s{%s='%s', %s, %s, %s, %s, %v} %s",
+	return fmt.Sprintf("%s{%s='%s', %s, %s, %s, %s, %s, %s, %v, %v} %s",
 		NETWORKINSTANCE_KEY,
 		OC_NAME_KEY, n.Name,
 		n.Config,
 		n.Loopbacks,
 		n.Interfaces,
 		n.Mpls,
+		n.Bfd,
+		n.Evpn,
 		n.Protocols,
+		n.TableConns,
 		n.SrChanges,
 	)
 }
@@ -174,10 +216,25 @@ func (n *NetworkInstance) Put(nodes []*ncxml.XPathNode, value string) error {
 			return err
 		}
 
+	case BFD_KEY:
+		if err := n.Bfd.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case NETWORKINSTANCE_EVPN_KEY:
+		if err := n.Evpn.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
 	case NETWORKINSTANCE_PROTOS_KEY:
 		if err := n.Protocols.Put(nodes[1:], value); err != nil {
 			return err
 		}
+
+	case NETWORKINSTANCE_TBLCONNS_KEY:
+		if err := n.TableConns.Put(nodes[1:], value); err != nil {
+			return err
+		}
 	}
 
 	n.SetChange(nodes[0].Name)
@@ -241,6 +298,30 @@ func ProcessNetworkInstance(p NetworkInstanceProcessor, reverse bool, name strin
 		return nil
 	}
 
+	bfdFunc := func() error {
+		if ni.GetChange(BFD_KEY) {
+			return ProcessBfd(
+				p.(BfdProcessor),
+				reverse,
+				name,
+				ni.Bfd,
+			)
+		}
+		return nil
+	}
+
+	evpnFunc := func() error {
+		if ni.GetChange(NETWORKINSTANCE_EVPN_KEY) {
+			return ProcessNetworkInstanceEvpn(
+				p.(NetworkInstanceEvpnProcessor),
+				reverse,
+				name,
+				ni.Evpn,
+			)
+		}
+		return nil
+	}
+
 	protosFunc := func() error {
 		if ni.GetChange(NETWORKINSTANCE_PROTOS_KEY) {
 			return ProcessNetworkInstanceProtocols(
@@ -253,7 +334,19 @@ func ProcessNetworkInstance(p NetworkInstanceProcessor, reverse bool, name strin
 		return nil
 	}
 
-	return nclib.CallFunctions(reverse, nameFunc, configFunc, losFunc, ifsFunc, mplsFunc, protosFunc)
+	tblconnsFunc := func() error {
+		if ni.GetChange(NETWORKINSTANCE_TBLCONNS_KEY) {
+			return ProcessTableConnections(
+				p.(TableConnectionProcessor),
+				reverse,
+				name,
+				ni.TableConns,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, nameFunc, configFunc, losFunc, ifsFunc, mplsFunc, bfdFunc, evpnFunc, protosFunc, tblconnsFunc)
 }
 
 //
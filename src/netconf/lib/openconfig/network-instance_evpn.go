@@ -0,0 +1,315 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"encoding/xml"
+	"fmt"
+	nclib "netconf/lib"
+	ncnet "netconf/lib/net"
+	ncxml "netconf/lib/xml"
+	"strconv"
+)
+
+const (
+	NETWORKINSTANCE_EVPN_KEY = "evpn"
+	EVPN_INSTANCES_KEY       = "evpn-instances"
+	EVPN_INSTANCE_KEY        = "evpn-instance"
+	EVPN_EVI_KEY             = "evi"
+	EVPN_VNI_KEY             = "vni"
+	EVPN_BRIDGE_KEY          = "bridge"
+	EVPN_VNI_MAX             = 16777215
+)
+
+//
+// network-instances/network-instance[name]/evpn
+//
+type NetworkInstanceEvpn struct {
+	nclib.SrChanges `xml:"-"`
+
+	Instances EvpnInstances `xml:"evpn-instances"`
+}
+
+type NetworkInstanceEvpnProcessor interface {
+	networkInstanceEvpnProcessor
+	EvpnInstanceProcessor
+}
+
+type networkInstanceEvpnProcessor interface {
+	NetworkInstanceEvpn(string, *NetworkInstanceEvpn) error
+}
+
+func NewNetworkInstanceEvpn() *NetworkInstanceEvpn {
+	return &NetworkInstanceEvpn{
+		SrChanges: nclib.NewSrChanges(),
+		Instances: NewEvpnInstances(),
+	}
+}
+
+func (n *NetworkInstanceEvpn) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		NETWORKINSTANCE_EVPN_KEY,
+		n.Instances,
+		n.SrChanges,
+	)
+}
+
+func (n *NetworkInstanceEvpn) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case EVPN_INSTANCES_KEY:
+		if err := n.Instances.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	n.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessNetworkInstanceEvpn(p NetworkInstanceEvpnProcessor, reverse bool, name string, evpn *NetworkInstanceEvpn) error {
+	evpnFunc := func() error {
+		return p.NetworkInstanceEvpn(name, evpn)
+	}
+
+	instsFunc := func() error {
+		if evpn.GetChange(EVPN_INSTANCES_KEY) {
+			return ProcessEvpnInstances(p, reverse, name, evpn.Instances)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, evpnFunc, instsFunc)
+}
+
+//
+// network-instances/network-instance[name]/evpn/evpn-instances
+//
+type EvpnInstances map[string]*EvpnInstance
+
+func NewEvpnInstances() EvpnInstances {
+	return EvpnInstances{}
+}
+
+func (e EvpnInstances) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	evi, ok := nodes[0].Attrs[EVPN_EVI_KEY]
+	if !ok {
+		return fmt.Errorf("%s@%s not found. %s", EVPN_INSTANCE_KEY, EVPN_EVI_KEY, nodes[0])
+	}
+
+	inst, ok := e[evi]
+	if !ok {
+		inst = NewEvpnInstance(evi)
+		e[evi] = inst
+	}
+
+	return inst.Put(nodes[1:], value)
+}
+
+func (e EvpnInstances) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = EVPN_INSTANCES_KEY
+	enc.EncodeToken(start)
+
+	for _, inst := range e {
+		err := enc.EncodeElement(inst, xml.StartElement{Name: xml.Name{Local: EVPN_INSTANCE_KEY}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return enc.EncodeToken(start.End())
+}
+
+func ProcessEvpnInstances(p EvpnInstanceProcessor, reverse bool, name string, insts EvpnInstances) error {
+	for evi, inst := range insts {
+		if err := ProcessEvpnInstance(p, reverse, name, evi, inst); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//
+// network-instances/network-instance[name]/evpn/evpn-instances/evpn-instance[evi]
+//
+type EvpnInstance struct {
+	nclib.SrChanges `xml:"-"`
+
+	Evi    string              `xml:"evi"`
+	Config *EvpnInstanceConfig `xml:"config"`
+}
+
+type EvpnInstanceProcessor interface {
+	NetworkInstanceEvpnInstance(string, string, *EvpnInstance) error
+	NetworkInstanceEvpnInstanceConfig(string, string, *EvpnInstanceConfig) error
+}
+
+func NewEvpnInstance(evi string) *EvpnInstance {
+	return &EvpnInstance{
+		SrChanges: nclib.NewSrChanges(),
+		Evi:       evi,
+		Config:    NewEvpnInstanceConfig(),
+	}
+}
+
+func (e *EvpnInstance) String() string {
+	return fmt.Sprintf("%s{%s=%s, %s} %s",
+		EVPN_INSTANCE_KEY,
+		EVPN_EVI_KEY, e.Evi,
+		e.Config,
+		e.SrChanges,
+	)
+}
+
+func (e *EvpnInstance) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case EVPN_EVI_KEY:
+		// e.Evi = value // set by NewEvpnInstance
+
+	case OC_CONFIG_KEY:
+		if err := e.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	e.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessEvpnInstance(p EvpnInstanceProcessor, reverse bool, name string, evi string, inst *EvpnInstance) error {
+	instFunc := func() error {
+		if inst.GetChange(EVPN_EVI_KEY) {
+			return p.NetworkInstanceEvpnInstance(name, evi, inst)
+		}
+		return nil
+	}
+
+	configFunc := func() error {
+		if inst.GetChange(OC_CONFIG_KEY) {
+			return p.NetworkInstanceEvpnInstanceConfig(name, evi, inst.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, instFunc, configFunc)
+}
+
+//
+// network-instances/network-instance[name]/evpn/evpn-instances/evpn-instance[evi]/config
+//
+type EvpnInstanceConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Evi    uint32                   `xml:"evi"`
+	RD     ncnet.RouteDistinguisher `xml:"route-distinguisher"`
+	RT     ncnet.RouteDistinguisher `xml:"route-target"`
+	Vni    uint32                   `xml:"vni"`
+	Bridge string                   `xml:"bridge"`
+}
+
+func NewEvpnInstanceConfig() *EvpnInstanceConfig {
+	return &EvpnInstanceConfig{
+		SrChanges: nclib.NewSrChanges(),
+		Evi:       0,
+		RD:        ncnet.RouteDistinguisherNone{},
+		RT:        ncnet.RouteDistinguisherNone{},
+		Vni:       0,
+		Bridge:    "",
+	}
+}
+
+func (e *EvpnInstanceConfig) String() string {
+	return fmt.Sprintf("%s{%s=%d, %s='%s', %s='%s', %s=%d, %s='%s'} %s",
+		OC_CONFIG_KEY,
+		EVPN_EVI_KEY, e.Evi,
+		NETWORKINSTANCE_RD_KEY, e.RD,
+		NETWORKINSTANCE_RT_KEY, e.RT,
+		EVPN_VNI_KEY, e.Vni,
+		EVPN_BRIDGE_KEY, e.Bridge,
+		e.SrChanges,
+	)
+}
+
+func (e *EvpnInstanceConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case EVPN_EVI_KEY:
+		evi, err := strconv.ParseUint(value, 0, 32)
+		if err != nil {
+			return err
+		}
+		e.Evi = uint32(evi)
+
+	case NETWORKINSTANCE_RD_KEY:
+		rd, err := ncnet.ParseRouteDistinguisher(value)
+		if err != nil {
+			return err
+		}
+		e.RD = rd
+
+	case NETWORKINSTANCE_RT_KEY:
+		rt, err := ncnet.ParseRouteDistinguisher(value)
+		if err != nil {
+			return err
+		}
+		e.RT = rt
+
+	case EVPN_VNI_KEY:
+		vni, err := ParseEvpnVni(value)
+		if err != nil {
+			return err
+		}
+		e.Vni = vni
+
+	case EVPN_BRIDGE_KEY:
+		e.Bridge = value
+	}
+
+	e.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// ParseEvpnVni parses VXLAN network identifier (1 - 16777215).
+//
+func ParseEvpnVni(s string) (uint32, error) {
+	vni, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	if vni == 0 || vni > EVPN_VNI_MAX {
+		return 0, fmt.Errorf("Invalid %s. %s", EVPN_VNI_KEY, s)
+	}
+
+	return uint32(vni), nil
+}
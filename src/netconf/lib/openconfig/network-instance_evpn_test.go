@@ -0,0 +1,101 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	srlib "netconf/lib/sysrepo"
+	"testing"
+)
+
+func makeNetworkInstanceEvpn(datas [][2]string) (*NetworkInstanceEvpn, error) {
+	evpn := NewNetworkInstanceEvpn()
+	for _, data := range datas {
+		xpath, value := data[0], data[1]
+		nodes := srlib.ParseXPath(xpath)
+		if err := evpn.Put(nodes[1:], value); err != nil {
+			return nil, err
+		}
+	}
+	return evpn, nil
+}
+
+func TestNetworkInstanceEvpnInstance(t *testing.T) {
+	evpn, err := makeNetworkInstanceEvpn([][2]string{
+		{"/evpn/evpn-instances/evpn-instance[evi='10']/evi", "10"},
+		{"/evpn/evpn-instances/evpn-instance[evi='10']/config/evi", "10"},
+		{"/evpn/evpn-instances/evpn-instance[evi='10']/config/route-distinguisher", "10.0.0.1:10"},
+		{"/evpn/evpn-instances/evpn-instance[evi='10']/config/route-target", "65001:10"},
+		{"/evpn/evpn-instances/evpn-instance[evi='10']/config/vni", "10010"},
+		{"/evpn/evpn-instances/evpn-instance[evi='10']/config/bridge", "br10"},
+	})
+
+	if err != nil {
+		t.Errorf("evpn.Put error. %s", err)
+	}
+
+	if v := evpn.Compare(EVPN_INSTANCES_KEY); !v {
+		t.Errorf("evpn.Put unmatch. cmp=%t", v)
+	}
+
+	inst, ok := evpn.Instances["10"]
+	if !ok {
+		t.Fatalf("evpn.Put unmatch. %v", evpn.Instances)
+	}
+
+	if v := inst.Compare(EVPN_EVI_KEY, OC_CONFIG_KEY); !v {
+		t.Errorf("evpn.Put unmatch. cmp=%t", v)
+	}
+
+	if v := inst.Config.Evi; v != 10 {
+		t.Errorf("evpn.Put unmatch. evi=%d", v)
+	}
+
+	if v := inst.Config.RD.String(); v != "10.0.0.1:10" {
+		t.Errorf("evpn.Put unmatch. rd=%s", v)
+	}
+
+	if v := inst.Config.RT.String(); v != "65001:10" {
+		t.Errorf("evpn.Put unmatch. rt=%s", v)
+	}
+
+	if v := inst.Config.Vni; v != 10010 {
+		t.Errorf("evpn.Put unmatch. vni=%d", v)
+	}
+
+	if v := inst.Config.Bridge; v != "br10" {
+		t.Errorf("evpn.Put unmatch. bridge=%s", v)
+	}
+}
+
+func TestNetworkInstanceEvpnInstance_vni(t *testing.T) {
+	datas := []string{"0", "16777216", "x"}
+
+	for _, data := range datas {
+		_, err := makeNetworkInstanceEvpn([][2]string{
+			{"/evpn/evpn-instances/evpn-instance[evi='10']/config/vni", data},
+		})
+
+		if err == nil {
+			t.Errorf("evpn.Put must be error. vni=%s", data)
+		}
+	}
+
+	if v, err := ParseEvpnVni("16777215"); err != nil || v != 16777215 {
+		t.Errorf("ParseEvpnVni unmatch. %d %s", v, err)
+	}
+}
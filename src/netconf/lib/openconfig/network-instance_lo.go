@@ -287,7 +287,7 @@ func (i NetworkInstanceLoopbackAddrs) MarshalXML(e *xml.Encoder, start xml.Start
 type NetworkInstanceLoopbackAddr struct {
 	nclib.SrChanges `xml:"-"`
 
-	Index  string                             `xml:"index"`
+	Index  string                             `xml:"id" yang:"index"`
 	Config *NetworkInstanceLoopbackAddrConfig `xml:"config"`
 }
 
@@ -368,7 +368,7 @@ func ProcessNetworkInstanceLoopbackAddr(p NetworkInstanceLoopbackAddrProcessor,
 type NetworkInstanceLoopbackAddrConfig struct {
 	nclib.SrChanges `xml:"-"`
 
-	Index     string `xml:"id"`
+	Index     string `xml:"index"`
 	Ip        net.IP `xml:"ip"`
 	PrefixLen uint8  `xml:"prefix-length"`
 }
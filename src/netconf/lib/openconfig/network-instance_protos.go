@@ -112,10 +112,11 @@ type NetworkInstanceProtocol struct {
 	Ident        InstallProtocolType            `xml:"identifier"`
 	Name         string                         `xml:"name"`
 	Config       *NetworkInstanceProtocolConfig `xml:"config"`
-	StaticRoutes StaticRoutes                   `xml:"-"`
-	Ospfv2       *Ospfv2                        `xml:"-"`
-	Ospfv3       *Ospfv3                        `xml:"-"`
-	Bgp          *Bgp                           `xml:"-"`
+	StaticRoutes StaticRoutes                   `xml:"-" yang:"static-routes"`
+	Ospfv2       *Ospfv2                        `xml:"-" yang:"ospfv2"`
+	Ospfv3       *Ospfv3                        `xml:"-" yang:"ospfv3"`
+	Bgp          *Bgp                           `xml:"-" yang:"bgp"`
+	Isis         *Isis                          `xml:"isis"`
 }
 
 type NetworkInstanceProtocolProcessor interface {
@@ -125,6 +126,8 @@ type NetworkInstanceProtocolProcessor interface {
 	Ospfv2Processor
 	Ospfv3Processor
 	BgpProcessor
+	IsisProcessor
+	SegmentRoutingProcessor
 }
 
 type networkInstanceProtocolProcessor interface {
@@ -141,6 +144,7 @@ func NewNetworkInstanceProtocol(key *NetworkInstanceProtocolKey) *NetworkInstanc
 		Ospfv2:       NewOspfv2(),
 		Ospfv3:       NewOspfv3(),
 		Bgp:          NewBgp(),
+		Isis:         NewIsis(),
 	}
 }
 
@@ -180,6 +184,11 @@ func (p *NetworkInstanceProtocol) Put(nodes []*ncxml.XPathNode, value string) er
 		if err := p.Bgp.Put(nodes[1:], value); err != nil {
 			return err
 		}
+
+	case ISIS_KEY:
+		if err := p.Isis.Put(nodes[1:], value); err != nil {
+			return err
+		}
 	}
 
 	p.SetChange(nodes[0].Name)
@@ -259,7 +268,20 @@ func ProcessNetworkInstanceProtocol(p NetworkInstanceProtocolProcessor, reverse
 		return nil
 	}
 
-	return nclib.CallFunctions(reverse, niProtoFunc, configFunc, staticFunc, ospfv2Func, ospfv3Func, bgpFunc)
+	isisFunc := func() error {
+		if proto.GetChange(ISIS_KEY) && key.Ident == INSTALL_PROTOCOL_ISIS {
+			return ProcessIsis(
+				p.(IsisProcessor),
+				reverse,
+				name,
+				key,
+				proto.Isis,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, niProtoFunc, configFunc, staticFunc, ospfv2Func, ospfv3Func, bgpFunc, isisFunc)
 }
 
 type NetworkInstanceProtocolConfig struct {
@@ -0,0 +1,280 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"encoding/xml"
+	"fmt"
+	nclib "netconf/lib"
+	ncxml "netconf/lib/xml"
+)
+
+const (
+	NETWORKINSTANCE_TBLCONNS_KEY = "table-connections"
+	NETWORKINSTANCE_TBLCONN_KEY  = "table-connection"
+	TBLCONN_SRC_PROTO_KEY        = "src-protocol"
+	TBLCONN_DST_PROTO_KEY        = "dst-protocol"
+	TBLCONN_AF_KEY               = "address-family"
+	TBLCONN_IMPORT_KEY           = "import-policy"
+	TBLCONN_IMPORT_DEF_KEY       = "default-import-policy"
+)
+
+type TableConnectionKey struct {
+	SrcProtocol   InstallProtocolType
+	DstProtocol   InstallProtocolType
+	AddressFamily AddressFamily
+}
+
+func NewTableConnectionKey(src, dst InstallProtocolType, af AddressFamily) *TableConnectionKey {
+	return &TableConnectionKey{
+		SrcProtocol:   src,
+		DstProtocol:   dst,
+		AddressFamily: af,
+	}
+}
+
+func ParseTableConnectionKey(src, dst, af string) (*TableConnectionKey, error) {
+	srcProto, err := ParseInstallProtocolType(src)
+	if err != nil {
+		return nil, err
+	}
+
+	dstProto, err := ParseInstallProtocolType(dst)
+	if err != nil {
+		return nil, err
+	}
+
+	family, err := ParseAddressFamily(af)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTableConnectionKey(srcProto, dstProto, family), nil
+}
+
+func (k *TableConnectionKey) String() string {
+	return fmt.Sprintf("%s/%s/%s", k.SrcProtocol, k.DstProtocol, k.AddressFamily)
+}
+
+//
+// network-instances/network-instance[name]/table-connections
+//
+type TableConnections map[TableConnectionKey]*TableConnection
+
+func NewTableConnections() TableConnections {
+	return TableConnections{}
+}
+
+func (t TableConnections) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	src, ok := nodes[0].Attrs[TBLCONN_SRC_PROTO_KEY]
+	if !ok {
+		return fmt.Errorf("%s@%s not found. %s", NETWORKINSTANCE_TBLCONN_KEY, TBLCONN_SRC_PROTO_KEY, nodes[0])
+	}
+	dst, ok := nodes[0].Attrs[TBLCONN_DST_PROTO_KEY]
+	if !ok {
+		return fmt.Errorf("%s@%s not found. %s", NETWORKINSTANCE_TBLCONN_KEY, TBLCONN_DST_PROTO_KEY, nodes[0])
+	}
+	af, ok := nodes[0].Attrs[TBLCONN_AF_KEY]
+	if !ok {
+		return fmt.Errorf("%s@%s not found. %s", NETWORKINSTANCE_TBLCONN_KEY, TBLCONN_AF_KEY, nodes[0])
+	}
+	key, err := ParseTableConnectionKey(src, dst, af)
+	if err != nil {
+		return err
+	}
+
+	conn, ok := t[*key]
+	if !ok {
+		conn = NewTableConnection(key)
+		t[*key] = conn
+	}
+
+	return conn.Put(nodes[1:], value)
+}
+
+func ProcessTableConnections(p TableConnectionProcessor, reverse bool, name string, conns TableConnections) error {
+	for key, conn := range conns {
+		if err := ProcessTableConnection(p, reverse, name, &key, conn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t TableConnections) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = NETWORKINSTANCE_TBLCONNS_KEY
+	e.EncodeToken(start)
+
+	for _, conn := range t {
+		err := e.EncodeElement(conn, xml.StartElement{Name: xml.Name{Local: NETWORKINSTANCE_TBLCONN_KEY}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+//
+// network-instances/network-instance[name]/table-connections/table-connection[src-protocol dst-protocol address-family]
+//
+type TableConnection struct {
+	nclib.SrChanges `xml:"-"`
+
+	SrcProtocol   InstallProtocolType    `xml:"src-protocol"`
+	DstProtocol   InstallProtocolType    `xml:"dst-protocol"`
+	AddressFamily AddressFamily          `xml:"address-family"`
+	Config        *TableConnectionConfig `xml:"config"`
+}
+
+type TableConnectionProcessor interface {
+	TableConnectionConfig(string, *TableConnectionKey, *TableConnectionConfig) error
+}
+
+func NewTableConnection(key *TableConnectionKey) *TableConnection {
+	return &TableConnection{
+		SrChanges:     nclib.NewSrChanges(),
+		SrcProtocol:   key.SrcProtocol,
+		DstProtocol:   key.DstProtocol,
+		AddressFamily: key.AddressFamily,
+		Config:        NewTableConnectionConfig(),
+	}
+}
+
+func (t *TableConnection) String() string {
+	return fmt.Sprintf("%s{%s=%s, %s=%s, %s=%s, %s} %s",
+		NETWORKINSTANCE_TBLCONN_KEY,
+		TBLCONN_SRC_PROTO_KEY, t.SrcProtocol,
+		TBLCONN_DST_PROTO_KEY, t.DstProtocol,
+		TBLCONN_AF_KEY, t.AddressFamily,
+		t.Config,
+		t.SrChanges,
+	)
+}
+
+func (t *TableConnection) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case TBLCONN_SRC_PROTO_KEY, TBLCONN_DST_PROTO_KEY, TBLCONN_AF_KEY:
+		// set by NewTableConnection
+
+	case OC_CONFIG_KEY:
+		if err := t.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	t.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessTableConnection(p TableConnectionProcessor, reverse bool, name string, key *TableConnectionKey, conn *TableConnection) error {
+	configFunc := func() error {
+		if conn.GetChange(OC_CONFIG_KEY) {
+			return p.TableConnectionConfig(name, key, conn.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// network-instances/network-instance[name]/table-connections/table-connection[src-protocol dst-protocol address-family]/config
+//
+type TableConnectionConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	SrcProtocol   InstallProtocolType `xml:"src-protocol"`
+	DstProtocol   InstallProtocolType `xml:"dst-protocol"`
+	AddressFamily AddressFamily       `xml:"address-family"`
+	ImportPolicy  []string            `xml:"import-policy"`
+	ImportDefault PolicyDefaultType   `xml:"default-import-policy"`
+}
+
+func NewTableConnectionConfig() *TableConnectionConfig {
+	return &TableConnectionConfig{
+		SrChanges:     nclib.NewSrChanges(),
+		SrcProtocol:   INSTALL_PROTOCOL_TYPE,
+		DstProtocol:   INSTALL_PROTOCOL_TYPE,
+		AddressFamily: ADDRESS_FAMILY,
+		ImportPolicy:  []string{},
+		ImportDefault: POLICY_DEFAULT_REJECT_ROUTE,
+	}
+}
+
+func (c *TableConnectionConfig) String() string {
+	return fmt.Sprintf("%s{%s=%s, %s=%s, %s=%s, %s=%v, %s=%s} %s",
+		OC_CONFIG_KEY,
+		TBLCONN_SRC_PROTO_KEY, c.SrcProtocol,
+		TBLCONN_DST_PROTO_KEY, c.DstProtocol,
+		TBLCONN_AF_KEY, c.AddressFamily,
+		TBLCONN_IMPORT_KEY, c.ImportPolicy,
+		TBLCONN_IMPORT_DEF_KEY, c.ImportDefault,
+		c.SrChanges,
+	)
+}
+
+func (c *TableConnectionConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case TBLCONN_SRC_PROTO_KEY:
+		proto, err := ParseInstallProtocolType(value)
+		if err != nil {
+			return err
+		}
+		c.SrcProtocol = proto
+
+	case TBLCONN_DST_PROTO_KEY:
+		proto, err := ParseInstallProtocolType(value)
+		if err != nil {
+			return err
+		}
+		c.DstProtocol = proto
+
+	case TBLCONN_AF_KEY:
+		af, err := ParseAddressFamily(value)
+		if err != nil {
+			return err
+		}
+		c.AddressFamily = af
+
+	case TBLCONN_IMPORT_KEY:
+		c.ImportPolicy = append(c.ImportPolicy, value)
+
+	case TBLCONN_IMPORT_DEF_KEY:
+		t, err := ParsePolicyDefaultType(value)
+		if err != nil {
+			return err
+		}
+		c.ImportDefault = t
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
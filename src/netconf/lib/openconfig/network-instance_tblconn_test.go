@@ -0,0 +1,99 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	srlib "netconf/lib/sysrepo"
+	"testing"
+)
+
+func makeTableConnections(datas [][2]string) (TableConnections, error) {
+	conns := NewTableConnections()
+	for _, data := range datas {
+		xpath, value := data[0], data[1]
+		nodes := srlib.ParseXPath(xpath)
+		if err := conns.Put(nodes[1:], value); err != nil {
+			return nil, err
+		}
+	}
+	return conns, nil
+}
+
+func TestTableConnection(t *testing.T) {
+	path := "/table-connections/table-connection[src-protocol='openconfig-policy-types:BGP'][dst-protocol='openconfig-policy-types:OSPF'][address-family='openconfig-types:IPV4']"
+	conns, err := makeTableConnections([][2]string{
+		{path + "/src-protocol", "openconfig-policy-types:BGP"},
+		{path + "/config/src-protocol", "openconfig-policy-types:BGP"},
+		{path + "/config/dst-protocol", "openconfig-policy-types:OSPF"},
+		{path + "/config/address-family", "openconfig-types:IPV4"},
+		{path + "/config/import-policy", "bgp2ospf"},
+		{path + "/config/default-import-policy", "ACCEPT_ROUTE"},
+	})
+
+	if err != nil {
+		t.Fatalf("TableConnections.Put error. %s", err)
+	}
+
+	key := NewTableConnectionKey(INSTALL_PROTOCOL_BGP, INSTALL_PROTOCOL_OSPF, ADDRESS_FAMILY_IPV4)
+	conn, ok := conns[*key]
+	if !ok {
+		t.Fatalf("TableConnections.Put unmatch. %v", conns)
+	}
+
+	if v := conn.Compare(TBLCONN_SRC_PROTO_KEY, OC_CONFIG_KEY); !v {
+		t.Errorf("TableConnections.Put unmatch. cmp=%t", v)
+	}
+
+	if v := key.String(); v != "BGP/OSPF/IPV4" {
+		t.Errorf("TableConnectionKey.String unmatch. %s", v)
+	}
+
+	if v := conn.Config.SrcProtocol; v != INSTALL_PROTOCOL_BGP {
+		t.Errorf("TableConnections.Put unmatch. src=%s", v)
+	}
+
+	if v := conn.Config.DstProtocol; v != INSTALL_PROTOCOL_OSPF {
+		t.Errorf("TableConnections.Put unmatch. dst=%s", v)
+	}
+
+	if v := conn.Config.AddressFamily; v != ADDRESS_FAMILY_IPV4 {
+		t.Errorf("TableConnections.Put unmatch. af=%s", v)
+	}
+
+	if v := conn.Config.ImportPolicy; len(v) != 1 || v[0] != "bgp2ospf" {
+		t.Errorf("TableConnections.Put unmatch. import=%v", v)
+	}
+
+	if v := conn.Config.ImportDefault; v != POLICY_DEFAULT_ACCEPT_ROUTE {
+		t.Errorf("TableConnections.Put unmatch. default=%s", v)
+	}
+}
+
+func TestTableConnection_invalid(t *testing.T) {
+	datas := []string{
+		"/table-connections/table-connection[src-protocol='BGP'][dst-protocol='OSPF']/config/import-policy",
+		"/table-connections/table-connection[src-protocol='BGP'][dst-protocol='RIP'][address-family='IPV4']/config/import-policy",
+		"/table-connections/table-connection[src-protocol='BGP'][dst-protocol='OSPF'][address-family='IPX']/config/import-policy",
+	}
+
+	for _, data := range datas {
+		if _, err := makeTableConnections([][2]string{{data, "p"}}); err == nil {
+			t.Errorf("TableConnections.Put must be error. %s", data)
+		}
+	}
+}
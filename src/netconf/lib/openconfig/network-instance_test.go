@@ -18,10 +18,205 @@
 package openconfig
 
 import (
+	"encoding/json"
+	"encoding/xml"
+	"io/ioutil"
+	"net"
+	nclib "netconf/lib"
 	srlib "netconf/lib/sysrepo"
+	ncxml "netconf/lib/xml"
+	"path/filepath"
+	"reflect"
 	"testing"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+var (
+	srChangesType = reflect.TypeOf(nclib.NewSrChanges())
+	xmlNameType   = reflect.TypeOf(xml.Name{})
+)
+
+const (
+	TEST_XML_DIR      = "../../../../etc/test/xml"
+	TEST_EXAMPLES_DIR = "../../../../doc"
 )
 
+func testXMLFiles(t *testing.T, patterns ...string) []string {
+	files := []string{}
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			t.Fatalf("Glob error. %s %s", pattern, err)
+		}
+		files = append(files, matches...)
+	}
+
+	if len(files) == 0 {
+		t.Fatalf("xml files not found. %v", patterns)
+	}
+
+	return files
+}
+
+func unmarshalXMLFile(t *testing.T, path string, v interface{}) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile error. %s %s", path, err)
+	}
+
+	if err := xml.Unmarshal(data, v); err != nil {
+		t.Errorf("xml.Unmarshal error. %s %s", path, err)
+	}
+}
+
+//
+// ocTreeEqual compares the values of the models. The changes (SrChanges)
+// depend on the xpaths each decoder puts and XMLName is set by xml decoder
+// only, so they are not compared.
+//
+func ocTreeEqual(a, b reflect.Value) bool {
+	if t := a.Type(); t == srChangesType || t == xmlNameType {
+		return true
+	}
+
+	switch a.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		return ocTreeEqual(a.Elem(), b.Elem())
+
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if !ocTreeEqual(a.Field(i), b.Field(i)) {
+				return false
+			}
+		}
+		return true
+
+	case reflect.Map:
+		if a.Len() != b.Len() {
+			return false
+		}
+		values := map[interface{}]reflect.Value{}
+		for _, key := range b.MapKeys() {
+			values[ocTreeValue(key)] = b.MapIndex(key)
+		}
+		for _, key := range a.MapKeys() {
+			v, ok := values[ocTreeValue(key)]
+			if !ok || !ocTreeEqual(a.MapIndex(key), v) {
+				return false
+			}
+		}
+		return true
+
+	case reflect.Slice, reflect.Array:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !ocTreeEqual(a.Index(i), b.Index(i)) {
+				return false
+			}
+		}
+		return true
+
+	default:
+		return reflect.DeepEqual(ocTreeValue(a), ocTreeValue(b))
+	}
+}
+
+//
+// ocTreeValue returns the value without the prefix of the identity,
+// which differs between the documents.
+// (e.g. oc-bgp-types:IPV4_UNICAST, openconfig-bgp-types:IPV4_UNICAST)
+//
+func ocTreeValue(v reflect.Value) interface{} {
+	if v.Kind() != reflect.String {
+		return v.Interface()
+	}
+
+	s := v.String()
+	if ip := net.ParseIP(s); ip != nil {
+		return s
+	}
+	if _, _, err := net.ParseCIDR(s); err == nil {
+		return s
+	}
+
+	_, name := ncxml.ParseXPathName(s)
+	return name
+}
+
+//
+// testJSONRoundTrip encodes src, decodes it to dst and encodes dst again.
+// dst must be the same model as src, and so must the documents.
+//
+func testJSONRoundTrip(t *testing.T, path string, src interface{}, dst interface{}) {
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("json.Marshal error. %s %s", path, err)
+	}
+
+	if err := json.Unmarshal(data, dst); err != nil {
+		t.Fatalf("json.Unmarshal error. %s %s\n%s", path, err, data)
+	}
+
+	if !ocTreeEqual(reflect.ValueOf(src), reflect.ValueOf(dst)) {
+		t.Errorf("json round trip tree unmatch. %s\n%v\n%v", path, src, dst)
+	}
+
+	redata, err := json.Marshal(dst)
+	if err != nil {
+		t.Fatalf("json.Marshal error. %s %s", path, err)
+	}
+
+	if string(data) != string(redata) {
+		t.Errorf("json round trip unmatch. %s\n%s\n%s", path, data, redata)
+	}
+}
+
+func testYAMLRoundTrip(t *testing.T, path string, src interface{}, dst interface{}) {
+	data, err := yaml.Marshal(src)
+	if err != nil {
+		t.Fatalf("yaml.Marshal error. %s %s", path, err)
+	}
+
+	if err := yaml.Unmarshal(data, dst); err != nil {
+		t.Fatalf("yaml.Unmarshal error. %s %s\n%s", path, err, data)
+	}
+
+	if !ocTreeEqual(reflect.ValueOf(src), reflect.ValueOf(dst)) {
+		t.Errorf("yaml round trip tree unmatch. %s\n%v\n%v", path, src, dst)
+	}
+
+	redata, err := yaml.Marshal(dst)
+	if err != nil {
+		t.Fatalf("yaml.Marshal error. %s %s", path, err)
+	}
+
+	if string(data) != string(redata) {
+		t.Errorf("yaml round trip unmatch. %s\n%s\n%s", path, data, redata)
+	}
+}
+
+func testDiffEqual(t *testing.T, path string, expected interface{}, actual interface{}) {
+	e, err := json.Marshal(expected)
+	if err != nil {
+		t.Fatalf("json.Marshal error. %s %s", path, err)
+	}
+
+	a, err := json.Marshal(actual)
+	if err != nil {
+		t.Fatalf("json.Marshal error. %s %s", path, err)
+	}
+
+	if string(e) != string(a) {
+		t.Errorf("diff unmatch. %s\n%s\n%s", path, e, a)
+	}
+}
+
 func makeNwInstances(datas [][2]string) NetworkInstances {
 	insts := NewNetworkInstances()
 	for _, data := range datas {
@@ -52,3 +247,187 @@ func TestNwInstanceLDP(t *testing.T) {
 	insts := makeNwInstances(datas)
 	t.Log(insts)
 }
+
+func TestNwInstances_UnmarshalXML(t *testing.T) {
+	files := testXMLFiles(t,
+		filepath.Join(TEST_XML_DIR, "beluganos-network-instance-*.xml"),
+		filepath.Join(TEST_EXAMPLES_DIR, "examples*", "*network-instance*.xml"),
+	)
+
+	for _, file := range files {
+		insts := NewNetworkInstances()
+		unmarshalXMLFile(t, file, &insts)
+		t.Log(file, insts)
+	}
+}
+
+func TestNwInstances_UnmarshalXML_Proto(t *testing.T) {
+	insts := NetworkInstances(nil)
+	unmarshalXMLFile(t, filepath.Join(TEST_XML_DIR, "beluganos-network-instance-st-proto.xml"), &insts)
+
+	ni, ok := insts["PE1"]
+	if !ok {
+		t.Fatalf("NetworkInstances.UnmarshalXML unmatch. %v", insts)
+	}
+
+	if v := ni.Config.Type; v != NETWORK_INSTANCE_DEFAULT {
+		t.Errorf("NetworkInstances.UnmarshalXML unmatch. type=%s", v)
+	}
+
+	if v := ni.Config.RouterId.String(); v != "10.0.0.1" {
+		t.Errorf("NetworkInstances.UnmarshalXML unmatch. router-id=%s", v)
+	}
+
+	if v := len(ni.Loopbacks["lo"].Addrs); v != 3 {
+		t.Errorf("NetworkInstances.UnmarshalXML unmatch. #lo=%d", v)
+	}
+
+	if v := len(ni.Interfaces); v != 4 {
+		t.Errorf("NetworkInstances.UnmarshalXML unmatch. #ifaces=%d", v)
+	}
+
+	if v := len(ni.Protocols); v != 3 {
+		t.Errorf("NetworkInstances.UnmarshalXML unmatch. #protos=%d", v)
+	}
+
+	if !ni.GetChanges(OC_CONFIG_KEY, NETWORKINSTANCE_LOS_KEY, INTERFACES_KEY, NETWORKINSTANCE_PROTOS_KEY) {
+		t.Errorf("NetworkInstances.UnmarshalXML unmatch. changes=%s", ni.SrChanges)
+	}
+}
+
+func TestNwInstances_JSON(t *testing.T) {
+	files := testXMLFiles(t,
+		filepath.Join(TEST_XML_DIR, "beluganos-network-instance-*.xml"),
+		filepath.Join(TEST_EXAMPLES_DIR, "examples*", "*network-instance*.xml"),
+	)
+
+	for _, file := range files {
+		insts := NewNetworkInstances()
+		unmarshalXMLFile(t, file, &insts)
+
+		decoded := NetworkInstances(nil)
+		testJSONRoundTrip(t, file, &insts, &decoded)
+	}
+}
+
+func TestNwInstances_JSON_Proto(t *testing.T) {
+	insts := NetworkInstances(nil)
+	unmarshalXMLFile(t, filepath.Join(TEST_XML_DIR, "beluganos-network-instance-st-proto.xml"), &insts)
+
+	data, err := json.Marshal(insts)
+	if err != nil {
+		t.Fatalf("NetworkInstances.MarshalJSON error. %s", err)
+	}
+
+	doc := map[string]interface{}{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("json.Unmarshal error. %s", err)
+	}
+
+	root, ok := doc["beluganos-network-instance:network-instances"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("NetworkInstances.MarshalJSON unmatch. %s", data)
+	}
+
+	ni := root["network-instance"].([]interface{})[0].(map[string]interface{})
+	config := ni["config"].(map[string]interface{})
+	if v := config["type"]; v != "openconfig-network-instance-types:DEFAULT_INSTANCE" {
+		t.Errorf("NetworkInstances.MarshalJSON unmatch. type=%v", v)
+	}
+
+	proto := ni["protocols"].(map[string]interface{})["protocol"].([]interface{})[0].(map[string]interface{})
+	if v := proto["identifier"]; v != "openconfig-policy-types:BGP" {
+		t.Errorf("NetworkInstances.MarshalJSON unmatch. identifier=%v", v)
+	}
+}
+
+func TestNwInstances_YAML(t *testing.T) {
+	files := testXMLFiles(t,
+		filepath.Join(TEST_XML_DIR, "beluganos-network-instance-*.xml"),
+		filepath.Join(TEST_EXAMPLES_DIR, "examples*", "*network-instance*.xml"),
+	)
+
+	for _, file := range files {
+		insts := NewNetworkInstances()
+		unmarshalXMLFile(t, file, &insts)
+
+		decoded := NetworkInstances(nil)
+		testYAMLRoundTrip(t, file, &insts, &decoded)
+	}
+}
+
+func TestDiffNetworkInstances(t *testing.T) {
+	path := "/beluganos-network-instance:network-instances/network-instance[name='PE1']"
+	src := makeNwInstances([][2]string{
+		{path + "/config/router-id", "10.0.0.1"},
+		{path + "/interfaces/interface[id='eth1']/config/interface", "eth1"},
+	})
+	dst := makeNwInstances([][2]string{
+		{path + "/config/router-id", "10.0.0.2"},
+		{path + "/interfaces/interface[id='eth2']/config/interface", "eth2"},
+	})
+
+	cre, mod, del := NewNetworkInstances(), NewNetworkInstances(), NewNetworkInstances()
+	if err := DiffNetworkInstances(src, dst, cre, mod, del); err != nil {
+		t.Fatalf("DiffNetworkInstances error. %s", err)
+	}
+
+	if v := mod["PE1"].Config; v.RouterId.String() != "10.0.0.2" || !v.Compare(NETWORKINSTANCE_ROUTERID_KEY) {
+		t.Errorf("DiffNetworkInstances unmatch. modified=%s", v)
+	}
+
+	if v := mod["PE1"]; !v.Compare(OC_CONFIG_KEY) {
+		t.Errorf("DiffNetworkInstances unmatch. modified=%s", v)
+	}
+
+	if _, ok := cre["PE1"].Interfaces["eth2"]; !ok || len(cre["PE1"].Interfaces) != 1 {
+		t.Errorf("DiffNetworkInstances unmatch. created=%s", cre["PE1"].Interfaces)
+	}
+
+	if _, ok := del["PE1"].Interfaces["eth1"]; !ok || len(del["PE1"].Interfaces) != 1 {
+		t.Errorf("DiffNetworkInstances unmatch. deleted=%s", del["PE1"].Interfaces)
+	}
+
+	if v := cre["PE1"].Interfaces["eth2"].Config; v.Interface != "eth2" || !v.Compare(INTERFACE_KEY) {
+		t.Errorf("DiffNetworkInstances unmatch. created=%s", v)
+	}
+}
+
+func TestDiffNetworkInstances_Files(t *testing.T) {
+	files := testXMLFiles(t,
+		filepath.Join(TEST_XML_DIR, "beluganos-network-instance-*.xml"),
+		filepath.Join(TEST_EXAMPLES_DIR, "examples*", "*network-instance*.xml"),
+	)
+
+	for _, file := range files {
+		insts := NewNetworkInstances()
+		unmarshalXMLFile(t, file, &insts)
+
+		// created from empty.
+		cre, mod, del := NewNetworkInstances(), NewNetworkInstances(), NewNetworkInstances()
+		if err := DiffNetworkInstances(NewNetworkInstances(), insts, cre, mod, del); err != nil {
+			t.Fatalf("DiffNetworkInstances error. %s %s", file, err)
+		}
+		testDiffEqual(t, file, insts, cre)
+		testDiffEqual(t, file, NewNetworkInstances(), mod)
+		testDiffEqual(t, file, NewNetworkInstances(), del)
+
+		// deleted to empty.
+		cre, mod, del = NewNetworkInstances(), NewNetworkInstances(), NewNetworkInstances()
+		if err := DiffNetworkInstances(insts, NewNetworkInstances(), cre, mod, del); err != nil {
+			t.Fatalf("DiffNetworkInstances error. %s %s", file, err)
+		}
+		testDiffEqual(t, file, NewNetworkInstances(), cre)
+		testDiffEqual(t, file, NewNetworkInstances(), mod)
+		testDiffEqual(t, file, insts, del)
+
+		// no changes.
+		cre, mod, del = NewNetworkInstances(), NewNetworkInstances(), NewNetworkInstances()
+		if err := DiffNetworkInstances(insts, insts, cre, mod, del); err != nil {
+			t.Fatalf("DiffNetworkInstances error. %s %s", file, err)
+		}
+		testDiffEqual(t, file, NewNetworkInstances(), cre)
+		testDiffEqual(t, file, NewNetworkInstances(), mod)
+		testDiffEqual(t, file, NewNetworkInstances(), del)
+	}
+}
@@ -0,0 +1,539 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	ncianalib "netconf/lib/iana"
+	ncxml "netconf/lib/xml"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//
+// Module names used to qualify member names and identities (RFC 7951).
+//
+const (
+	OC_TYPES_YANG_MODULE               = "openconfig-types"
+	NETWORK_INSTANCE_TYPES_YANG_MODULE = "openconfig-network-instance-types"
+	POLICY_TYPES_YANG_MODULE           = "openconfig-policy-types"
+	BGP_TYPES_YANG_MODULE              = "openconfig-bgp-types"
+	MPLS_TYPES_YANG_MODULE             = "openconfig-mpls-types"
+	OSPF_TYPES_YANG_MODULE             = "openconfig-ospf-types"
+	BGP_POLICY_YANG_MODULE             = "beluganos-bgp-policy"
+	ISIS_YANG_MODULE                   = "beluganos-isis"
+	OSPFV2_YANG_MODULE                 = "beluganos-ospfv2"
+)
+
+//
+// Entry names of the lists. A list field whose tag equals its entry name
+// has no enclosing container (e.g. discovery/interfaces/interface).
+//
+var ocListEntryNames = map[reflect.Type]string{
+	reflect.TypeOf(NetworkInstances{}):             NETWORKINSTANCE_KEY,
+	reflect.TypeOf(NetworkInstanceLoopbacks{}):     NETWORKINSTANCE_LO_KEY,
+	reflect.TypeOf(NetworkInstanceLoopbackAddrs{}): NETWORKINSTANCE_LO_ADDR_KEY,
+	reflect.TypeOf(NetworkInstanceInterfaces{}):    INTERFACE_KEY,
+	reflect.TypeOf(NetworkInstanceProtocols{}):     NETWORKINSTANCE_PROTO_KEY,
+	reflect.TypeOf(TableConnections{}):             NETWORKINSTANCE_TBLCONN_KEY,
+	reflect.TypeOf(EvpnInstances{}):                EVPN_INSTANCE_KEY,
+	reflect.TypeOf(MplsInterfaceAttrs{}):           INTERFACE_KEY,
+	reflect.TypeOf(MplsLdpInterfaces{}):            INTERFACE_KEY,
+	reflect.TypeOf(MplsStaticLsps{}):               MPLS_STATIC_LSP_KEY,
+	reflect.TypeOf(BfdInterfaces{}):                INTERFACE_KEY,
+	reflect.TypeOf(StaticRoutes{}):                 STATICROUTE_KEY,
+	reflect.TypeOf(StaticRouteNexthops{}):          STATICROUTE_NEXTHOP_KEY,
+	reflect.TypeOf(BgpNeighbors{}):                 BGP_NEIGHBOR_KEY,
+	reflect.TypeOf(BgpPeerGroups{}):                BGP_PEERGROUP_KEY,
+	reflect.TypeOf(BgpAfiSafis{}):                  BGP_AFISAFI_KEY,
+	reflect.TypeOf(BgpDynamicNeighbors{}):          BGP_DYNAMIC_NEIGHBOR_KEY,
+	reflect.TypeOf(Ospfv2Areas{}):                  OSPFV2_AREA_KEY,
+	reflect.TypeOf(Ospfv2Interfaces{}):             INTERFACE_KEY,
+	reflect.TypeOf(Ospfv2AreaRanges{}):             OSPFV2_RANGE_KEY,
+	reflect.TypeOf(Ospfv2Redistributions{}):        OSPFV2_REDISTRIBUTION_KEY,
+	reflect.TypeOf(Ospfv3Areas{}):                  OSPFV3_AREA_KEY,
+	reflect.TypeOf(Ospfv3Interfaces{}):             INTERFACE_KEY,
+	reflect.TypeOf(Ospfv3AreaRanges{}):             OSPFV3_RANGE_KEY,
+	reflect.TypeOf(IsisLevels{}):                   ISIS_LEVEL_KEY,
+	reflect.TypeOf(IsisInterfaces{}):               INTERFACE_KEY,
+	reflect.TypeOf(IsisInterfaceAfs{}):             ISIS_AF_KEY,
+	reflect.TypeOf(SegmentRoutingPrefixSids{}):     SR_PREFIX_SID_KEY,
+	reflect.TypeOf(Interfaces{}):                   INTERFACE_KEY,
+	reflect.TypeOf(Subinterfaces{}):                SUBINTERFACE_KEY,
+	reflect.TypeOf(IPAddresses{}):                  SUBINTERFACE_ADDR_KEY,
+	reflect.TypeOf(VrrpGroups{}):                   VRRP_GROUP_KEY,
+	reflect.TypeOf(PolicyDefinitions{}):            POLICYDEF_KEY,
+	reflect.TypeOf(PolicyStatements{}):             POLICYDEF_STMT_KEY,
+	reflect.TypeOf(PolicyPrefixSets{}):             POLICYPFXSET_KEY,
+	reflect.TypeOf(PolicyPrefixSetPrefixes{}):      POLICYPFXSET_PREFIX_KEY,
+	reflect.TypeOf(PolicyNeighborSets{}):           POLICYNEIGHSET_KEY,
+	reflect.TypeOf(PolicyTagSets{}):                POLICYTAGSET_KEY,
+	reflect.TypeOf(PolicyBgpCommunitySets{}):       BGP_COMMSET_KEY,
+	reflect.TypeOf(PolicyBgpExtCommunitySets{}):    BGP_EXTCOMMSET_KEY,
+	reflect.TypeOf(PolicyBgpAsPathSets{}):          BGP_ASPATHSET_KEY,
+}
+
+//
+// Containers augmented by other modules.
+//
+var ocAugmentModules = map[reflect.Type]string{
+	reflect.TypeOf(InterfaceEthernet{}):    INTERFACE_ETH_MODULE,
+	reflect.TypeOf(InterfaceAggregation{}): INTERFACE_AGG_MODULE,
+	reflect.TypeOf(InterfaceBridge{}):      INTERFACE_BRIDGE_MODULE,
+	reflect.TypeOf(SubinterfaceIPv4{}):     SUBINTERFACE_IP_MODULE,
+	reflect.TypeOf(SubinterfaceIPv6{}):     SUBINTERFACE_IP_MODULE,
+	reflect.TypeOf(PolicyBgpActions{}):     BGP_POLICY_YANG_MODULE,
+	reflect.TypeOf(PolicyBgpDefinedSets{}): BGP_POLICY_YANG_MODULE,
+	reflect.TypeOf(PolicyBgpConditions{}):  BGP_POLICY_YANG_MODULE,
+}
+
+//
+// Modules defining the identities of identityref leaves.
+//
+var ocIdentityModules = map[reflect.Type]string{
+	reflect.TypeOf(ADDRESS_FAMILY):           OC_TYPES_YANG_MODULE,
+	reflect.TypeOf(NETWORK_INSTANCE_TYPE):    NETWORK_INSTANCE_TYPES_YANG_MODULE,
+	reflect.TypeOf(INSTALL_PROTOCOL_TYPE):    POLICY_TYPES_YANG_MODULE,
+	reflect.TypeOf(POLICY_ATTR_EQ):           POLICY_TYPES_YANG_MODULE,
+	reflect.TypeOf(BgpAfiSafiType(0)):        BGP_TYPES_YANG_MODULE,
+	reflect.TypeOf(MplsNullLabelType(0)):     MPLS_TYPES_YANG_MODULE,
+	reflect.TypeOf(OSPF_NETWORK_TYPE):        OSPF_TYPES_YANG_MODULE,
+	reflect.TypeOf(OSPFV2_AREA_TYPE):         OSPFV2_YANG_MODULE,
+	reflect.TypeOf(ISIS_LEVEL_TYPE):          ISIS_YANG_MODULE,
+	reflect.TypeOf(ISIS_AUTH_MODE):           ISIS_YANG_MODULE,
+	reflect.TypeOf(ISIS_AFI_TYPE):            ISIS_YANG_MODULE,
+	reflect.TypeOf(ncianalib.IANAifType("")): ncianalib.IANAifType_MODULE,
+}
+
+var (
+	ocPkgPath         = reflect.TypeOf(NetworkInstance{}).PkgPath()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	stringerType      = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+//
+// ocMember is a member of ocObject.
+//
+type ocMember struct {
+	Name  string
+	Value interface{}
+}
+
+//
+// ocObject is an ordered object of the RFC 7951 encoding.
+// Values are ocObject (container, list entry), []interface{} (list, leaf-list)
+// or json-typed scalars (leaf).
+//
+type ocObject []*ocMember
+
+func (o *ocObject) add(name string, value interface{}) {
+	*o = append(*o, &ocMember{Name: name, Value: value})
+}
+
+func ocQualifiedName(module string, name string) string {
+	return fmt.Sprintf("%s:%s", module, name)
+}
+
+func ocFieldName(f reflect.StructField) string {
+	if f.Anonymous || f.Name == "XMLName" {
+		return ""
+	}
+
+	// yang tag overrides xml tag which does not match the node name.
+	tag, ok := f.Tag.Lookup("yang")
+	if !ok {
+		tag = strings.Split(f.Tag.Get("xml"), ",")[0]
+	}
+	if tag == "-" {
+		return ""
+	}
+
+	// drop namespace. (e.g. "<namespace> <name>")
+	ss := strings.Fields(tag)
+	if len(ss) == 0 {
+		return ""
+	}
+	return ss[len(ss)-1]
+}
+
+func ocIsContainerType(t reflect.Type) bool {
+	return t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct && t.Elem().PkgPath() == ocPkgPath
+}
+
+func ocIsLeafType(t reflect.Type) bool {
+	if t.Implements(textMarshalerType) || t.Implements(stringerType) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Struct:
+		return false
+	default:
+		return true
+	}
+}
+
+//
+// ocLeafValue converts a leaf to the json-typed value.
+// int64 and uint64 are encoded as string, and identities are
+// prefixed with the module name as RFC 7951 requires.
+//
+func ocLeafValue(v reflect.Value) (interface{}, bool) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map:
+		if v.IsNil() {
+			return nil, false
+		}
+	}
+
+	if module, ok := ocIdentityModules[v.Type()]; ok {
+		_, name := ncxml.ParseXPathName(fmt.Sprintf("%s", v.Interface()))
+		return ocQualifiedName(module, name), true
+	}
+
+	if v.Kind() == reflect.Bool {
+		return v.Bool(), true
+	}
+
+	if v.Type().Implements(textMarshalerType) {
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return nil, false
+		}
+		return string(text), true
+	}
+
+	if v.Type().Implements(stringerType) {
+		return v.Interface().(fmt.Stringer).String(), true
+	}
+
+	switch v.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int:
+		return v.Int(), true
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint:
+		return v.Uint(), true
+	case reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.String:
+		return v.String(), true
+	default:
+		return fmt.Sprintf("%v", v.Interface()), true
+	}
+}
+
+func ocLeafListValue(v reflect.Value) []interface{} {
+	values := []interface{}{}
+	for i := 0; i < v.Len(); i++ {
+		if value, ok := ocLeafValue(v.Index(i)); ok {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func ocSortedMapKeys(v reflect.Value) []reflect.Value {
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		ki, kj := keys[i], keys[j]
+		switch ki.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return ki.Int() < kj.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return ki.Uint() < kj.Uint()
+		default:
+			return fmt.Sprintf("%v", ki.Interface()) < fmt.Sprintf("%v", kj.Interface())
+		}
+	})
+	return keys
+}
+
+//
+// ocEncodeList encodes entries of the list sorted by the keys.
+//
+func ocEncodeList(v reflect.Value, module string) []interface{} {
+	entries := []interface{}{}
+	for _, key := range ocSortedMapKeys(v) {
+		entry := v.MapIndex(key)
+		if entry.IsNil() {
+			continue
+		}
+		entries = append(entries, ocEncodeNode(entry, module, true))
+	}
+	return entries
+}
+
+//
+// ocEncodeNode encodes the changed members of the container (or list entry).
+// The leaves of list entry are keys, so they are always encoded.
+//
+func ocEncodeNode(v reflect.Value, module string, entry bool) ocObject {
+	obj := ocObject{}
+	changes, _ := v.Interface().(interface {
+		GetChange(string) bool
+	})
+
+	s := v.Elem()
+	for i := 0; i < s.NumField(); i++ {
+		name := ocFieldName(s.Type().Field(i))
+		if len(name) == 0 {
+			continue
+		}
+
+		fv := s.Field(i)
+		ft := fv.Type()
+		changed := changes != nil && changes.GetChange(name)
+
+		switch {
+		case ft.Kind() == reflect.Map:
+			if !changed {
+				continue
+			}
+			entries := ocEncodeList(fv, module)
+			if entryName := ocListEntryNames[ft]; entryName == name {
+				if len(entries) != 0 {
+					obj.add(name, entries)
+				}
+			} else {
+				list := ocObject{}
+				if len(entries) != 0 {
+					list.add(entryName, entries)
+				}
+				obj.add(name, list)
+			}
+
+		case ocIsContainerType(ft):
+			if !changed || fv.IsNil() {
+				continue
+			}
+			childModule := module
+			if m, ok := ocAugmentModules[ft.Elem()]; ok {
+				childModule = m
+			}
+			if childModule != module {
+				name = ocQualifiedName(childModule, name)
+			}
+			obj.add(name, ocEncodeNode(fv, childModule, false))
+
+		case ocIsLeafType(ft):
+			if !changed && !entry {
+				continue
+			}
+			if value, ok := ocLeafValue(fv); ok {
+				obj.add(name, value)
+			}
+
+		case ft.Kind() == reflect.Slice:
+			if !changed {
+				continue
+			}
+			if values := ocLeafListValue(fv); len(values) != 0 {
+				obj.add(name, values)
+			}
+		}
+	}
+
+	if entry {
+		ocResolveEntryKeys(obj)
+	}
+
+	return obj
+}
+
+//
+// ocResolveEntryKeys replaces the keys of list entry with the values of
+// config, because the keys are leafref to ../config/<key> and the model keeps
+// them as they are put. (e.g. afi-safi-name is the raw string with prefix.)
+//
+func ocResolveEntryKeys(entry ocObject) {
+	var config ocObject
+	for _, member := range entry {
+		if member.Name == OC_CONFIG_KEY {
+			config, _ = member.Value.(ocObject)
+		}
+	}
+
+	for _, member := range entry {
+		switch member.Value.(type) {
+		case ocObject, []interface{}:
+			continue
+		}
+		for _, c := range config {
+			if c.Name == member.Name {
+				member.Value = c.Value
+			}
+		}
+	}
+}
+
+//
+// newOcListBody encodes the members of the top level container
+// which has a list. (e.g. network-instances/network-instance)
+//
+func newOcListBody(module string, list interface{}) ocObject {
+	v := reflect.ValueOf(list)
+	body := ocObject{}
+	if entries := ocEncodeList(v, module); len(entries) != 0 {
+		body.add(ocListEntryNames[v.Type()], entries)
+	}
+	return body
+}
+
+func newOcListRoot(module string, name string, list interface{}) ocObject {
+	root := ocObject{}
+	root.add(ocQualifiedName(module, name), newOcListBody(module, list))
+	return root
+}
+
+//
+// newOcContainerRoot encodes the top level container.
+//
+func newOcContainerRoot(module string, name string, container interface{}) ocObject {
+	root := ocObject{}
+	root.add(
+		ocQualifiedName(module, name),
+		ocEncodeNode(reflect.ValueOf(container), module, false),
+	)
+	return root
+}
+
+//
+// ocScalarString converts the decoded scalar to the value of Put.
+//
+func ocScalarString(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case json.Number:
+		return value.String()
+	case bool:
+		return strconv.FormatBool(value)
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
+
+func ocIsScalar(v interface{}) bool {
+	switch v.(type) {
+	case map[string]interface{}, []interface{}:
+		return false
+	default:
+		return true
+	}
+}
+
+func ocUnqualifiedName(name string) string {
+	_, local := ncxml.ParseXPathName(name)
+	return local
+}
+
+func ocSortedNames(obj map[string]interface{}) []string {
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func ocDecodeMembers(h ncxml.XPathHandler, nodes []*ncxml.XPathNode, obj map[string]interface{}) error {
+	for _, name := range ocSortedNames(obj) {
+		if err := ocDecodeMember(h, nodes, name, obj[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//
+// ocDecodeMember calls h.Put for each leaf of the member.
+// Keys of list entry are the leaves of the entry, so xpath predicates
+// are rebuilt from them as DecodeXPath does.
+//
+func ocDecodeMember(h ncxml.XPathHandler, parents []*ncxml.XPathNode, name string, value interface{}) error {
+	newNodes := func(attrs map[string]string) []*ncxml.XPathNode {
+		nodes := make([]*ncxml.XPathNode, len(parents), len(parents)+1)
+		copy(nodes, parents)
+		return append(nodes, ncxml.NewXPathNode("", ocUnqualifiedName(name), attrs))
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		nodes := newNodes(map[string]string{})
+		if len(v) == 0 {
+			return h.Put(nodes, "")
+		}
+		return ocDecodeMembers(h, nodes, v)
+
+	case []interface{}:
+		for _, elem := range v {
+			entry, ok := elem.(map[string]interface{})
+			if !ok {
+				// leaf-list, or [null] of the empty type.
+				if err := h.Put(newNodes(map[string]string{}), ocScalarString(elem)); err != nil {
+					return err
+				}
+				continue
+			}
+
+			attrs := map[string]string{}
+			for key, val := range entry {
+				if ocIsScalar(val) {
+					attrs[ocUnqualifiedName(key)] = ocScalarString(val)
+				}
+			}
+			if err := ocDecodeMembers(h, newNodes(attrs), entry); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	default:
+		return h.Put(newNodes(map[string]string{}), ocScalarString(v))
+	}
+}
+
+//
+// ocDecodeRoot decodes the document which has the top level container.
+// The xpath nodes are relative to the container as DecodeXPath does.
+//
+func ocDecodeRoot(h ncxml.XPathHandler, name string, root map[string]interface{}) error {
+	for key, value := range root {
+		if ocUnqualifiedName(key) != name {
+			return fmt.Errorf("Invalid top level member. %s", key)
+		}
+
+		body, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("Invalid %s. %v", key, value)
+		}
+
+		if err := ocDecodeMembers(h, []*ncxml.XPathNode{}, body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
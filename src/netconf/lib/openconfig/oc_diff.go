@@ -0,0 +1,200 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"fmt"
+	ncxml "netconf/lib/xml"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+//
+// ocDiffNode is a node of the tree as sysrepo reports in change values.
+// value is empty for containers and list entries.
+//
+type ocDiffNode struct {
+	nodes []*ncxml.XPathNode
+	value string
+}
+
+//
+// ocDiffNodes has the nodes of the tree in the order of the tree (parent first).
+//
+type ocDiffNodes struct {
+	keys  []string
+	nodes map[string]*ocDiffNode
+}
+
+func newOcDiffNodes(body ocObject) *ocDiffNodes {
+	n := &ocDiffNodes{
+		keys:  []string{},
+		nodes: map[string]*ocDiffNode{},
+	}
+	n.addObject([]*ncxml.XPathNode{}, body)
+	return n
+}
+
+func (n *ocDiffNodes) add(key string, nodes []*ncxml.XPathNode, value string) {
+	n.keys = append(n.keys, key)
+	n.nodes[key] = &ocDiffNode{nodes: nodes, value: value}
+}
+
+func ocDiffXPathNode(name string, attrs map[string]string) *ncxml.XPathNode {
+	ns, local := ncxml.ParseXPathName(name)
+	return ncxml.NewXPathNode(ns, local, attrs)
+}
+
+//
+// ocDiffKey returns the xpath with predicates which identifies the node.
+//
+func ocDiffKey(nodes []*ncxml.XPathNode) string {
+	names := make([]string, len(nodes))
+	for index, node := range nodes {
+		keys := make([]string, 0, len(node.Attrs))
+		for key := range node.Attrs {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		name := node.Name
+		for _, key := range keys {
+			name += fmt.Sprintf("[%s='%s']", key, node.Attrs[key])
+		}
+		names[index] = name
+	}
+	return "/" + strings.Join(names, "/")
+}
+
+func (n *ocDiffNodes) addObject(parents []*ncxml.XPathNode, obj ocObject) {
+	newNodes := func(name string, attrs map[string]string) []*ncxml.XPathNode {
+		nodes := make([]*ncxml.XPathNode, len(parents), len(parents)+1)
+		copy(nodes, parents)
+		return append(nodes, ocDiffXPathNode(name, attrs))
+	}
+
+	for _, member := range obj {
+		switch v := member.Value.(type) {
+		case ocObject:
+			nodes := newNodes(member.Name, map[string]string{})
+			n.add(ocDiffKey(nodes), nodes, "")
+			n.addObject(nodes, v)
+
+		case []interface{}:
+			for _, elem := range v {
+				entry, ok := elem.(ocObject)
+				if !ok {
+					// leaf-list entries are identified by the value.
+					nodes := newNodes(member.Name, map[string]string{})
+					value := ocScalarString(elem)
+					n.add(fmt.Sprintf("%s[.='%s']", ocDiffKey(nodes), value), nodes, value)
+					continue
+				}
+
+				attrs := map[string]string{}
+				for _, m := range entry {
+					switch m.Value.(type) {
+					case ocObject, []interface{}:
+					default:
+						attrs[m.Name] = ocScalarString(m.Value)
+					}
+				}
+				nodes := newNodes(member.Name, attrs)
+				n.add(ocDiffKey(nodes), nodes, "")
+				n.addObject(nodes, entry)
+			}
+
+		default:
+			nodes := newNodes(member.Name, map[string]string{})
+			n.add(ocDiffKey(nodes), nodes, ocScalarString(v))
+		}
+	}
+}
+
+//
+// ocDiff calls Put of cre, mod and del with the nodes created, modified
+// and deleted from src to dst, as SrChangeVal.Dispatch does with the changes.
+//
+func ocDiff(src, dst ocObject, cre, mod, del ncxml.XPathHandler) error {
+	srcNodes := newOcDiffNodes(src)
+	dstNodes := newOcDiffNodes(dst)
+
+	for _, key := range dstNodes.keys {
+		d := dstNodes.nodes[key]
+		s, ok := srcNodes.nodes[key]
+		if !ok {
+			if err := cre.Put(d.nodes, d.value); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if s.value != d.value {
+			if err := mod.Put(d.nodes, d.value); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, key := range srcNodes.keys {
+		if _, ok := dstNodes.nodes[key]; ok {
+			continue
+		}
+
+		s := srcNodes.nodes[key]
+		if err := del.Put(s.nodes, s.value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//
+// DiffNetworkInstances puts the changes from src to dst to cre, mod and del.
+//
+func DiffNetworkInstances(src, dst NetworkInstances, cre, mod, del ncxml.XPathHandler) error {
+	return ocDiff(
+		newOcListBody(NETWORKINSTANCES_MODULE, src),
+		newOcListBody(NETWORKINSTANCES_MODULE, dst),
+		cre, mod, del,
+	)
+}
+
+//
+// DiffInterfaces puts the changes from src to dst to cre, mod and del.
+//
+func DiffInterfaces(src, dst Interfaces, cre, mod, del ncxml.XPathHandler) error {
+	return ocDiff(
+		newOcListBody(INTERFACES_MODULE, src),
+		newOcListBody(INTERFACES_MODULE, dst),
+		cre, mod, del,
+	)
+}
+
+//
+// DiffRoutingPolicy puts the changes from src to dst to cre, mod and del.
+//
+func DiffRoutingPolicy(src, dst *RoutingPolicy, cre, mod, del ncxml.XPathHandler) error {
+	return ocDiff(
+		ocEncodeNode(reflect.ValueOf(src), ROUTINGPOLICY_MODULE, false),
+		ocEncodeNode(reflect.ValueOf(dst), ROUTINGPOLICY_MODULE, false),
+		cre, mod, del,
+	)
+}
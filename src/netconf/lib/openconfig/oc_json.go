@@ -0,0 +1,67 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"bytes"
+	"encoding/json"
+	ncxml "netconf/lib/xml"
+)
+
+//
+// MarshalJSON encodes members in order.
+//
+func (o ocObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for index, member := range o {
+		if index > 0 {
+			buf.WriteByte(',')
+		}
+
+		name, err := json.Marshal(member.Name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(member.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+//
+// decodeJSON decodes the RFC 7951 document and calls h.Put for each leaf.
+//
+func decodeJSON(data []byte, name string, h ncxml.XPathHandler) error {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+
+	root := map[string]interface{}{}
+	if err := d.Decode(&root); err != nil {
+		return err
+	}
+
+	return ocDecodeRoot(h, name, root)
+}
@@ -0,0 +1,80 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"fmt"
+	ncxml "netconf/lib/xml"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+//
+// MarshalYAML encodes members in order.
+// The document has same names and values as RFC 7951 json.
+// yaml.v2 does not call MarshalYAML of the value returned by MarshalYAML,
+// so the top level types return the result of this method.
+//
+func (o ocObject) MarshalYAML() (interface{}, error) {
+	ms := yaml.MapSlice{}
+	for _, member := range o {
+		ms = append(ms, yaml.MapItem{Key: member.Name, Value: member.Value})
+	}
+	return ms, nil
+}
+
+//
+// ocYAMLValue converts the keys of mappings decoded by yaml to string.
+//
+func ocYAMLValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[interface{}]interface{}:
+		m := map[string]interface{}{}
+		for key, val := range value {
+			m[fmt.Sprintf("%v", key)] = ocYAMLValue(val)
+		}
+		return m
+
+	case []interface{}:
+		l := make([]interface{}, len(value))
+		for index, val := range value {
+			l[index] = ocYAMLValue(val)
+		}
+		return l
+
+	default:
+		return value
+	}
+}
+
+//
+// decodeYAML decodes the yaml document and calls h.Put for each leaf.
+//
+func decodeYAML(unmarshal func(interface{}) error, name string, h ncxml.XPathHandler) error {
+	var v interface{}
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+
+	root, ok := ocYAMLValue(v).(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("Invalid %s. %v", name, v)
+	}
+
+	return ocDecodeRoot(h, name, root)
+}
@@ -59,3 +59,47 @@ func ParseOspfNetworkType(s string) (OspfNetworkType, error) {
 	}
 	return OSPF_NETWORK_TYPE, fmt.Errorf("Invalid OspfNetworkType. %s", s)
 }
+
+type Ospfv2AreaType int
+
+const (
+	OSPFV2_AREA_TYPE Ospfv2AreaType = iota
+	OSPFV2_NORMAL_AREA
+	OSPFV2_STUB_AREA
+	OSPFV2_TOTALLY_STUBBY_AREA
+	OSPFV2_NSSA_AREA
+	OSPFV2_TOTALLY_NSSA_AREA
+)
+
+var ospfv2AreaTypeNames = map[Ospfv2AreaType]string{
+	OSPFV2_AREA_TYPE:           "OSPFV2_AREA_TYPE",
+	OSPFV2_NORMAL_AREA:         "NORMAL_AREA",
+	OSPFV2_STUB_AREA:           "STUB_AREA",
+	OSPFV2_TOTALLY_STUBBY_AREA: "TOTALLY_STUBBY_AREA",
+	OSPFV2_NSSA_AREA:           "NSSA_AREA",
+	OSPFV2_TOTALLY_NSSA_AREA:   "TOTALLY_NSSA_AREA",
+}
+
+var ospfv2AreaTypeValues = map[string]Ospfv2AreaType{
+	"OSPFV2_AREA_TYPE":    OSPFV2_AREA_TYPE,
+	"NORMAL_AREA":         OSPFV2_NORMAL_AREA,
+	"STUB_AREA":           OSPFV2_STUB_AREA,
+	"TOTALLY_STUBBY_AREA": OSPFV2_TOTALLY_STUBBY_AREA,
+	"NSSA_AREA":           OSPFV2_NSSA_AREA,
+	"TOTALLY_NSSA_AREA":   OSPFV2_TOTALLY_NSSA_AREA,
+}
+
+func (v Ospfv2AreaType) String() string {
+	if s, ok := ospfv2AreaTypeNames[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("Ospfv2AreaType(%d)", v)
+}
+
+func ParseOspfv2AreaType(s string) (Ospfv2AreaType, error) {
+	_, ss := ncxml.ParseXPathName(s)
+	if v, ok := ospfv2AreaTypeValues[ss]; ok {
+		return v, nil
+	}
+	return OSPFV2_AREA_TYPE, fmt.Errorf("Invalid Ospfv2AreaType. %s", s)
+}
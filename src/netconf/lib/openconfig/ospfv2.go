@@ -20,20 +20,39 @@ package openconfig
 import (
 	nclib "netconf/lib"
 	ncxml "netconf/lib/xml"
+	"strconv"
 )
 
 const (
-	OSPFV2_KEY                = "ospfv2"
-	OSPFV2_ROUTERID_KEY       = "router-id"
-	OSPFV2_AREAS_KEY          = "areas"
-	OSPFV2_AREA_KEY           = "area"
-	OSPFV2_METRIC_KEY         = "metric"
-	OSPFV2_PASSIVE_KEY        = "passive"
-	OSPFV2_NETWORK_TYPE_KEY   = "network-type"
-	OSPFV2_PRIORITY_KEY       = "priority"
-	OSPFV2_TIMERS_KEY         = "timers"
-	OSPFV2_DEAD_INTERVAL_KEY  = "dead-interval"
-	OSPFV2_HELLO_INTERVAL_KEY = "hello-interval"
+	OSPFV2_KEY                        = "ospfv2"
+	OSPFV2_ROUTERID_KEY               = "router-id"
+	OSPFV2_DEFAULT_INFO_ORIGINATE_KEY = "default-information-originate"
+	OSPFV2_DEFAULT_INFO_ALWAYS_KEY    = "default-information-always"
+	OSPFV2_REDISTRIBUTIONS_KEY        = "redistributions"
+	OSPFV2_REDISTRIBUTION_KEY         = "redistribution"
+	OSPFV2_PROTOCOL_KEY               = "protocol"
+	OSPFV2_METRIC_TYPE_KEY            = "metric-type"
+	OSPFV2_AREAS_KEY                  = "areas"
+	OSPFV2_AREA_KEY                   = "area"
+	OSPFV2_AREA_TYPE_KEY              = "area-type"
+	OSPFV2_METRIC_KEY                 = "metric"
+	OSPFV2_PASSIVE_KEY                = "passive"
+	OSPFV2_NETWORK_TYPE_KEY           = "network-type"
+	OSPFV2_PRIORITY_KEY               = "priority"
+	OSPFV2_ENABLE_BFD_KEY             = "enable-bfd"
+	OSPFV2_AUTH_KEY                   = "authentication"
+	OSPFV2_AUTH_KEY_ID_KEY            = "key-id"
+	OSPFV2_AUTH_AUTH_KEY_KEY          = "auth-key"
+	OSPFV2_TIMERS_KEY                 = "timers"
+	OSPFV2_DEAD_INTERVAL_KEY          = "dead-interval"
+	OSPFV2_HELLO_INTERVAL_KEY         = "hello-interval"
+	OSPFV2_RANGES_KEY                 = "ranges"
+	OSPFV2_RANGE_KEY                  = "range"
+	OSPFV2_RANGE_IP_KEY               = "ip"
+	OSPFV2_RANGE_PREFIXLEN_KEY        = "prefix-length"
+	OSPFV2_RANGE_ADVERTISE_KEY        = "advertise"
+	OSPFV2_METRIC_MAX                 = 16777214
+	OSPFV2_AUTH_KEY_LEN_MAX           = 16
 )
 
 //
@@ -125,17 +144,22 @@ func ProcessOspfv2(p Ospfv2Processor, reverse bool, name string, key *NetworkIns
 type Ospfv2Global struct {
 	nclib.SrChanges `xml:"-"`
 
-	Config *Ospfv2GlobalConfig `xml:"config"`
+	Config          *Ospfv2GlobalConfig   `xml:"config"`
+	Redistributions Ospfv2Redistributions `xml:"redistributions"`
+	SegmentRouting  *SegmentRouting       `xml:"segment-routing"`
 }
 
 type Ospfv2GlobalProcessor interface {
 	Ospfv2GlobalConfigProcessor
+	Ospfv2RedistributionProcessor
 }
 
 func NewOspfv2Global() *Ospfv2Global {
 	return &Ospfv2Global{
-		SrChanges: nclib.NewSrChanges(),
-		Config:    NewOspfv2GlobalConfig(),
+		SrChanges:       nclib.NewSrChanges(),
+		Config:          NewOspfv2GlobalConfig(),
+		Redistributions: NewOspfv2Redistributions(),
+		SegmentRouting:  NewSegmentRouting(),
 	}
 }
 
@@ -149,6 +173,16 @@ func (o *Ospfv2Global) Put(nodes []*ncxml.XPathNode, value string) error {
 		if err := o.Config.Put(nodes[1:], value); err != nil {
 			return err
 		}
+
+	case OSPFV2_REDISTRIBUTIONS_KEY:
+		if err := o.Redistributions.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case SR_KEY:
+		if err := o.SegmentRouting.Put(nodes[1:], value); err != nil {
+			return err
+		}
 	}
 
 	o.SetChange(nodes[0].Name)
@@ -169,7 +203,33 @@ func ProcessOspfv2Global(p Ospfv2GlobalProcessor, reverse bool, name string, key
 		return nil
 	}
 
-	return nclib.CallFunctions(reverse, configFunc)
+	redistFunc := func() error {
+		if global.GetChange(OSPFV2_REDISTRIBUTIONS_KEY) {
+			return ProcessOspfv2Redistributions(
+				p.(Ospfv2RedistributionProcessor),
+				reverse,
+				name,
+				key,
+				global.Redistributions,
+			)
+		}
+		return nil
+	}
+
+	srFunc := func() error {
+		if global.GetChange(SR_KEY) {
+			return ProcessSegmentRouting(
+				p.(SegmentRoutingProcessor),
+				reverse,
+				name,
+				key,
+				global.SegmentRouting,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc, redistFunc, srFunc)
 }
 
 //
@@ -178,7 +238,9 @@ func ProcessOspfv2Global(p Ospfv2GlobalProcessor, reverse bool, name string, key
 type Ospfv2GlobalConfig struct {
 	nclib.SrChanges `xml:"-"`
 
-	RouterId string `xml:"router-id"`
+	RouterId                    string `xml:"router-id"`
+	DefaultInformationOriginate bool   `xml:"default-information-originate"`
+	DefaultInformationAlways    bool   `xml:"default-information-always"`
 }
 
 type Ospfv2GlobalConfigProcessor interface {
@@ -187,8 +249,10 @@ type Ospfv2GlobalConfigProcessor interface {
 
 func NewOspfv2GlobalConfig() *Ospfv2GlobalConfig {
 	return &Ospfv2GlobalConfig{
-		SrChanges: nclib.NewSrChanges(),
-		RouterId:  "",
+		SrChanges:                   nclib.NewSrChanges(),
+		RouterId:                    "",
+		DefaultInformationOriginate: false,
+		DefaultInformationAlways:    false,
 	}
 }
 
@@ -200,6 +264,20 @@ func (c *Ospfv2GlobalConfig) Put(nodes []*ncxml.XPathNode, value string) error {
 	switch nodes[0].Name {
 	case OSPFV2_ROUTERID_KEY:
 		c.RouterId = value
+
+	case OSPFV2_DEFAULT_INFO_ORIGINATE_KEY:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		c.DefaultInformationOriginate = b
+
+	case OSPFV2_DEFAULT_INFO_ALWAYS_KEY:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		c.DefaultInformationAlways = b
 	}
 
 	c.SetChange(nodes[0].Name)
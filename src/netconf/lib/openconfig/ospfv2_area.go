@@ -84,12 +84,14 @@ type Ospfv2Area struct {
 	Ident      string            `xml:"identifier"`
 	Config     *Ospfv2AreaConfig `xml:"config"`
 	Interfaces Ospfv2Interfaces  `xml:"interfaces"`
+	Ranges     Ospfv2AreaRanges  `xml:"ranges"`
 }
 
 type Ospfv2AreaProcessor interface {
 	ospfv2AreaProcessor
 	Ospfv2AreaConfigProcessor
 	Ospfv2InterfaceProcessor
+	Ospfv2AreaRangeProcessor
 }
 
 type ospfv2AreaProcessor interface {
@@ -102,6 +104,7 @@ func NewOspfv2Area(ident string) *Ospfv2Area {
 		Ident:      ident,
 		Config:     NewOspfv2AreaConfig(),
 		Interfaces: NewOspfv2Interfaces(),
+		Ranges:     NewOspfv2AreaRanges(),
 	}
 }
 
@@ -123,6 +126,11 @@ func (o *Ospfv2Area) Put(nodes []*ncxml.XPathNode, value string) error {
 		if err := o.Interfaces.Put(nodes[1:], value); err != nil {
 			return err
 		}
+
+	case OSPFV2_RANGES_KEY:
+		if err := o.Ranges.Put(nodes[1:], value); err != nil {
+			return err
+		}
 	}
 
 	o.SetChange(nodes[0].Name)
@@ -166,7 +174,21 @@ func ProcessOspfv2Area(p Ospfv2AreaProcessor, reverse bool, name string, key *Ne
 		return nil
 	}
 
-	return nclib.CallFunctions(reverse, identFunc, configFunc, ifaccesFunc)
+	rangesFunc := func() error {
+		if area.GetChange(OSPFV2_RANGES_KEY) {
+			return ProcessOspfv2AreaRanges(
+				p.(Ospfv2AreaRangeProcessor),
+				reverse,
+				name,
+				key,
+				areaId,
+				area.Ranges,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, identFunc, configFunc, ifaccesFunc, rangesFunc)
 }
 
 //
@@ -175,7 +197,8 @@ func ProcessOspfv2Area(p Ospfv2AreaProcessor, reverse bool, name string, key *Ne
 type Ospfv2AreaConfig struct {
 	nclib.SrChanges `xml:"-"`
 
-	Ident string `xml:"identifier"`
+	Ident    string         `xml:"identifier"`
+	AreaType Ospfv2AreaType `xml:"area-type"`
 }
 
 type Ospfv2AreaConfigProcessor interface {
@@ -186,6 +209,7 @@ func NewOspfv2AreaConfig() *Ospfv2AreaConfig {
 	return &Ospfv2AreaConfig{
 		SrChanges: nclib.NewSrChanges(),
 		Ident:     "",
+		AreaType:  OSPFV2_NORMAL_AREA,
 	}
 }
 
@@ -197,6 +221,13 @@ func (c *Ospfv2AreaConfig) Put(nodes []*ncxml.XPathNode, value string) error {
 	switch nodes[0].Name {
 	case OC_IDENT_KEY:
 		c.Ident = value
+
+	case OSPFV2_AREA_TYPE_KEY:
+		areaType, err := ParseOspfv2AreaType(value)
+		if err != nil {
+			return err
+		}
+		c.AreaType = areaType
 	}
 
 	c.SetChange(nodes[0].Name)
@@ -76,16 +76,18 @@ func (p Ospfv2Interfaces) MarshalXML(e *xml.Encoder, start xml.StartElement) err
 type Ospfv2Interface struct {
 	nclib.SrChanges `xml:"-"`
 
-	Id           string                 `xml:"id"`
-	Config       *Ospfv2InterfaceConfig `xml:"config"`
-	InterfaceRef *InterfaceRef          `xml:"interface-ref"`
-	Timers       *Ospfv2InterfaceTimers `xml:"timers"`
+	Id             string                         `xml:"id"`
+	Config         *Ospfv2InterfaceConfig         `xml:"config"`
+	InterfaceRef   *InterfaceRef                  `xml:"interface-ref"`
+	Authentication *Ospfv2InterfaceAuthentication `xml:"authentication"`
+	Timers         *Ospfv2InterfaceTimers         `xml:"timers"`
 }
 
 type Ospfv2InterfaceProcessor interface {
 	ospfv2InterfaceProcessor
 	Ospfv2InterfaceConfigProcessor
 	Ospfv2InterfaceRefProcessor
+	Ospfv2InterfaceAuthenticationProcessor
 	Ospfv2InterfaceTimersProcessor
 }
 
@@ -95,11 +97,12 @@ type ospfv2InterfaceProcessor interface {
 
 func NewOspfv2Interface(id string) *Ospfv2Interface {
 	return &Ospfv2Interface{
-		SrChanges:    nclib.NewSrChanges(),
-		Id:           id,
-		Config:       NewOspfv2InterfaceConfig(),
-		InterfaceRef: NewInterfaceRef(),
-		Timers:       NewOspfv2InterfaceTimers(),
+		SrChanges:      nclib.NewSrChanges(),
+		Id:             id,
+		Config:         NewOspfv2InterfaceConfig(),
+		InterfaceRef:   NewInterfaceRef(),
+		Authentication: NewOspfv2InterfaceAuthentication(),
+		Timers:         NewOspfv2InterfaceTimers(),
 	}
 }
 
@@ -122,6 +125,11 @@ func (o *Ospfv2Interface) Put(nodes []*ncxml.XPathNode, value string) error {
 			return err
 		}
 
+	case OSPFV2_AUTH_KEY:
+		if err := o.Authentication.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
 	case OSPFV2_TIMERS_KEY:
 		if err := o.Timers.Put(nodes[1:], value); err != nil {
 			return err
@@ -170,6 +178,21 @@ func ProcessOspfv2Interface(p Ospfv2InterfaceProcessor, reverse bool, name strin
 		return nil
 	}
 
+	authFunc := func() error {
+		if iface.GetChange(OSPFV2_AUTH_KEY) {
+			return ProcessOspfv2InterfaceAuthentication(
+				p.(Ospfv2InterfaceAuthenticationProcessor),
+				reverse,
+				name,
+				key,
+				areaId,
+				ifaceId,
+				iface.Authentication,
+			)
+		}
+		return nil
+	}
+
 	timersFunc := func() error {
 		if iface.GetChange(OSPFV2_TIMERS_KEY) {
 			return ProcessOspfv2InterfaceTimers(
@@ -185,7 +208,7 @@ func ProcessOspfv2Interface(p Ospfv2InterfaceProcessor, reverse bool, name strin
 		return nil
 	}
 
-	return nclib.CallFunctions(reverse, ifaceFunc, configFunc, ifrefFunc, timersFunc)
+	return nclib.CallFunctions(reverse, ifaceFunc, configFunc, ifrefFunc, authFunc, timersFunc)
 }
 
 type Ospfv2InterfaceConfig struct {
@@ -196,6 +219,7 @@ type Ospfv2InterfaceConfig struct {
 	Passive     bool            `xml:"passive"`
 	NetworkType OspfNetworkType `xml:"network-type"`
 	Priority    uint8           `xml:"priority"`
+	EnableBfd   bool            `xml:"enable-bfd"`
 }
 
 type Ospfv2InterfaceConfigProcessor interface {
@@ -210,6 +234,7 @@ func NewOspfv2InterfaceConfig() *Ospfv2InterfaceConfig {
 		Passive:     false,
 		NetworkType: OSPF_BROADCAST_NETWORK,
 		Priority:    1,
+		EnableBfd:   false,
 	}
 }
 
@@ -249,6 +274,13 @@ func (c *Ospfv2InterfaceConfig) Put(nodes []*ncxml.XPathNode, value string) erro
 			return err
 		}
 		c.Priority = uint8(priority)
+
+	case OSPFV2_ENABLE_BFD_KEY:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		c.EnableBfd = b
 	}
 
 	c.SetChange(nodes[0].Name)
@@ -263,6 +295,113 @@ func ProcessOspfv2InterfaceConfig(p Ospfv2InterfaceConfigProcessor, reverse bool
 	return nclib.CallFunctions(reverse, configFunc)
 }
 
+type Ospfv2InterfaceAuthentication struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *Ospfv2InterfaceAuthenticationConfig `xml:"config"`
+}
+
+type Ospfv2InterfaceAuthenticationProcessor interface {
+	Ospfv2InterfaceAuthenticationConfig(string, *NetworkInstanceProtocolKey, string, string, *Ospfv2InterfaceAuthenticationConfig) error
+}
+
+func NewOspfv2InterfaceAuthentication() *Ospfv2InterfaceAuthentication {
+	return &Ospfv2InterfaceAuthentication{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewOspfv2InterfaceAuthenticationConfig(),
+	}
+}
+
+func (o *Ospfv2InterfaceAuthentication) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := o.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	o.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessOspfv2InterfaceAuthentication(p Ospfv2InterfaceAuthenticationProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, areaId string, ifaceId string, auth *Ospfv2InterfaceAuthentication) error {
+	configFunc := func() error {
+		if auth.GetChange(OC_CONFIG_KEY) {
+			return p.Ospfv2InterfaceAuthenticationConfig(name, key, areaId, ifaceId, auth.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+type Ospfv2InterfaceAuthenticationConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Enabled bool   `xml:"enabled"`
+	KeyId   uint8  `xml:"key-id"`
+	AuthKey string `xml:"auth-key"`
+}
+
+func NewOspfv2InterfaceAuthenticationConfig() *Ospfv2InterfaceAuthenticationConfig {
+	return &Ospfv2InterfaceAuthenticationConfig{
+		SrChanges: nclib.NewSrChanges(),
+		Enabled:   false,
+		KeyId:     0,
+		AuthKey:   "",
+	}
+}
+
+//
+// String omits auth-key not to write it to the log.
+//
+func (c *Ospfv2InterfaceAuthenticationConfig) String() string {
+	return fmt.Sprintf("%s{%s=%t, %s=%d} %s",
+		OC_CONFIG_KEY,
+		OC_ENABLED_KEY, c.Enabled,
+		OSPFV2_AUTH_KEY_ID_KEY, c.KeyId,
+		c.SrChanges,
+	)
+}
+
+func (c *Ospfv2InterfaceAuthenticationConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_ENABLED_KEY:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		c.Enabled = b
+
+	case OSPFV2_AUTH_KEY_ID_KEY:
+		keyId, err := strconv.ParseUint(value, 0, 8)
+		if err != nil {
+			return err
+		}
+		if keyId == 0 {
+			return fmt.Errorf("Invalid OSPFv2 key-id. %s", value)
+		}
+		c.KeyId = uint8(keyId)
+
+	case OSPFV2_AUTH_AUTH_KEY_KEY:
+		if len(value) > OSPFV2_AUTH_KEY_LEN_MAX {
+			return fmt.Errorf("Invalid OSPFv2 auth-key. too long.")
+		}
+		c.AuthKey = value
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
 type Ospfv2InterfaceTimers struct {
 	nclib.SrChanges `xml:"-"`
 
@@ -0,0 +1,265 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net"
+	nclib "netconf/lib"
+	ncnet "netconf/lib/net"
+	ncxml "netconf/lib/xml"
+	"strconv"
+)
+
+type Ospfv2AreaRangeKey struct {
+	Ip        string
+	PrefixLen uint8
+}
+
+func NewOspfv2AreaRangeKey(ip string, plen uint8) *Ospfv2AreaRangeKey {
+	return &Ospfv2AreaRangeKey{
+		Ip:        ip,
+		PrefixLen: plen,
+	}
+}
+
+func ParseOspfv2AreaRangeKey(ip, plen string) (*Ospfv2AreaRangeKey, error) {
+	n, err := strconv.ParseUint(plen, 0, 8)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewOspfv2AreaRangeKey(ip, uint8(n)), nil
+}
+
+func (o *Ospfv2AreaRangeKey) String() string {
+	return fmt.Sprintf("%s/%d", o.Ip, o.PrefixLen)
+}
+
+//
+// ospfv2/areas/area[id]/ranges
+//
+type Ospfv2AreaRanges map[Ospfv2AreaRangeKey]*Ospfv2AreaRange
+
+func NewOspfv2AreaRanges() Ospfv2AreaRanges {
+	return Ospfv2AreaRanges{}
+}
+
+func (o Ospfv2AreaRanges) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	ip, ok := nodes[0].Attrs[OSPFV2_RANGE_IP_KEY]
+	if !ok {
+		return fmt.Errorf("%s@%s not found. %s", OSPFV2_RANGE_KEY, OSPFV2_RANGE_IP_KEY, nodes[0])
+	}
+	plen, ok := nodes[0].Attrs[OSPFV2_RANGE_PREFIXLEN_KEY]
+	if !ok {
+		return fmt.Errorf("%s@%s not found. %s", OSPFV2_RANGE_KEY, OSPFV2_RANGE_PREFIXLEN_KEY, nodes[0])
+	}
+	key, err := ParseOspfv2AreaRangeKey(ip, plen)
+	if err != nil {
+		return err
+	}
+
+	rng, ok := o[*key]
+	if !ok {
+		rng = NewOspfv2AreaRange(key)
+		o[*key] = rng
+	}
+
+	return rng.Put(nodes[1:], value)
+}
+
+func ProcessOspfv2AreaRanges(p Ospfv2AreaRangeProcessor, reverse bool, name string, nikey *NetworkInstanceProtocolKey, areaId string, ranges Ospfv2AreaRanges) error {
+	for rngkey, rng := range ranges {
+		if err := ProcessOspfv2AreaRange(p, reverse, name, nikey, areaId, &rngkey, rng); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o Ospfv2AreaRanges) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = OSPFV2_RANGES_KEY
+	e.EncodeToken(start)
+
+	for _, rng := range o {
+		err := e.EncodeElement(rng, xml.StartElement{Name: xml.Name{Local: OSPFV2_RANGE_KEY}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+type Ospfv2AreaRange struct {
+	nclib.SrChanges `xml:"-"`
+
+	Ip        string                 `xml:"ip"`
+	PrefixLen uint8                  `xml:"prefix-length"`
+	Config    *Ospfv2AreaRangeConfig `xml:"config"`
+}
+
+type Ospfv2AreaRangeProcessor interface {
+	ospfv2AreaRangeProcessor
+	Ospfv2AreaRangeConfigProcessor
+}
+
+type ospfv2AreaRangeProcessor interface {
+	Ospfv2AreaRange(string, *NetworkInstanceProtocolKey, string, *Ospfv2AreaRangeKey, *Ospfv2AreaRange) error
+}
+
+func NewOspfv2AreaRange(key *Ospfv2AreaRangeKey) *Ospfv2AreaRange {
+	return &Ospfv2AreaRange{
+		SrChanges: nclib.NewSrChanges(),
+		Ip:        key.Ip,
+		PrefixLen: key.PrefixLen,
+		Config:    NewOspfv2AreaRangeConfig(),
+	}
+}
+
+func (o *Ospfv2AreaRange) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OSPFV2_RANGE_IP_KEY:
+		// o.Ip = value // set by NewOspfv2AreaRange
+
+	case OSPFV2_RANGE_PREFIXLEN_KEY:
+		// o.PrefixLen = strings.ParseUint(value, 0,8) // set by NewOspfv2AreaRange
+
+	case OC_CONFIG_KEY:
+		if err := o.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	o.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessOspfv2AreaRange(p Ospfv2AreaRangeProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, areaId string, rngkey *Ospfv2AreaRangeKey, rng *Ospfv2AreaRange) error {
+	rangeFunc := func() error {
+		if rng.GetChanges(OSPFV2_RANGE_IP_KEY, OSPFV2_RANGE_PREFIXLEN_KEY) {
+			return p.Ospfv2AreaRange(name, key, areaId, rngkey, rng)
+		}
+		return nil
+	}
+
+	configFunc := func() error {
+		if rng.GetChange(OC_CONFIG_KEY) {
+			return ProcessOspfv2AreaRangeConfig(
+				p.(Ospfv2AreaRangeConfigProcessor),
+				reverse,
+				name,
+				key,
+				areaId,
+				rngkey,
+				rng.Config,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, rangeFunc, configFunc)
+}
+
+type Ospfv2AreaRangeConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Ip        net.IP `xml:"ip"`
+	PrefixLen uint8  `xml:"prefix-length"`
+	Advertise bool   `xml:"advertise"`
+}
+
+type Ospfv2AreaRangeConfigProcessor interface {
+	Ospfv2AreaRangeConfig(string, *NetworkInstanceProtocolKey, string, *Ospfv2AreaRangeKey, *Ospfv2AreaRangeConfig) error
+}
+
+func NewOspfv2AreaRangeConfig() *Ospfv2AreaRangeConfig {
+	return &Ospfv2AreaRangeConfig{
+		SrChanges: nclib.NewSrChanges(),
+		Ip:        nil,
+		PrefixLen: 0,
+		Advertise: true,
+	}
+}
+
+func (o *Ospfv2AreaRangeConfig) IPNet() *net.IPNet {
+	return ncnet.IPToIPNet(o.Ip, int(o.PrefixLen))
+}
+
+func (o *Ospfv2AreaRangeConfig) SetIP(ip net.IP) {
+	o.Ip = ip
+	o.SetChange(OSPFV2_RANGE_IP_KEY)
+}
+
+func (o *Ospfv2AreaRangeConfig) SetPLen(plen uint8) {
+	o.PrefixLen = plen
+	o.SetChange(OSPFV2_RANGE_PREFIXLEN_KEY)
+}
+
+func (o *Ospfv2AreaRangeConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OSPFV2_RANGE_IP_KEY:
+		ip := net.ParseIP(value)
+		if ip == nil || ip.To4() == nil {
+			return fmt.Errorf("Invalid IP. %s", value)
+		}
+		o.Ip = ip
+
+	case OSPFV2_RANGE_PREFIXLEN_KEY:
+		prefixLen, err := strconv.ParseUint(value, 0, 8)
+		if err != nil {
+			return err
+		}
+		if prefixLen > 32 {
+			return fmt.Errorf("Invalid prefix-length. %s", value)
+		}
+		o.PrefixLen = uint8(prefixLen)
+
+	case OSPFV2_RANGE_ADVERTISE_KEY:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		o.Advertise = b
+	}
+
+	o.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessOspfv2AreaRangeConfig(p Ospfv2AreaRangeConfigProcessor, reverse bool, name string, nikey *NetworkInstanceProtocolKey, areaId string, rngkey *Ospfv2AreaRangeKey, config *Ospfv2AreaRangeConfig) error {
+	configFunc := func() error {
+		return p.Ospfv2AreaRangeConfig(name, nikey, areaId, rngkey, config)
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
@@ -0,0 +1,188 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package openconfig
+
+import (
+	"encoding/xml"
+	"fmt"
+	nclib "netconf/lib"
+	ncxml "netconf/lib/xml"
+	"strconv"
+)
+
+//
+// ospfv2/global/redistributions
+//
+type Ospfv2Redistributions map[string]*Ospfv2Redistribution
+
+func NewOspfv2Redistributions() Ospfv2Redistributions {
+	return Ospfv2Redistributions{}
+}
+
+func (o Ospfv2Redistributions) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	proto, ok := nodes[0].Attrs[OSPFV2_PROTOCOL_KEY]
+	if !ok {
+		return fmt.Errorf("%s@%s not found. %s", OSPFV2_REDISTRIBUTION_KEY, OSPFV2_PROTOCOL_KEY, nodes[0])
+	}
+
+	redist, ok := o[proto]
+	if !ok {
+		redist = NewOspfv2Redistribution(proto)
+		o[proto] = redist
+	}
+
+	return redist.Put(nodes[1:], value)
+}
+
+func ProcessOspfv2Redistributions(p Ospfv2RedistributionProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, redists Ospfv2Redistributions) error {
+	for proto, redist := range redists {
+		if err := ProcessOspfv2Redistribution(p, reverse, name, key, proto, redist); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o Ospfv2Redistributions) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = OSPFV2_REDISTRIBUTIONS_KEY
+	e.EncodeToken(start)
+
+	for _, redist := range o {
+		err := e.EncodeElement(redist, xml.StartElement{Name: xml.Name{Local: OSPFV2_REDISTRIBUTION_KEY}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+//
+// ospfv2/global/redistributions/redistribution[protocol]
+//
+type Ospfv2Redistribution struct {
+	nclib.SrChanges `xml:"-"`
+
+	Protocol string                      `xml:"protocol"`
+	Config   *Ospfv2RedistributionConfig `xml:"config"`
+}
+
+type Ospfv2RedistributionProcessor interface {
+	Ospfv2RedistributionConfig(string, *NetworkInstanceProtocolKey, string, *Ospfv2RedistributionConfig) error
+}
+
+func NewOspfv2Redistribution(proto string) *Ospfv2Redistribution {
+	return &Ospfv2Redistribution{
+		SrChanges: nclib.NewSrChanges(),
+		Protocol:  proto,
+		Config:    NewOspfv2RedistributionConfig(),
+	}
+}
+
+func (o *Ospfv2Redistribution) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OSPFV2_PROTOCOL_KEY:
+		// o.Protocol = value // set by NewOspfv2Redistribution
+
+	case OC_CONFIG_KEY:
+		if err := o.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	o.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessOspfv2Redistribution(p Ospfv2RedistributionProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, proto string, redist *Ospfv2Redistribution) error {
+	configFunc := func() error {
+		if redist.GetChange(OC_CONFIG_KEY) {
+			return p.Ospfv2RedistributionConfig(name, key, proto, redist.Config)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// ospfv2/global/redistributions/redistribution[protocol]/config
+//
+type Ospfv2RedistributionConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Protocol   InstallProtocolType `xml:"protocol"`
+	Metric     uint32              `xml:"metric"`
+	MetricType uint8               `xml:"metric-type"`
+}
+
+func NewOspfv2RedistributionConfig() *Ospfv2RedistributionConfig {
+	return &Ospfv2RedistributionConfig{
+		SrChanges:  nclib.NewSrChanges(),
+		Protocol:   INSTALL_PROTOCOL_TYPE,
+		Metric:     0,
+		MetricType: 2,
+	}
+}
+
+func (c *Ospfv2RedistributionConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OSPFV2_PROTOCOL_KEY:
+		proto, err := ParseInstallProtocolType(value)
+		if err != nil {
+			return err
+		}
+		c.Protocol = proto
+
+	case OSPFV2_METRIC_KEY:
+		metric, err := strconv.ParseUint(value, 0, 32)
+		if err != nil {
+			return err
+		}
+		if metric > OSPFV2_METRIC_MAX {
+			return fmt.Errorf("Invalid OSPFv2 redistribution metric. %s", value)
+		}
+		c.Metric = uint32(metric)
+
+	case OSPFV2_METRIC_TYPE_KEY:
+		metricType, err := strconv.ParseUint(value, 0, 8)
+		if err != nil {
+			return err
+		}
+		if metricType < 1 || metricType > 2 {
+			return fmt.Errorf("Invalid OSPFv2 redistribution metric-type. %s", value)
+		}
+		c.MetricType = uint8(metricType)
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
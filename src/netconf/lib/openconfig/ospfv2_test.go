@@ -0,0 +1,192 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package openconfig
+
+import (
+	srlib "netconf/lib/sysrepo"
+	"testing"
+)
+
+func makeOspfv2(datas [][2]string) (*Ospfv2, error) {
+	ospf := NewOspfv2()
+	for _, data := range datas {
+		xpath, value := data[0], data[1]
+		nodes := srlib.ParseXPath(xpath)
+		if err := ospf.Put(nodes[1:], value); err != nil {
+			return nil, err
+		}
+	}
+	return ospf, nil
+}
+
+func TestOspfv2Global(t *testing.T) {
+	ospf, err := makeOspfv2([][2]string{
+		{"/ospfv2/global/config/router-id", "10.0.0.1"},
+		{"/ospfv2/global/config/default-information-originate", "true"},
+		{"/ospfv2/global/config/default-information-always", "true"},
+		{"/ospfv2/global/redistributions/redistribution[protocol='oc-pol-types:STATIC']/protocol", "oc-pol-types:STATIC"},
+		{"/ospfv2/global/redistributions/redistribution[protocol='oc-pol-types:STATIC']/config/protocol", "oc-pol-types:STATIC"},
+		{"/ospfv2/global/redistributions/redistribution[protocol='oc-pol-types:STATIC']/config/metric", "100"},
+		{"/ospfv2/global/redistributions/redistribution[protocol='oc-pol-types:STATIC']/config/metric-type", "1"},
+	})
+
+	if err != nil {
+		t.Errorf("ospfv2.Put error. %s", err)
+	}
+
+	if v := ospf.Global.Compare(OC_CONFIG_KEY, OSPFV2_REDISTRIBUTIONS_KEY); !v {
+		t.Errorf("ospfv2.Put unmatch. cmp=%t", v)
+	}
+
+	config := ospf.Global.Config
+	if v := config.Compare(OSPFV2_ROUTERID_KEY, OSPFV2_DEFAULT_INFO_ORIGINATE_KEY, OSPFV2_DEFAULT_INFO_ALWAYS_KEY); !v {
+		t.Errorf("ospfv2.Put unmatch. cmp=%t", v)
+	}
+
+	if v := config.DefaultInformationOriginate; !v {
+		t.Errorf("ospfv2.Put unmatch. default-information-originate=%t", v)
+	}
+
+	if v := config.DefaultInformationAlways; !v {
+		t.Errorf("ospfv2.Put unmatch. default-information-always=%t", v)
+	}
+
+	redist, ok := ospf.Global.Redistributions["oc-pol-types:STATIC"]
+	if !ok {
+		t.Fatalf("ospfv2.Put unmatch. %v", ospf.Global.Redistributions)
+	}
+
+	if v := redist.Config.Compare(OSPFV2_PROTOCOL_KEY, OSPFV2_METRIC_KEY, OSPFV2_METRIC_TYPE_KEY); !v {
+		t.Errorf("ospfv2.Put unmatch. cmp=%t", v)
+	}
+
+	if v := redist.Config.Protocol; v != INSTALL_PROTOCOL_STATIC {
+		t.Errorf("ospfv2.Put unmatch. protocol=%s", v)
+	}
+
+	if v := redist.Config.Metric; v != 100 {
+		t.Errorf("ospfv2.Put unmatch. metric=%d", v)
+	}
+
+	if v := redist.Config.MetricType; v != 1 {
+		t.Errorf("ospfv2.Put unmatch. metric-type=%d", v)
+	}
+}
+
+func TestOspfv2Area(t *testing.T) {
+	ospf, err := makeOspfv2([][2]string{
+		{"/ospfv2/areas/area[identifier='0.0.0.1']/identifier", "0.0.0.1"},
+		{"/ospfv2/areas/area[identifier='0.0.0.1']/config/identifier", "0.0.0.1"},
+		{"/ospfv2/areas/area[identifier='0.0.0.1']/config/area-type", "boc-ospfv2:TOTALLY_NSSA_AREA"},
+		{"/ospfv2/areas/area[identifier='0.0.0.1']/ranges/range[ip='10.1.0.0'][prefix-length='16']/ip", "10.1.0.0"},
+		{"/ospfv2/areas/area[identifier='0.0.0.1']/ranges/range[ip='10.1.0.0'][prefix-length='16']/prefix-length", "16"},
+		{"/ospfv2/areas/area[identifier='0.0.0.1']/ranges/range[ip='10.1.0.0'][prefix-length='16']/config/ip", "10.1.0.0"},
+		{"/ospfv2/areas/area[identifier='0.0.0.1']/ranges/range[ip='10.1.0.0'][prefix-length='16']/config/prefix-length", "16"},
+		{"/ospfv2/areas/area[identifier='0.0.0.1']/ranges/range[ip='10.1.0.0'][prefix-length='16']/config/advertise", "false"},
+	})
+
+	if err != nil {
+		t.Errorf("ospfv2.Put error. %s", err)
+	}
+
+	area, ok := ospf.Areas["0.0.0.1"]
+	if !ok {
+		t.Fatalf("ospfv2.Put unmatch. %v", ospf.Areas)
+	}
+
+	if v := area.Compare(OC_IDENT_KEY, OC_CONFIG_KEY, OSPFV2_RANGES_KEY); !v {
+		t.Errorf("ospfv2.Put unmatch. cmp=%t", v)
+	}
+
+	if v := area.Config.AreaType; v != OSPFV2_TOTALLY_NSSA_AREA {
+		t.Errorf("ospfv2.Put unmatch. area-type=%s", v)
+	}
+
+	rng, ok := area.Ranges[*NewOspfv2AreaRangeKey("10.1.0.0", 16)]
+	if !ok {
+		t.Fatalf("ospfv2.Put unmatch. %v", area.Ranges)
+	}
+
+	if v := rng.Config.IPNet().String(); v != "10.1.0.0/16" {
+		t.Errorf("ospfv2.Put unmatch. range=%s", v)
+	}
+
+	if v := rng.Config.Advertise; v {
+		t.Errorf("ospfv2.Put unmatch. advertise=%t", v)
+	}
+}
+
+func TestOspfv2Interface(t *testing.T) {
+	ospf, err := makeOspfv2([][2]string{
+		{"/ospfv2/areas/area[identifier='0.0.0.0']/interfaces/interface[id='eth1.10']/id", "eth1.10"},
+		{"/ospfv2/areas/area[identifier='0.0.0.0']/interfaces/interface[id='eth1.10']/config/id", "eth1.10"},
+		{"/ospfv2/areas/area[identifier='0.0.0.0']/interfaces/interface[id='eth1.10']/config/enable-bfd", "true"},
+		{"/ospfv2/areas/area[identifier='0.0.0.0']/interfaces/interface[id='eth1.10']/authentication/config/enabled", "true"},
+		{"/ospfv2/areas/area[identifier='0.0.0.0']/interfaces/interface[id='eth1.10']/authentication/config/key-id", "1"},
+		{"/ospfv2/areas/area[identifier='0.0.0.0']/interfaces/interface[id='eth1.10']/authentication/config/auth-key", "secret"},
+	})
+
+	if err != nil {
+		t.Errorf("ospfv2.Put error. %s", err)
+	}
+
+	iface, ok := ospf.Areas["0.0.0.0"].Interfaces["eth1.10"]
+	if !ok {
+		t.Fatalf("ospfv2.Put unmatch. %v", ospf.Areas["0.0.0.0"].Interfaces)
+	}
+
+	if v := iface.Compare(OC_ID_KEY, OC_CONFIG_KEY, OSPFV2_AUTH_KEY); !v {
+		t.Errorf("ospfv2.Put unmatch. cmp=%t", v)
+	}
+
+	if v := iface.Config.EnableBfd; !v {
+		t.Errorf("ospfv2.Put unmatch. enable-bfd=%t", v)
+	}
+
+	auth := iface.Authentication.Config
+	if v := auth.Compare(OC_ENABLED_KEY, OSPFV2_AUTH_KEY_ID_KEY, OSPFV2_AUTH_AUTH_KEY_KEY); !v {
+		t.Errorf("ospfv2.Put unmatch. cmp=%t", v)
+	}
+
+	if v := auth.KeyId; v != 1 {
+		t.Errorf("ospfv2.Put unmatch. key-id=%d", v)
+	}
+
+	if v := auth.AuthKey; v != "secret" {
+		t.Errorf("ospfv2.Put unmatch. auth-key=%s", v)
+	}
+}
+
+func TestOspfv2_invalid(t *testing.T) {
+	datas := [][2]string{
+		{"/ospfv2/global/redistributions/redistribution[protocol='oc-pol-types:STATIC']/config/metric-type", "3"},
+		{"/ospfv2/global/redistributions/redistribution[protocol='oc-pol-types:STATIC']/config/metric", "16777215"},
+		{"/ospfv2/areas/area[identifier='0.0.0.1']/config/area-type", "boc-ospfv2:NO_AREA"},
+		{"/ospfv2/areas/area[identifier='0.0.0.1']/ranges/range[ip='10.1.0.0'][prefix-length='16']/config/ip", "2001:db8::"},
+		{"/ospfv2/areas/area[identifier='0.0.0.1']/ranges/range[ip='10.1.0.0'][prefix-length='16']/config/prefix-length", "33"},
+		{"/ospfv2/areas/area[identifier='0.0.0.0']/interfaces/interface[id='eth1']/authentication/config/key-id", "0"},
+		{"/ospfv2/areas/area[identifier='0.0.0.0']/interfaces/interface[id='eth1']/authentication/config/auth-key", "12345678901234567"},
+	}
+
+	for _, data := range datas {
+		if _, err := makeOspfv2([][2]string{data}); err == nil {
+			t.Errorf("ospfv2.Put must be error. %v", data)
+		}
+	}
+}
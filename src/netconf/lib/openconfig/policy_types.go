@@ -52,6 +52,7 @@ var installProtocolTypeValues = map[string]InstallProtocolType{
 	"ISIS":                  INSTALL_PROTOCOL_ISIS,
 	"OSPF":                  INSTALL_PROTOCOL_OSPF,
 	"OSPF3":                 INSTALL_PROTOCOL_OSPF3,
+	"OSPF6":                 INSTALL_PROTOCOL_OSPF3, // beluganos yang (python)
 	"STATIC":                INSTALL_PROTOCOL_STATIC,
 	"DIRECTLY_CONNECTED":    INSTALL_PROTOCOL_DIRECTLY_CONNECTED,
 	"LOCAL_AGGREGATE":       INSTALL_PROTOCOL_LOCAL_AGGREGATE,
@@ -109,6 +110,44 @@ func ParsePolicyMatchSetOptionsType(s string) (PolicyMatchSetOptionsType, error)
 	return POLICY_MATCH_SET_OPTIONS_TYPE, fmt.Errorf("Invalid PolicyMatchSetOptionsType. %s", s)
 }
 
+type PolicyAttrComparisonType int
+
+const (
+	POLICY_ATTR_COMPARISON_TYPE PolicyAttrComparisonType = iota
+	POLICY_ATTR_EQ
+	POLICY_ATTR_GE
+	POLICY_ATTR_LE
+)
+
+var policyAttrComparisonTypeNames = map[PolicyAttrComparisonType]string{
+	POLICY_ATTR_COMPARISON_TYPE: "ATTRIBUTE_COMPARISON",
+	POLICY_ATTR_EQ:              "ATTRIBUTE_EQ",
+	POLICY_ATTR_GE:              "ATTRIBUTE_GE",
+	POLICY_ATTR_LE:              "ATTRIBUTE_LE",
+}
+
+var policyAttrComparisonTypeValues = map[string]PolicyAttrComparisonType{
+	"ATTRIBUTE_COMPARISON": POLICY_ATTR_COMPARISON_TYPE,
+	"ATTRIBUTE_EQ":         POLICY_ATTR_EQ,
+	"ATTRIBUTE_GE":         POLICY_ATTR_GE,
+	"ATTRIBUTE_LE":         POLICY_ATTR_LE,
+}
+
+func (v PolicyAttrComparisonType) String() string {
+	if s, ok := policyAttrComparisonTypeNames[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("PolicyAttrComparisonType(%d)", v)
+}
+
+func ParsePolicyAttrComparisonType(s string) (PolicyAttrComparisonType, error) {
+	_, ss := ncxml.ParseXPathName(s)
+	if v, ok := policyAttrComparisonTypeValues[ss]; ok {
+		return v, nil
+	}
+	return POLICY_ATTR_COMPARISON_TYPE, fmt.Errorf("Invalid PolicyAttrComparisonType. %s", s)
+}
+
 type PolicyResultType int
 
 const (
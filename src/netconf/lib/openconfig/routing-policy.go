@@ -18,6 +18,7 @@
 package openconfig
 
 import (
+	"encoding/json"
 	"encoding/xml"
 	"fmt"
 	nclib "netconf/lib"
@@ -34,6 +35,11 @@ const (
 	POLICYDEF_ACTS_POLRESULT_KEY = "policy-result"
 	POLICYDEF_STMTS_KEY          = "statements"
 	POLICYDEF_STMT_KEY           = "statement"
+	POLICYDEF_CONDS_KEY          = "conditions"
+	POLICYMATCH_PFXSET_KEY       = "match-prefix-set"
+	POLICYMATCH_NEIGHSET_KEY     = "match-neighbor-set"
+	POLICYMATCH_TAGSET_KEY       = "match-tag-set"
+	POLICYMATCH_SETOPTS_KEY      = "match-set-options"
 	POLICYDEFSETS_KEY            = "defined-sets"
 	POLICYPFXSETS_KEY            = "prefix-sets"
 	POLICYPFXSET_KEY             = "prefix-set"
@@ -41,6 +47,7 @@ const (
 	POLICYPFXSET_PREFIX_KEY      = "prefix"
 	POLICYPFXSET_PREFIX_IP_KEY   = "ip-prefix"
 	POLICYPFXSET_PREFIX_MLR_KEY  = "masklength-range"
+	POLICYPFXSET_MLR_EXACT       = "exact"
 	POLICYPFXSET_MODE_KEY        = "mode"
 	POLICYNEIGHSETS_KEY          = "neighbor-sets"
 	POLICYNEIGHSET_KEY           = "neighbor-set"
@@ -109,6 +116,36 @@ func (p *RoutingPolicy) Put(nodes []*ncxml.XPathNode, value string) error {
 	return nil
 }
 
+func (p *RoutingPolicy) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if p.DefinedSets == nil {
+		*p = *NewRoutingPolicy()
+	}
+	p.XMLName = start.Name
+	return ncxml.DecodeXPath(d, start, p)
+}
+
+func (p *RoutingPolicy) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newOcContainerRoot(ROUTINGPOLICY_MODULE, ROUTINGPOLICY_KEY, p))
+}
+
+func (p *RoutingPolicy) UnmarshalJSON(data []byte) error {
+	if p.DefinedSets == nil {
+		*p = *NewRoutingPolicy()
+	}
+	return decodeJSON(data, ROUTINGPOLICY_KEY, p)
+}
+
+func (p *RoutingPolicy) MarshalYAML() (interface{}, error) {
+	return newOcContainerRoot(ROUTINGPOLICY_MODULE, ROUTINGPOLICY_KEY, p).MarshalYAML()
+}
+
+func (p *RoutingPolicy) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if p.DefinedSets == nil {
+		*p = *NewRoutingPolicy()
+	}
+	return decodeYAML(unmarshal, ROUTINGPOLICY_KEY, p)
+}
+
 func ProcessRoutingPolicy(p RoutingPolicyProcessor, reverse bool, rpol *RoutingPolicy) error {
 
 	defsetFunc := func() error {
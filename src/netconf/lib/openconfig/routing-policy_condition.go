@@ -0,0 +1,514 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"fmt"
+	nclib "netconf/lib"
+	ncxml "netconf/lib/xml"
+)
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/conditions
+//
+type PolicyStatementConditions struct {
+	nclib.SrChanges `xml:"-"`
+
+	MatchPrefixSet   *PolicyMatchPrefixSet   `xml:"match-prefix-set"`
+	MatchNeighborSet *PolicyMatchNeighborSet `xml:"match-neighbor-set"`
+	MatchTagSet      *PolicyMatchTagSet      `xml:"match-tag-set"`
+	Bgp              *PolicyBgpConditions    `xml:"bgp-conditions"`
+}
+
+type PolicyStatementConditionsProcessor interface {
+	PolicyMatchPrefixSetProcessor
+	PolicyMatchNeighborSetProcessor
+	PolicyMatchTagSetProcessor
+	PolicyBgpConditionsProcessor
+}
+
+func NewPolicyStatementConditions() *PolicyStatementConditions {
+	return &PolicyStatementConditions{
+		SrChanges:        nclib.NewSrChanges(),
+		MatchPrefixSet:   NewPolicyMatchPrefixSet(),
+		MatchNeighborSet: NewPolicyMatchNeighborSet(),
+		MatchTagSet:      NewPolicyMatchTagSet(),
+		Bgp:              NewPolicyBgpConditions(),
+	}
+}
+
+func (c *PolicyStatementConditions) String() string {
+	return fmt.Sprintf("%s{%s, %s, %s, %s} %s",
+		POLICYDEF_CONDS_KEY,
+		c.MatchPrefixSet,
+		c.MatchNeighborSet,
+		c.MatchTagSet,
+		c.Bgp,
+		c.SrChanges,
+	)
+}
+
+func (c *PolicyStatementConditions) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case POLICYMATCH_PFXSET_KEY:
+		if err := c.MatchPrefixSet.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case POLICYMATCH_NEIGHSET_KEY:
+		if err := c.MatchNeighborSet.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case POLICYMATCH_TAGSET_KEY:
+		if err := c.MatchTagSet.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case BGP_CONDS_KEY:
+		if err := c.Bgp.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyStatementConditions(p PolicyStatementConditionsProcessor, reverse bool, name string, stmtName string, conds *PolicyStatementConditions) error {
+	pfxFunc := func() error {
+		if conds.GetChange(POLICYMATCH_PFXSET_KEY) {
+			return ProcessPolicyMatchPrefixSet(
+				p.(PolicyMatchPrefixSetProcessor),
+				reverse,
+				name,
+				stmtName,
+				conds.MatchPrefixSet,
+			)
+		}
+		return nil
+	}
+
+	neighFunc := func() error {
+		if conds.GetChange(POLICYMATCH_NEIGHSET_KEY) {
+			return ProcessPolicyMatchNeighborSet(
+				p.(PolicyMatchNeighborSetProcessor),
+				reverse,
+				name,
+				stmtName,
+				conds.MatchNeighborSet,
+			)
+		}
+		return nil
+	}
+
+	tagFunc := func() error {
+		if conds.GetChange(POLICYMATCH_TAGSET_KEY) {
+			return ProcessPolicyMatchTagSet(
+				p.(PolicyMatchTagSetProcessor),
+				reverse,
+				name,
+				stmtName,
+				conds.MatchTagSet,
+			)
+		}
+		return nil
+	}
+
+	bgpFunc := func() error {
+		if conds.GetChange(BGP_CONDS_KEY) {
+			return ProcessPolicyBgpConditions(
+				p.(PolicyBgpConditionsProcessor),
+				reverse,
+				name,
+				stmtName,
+				conds.Bgp,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, pfxFunc, neighFunc, tagFunc, bgpFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/conditions/match-prefix-set
+//
+type PolicyMatchPrefixSet struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *PolicyMatchPrefixSetConfig `xml:"config"`
+}
+
+type PolicyMatchPrefixSetProcessor interface {
+	PolicyMatchPrefixSetConfigProcessor
+}
+
+func NewPolicyMatchPrefixSet() *PolicyMatchPrefixSet {
+	return &PolicyMatchPrefixSet{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewPolicyMatchPrefixSetConfig(),
+	}
+}
+
+func (m *PolicyMatchPrefixSet) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		POLICYMATCH_PFXSET_KEY,
+		m.Config,
+		m.SrChanges,
+	)
+}
+
+func (m *PolicyMatchPrefixSet) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := m.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	m.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyMatchPrefixSet(p PolicyMatchPrefixSetProcessor, reverse bool, name string, stmtName string, match *PolicyMatchPrefixSet) error {
+	configFunc := func() error {
+		if match.GetChange(OC_CONFIG_KEY) {
+			return ProcessPolicyMatchPrefixSetConfig(
+				p.(PolicyMatchPrefixSetConfigProcessor),
+				reverse,
+				name,
+				stmtName,
+				match.Config,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/conditions/match-prefix-set/config
+//
+type PolicyMatchPrefixSetConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	PrefixSet       string                    `xml:"prefix-set"`
+	MatchSetOptions PolicyMatchSetOptionsType `xml:"match-set-options"`
+}
+
+type PolicyMatchPrefixSetConfigProcessor interface {
+	PolicyMatchPrefixSetConfig(string, string, *PolicyMatchPrefixSetConfig) error
+}
+
+func NewPolicyMatchPrefixSetConfig() *PolicyMatchPrefixSetConfig {
+	return &PolicyMatchPrefixSetConfig{
+		SrChanges:       nclib.NewSrChanges(),
+		PrefixSet:       "",
+		MatchSetOptions: POLICY_MATCH_SET_OPTIONS_TYPE,
+	}
+}
+
+func (c *PolicyMatchPrefixSetConfig) String() string {
+	return fmt.Sprintf("%s{%s='%s', %s=%s} %s",
+		OC_CONFIG_KEY,
+		POLICYPFXSET_KEY, c.PrefixSet,
+		POLICYMATCH_SETOPTS_KEY, c.MatchSetOptions,
+		c.SrChanges,
+	)
+}
+
+func (c *PolicyMatchPrefixSetConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case POLICYPFXSET_KEY:
+		c.PrefixSet = value
+
+	case POLICYMATCH_SETOPTS_KEY:
+		opts, err := ParsePolicyMatchSetOptionsType(value)
+		if err != nil {
+			return err
+		}
+		c.MatchSetOptions = opts
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyMatchPrefixSetConfig(p PolicyMatchPrefixSetConfigProcessor, reverse bool, name string, stmtName string, config *PolicyMatchPrefixSetConfig) error {
+	configFunc := func() error {
+		return p.PolicyMatchPrefixSetConfig(name, stmtName, config)
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/conditions/match-neighbor-set
+//
+type PolicyMatchNeighborSet struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *PolicyMatchNeighborSetConfig `xml:"config"`
+}
+
+type PolicyMatchNeighborSetProcessor interface {
+	PolicyMatchNeighborSetConfigProcessor
+}
+
+func NewPolicyMatchNeighborSet() *PolicyMatchNeighborSet {
+	return &PolicyMatchNeighborSet{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewPolicyMatchNeighborSetConfig(),
+	}
+}
+
+func (m *PolicyMatchNeighborSet) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		POLICYMATCH_NEIGHSET_KEY,
+		m.Config,
+		m.SrChanges,
+	)
+}
+
+func (m *PolicyMatchNeighborSet) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := m.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	m.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyMatchNeighborSet(p PolicyMatchNeighborSetProcessor, reverse bool, name string, stmtName string, match *PolicyMatchNeighborSet) error {
+	configFunc := func() error {
+		if match.GetChange(OC_CONFIG_KEY) {
+			return ProcessPolicyMatchNeighborSetConfig(
+				p.(PolicyMatchNeighborSetConfigProcessor),
+				reverse,
+				name,
+				stmtName,
+				match.Config,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/conditions/match-neighbor-set/config
+//
+type PolicyMatchNeighborSetConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	NeighborSet     string                    `xml:"neighbor-set"`
+	MatchSetOptions PolicyMatchSetOptionsType `xml:"match-set-options"`
+}
+
+type PolicyMatchNeighborSetConfigProcessor interface {
+	PolicyMatchNeighborSetConfig(string, string, *PolicyMatchNeighborSetConfig) error
+}
+
+func NewPolicyMatchNeighborSetConfig() *PolicyMatchNeighborSetConfig {
+	return &PolicyMatchNeighborSetConfig{
+		SrChanges:       nclib.NewSrChanges(),
+		NeighborSet:     "",
+		MatchSetOptions: POLICY_MATCH_SET_OPTIONS_TYPE,
+	}
+}
+
+func (c *PolicyMatchNeighborSetConfig) String() string {
+	return fmt.Sprintf("%s{%s='%s', %s=%s} %s",
+		OC_CONFIG_KEY,
+		POLICYNEIGHSET_KEY, c.NeighborSet,
+		POLICYMATCH_SETOPTS_KEY, c.MatchSetOptions,
+		c.SrChanges,
+	)
+}
+
+func (c *PolicyMatchNeighborSetConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case POLICYNEIGHSET_KEY:
+		c.NeighborSet = value
+
+	case POLICYMATCH_SETOPTS_KEY:
+		opts, err := ParsePolicyMatchSetOptionsType(value)
+		if err != nil {
+			return err
+		}
+		c.MatchSetOptions = opts
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyMatchNeighborSetConfig(p PolicyMatchNeighborSetConfigProcessor, reverse bool, name string, stmtName string, config *PolicyMatchNeighborSetConfig) error {
+	configFunc := func() error {
+		return p.PolicyMatchNeighborSetConfig(name, stmtName, config)
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/conditions/match-tag-set
+//
+type PolicyMatchTagSet struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *PolicyMatchTagSetConfig `xml:"config"`
+}
+
+type PolicyMatchTagSetProcessor interface {
+	PolicyMatchTagSetConfigProcessor
+}
+
+func NewPolicyMatchTagSet() *PolicyMatchTagSet {
+	return &PolicyMatchTagSet{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewPolicyMatchTagSetConfig(),
+	}
+}
+
+func (m *PolicyMatchTagSet) String() string {
+	return fmt.Sprintf("%s{%s} %s",
+		POLICYMATCH_TAGSET_KEY,
+		m.Config,
+		m.SrChanges,
+	)
+}
+
+func (m *PolicyMatchTagSet) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := m.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	m.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyMatchTagSet(p PolicyMatchTagSetProcessor, reverse bool, name string, stmtName string, match *PolicyMatchTagSet) error {
+	configFunc := func() error {
+		if match.GetChange(OC_CONFIG_KEY) {
+			return ProcessPolicyMatchTagSetConfig(
+				p.(PolicyMatchTagSetConfigProcessor),
+				reverse,
+				name,
+				stmtName,
+				match.Config,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
+
+//
+// routing-policy/policy-definitions/policy-definition[name]/statements/statement[name]/conditions/match-tag-set/config
+//
+type PolicyMatchTagSetConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	TagSet          string                    `xml:"tag-set"`
+	MatchSetOptions PolicyMatchSetOptionsType `xml:"match-set-options"`
+}
+
+type PolicyMatchTagSetConfigProcessor interface {
+	PolicyMatchTagSetConfig(string, string, *PolicyMatchTagSetConfig) error
+}
+
+func NewPolicyMatchTagSetConfig() *PolicyMatchTagSetConfig {
+	return &PolicyMatchTagSetConfig{
+		SrChanges:       nclib.NewSrChanges(),
+		TagSet:          "",
+		MatchSetOptions: POLICY_MATCH_SET_OPTIONS_TYPE,
+	}
+}
+
+func (c *PolicyMatchTagSetConfig) String() string {
+	return fmt.Sprintf("%s{%s='%s', %s=%s} %s",
+		OC_CONFIG_KEY,
+		POLICYTAGSET_KEY, c.TagSet,
+		POLICYMATCH_SETOPTS_KEY, c.MatchSetOptions,
+		c.SrChanges,
+	)
+}
+
+func (c *PolicyMatchTagSetConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case POLICYTAGSET_KEY:
+		c.TagSet = value
+
+	case POLICYMATCH_SETOPTS_KEY:
+		opts, err := ParsePolicyMatchSetOptionsType(value)
+		if err != nil {
+			return err
+		}
+		c.MatchSetOptions = opts
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessPolicyMatchTagSetConfig(p PolicyMatchTagSetConfigProcessor, reverse bool, name string, stmtName string, config *PolicyMatchTagSetConfig) error {
+	configFunc := func() error {
+		return p.PolicyMatchTagSetConfig(name, stmtName, config)
+	}
+
+	return nclib.CallFunctions(reverse, configFunc)
+}
@@ -29,15 +29,17 @@ import (
 type PolicyDefinedSets struct {
 	nclib.SrChanges `xml:"-"`
 
-	PrefixSets   PolicyPrefixSets   `xml:"-"`
-	NeighborSets PolicyNeighborSets `xml:"-"`
-	TagSets      PolicyTagSets      `xml:"-"`
+	PrefixSets   PolicyPrefixSets      `xml:"-" yang:"prefix-sets"`
+	NeighborSets PolicyNeighborSets    `xml:"-" yang:"neighbor-sets"`
+	TagSets      PolicyTagSets         `xml:"-" yang:"tag-sets"`
+	Bgp          *PolicyBgpDefinedSets `xml:"bgp-defined-sets"`
 }
 
 type PolicyDefinedSetsProcessor interface {
 	PolicyPrefixSetProcessor
 	PolicyNeighborSetProcessor
 	PolicyTagSetProcessor
+	PolicyBgpDefinedSetsProcessor
 }
 
 func NewPolicyDefinedSets() *PolicyDefinedSets {
@@ -46,15 +48,17 @@ func NewPolicyDefinedSets() *PolicyDefinedSets {
 		PrefixSets:   NewPolicyPrefixSets(),
 		NeighborSets: NewPolicyNeighborSets(),
 		TagSets:      NewPolicyTagSets(),
+		Bgp:          NewPolicyBgpDefinedSets(),
 	}
 }
 
 func (d *PolicyDefinedSets) String() string {
-	return fmt.Sprintf("%s{%s, %s, %s} %s",
+	return fmt.Sprintf("%s{%s, %s, %s, %s} %s",
 		POLICYDEFSETS_KEY,
 		d.PrefixSets,
 		d.NeighborSets,
 		d.TagSets,
+		d.Bgp,
 		d.SrChanges,
 	)
 }
@@ -79,6 +83,11 @@ func (d *PolicyDefinedSets) Put(nodes []*ncxml.XPathNode, value string) error {
 		if err := d.TagSets.Put(nodes[1:], value); err != nil {
 			return err
 		}
+
+	case BGP_DEFSETS_KEY:
+		if err := d.Bgp.Put(nodes[1:], value); err != nil {
+			return err
+		}
 	}
 
 	d.SetChange(nodes[0].Name)
@@ -119,5 +128,16 @@ func ProcessPolicyDefinedSets(p PolicyDefinedSetsProcessor, reverse bool, polset
 		return nil
 	}
 
-	return nclib.CallFunctions(reverse, pfxFunc, neiFunc, tagFunc)
+	bgpFunc := func() error {
+		if polsets.GetChange(BGP_DEFSETS_KEY) {
+			return ProcessPolicyBgpDefinedSets(
+				p.(PolicyBgpDefinedSetsProcessor),
+				reverse,
+				polsets.Bgp,
+			)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, pfxFunc, neiFunc, tagFunc, bgpFunc)
 }
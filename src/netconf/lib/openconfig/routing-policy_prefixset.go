@@ -79,11 +79,11 @@ func (p PolicyPrefixSets) MarshalXML(e *xml.Encoder, start xml.StartElement) err
 // routing-policy/defined-sets/prefix-sets/prefix-set[name]
 //
 type PolicyPrefixSet struct {
-	nclib.SrChanges `xml:"-"`
+	nclib.SrChanges `xnl:"-"`
 
 	Name     string                  `xml:"name"`
 	Config   *PolicyPrefixSetConfig  `xml:"config"`
-	Prefixes PolicyPrefixSetPrefixes `xnl:"prefixes"`
+	Prefixes PolicyPrefixSetPrefixes `xnl:"prefixes" yang:"prefixes"`
 }
 
 type PolicyPrefixSetProcessor interface {
@@ -174,7 +174,7 @@ func ProcessPolicyPrefixSet(p PolicyPrefixSetProcessor, reverse bool, name strin
 // routing-policy/defined-sets/prefix-sets/prefix-set[name]/config
 //
 type PolicyPrefixSetConfig struct {
-	nclib.SrChanges `xnl:"-"`
+	nclib.SrChanges `xml:"-"`
 
 	Name string              `xml:"name"`
 	Mode PolicyPrefixSetMode `xml:"mode"`
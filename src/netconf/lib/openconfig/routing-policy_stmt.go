@@ -79,32 +79,36 @@ func (p PolicyStatements) MarshalXML(e *xml.Encoder, start xml.StartElement) err
 type PolicyStatement struct {
 	nclib.SrChanges `xml:"-"`
 
-	XMLName xml.Name                `xml:"statement"`
-	Name    string                  `xml:"name"`
-	Config  *PolicyStatementConfig  `xml:"config"`
-	Actions *PolicyStatementActions `xml:"actions"`
+	XMLName    xml.Name                   `xml:"statement"`
+	Name       string                     `xml:"name"`
+	Config     *PolicyStatementConfig     `xml:"config"`
+	Conditions *PolicyStatementConditions `xml:"conditions"`
+	Actions    *PolicyStatementActions    `xml:"actions"`
 }
 
 type PolicyStatementProcessor interface {
 	PolicyStatement(string, string, *PolicyStatement) error
 	PolicyStatementConfigProcessor
+	PolicyStatementConditionsProcessor
 	PolicyStatementActionsProcessor
 }
 
 func NewPolicyStatement(name string) *PolicyStatement {
 	return &PolicyStatement{
-		SrChanges: nclib.NewSrChanges(),
-		Name:      name,
-		Config:    NewPolicyStatementConfig(),
-		Actions:   NewPolicyStatementActions(),
+		SrChanges:  nclib.NewSrChanges(),
+		Name:       name,
+		Config:     NewPolicyStatementConfig(),
+		Conditions: NewPolicyStatementConditions(),
+		Actions:    NewPolicyStatementActions(),
 	}
 }
 
 func (p *PolicyStatement) String() string {
-	return fmt.Sprintf("%s{%s='%s', %s, %s} %s",
+	return fmt.Sprintf("%s{%s='%s', %s, %s, %s} %s",
 		POLICYDEF_STMT_KEY,
 		OC_NAME_KEY, p.Name,
 		p.Config,
+		p.Conditions,
 		p.Actions,
 		p.SrChanges,
 	)
@@ -124,6 +128,11 @@ func (p *PolicyStatement) Put(nodes []*ncxml.XPathNode, value string) error {
 			return err
 		}
 
+	case POLICYDEF_CONDS_KEY:
+		if err := p.Conditions.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
 	case POLICYDEF_ACTS_KEY:
 		if err := p.Actions.Put(nodes[1:], value); err != nil {
 			return err
@@ -155,6 +164,19 @@ func ProcessPolicyStatement(p PolicyStatementProcessor, reverse bool, name strin
 		return nil
 	}
 
+	condsFunc := func() error {
+		if stmt.GetChange(POLICYDEF_CONDS_KEY) {
+			return ProcessPolicyStatementConditions(
+				p.(PolicyStatementConditionsProcessor),
+				reverse,
+				name,
+				stmtName,
+				stmt.Conditions,
+			)
+		}
+		return nil
+	}
+
 	actsFunc := func() error {
 		if stmt.GetChange(POLICYDEF_ACTS_KEY) {
 			return ProcessPolicyStatementActions(
@@ -168,7 +190,7 @@ func ProcessPolicyStatement(p PolicyStatementProcessor, reverse bool, name strin
 		return nil
 	}
 
-	return nclib.CallFunctions(reverse, nameFunc, configFunc, actsFunc)
+	return nclib.CallFunctions(reverse, nameFunc, configFunc, condsFunc, actsFunc)
 }
 
 //
@@ -19,6 +19,7 @@ package openconfig
 
 import (
 	srlib "netconf/lib/sysrepo"
+	"path/filepath"
 	"testing"
 )
 
@@ -55,3 +56,251 @@ func TestRoutingPolicy_Put(t *testing.T) {
 		t.Errorf("RoutingPolicy.Put unmatch. #defs=%d", v)
 	}
 }
+
+func TestRoutingPolicy_UnmarshalXML(t *testing.T) {
+	files := testXMLFiles(t,
+		filepath.Join(TEST_XML_DIR, "beluganos-routing-policy-*.xml"),
+		filepath.Join(TEST_EXAMPLES_DIR, "examples*", "*routing-policy*.xml"),
+	)
+
+	for _, file := range files {
+		policy := NewRoutingPolicy()
+		unmarshalXMLFile(t, file, policy)
+		t.Log(file, policy)
+	}
+}
+
+func TestRoutingPolicy_UnmarshalXML_St(t *testing.T) {
+	policy := &RoutingPolicy{}
+	unmarshalXMLFile(t, filepath.Join(TEST_XML_DIR, "beluganos-routing-policy-st.xml"), policy)
+
+	def, ok := policy.Definitions["policy-next-hop-self"]
+	if !ok {
+		t.Fatalf("RoutingPolicy.UnmarshalXML unmatch. %v", policy.Definitions)
+	}
+
+	stmt, ok := def.Stmts["stmt-next-hop-self"]
+	if !ok {
+		t.Fatalf("RoutingPolicy.UnmarshalXML unmatch. %v", def.Stmts)
+	}
+
+	if v := stmt.Actions.Config.PolicyResult; v != POLICY_RESULT_ACCEPT_ROUTE {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. result=%s", v)
+	}
+
+	if v := stmt.Actions.Bgp.Config.SetNexthop; v != BGP_NEXTHOP_SELF {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. next-hop=%s", v)
+	}
+}
+
+func TestRoutingPolicy_UnmarshalXML_Conditions(t *testing.T) {
+	policy := &RoutingPolicy{}
+	unmarshalXMLFile(t, filepath.Join(TEST_XML_DIR, "beluganos-routing-policy-3.xml"), policy)
+
+	if _, ok := policy.DefinedSets.PrefixSets["ps-private"]; !ok {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. %v", policy.DefinedSets.PrefixSets)
+	}
+
+	def, ok := policy.Definitions["policy-rr"]
+	if !ok {
+		t.Fatalf("RoutingPolicy.UnmarshalXML unmatch. %v", policy.Definitions)
+	}
+
+	stmt, ok := def.Stmts["stmt-reject-private"]
+	if !ok {
+		t.Fatalf("RoutingPolicy.UnmarshalXML unmatch. %v", def.Stmts)
+	}
+
+	if v := stmt.Conditions.MatchPrefixSet.Config.PrefixSet; v != "ps-private" {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. prefix-set=%s", v)
+	}
+
+	if v := stmt.Conditions.MatchPrefixSet.Config.MatchSetOptions; v != POLICY_MATCH_SET_OPTIONS_ANY {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. match-set-options=%s", v)
+	}
+
+	if stmt.Conditions.GetChange(POLICYMATCH_NEIGHSET_KEY) {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. %s", stmt.Conditions)
+	}
+
+	stmt, ok = def.Stmts["stmt-next-hop-self"]
+	if !ok {
+		t.Fatalf("RoutingPolicy.UnmarshalXML unmatch. %v", def.Stmts)
+	}
+
+	if v := stmt.Conditions.MatchNeighborSet.Config.NeighborSet; v != "ns-rr" {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. neighbor-set=%s", v)
+	}
+
+	if v := stmt.Conditions.MatchNeighborSet.Config.MatchSetOptions; v != POLICY_MATCH_SET_OPTIONS_INVERT {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. match-set-options=%s", v)
+	}
+
+	if v := stmt.Conditions.MatchTagSet.Config.TagSet; v != "ts-100" {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. tag-set=%s", v)
+	}
+}
+
+func TestRoutingPolicy_UnmarshalXML_BgpConditions(t *testing.T) {
+	policy := &RoutingPolicy{}
+	unmarshalXMLFile(t, filepath.Join(TEST_XML_DIR, "beluganos-routing-policy-4.xml"), policy)
+
+	bgpSets := policy.DefinedSets.Bgp
+	if bgpSets == nil {
+		t.Fatalf("RoutingPolicy.UnmarshalXML unmatch. %v", policy.DefinedSets)
+	}
+
+	commSet, ok := bgpSets.CommunitySets["cs-customer"]
+	if !ok {
+		t.Fatalf("RoutingPolicy.UnmarshalXML unmatch. %v", bgpSets.CommunitySets)
+	}
+
+	if v := commSet.Config.Members; len(v) != 2 || v[0] != "65001:100" || v[1] != "NO_EXPORT" {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. community-member=%v", v)
+	}
+
+	if _, ok := bgpSets.ExtCommunitySets["ecs-vpn"]; !ok {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. %v", bgpSets.ExtCommunitySets)
+	}
+
+	if _, ok := bgpSets.AsPathSets["as-customer"]; !ok {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. %v", bgpSets.AsPathSets)
+	}
+
+	stmt := policy.Definitions["policy-customer"].Stmts["stmt-customer"]
+	conds := stmt.Conditions.Bgp
+	if conds == nil {
+		t.Fatalf("RoutingPolicy.UnmarshalXML unmatch. %s", stmt.Conditions)
+	}
+
+	if v := conds.Config.MedEq; v != 10 {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. med-eq=%d", v)
+	}
+
+	if v := conds.Config.OriginEq; v != BGP_ORIGIN_ATTR_IGP {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. origin-eq=%s", v)
+	}
+
+	if v := conds.Config.AfiSafiIn; len(v) != 1 || v[0] != BGP_AFI_SAFI_IPV4_UNICAST {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. afi-safi-in=%v", v)
+	}
+
+	if v := conds.AsPathLength.Config.Operator; v != POLICY_ATTR_LE {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. operator=%s", v)
+	}
+
+	if v := conds.MatchCommunitySet.Config; v.CommunitySet != "cs-customer" || v.MatchSetOptions != POLICY_MATCH_SET_OPTIONS_ALL {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. %s", v)
+	}
+
+	if v := conds.MatchExtCommunitySet.Config.ExtCommunitySet; v != "ecs-vpn" {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. ext-community-set=%s", v)
+	}
+
+	if v := conds.MatchAsPathSet.Config.AsPathSet; v != "as-customer" {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. as-path-set=%s", v)
+	}
+}
+
+func TestRoutingPolicy_UnmarshalXML_BgpActions(t *testing.T) {
+	policy := &RoutingPolicy{}
+	unmarshalXMLFile(t, filepath.Join(TEST_XML_DIR, "beluganos-routing-policy-4.xml"), policy)
+
+	stmt := policy.Definitions["policy-customer"].Stmts["stmt-customer"]
+	actions := stmt.Actions.Bgp
+
+	med, op, err := actions.Config.SetMed.Values()
+	if err != nil || med != 10 || op != "+" {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. set-med=%s %s", actions.Config.SetMed, err)
+	}
+
+	if v := actions.Config.SetRouteOrigin; v != BGP_ORIGIN_ATTR_EGP {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. set-route-origin=%s", v)
+	}
+
+	if v := actions.SetAsPathPrepend.Config; v.RepeatN != 3 || v.Asn != 65001 {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. %s", v)
+	}
+
+	comm := actions.SetCommunity
+	if v := comm.Config; v.Method != BGP_SET_COMMUNITY_METHOD_INLINE || v.Options != BGP_SET_COMMUNITY_OPTION_ADD {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. %s", v)
+	}
+
+	if v := comm.Inline.Config.Communities; len(v) != 2 || v[0] != "65001:200" || v[1] != "NO_ADVERTISE" {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. communities=%v", v)
+	}
+
+	if comm.GetChange(BGP_ACTIONS_REFERENCE_KEY) {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. %s", comm)
+	}
+
+	extComm := actions.SetExtCommunity
+	if v := extComm.Config.Options; v != BGP_SET_COMMUNITY_OPTION_REPLACE {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. options=%s", v)
+	}
+
+	if v := extComm.Reference.Config.ExtCommunitySetRef; v != "ecs-vpn" {
+		t.Errorf("RoutingPolicy.UnmarshalXML unmatch. ext-community-set-ref=%s", v)
+	}
+}
+
+func TestRoutingPolicy_JSON(t *testing.T) {
+	files := testXMLFiles(t,
+		filepath.Join(TEST_XML_DIR, "beluganos-routing-policy-*.xml"),
+		filepath.Join(TEST_EXAMPLES_DIR, "examples*", "*routing-policy*.xml"),
+	)
+
+	for _, file := range files {
+		policy := NewRoutingPolicy()
+		unmarshalXMLFile(t, file, policy)
+
+		decoded := &RoutingPolicy{}
+		testJSONRoundTrip(t, file, policy, decoded)
+	}
+}
+
+func TestRoutingPolicy_YAML(t *testing.T) {
+	files := testXMLFiles(t,
+		filepath.Join(TEST_XML_DIR, "beluganos-routing-policy-*.xml"),
+		filepath.Join(TEST_EXAMPLES_DIR, "examples*", "*routing-policy*.xml"),
+	)
+
+	for _, file := range files {
+		policy := NewRoutingPolicy()
+		unmarshalXMLFile(t, file, policy)
+
+		decoded := &RoutingPolicy{}
+		testYAMLRoundTrip(t, file, policy, decoded)
+	}
+}
+
+func TestDiffRoutingPolicy(t *testing.T) {
+	src := NewRoutingPolicy()
+	unmarshalXMLFile(t, filepath.Join(TEST_XML_DIR, "beluganos-routing-policy-1.xml"), src)
+
+	dst := NewRoutingPolicy()
+	unmarshalXMLFile(t, filepath.Join(TEST_XML_DIR, "beluganos-routing-policy-st.xml"), dst)
+
+	cre, mod, del := NewRoutingPolicy(), NewRoutingPolicy(), NewRoutingPolicy()
+	if err := DiffRoutingPolicy(src, dst, cre, mod, del); err != nil {
+		t.Fatalf("DiffRoutingPolicy error. %s", err)
+	}
+
+	t.Log(cre)
+	t.Log(mod)
+	t.Log(del)
+
+	if v := len(cre.Definitions); v != 0 {
+		t.Errorf("DiffRoutingPolicy unmatch. #created=%d", v)
+	}
+
+	stmts := del.Definitions["policy-next-hop-self"].Stmts
+	if _, ok := stmts["stmt-next-hop-self2"]; !ok {
+		t.Errorf("DiffRoutingPolicy unmatch. deleted=%s", stmts)
+	}
+
+	if v := stmts["stmt-next-hop-self"].Actions.Bgp.Config; !v.Compare(BGP_ACTIONS_SET_LOCALPREF_KEY) {
+		t.Errorf("DiffRoutingPolicy unmatch. deleted=%s", v)
+	}
+}
@@ -0,0 +1,453 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net"
+	nclib "netconf/lib"
+	ncxml "netconf/lib/xml"
+	"strconv"
+)
+
+const (
+	SR_KEY               = "segment-routing"
+	SR_SRGB_KEY          = "srgb"
+	SR_SRLB_KEY          = "srlb"
+	SR_LOWER_BOUND_KEY   = "lower-bound"
+	SR_UPPER_BOUND_KEY   = "upper-bound"
+	SR_PREFIX_SIDS_KEY   = "prefix-sids"
+	SR_PREFIX_SID_KEY    = "prefix-sid"
+	SR_PREFIX_KEY        = "prefix"
+	SR_SID_ID_KEY        = "sid-id"
+	SR_LABEL_OPTIONS_KEY = "label-options"
+	SR_LABEL_MIN         = 16
+	SR_LABEL_MAX         = 1048575
+)
+
+//
+// <igp>/global/segment-routing
+//
+type SegmentRouting struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config     *SegmentRoutingConfig    `xml:"config"`
+	Srgb       *SegmentRoutingBlock     `xml:"srgb"`
+	Srlb       *SegmentRoutingBlock     `xml:"srlb"`
+	PrefixSids SegmentRoutingPrefixSids `xml:"prefix-sids"`
+}
+
+type SegmentRoutingProcessor interface {
+	SegmentRoutingConfig(string, *NetworkInstanceProtocolKey, *SegmentRoutingConfig) error
+	SegmentRoutingBlockConfig(string, *NetworkInstanceProtocolKey, string, *SegmentRoutingBlockConfig) error
+	SegmentRoutingPrefixSidConfig(string, *NetworkInstanceProtocolKey, string, *SegmentRoutingPrefixSidConfig) error
+}
+
+func NewSegmentRouting() *SegmentRouting {
+	return &SegmentRouting{
+		SrChanges:  nclib.NewSrChanges(),
+		Config:     NewSegmentRoutingConfig(),
+		Srgb:       NewSegmentRoutingBlock(),
+		Srlb:       NewSegmentRoutingBlock(),
+		PrefixSids: NewSegmentRoutingPrefixSids(),
+	}
+}
+
+func (s *SegmentRouting) String() string {
+	return fmt.Sprintf("%s{%s, %s=%s, %s=%s, %s=%v} %s",
+		SR_KEY,
+		s.Config,
+		SR_SRGB_KEY, s.Srgb,
+		SR_SRLB_KEY, s.Srlb,
+		SR_PREFIX_SIDS_KEY, s.PrefixSids,
+		s.SrChanges,
+	)
+}
+
+func (s *SegmentRouting) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := s.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case SR_SRGB_KEY:
+		if err := s.Srgb.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case SR_SRLB_KEY:
+		if err := s.Srlb.Put(nodes[1:], value); err != nil {
+			return err
+		}
+
+	case SR_PREFIX_SIDS_KEY:
+		if err := s.PrefixSids.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	s.SetChange(nodes[0].Name)
+	return nil
+}
+
+func ProcessSegmentRouting(p SegmentRoutingProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, sr *SegmentRouting) error {
+	configFunc := func() error {
+		if sr.GetChange(OC_CONFIG_KEY) {
+			return p.SegmentRoutingConfig(name, key, sr.Config)
+		}
+		return nil
+	}
+
+	srgbFunc := func() error {
+		if sr.GetChange(SR_SRGB_KEY) && sr.Srgb.GetChange(OC_CONFIG_KEY) {
+			return p.SegmentRoutingBlockConfig(name, key, SR_SRGB_KEY, sr.Srgb.Config)
+		}
+		return nil
+	}
+
+	srlbFunc := func() error {
+		if sr.GetChange(SR_SRLB_KEY) && sr.Srlb.GetChange(OC_CONFIG_KEY) {
+			return p.SegmentRoutingBlockConfig(name, key, SR_SRLB_KEY, sr.Srlb.Config)
+		}
+		return nil
+	}
+
+	sidsFunc := func() error {
+		if sr.GetChange(SR_PREFIX_SIDS_KEY) {
+			return ProcessSegmentRoutingPrefixSids(p, reverse, name, key, sr.PrefixSids)
+		}
+		return nil
+	}
+
+	return nclib.CallFunctions(reverse, srgbFunc, srlbFunc, configFunc, sidsFunc)
+}
+
+//
+// <igp>/global/segment-routing/config
+//
+type SegmentRoutingConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Enabled bool `xml:"enabled"`
+}
+
+func NewSegmentRoutingConfig() *SegmentRoutingConfig {
+	return &SegmentRoutingConfig{
+		SrChanges: nclib.NewSrChanges(),
+		Enabled:   false,
+	}
+}
+
+func (c *SegmentRoutingConfig) String() string {
+	return fmt.Sprintf("%s{%s=%t} %s",
+		OC_CONFIG_KEY,
+		OC_ENABLED_KEY, c.Enabled,
+		c.SrChanges,
+	)
+}
+
+func (c *SegmentRoutingConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_ENABLED_KEY:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		c.Enabled = b
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// <igp>/global/segment-routing/srgb
+// <igp>/global/segment-routing/srlb
+//
+type SegmentRoutingBlock struct {
+	nclib.SrChanges `xml:"-"`
+
+	Config *SegmentRoutingBlockConfig `xml:"config"`
+}
+
+func NewSegmentRoutingBlock() *SegmentRoutingBlock {
+	return &SegmentRoutingBlock{
+		SrChanges: nclib.NewSrChanges(),
+		Config:    NewSegmentRoutingBlockConfig(),
+	}
+}
+
+func (s *SegmentRoutingBlock) String() string {
+	return fmt.Sprintf("{%s} %s", s.Config, s.SrChanges)
+}
+
+func (s *SegmentRoutingBlock) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case OC_CONFIG_KEY:
+		if err := s.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	s.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// <igp>/global/segment-routing/srgb/config
+// <igp>/global/segment-routing/srlb/config
+//
+type SegmentRoutingBlockConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	LowerBound uint32 `xml:"lower-bound"`
+	UpperBound uint32 `xml:"upper-bound"`
+}
+
+func NewSegmentRoutingBlockConfig() *SegmentRoutingBlockConfig {
+	return &SegmentRoutingBlockConfig{
+		SrChanges:  nclib.NewSrChanges(),
+		LowerBound: 0,
+		UpperBound: 0,
+	}
+}
+
+func (c *SegmentRoutingBlockConfig) String() string {
+	return fmt.Sprintf("%s{%s=%d, %s=%d} %s",
+		OC_CONFIG_KEY,
+		SR_LOWER_BOUND_KEY, c.LowerBound,
+		SR_UPPER_BOUND_KEY, c.UpperBound,
+		c.SrChanges,
+	)
+}
+
+func ParseSegmentRoutingLabel(s string) (uint32, error) {
+	v, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	if v < SR_LABEL_MIN || v > SR_LABEL_MAX {
+		return 0, fmt.Errorf("Invalid label. %s", s)
+	}
+
+	return uint32(v), nil
+}
+
+func (c *SegmentRoutingBlockConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case SR_LOWER_BOUND_KEY:
+		label, err := ParseSegmentRoutingLabel(value)
+		if err != nil {
+			return err
+		}
+		c.LowerBound = label
+
+	case SR_UPPER_BOUND_KEY:
+		label, err := ParseSegmentRoutingLabel(value)
+		if err != nil {
+			return err
+		}
+		c.UpperBound = label
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// <igp>/global/segment-routing/prefix-sids
+//
+type SegmentRoutingPrefixSids map[string]*SegmentRoutingPrefixSid
+
+func NewSegmentRoutingPrefixSids() SegmentRoutingPrefixSids {
+	return SegmentRoutingPrefixSids{}
+}
+
+func (s SegmentRoutingPrefixSids) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	prefix, ok := nodes[0].Attrs[SR_PREFIX_KEY]
+	if !ok {
+		return fmt.Errorf("%s@%s not found. %s", SR_PREFIX_SID_KEY, SR_PREFIX_KEY, nodes[0])
+	}
+
+	sid, ok := s[prefix]
+	if !ok {
+		sid = NewSegmentRoutingPrefixSid(prefix)
+		s[prefix] = sid
+	}
+
+	return sid.Put(nodes[1:], value)
+}
+
+func (s SegmentRoutingPrefixSids) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = SR_PREFIX_SIDS_KEY
+	e.EncodeToken(start)
+
+	for _, sid := range s {
+		err := e.EncodeElement(sid, xml.StartElement{Name: xml.Name{Local: SR_PREFIX_SID_KEY}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+func ProcessSegmentRoutingPrefixSids(p SegmentRoutingProcessor, reverse bool, name string, key *NetworkInstanceProtocolKey, sids SegmentRoutingPrefixSids) error {
+	for prefix, sid := range sids {
+		if sid.GetChange(OC_CONFIG_KEY) {
+			if err := p.SegmentRoutingPrefixSidConfig(name, key, prefix, sid.Config); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+//
+// <igp>/global/segment-routing/prefix-sids/prefix-sid[prefix]
+//
+type SegmentRoutingPrefixSid struct {
+	nclib.SrChanges `xml:"-"`
+
+	Prefix string                         `xml:"prefix"`
+	Config *SegmentRoutingPrefixSidConfig `xml:"config"`
+}
+
+func NewSegmentRoutingPrefixSid(prefix string) *SegmentRoutingPrefixSid {
+	return &SegmentRoutingPrefixSid{
+		SrChanges: nclib.NewSrChanges(),
+		Prefix:    prefix,
+		Config:    NewSegmentRoutingPrefixSidConfig(),
+	}
+}
+
+func (s *SegmentRoutingPrefixSid) String() string {
+	return fmt.Sprintf("%s{%s='%s', %s} %s",
+		SR_PREFIX_SID_KEY,
+		SR_PREFIX_KEY, s.Prefix,
+		s.Config,
+		s.SrChanges,
+	)
+}
+
+func (s *SegmentRoutingPrefixSid) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case SR_PREFIX_KEY:
+		// s.Prefix = value // set by NewSegmentRoutingPrefixSid
+
+	case OC_CONFIG_KEY:
+		if err := s.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	s.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// <igp>/global/segment-routing/prefix-sids/prefix-sid[prefix]/config
+//
+type SegmentRoutingPrefixSidConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	Prefix       *net.IPNet        `xml:"prefix"`
+	SidId        uint32            `xml:"sid-id"`
+	LabelOptions SrLabelOptionType `xml:"label-options"`
+}
+
+func NewSegmentRoutingPrefixSidConfig() *SegmentRoutingPrefixSidConfig {
+	return &SegmentRoutingPrefixSidConfig{
+		SrChanges:    nclib.NewSrChanges(),
+		Prefix:       nil,
+		SidId:        0,
+		LabelOptions: SR_LABEL_OPTION_TYPE,
+	}
+}
+
+func (c *SegmentRoutingPrefixSidConfig) String() string {
+	return fmt.Sprintf("%s{%s=%s, %s=%d, %s=%s} %s",
+		OC_CONFIG_KEY,
+		SR_PREFIX_KEY, c.Prefix,
+		SR_SID_ID_KEY, c.SidId,
+		SR_LABEL_OPTIONS_KEY, c.LabelOptions,
+		c.SrChanges,
+	)
+}
+
+func (c *SegmentRoutingPrefixSidConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case SR_PREFIX_KEY:
+		_, nw, err := net.ParseCIDR(value)
+		if err != nil {
+			return err
+		}
+		c.Prefix = nw
+
+	case SR_SID_ID_KEY:
+		v, err := strconv.ParseUint(value, 0, 32)
+		if err != nil {
+			return err
+		}
+		if v > SR_LABEL_MAX {
+			return fmt.Errorf("Invalid %s. %s", SR_SID_ID_KEY, value)
+		}
+		c.SidId = uint32(v)
+
+	case SR_LABEL_OPTIONS_KEY:
+		opt, err := ParseSrLabelOptionType(value)
+		if err != nil {
+			return err
+		}
+		c.LabelOptions = opt
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
@@ -0,0 +1,183 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2019 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"fmt"
+	"testing"
+)
+
+type testSegmentRoutingProcessor struct {
+	calls []string
+}
+
+func (p *testSegmentRoutingProcessor) SegmentRoutingConfig(name string, key *NetworkInstanceProtocolKey, config *SegmentRoutingConfig) error {
+	p.calls = append(p.calls, fmt.Sprintf("config/%t", config.Enabled))
+	return nil
+}
+
+func (p *testSegmentRoutingProcessor) SegmentRoutingBlockConfig(name string, key *NetworkInstanceProtocolKey, blockName string, config *SegmentRoutingBlockConfig) error {
+	p.calls = append(p.calls, fmt.Sprintf("%s/%d-%d", blockName, config.LowerBound, config.UpperBound))
+	return nil
+}
+
+func (p *testSegmentRoutingProcessor) SegmentRoutingPrefixSidConfig(name string, key *NetworkInstanceProtocolKey, prefix string, config *SegmentRoutingPrefixSidConfig) error {
+	p.calls = append(p.calls, fmt.Sprintf("sid/%s/%d", prefix, config.SidId))
+	return nil
+}
+
+func TestSegmentRoutingOspfv2(t *testing.T) {
+	ospf, err := makeOspfv2([][2]string{
+		{"/ospfv2/global/segment-routing/config/enabled", "true"},
+		{"/ospfv2/global/segment-routing/srgb/config/lower-bound", "16000"},
+		{"/ospfv2/global/segment-routing/srgb/config/upper-bound", "23999"},
+		{"/ospfv2/global/segment-routing/srlb/config/lower-bound", "15000"},
+		{"/ospfv2/global/segment-routing/srlb/config/upper-bound", "15999"},
+		{"/ospfv2/global/segment-routing/prefix-sids/prefix-sid[prefix='10.0.0.1/32']/prefix", "10.0.0.1/32"},
+		{"/ospfv2/global/segment-routing/prefix-sids/prefix-sid[prefix='10.0.0.1/32']/config/prefix", "10.0.0.1/32"},
+		{"/ospfv2/global/segment-routing/prefix-sids/prefix-sid[prefix='10.0.0.1/32']/config/sid-id", "1"},
+		{"/ospfv2/global/segment-routing/prefix-sids/prefix-sid[prefix='10.0.0.1/32']/config/label-options", "boc-sr:NO_PHP"},
+	})
+
+	if err != nil {
+		t.Fatalf("ospfv2.Put error. %s", err)
+	}
+
+	sr := ospf.Global.SegmentRouting
+	if v := ospf.Global.Compare(SR_KEY); !v {
+		t.Errorf("ospfv2.Put unmatch. cmp=%t", v)
+	}
+
+	if v := sr.Compare(OC_CONFIG_KEY, SR_SRGB_KEY, SR_SRLB_KEY, SR_PREFIX_SIDS_KEY); !v {
+		t.Errorf("ospfv2.Put unmatch. cmp=%t", v)
+	}
+
+	if v := sr.Config.Enabled; !v {
+		t.Errorf("ospfv2.Put unmatch. enabled=%t", v)
+	}
+
+	if v := sr.Srgb.Config; v.LowerBound != 16000 || v.UpperBound != 23999 {
+		t.Errorf("ospfv2.Put unmatch. srgb=%s", v)
+	}
+
+	if v := sr.Srlb.Config; v.LowerBound != 15000 || v.UpperBound != 15999 {
+		t.Errorf("ospfv2.Put unmatch. srlb=%s", v)
+	}
+
+	sid, ok := sr.PrefixSids["10.0.0.1/32"]
+	if !ok {
+		t.Fatalf("ospfv2.Put unmatch. %v", sr.PrefixSids)
+	}
+
+	if v := sid.Config.Compare(SR_PREFIX_KEY, SR_SID_ID_KEY, SR_LABEL_OPTIONS_KEY); !v {
+		t.Errorf("ospfv2.Put unmatch. cmp=%t", v)
+	}
+
+	if v := sid.Config.Prefix.String(); v != "10.0.0.1/32" {
+		t.Errorf("ospfv2.Put unmatch. prefix=%s", v)
+	}
+
+	if v := sid.Config.SidId; v != 1 {
+		t.Errorf("ospfv2.Put unmatch. sid-id=%d", v)
+	}
+
+	if v := sid.Config.LabelOptions; v != SR_LABEL_OPTION_NO_PHP {
+		t.Errorf("ospfv2.Put unmatch. label-options=%s", v)
+	}
+}
+
+func TestSegmentRoutingIsis(t *testing.T) {
+	isis, err := makeIsis([][2]string{
+		{"/isis/global/segment-routing/config/enabled", "true"},
+		{"/isis/global/segment-routing/prefix-sids/prefix-sid[prefix='2001:db8::1/128']/prefix", "2001:db8::1/128"},
+		{"/isis/global/segment-routing/prefix-sids/prefix-sid[prefix='2001:db8::1/128']/config/prefix", "2001:db8::1/128"},
+		{"/isis/global/segment-routing/prefix-sids/prefix-sid[prefix='2001:db8::1/128']/config/sid-id", "100"},
+		{"/isis/global/segment-routing/prefix-sids/prefix-sid[prefix='2001:db8::1/128']/config/label-options", "boc-sr:EXPLICIT_NULL"},
+	})
+
+	if err != nil {
+		t.Fatalf("isis.Put error. %s", err)
+	}
+
+	sr := isis.Global.SegmentRouting
+	if v := sr.Compare(OC_CONFIG_KEY, SR_PREFIX_SIDS_KEY); !v {
+		t.Errorf("isis.Put unmatch. cmp=%t", v)
+	}
+
+	sid, ok := sr.PrefixSids["2001:db8::1/128"]
+	if !ok {
+		t.Fatalf("isis.Put unmatch. %v", sr.PrefixSids)
+	}
+
+	if v := sid.Config.SidId; v != 100 {
+		t.Errorf("isis.Put unmatch. sid-id=%d", v)
+	}
+
+	if v := sid.Config.LabelOptions; v != SR_LABEL_OPTION_EXPLICIT_NULL {
+		t.Errorf("isis.Put unmatch. label-options=%s", v)
+	}
+}
+
+func TestSegmentRoutingProcess(t *testing.T) {
+	ospf, err := makeOspfv2([][2]string{
+		{"/ospfv2/global/segment-routing/config/enabled", "true"},
+		{"/ospfv2/global/segment-routing/srgb/config/lower-bound", "16000"},
+		{"/ospfv2/global/segment-routing/srgb/config/upper-bound", "23999"},
+		{"/ospfv2/global/segment-routing/prefix-sids/prefix-sid[prefix='10.0.0.1/32']/config/sid-id", "1"},
+	})
+
+	if err != nil {
+		t.Fatalf("ospfv2.Put error. %s", err)
+	}
+
+	key := NewNetworkInstanceProtocolKey(INSTALL_PROTOCOL_OSPF, "ospf")
+	p := &testSegmentRoutingProcessor{}
+	if err := ProcessSegmentRouting(p, false, "PE1", key, ospf.Global.SegmentRouting); err != nil {
+		t.Errorf("ProcessSegmentRouting error. %s", err)
+	}
+
+	if v := fmt.Sprintf("%v", p.calls); v != "[srgb/16000-23999 config/true sid/10.0.0.1/32/1]" {
+		t.Errorf("ProcessSegmentRouting unmatch. %s", v)
+	}
+
+	p = &testSegmentRoutingProcessor{}
+	if err := ProcessSegmentRouting(p, true, "PE1", key, ospf.Global.SegmentRouting); err != nil {
+		t.Errorf("ProcessSegmentRouting error. %s", err)
+	}
+
+	if v := fmt.Sprintf("%v", p.calls); v != "[sid/10.0.0.1/32/1 config/true srgb/16000-23999]" {
+		t.Errorf("ProcessSegmentRouting unmatch. %s", v)
+	}
+}
+
+func TestSegmentRouting_invalid(t *testing.T) {
+	datas := [][2]string{
+		{"/ospfv2/global/segment-routing/config/enabled", "yes"},
+		{"/ospfv2/global/segment-routing/srgb/config/lower-bound", "15"},
+		{"/ospfv2/global/segment-routing/srlb/config/upper-bound", "1048576"},
+		{"/ospfv2/global/segment-routing/prefix-sids/prefix-sid[prefix='10.0.0.1/32']/config/prefix", "10.0.0.1"},
+		{"/ospfv2/global/segment-routing/prefix-sids/prefix-sid[prefix='10.0.0.1/32']/config/sid-id", "1048576"},
+		{"/ospfv2/global/segment-routing/prefix-sids/prefix-sid[prefix='10.0.0.1/32']/config/label-options", "boc-sr:PHP"},
+	}
+
+	for _, data := range datas {
+		if _, err := makeOspfv2([][2]string{data}); err == nil {
+			t.Errorf("ospfv2.Put must be error. %v", data)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"fmt"
+	ncxml "netconf/lib/xml"
+)
+
+//
+// Label options of prefix-SID
+//
+type SrLabelOptionType int
+
+const (
+	SR_LABEL_OPTION_TYPE SrLabelOptionType = iota
+	SR_LABEL_OPTION_NO_PHP
+	SR_LABEL_OPTION_EXPLICIT_NULL
+)
+
+var srLabelOptionTypeNames = map[SrLabelOptionType]string{
+	SR_LABEL_OPTION_TYPE:          "LABEL_OPTION_TYPE",
+	SR_LABEL_OPTION_NO_PHP:        "NO_PHP",
+	SR_LABEL_OPTION_EXPLICIT_NULL: "EXPLICIT_NULL",
+}
+
+var srLabelOptionTypeValues = map[string]SrLabelOptionType{
+	"LABEL_OPTION_TYPE": SR_LABEL_OPTION_TYPE,
+	"NO_PHP":            SR_LABEL_OPTION_NO_PHP,
+	"EXPLICIT_NULL":     SR_LABEL_OPTION_EXPLICIT_NULL,
+}
+
+func (v SrLabelOptionType) String() string {
+	if s, ok := srLabelOptionTypeNames[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("SrLabelOptionType(%d)", v)
+}
+
+func ParseSrLabelOptionType(s string) (SrLabelOptionType, error) {
+	_, ss := ncxml.ParseXPathName(s)
+	if v, ok := srLabelOptionTypeValues[ss]; ok {
+		return v, nil
+	}
+	return SR_LABEL_OPTION_TYPE, fmt.Errorf("Invalid SrLabelOptionType. %s", s)
+}
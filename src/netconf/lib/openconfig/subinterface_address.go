@@ -77,6 +77,7 @@ type IPAddress struct {
 
 	IP     string           `xml:"ip"`
 	Config *IPAddressConfig `xml:"config"`
+	Vrrp   VrrpGroups       `xml:"vrrp"`
 }
 
 func NewIPAddress(ip string) *IPAddress {
@@ -84,14 +85,16 @@ func NewIPAddress(ip string) *IPAddress {
 		SrChanges: nclib.NewSrChanges(),
 		IP:        ip,
 		Config:    NewIPAddressConfig(),
+		Vrrp:      NewVrrpGroups(),
 	}
 }
 
 func (a *IPAddress) String() string {
-	return fmt.Sprintf("%s{%s=%s, %s} '%s'",
+	return fmt.Sprintf("%s{%s=%s, %s, %s=%v} '%s'",
 		SUBINTERFACE_ADDR_KEY,
 		SUBINTERFACE_ADDR_IP_KEY, a.IP,
 		a.Config,
+		VRRP_KEY, a.Vrrp,
 		a.SrChanges,
 	)
 }
@@ -106,6 +109,11 @@ func (a *IPAddress) SetConfig(config *IPAddressConfig) {
 	a.SetChange(OC_CONFIG_KEY)
 }
 
+func (a *IPAddress) SetVrrp(vrrp VrrpGroups) {
+	a.Vrrp = vrrp
+	a.SetChange(VRRP_KEY)
+}
+
 func (a *IPAddress) Put(nodes []*ncxml.XPathNode, value string) error {
 	if len(nodes) == 0 {
 		return nil
@@ -119,6 +127,11 @@ func (a *IPAddress) Put(nodes []*ncxml.XPathNode, value string) error {
 		if err := a.Config.Put(nodes[1:], value); err != nil {
 			return err
 		}
+
+	case VRRP_KEY:
+		if err := a.Vrrp.Put(nodes[1:], value); err != nil {
+			return err
+		}
 	}
 
 	a.SetChange(nodes[0].Name)
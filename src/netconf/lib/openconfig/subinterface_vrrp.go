@@ -0,0 +1,244 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net"
+	nclib "netconf/lib"
+	ncxml "netconf/lib/xml"
+	"strconv"
+)
+
+const (
+	VRRP_KEY               = "vrrp"
+	VRRP_GROUP_KEY         = "vrrp-group"
+	VRRP_VRID_KEY          = "virtual-router-id"
+	VRRP_VADDR_KEY         = "virtual-address"
+	VRRP_PRIORITY_KEY      = "priority"
+	VRRP_PREEMPT_KEY       = "preempt"
+	VRRP_PREEMPT_DELAY_KEY = "preempt-delay"
+	VRRP_ACCEPT_MODE_KEY   = "accept-mode"
+	VRRP_ADV_INTERVAL_KEY  = "advertisement-interval"
+)
+
+const (
+	VRRP_PRIORITY_DEFAULT     = 100
+	VRRP_ADV_INTERVAL_DEFAULT = 100 // centiseconds
+)
+
+//
+// addresses/address[ip]/vrrp
+//
+type VrrpGroups map[uint8]*VrrpGroup
+
+func NewVrrpGroups() VrrpGroups {
+	return VrrpGroups{}
+}
+
+func (v VrrpGroups) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	vridStr, ok := nodes[0].Attrs[VRRP_VRID_KEY]
+	if !ok {
+		return fmt.Errorf("%s@%s not found. %s", VRRP_GROUP_KEY, VRRP_VRID_KEY, nodes[0])
+	}
+
+	vrid, err := strconv.ParseUint(vridStr, 0, 8)
+	if err != nil {
+		return err
+	}
+
+	group, ok := v[uint8(vrid)]
+	if !ok {
+		group = NewVrrpGroup(uint8(vrid))
+		v[uint8(vrid)] = group
+	}
+
+	return group.Put(nodes[1:], value)
+}
+
+func (v VrrpGroups) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = VRRP_KEY
+	e.EncodeToken(start)
+
+	for _, group := range v {
+		err := e.EncodeElement(group, xml.StartElement{Name: xml.Name{Local: VRRP_GROUP_KEY}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+//
+// addresses/address[ip]/vrrp/vrrp-group[virtual-router-id]
+//
+type VrrpGroup struct {
+	nclib.SrChanges `xml:"-"`
+
+	VirtualRouterId uint8            `xml:"virtual-router-id"`
+	Config          *VrrpGroupConfig `xml:"config"`
+}
+
+func NewVrrpGroup(vrid uint8) *VrrpGroup {
+	return &VrrpGroup{
+		SrChanges:       nclib.NewSrChanges(),
+		VirtualRouterId: vrid,
+		Config:          NewVrrpGroupConfig(),
+	}
+}
+
+func (v *VrrpGroup) String() string {
+	return fmt.Sprintf("%s{%s=%d, %s} %s",
+		VRRP_GROUP_KEY,
+		VRRP_VRID_KEY, v.VirtualRouterId,
+		v.Config,
+		v.SrChanges,
+	)
+}
+
+func (v *VrrpGroup) SetConfig(config *VrrpGroupConfig) {
+	v.Config = config
+	v.SetChange(OC_CONFIG_KEY)
+}
+
+func (v *VrrpGroup) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case VRRP_VRID_KEY:
+		// v.VirtualRouterId = value // set by NewVrrpGroup
+
+	case OC_CONFIG_KEY:
+		if err := v.Config.Put(nodes[1:], value); err != nil {
+			return err
+		}
+	}
+
+	v.SetChange(nodes[0].Name)
+	return nil
+}
+
+//
+// addresses/address[ip]/vrrp/vrrp-group[virtual-router-id]/config
+//
+type VrrpGroupConfig struct {
+	nclib.SrChanges `xml:"-"`
+
+	VirtualRouterId uint8    `xml:"virtual-router-id"`
+	VirtualAddrs    []net.IP `xml:"virtual-address"`
+	Priority        uint8    `xml:"priority"`
+	Preempt         bool     `xml:"preempt"`
+	PreemptDelay    uint16   `xml:"preempt-delay"`
+	AcceptMode      bool     `xml:"accept-mode"`
+	AdvInterval     uint16   `xml:"advertisement-interval"`
+}
+
+func NewVrrpGroupConfig() *VrrpGroupConfig {
+	return &VrrpGroupConfig{
+		SrChanges:       nclib.NewSrChanges(),
+		VirtualRouterId: 0,
+		VirtualAddrs:    []net.IP{},
+		Priority:        VRRP_PRIORITY_DEFAULT,
+		Preempt:         true,
+		PreemptDelay:    0,
+		AcceptMode:      false,
+		AdvInterval:     VRRP_ADV_INTERVAL_DEFAULT,
+	}
+}
+
+func (c *VrrpGroupConfig) String() string {
+	return fmt.Sprintf("%s{%s=%d, %s=%v, %s=%d, %s=%t, %s=%d, %s=%t, %s=%d} %s",
+		OC_CONFIG_KEY,
+		VRRP_VRID_KEY, c.VirtualRouterId,
+		VRRP_VADDR_KEY, c.VirtualAddrs,
+		VRRP_PRIORITY_KEY, c.Priority,
+		VRRP_PREEMPT_KEY, c.Preempt,
+		VRRP_PREEMPT_DELAY_KEY, c.PreemptDelay,
+		VRRP_ACCEPT_MODE_KEY, c.AcceptMode,
+		VRRP_ADV_INTERVAL_KEY, c.AdvInterval,
+		c.SrChanges,
+	)
+}
+
+func (c *VrrpGroupConfig) Put(nodes []*ncxml.XPathNode, value string) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	switch nodes[0].Name {
+	case VRRP_VRID_KEY:
+		vrid, err := strconv.ParseUint(value, 0, 8)
+		if err != nil {
+			return err
+		}
+		c.VirtualRouterId = uint8(vrid)
+
+	case VRRP_VADDR_KEY:
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return fmt.Errorf("Invalid IP. %s", value)
+		}
+		c.VirtualAddrs = append(c.VirtualAddrs, ip)
+
+	case VRRP_PRIORITY_KEY:
+		priority, err := strconv.ParseUint(value, 0, 8)
+		if err != nil {
+			return err
+		}
+		c.Priority = uint8(priority)
+
+	case VRRP_PREEMPT_KEY:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		c.Preempt = b
+
+	case VRRP_PREEMPT_DELAY_KEY:
+		delay, err := strconv.ParseUint(value, 0, 16)
+		if err != nil {
+			return err
+		}
+		c.PreemptDelay = uint16(delay)
+
+	case VRRP_ACCEPT_MODE_KEY:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		c.AcceptMode = b
+
+	case VRRP_ADV_INTERVAL_KEY:
+		interval, err := strconv.ParseUint(value, 0, 16)
+		if err != nil {
+			return err
+		}
+		c.AdvInterval = uint16(interval)
+	}
+
+	c.SetChange(nodes[0].Name)
+	return nil
+}
@@ -0,0 +1,128 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openconfig
+
+import (
+	srlib "netconf/lib/sysrepo"
+	"testing"
+)
+
+//
+// /addresses/address[ip='10.0.0.1']/vrrp/vrrp-group[virtual-router-id='10']
+//
+func TestVrrpGroup_default(t *testing.T) {
+	addrs := makeAddrs([][2]string{
+		{"/addresses/address[ip='10.0.0.1']/vrrp/vrrp-group[virtual-router-id='10']", ""},
+		{"/addresses/address[ip='10.0.0.1']/vrrp/vrrp-group[virtual-router-id='10']/config", ""},
+	})
+
+	t.Log(addrs)
+
+	addr := addrs["10.0.0.1"]
+
+	if v := addr.Compare(VRRP_KEY); !v {
+		t.Errorf("IPAddress.Put unmatch. cmp=%t", v)
+	}
+
+	group, ok := addr.Vrrp[10]
+	if !ok {
+		t.Fatalf("VrrpGroups.Put unmatch. %v", addr.Vrrp)
+	}
+
+	if v := group.VirtualRouterId; v != 10 {
+		t.Errorf("VrrpGroup.Put unmatch. vrid=%d", v)
+	}
+	if v := group.Config.Priority; v != VRRP_PRIORITY_DEFAULT {
+		t.Errorf("VrrpGroup.Put unmatch. priority=%d", v)
+	}
+	if v := group.Config.Preempt; !v {
+		t.Errorf("VrrpGroup.Put unmatch. preempt=%t", v)
+	}
+	if v := group.Config.AdvInterval; v != VRRP_ADV_INTERVAL_DEFAULT {
+		t.Errorf("VrrpGroup.Put unmatch. adv-interval=%d", v)
+	}
+	if v := len(group.Config.VirtualAddrs); v != 0 {
+		t.Errorf("VrrpGroup.Put unmatch. virtual-address=%d", v)
+	}
+}
+
+//
+// /addresses/address[ip='10.0.0.1']/vrrp/vrrp-group[virtual-router-id='10']/config/*
+//
+func TestVrrpGroup_config(t *testing.T) {
+	addrs := makeAddrs([][2]string{
+		{"/addresses/address[ip='10.0.0.1']/vrrp/vrrp-group[virtual-router-id='10']/config/virtual-router-id", "10"},
+		{"/addresses/address[ip='10.0.0.1']/vrrp/vrrp-group[virtual-router-id='10']/config/virtual-address", "10.0.0.254"},
+		{"/addresses/address[ip='10.0.0.1']/vrrp/vrrp-group[virtual-router-id='10']/config/virtual-address", "10.0.0.253"},
+		{"/addresses/address[ip='10.0.0.1']/vrrp/vrrp-group[virtual-router-id='10']/config/priority", "200"},
+		{"/addresses/address[ip='10.0.0.1']/vrrp/vrrp-group[virtual-router-id='10']/config/preempt", "false"},
+		{"/addresses/address[ip='10.0.0.1']/vrrp/vrrp-group[virtual-router-id='10']/config/advertisement-interval", "50"},
+	})
+
+	t.Log(addrs)
+
+	config := addrs["10.0.0.1"].Vrrp[10].Config
+
+	if v := config.Compare(VRRP_VRID_KEY, VRRP_VADDR_KEY, VRRP_PRIORITY_KEY, VRRP_PREEMPT_KEY, VRRP_ADV_INTERVAL_KEY); !v {
+		t.Errorf("VrrpGroupConfig.Put unmatch. cmp=%t", v)
+	}
+	if v := config.VirtualRouterId; v != 10 {
+		t.Errorf("VrrpGroupConfig.Put unmatch. vrid=%d", v)
+	}
+	if v := len(config.VirtualAddrs); v != 2 {
+		t.Fatalf("VrrpGroupConfig.Put unmatch. virtual-address=%v", config.VirtualAddrs)
+	}
+	if v := config.VirtualAddrs[0].String(); v != "10.0.0.254" {
+		t.Errorf("VrrpGroupConfig.Put unmatch. virtual-address=%s", v)
+	}
+	if v := config.VirtualAddrs[1].String(); v != "10.0.0.253" {
+		t.Errorf("VrrpGroupConfig.Put unmatch. virtual-address=%s", v)
+	}
+	if v := config.Priority; v != 200 {
+		t.Errorf("VrrpGroupConfig.Put unmatch. priority=%d", v)
+	}
+	if v := config.Preempt; v {
+		t.Errorf("VrrpGroupConfig.Put unmatch. preempt=%t", v)
+	}
+	if v := config.AdvInterval; v != 50 {
+		t.Errorf("VrrpGroupConfig.Put unmatch. adv-interval=%d", v)
+	}
+}
+
+//
+// /addresses/address[ip='10.0.0.1']/vrrp/vrrp-group
+// /addresses/address[ip='10.0.0.1']/vrrp/vrrp-group[virtual-router-id='10']/config/*
+//
+func TestVrrpGroup_err(t *testing.T) {
+	xpaths := [][2]string{
+		{"/addresses/address[ip='10.0.0.1']/vrrp/vrrp-group", ""},
+		{"/addresses/address[ip='10.0.0.1']/vrrp/vrrp-group[virtual-router-id='BAD_VRID']", ""},
+		{"/addresses/address[ip='10.0.0.1']/vrrp/vrrp-group[virtual-router-id='10']/config/virtual-address", "BAD_IP_ADDR"},
+		{"/addresses/address[ip='10.0.0.1']/vrrp/vrrp-group[virtual-router-id='10']/config/priority", "256"},
+		{"/addresses/address[ip='10.0.0.1']/vrrp/vrrp-group[virtual-router-id='10']/config/preempt", "BAD_BOOL"},
+		{"/addresses/address[ip='10.0.0.1']/vrrp/vrrp-group[virtual-router-id='10']/config/advertisement-interval", "BAD_INTERVAL"},
+	}
+
+	for _, xpath := range xpaths {
+		addrs := NewIPAddresses()
+		nodes := srlib.ParseXPath(xpath[0])
+		if err := addrs.Put(nodes[1:], xpath[1]); err == nil {
+			t.Errorf("addresses.Put must be error. %s", xpath)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vtylib
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+const (
+	BFD_PEER_STATUS_UP   = "up"
+	BFD_PEER_STATUS_DOWN = "down"
+)
+
+//
+// BfdPeerConfig is the addresses of the gobgp neighbors (address -> "yes")
+// which are torn down when the bfd peer of the same address goes down,
+// because gobgp has no bfd client.
+//
+type BfdPeerConfig map[string]string
+
+func NewBfdPeerConfig() BfdPeerConfig {
+	return BfdPeerConfig{}
+}
+
+func (c BfdPeerConfig) Set(addr string, value string) error {
+	c[addr] = value
+	return nil
+}
+
+func (c BfdPeerConfig) Get(f func(string) error) error {
+	addrs := []string{}
+	for addr, _ := range c {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+
+	for _, addr := range addrs {
+		line := fmt.Sprintf("%s=%s", addr, c[addr])
+		if err := f(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//
+// BfdPeerStatus is the entry of 'show bfd peers json'.
+//
+type BfdPeerStatus struct {
+	Peer      string `json:"peer"`
+	Interface string `json:"interface"`
+	Vrf       string `json:"vrf"`
+	Multihop  bool   `json:"multihop"`
+	Status    string `json:"status"`
+}
+
+//
+// ParseBfdPeers returns the status of the bfd peers (address -> status).
+//
+func ParseBfdPeers(data []byte) (map[string]string, error) {
+	peers := []*BfdPeerStatus{}
+	if err := json.Unmarshal(data, &peers); err != nil {
+		return nil, err
+	}
+
+	statuses := map[string]string{}
+	for _, peer := range peers {
+		statuses[peer.Peer] = peer.Status
+	}
+	return statuses, nil
+}
+
+//
+// BfdPeerTracker tracks the status of the bfd peers.
+// The neighbor is disabled only when the bfd peer goes down from up,
+// and is enabled when the bfd peer comes up again or is unconfigured.
+//
+type BfdPeerTracker struct {
+	statuses map[string]string
+	disabled map[string]struct{}
+}
+
+func NewBfdPeerTracker() *BfdPeerTracker {
+	return &BfdPeerTracker{
+		statuses: map[string]string{},
+		disabled: map[string]struct{}{},
+	}
+}
+
+func (t *BfdPeerTracker) Update(peers BfdPeerConfig, statuses map[string]string) ([]string, []string) {
+	downs := []string{}
+	ups := []string{}
+
+	for addr, _ := range peers {
+		status := statuses[addr]
+		_, disabled := t.disabled[addr]
+
+		switch {
+		case status == BFD_PEER_STATUS_DOWN && t.statuses[addr] == BFD_PEER_STATUS_UP && !disabled:
+			t.disabled[addr] = struct{}{}
+			downs = append(downs, addr)
+
+		case status == BFD_PEER_STATUS_UP && disabled:
+			delete(t.disabled, addr)
+			ups = append(ups, addr)
+		}
+
+		t.statuses[addr] = status
+	}
+
+	for addr, _ := range t.statuses {
+		if _, ok := peers[addr]; !ok {
+			delete(t.statuses, addr)
+		}
+	}
+
+	for addr, _ := range t.disabled {
+		if _, ok := peers[addr]; !ok {
+			delete(t.disabled, addr)
+			ups = append(ups, addr)
+		}
+	}
+
+	sort.Strings(downs)
+	sort.Strings(ups)
+	return downs, ups
+}
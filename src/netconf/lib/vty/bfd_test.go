@@ -0,0 +1,67 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package vtylib
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParseBfdPeers(t *testing.T) {
+	data := `[
+  {"multihop":false,"peer":"10.0.1.2","local":"10.0.1.1","vrf":"default","interface":"eth1","id":1,"remote-id":2,"status":"up","uptime":10},
+  {"multihop":true,"peer":"10.0.2.2","vrf":"default","id":3,"remote-id":0,"status":"down","downtime":5}
+]`
+
+	statuses, err := ParseBfdPeers([]byte(data))
+	if err != nil {
+		t.Errorf("ParseBfdPeers error. %s", err)
+	}
+
+	if v := fmt.Sprintf("%v", statuses); v != "map[10.0.1.2:up 10.0.2.2:down]" {
+		t.Errorf("ParseBfdPeers unmatch. %s", v)
+	}
+}
+
+func TestBfdPeerTracker(t *testing.T) {
+	peers := BfdPeerConfig{"10.0.1.2": "yes", "10.0.2.2": "yes"}
+	tracker := NewBfdPeerTracker()
+
+	check := func(statuses map[string]string, expDowns, expUps string) {
+		downs, ups := tracker.Update(peers, statuses)
+		if v := fmt.Sprintf("%v", downs); v != expDowns {
+			t.Errorf("BfdPeerTracker downs unmatch. %s", v)
+		}
+		if v := fmt.Sprintf("%v", ups); v != expUps {
+			t.Errorf("BfdPeerTracker ups unmatch. %s", v)
+		}
+	}
+
+	// not established yet.
+	check(map[string]string{"10.0.1.2": "down"}, "[]", "[]")
+	check(map[string]string{"10.0.1.2": "up", "10.0.2.2": "up"}, "[]", "[]")
+	// session down.
+	check(map[string]string{"10.0.1.2": "down", "10.0.2.2": "up"}, "[10.0.1.2]", "[]")
+	check(map[string]string{"10.0.1.2": "init", "10.0.2.2": "down"}, "[10.0.2.2]", "[]")
+	// session up.
+	check(map[string]string{"10.0.1.2": "up", "10.0.2.2": "down"}, "[]", "[10.0.1.2]")
+	// unconfigured while down.
+	delete(peers, "10.0.2.2")
+	check(map[string]string{"10.0.1.2": "up"}, "[]", "[10.0.2.2]")
+}
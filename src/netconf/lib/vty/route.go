@@ -0,0 +1,82 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vtylib
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+//
+// RouteDescConfig is the descriptions of the static routes (prefix -> description),
+// because frr has no description of static route.
+//
+type RouteDescConfig map[string]string
+
+func NewRouteDescConfig() RouteDescConfig {
+	return RouteDescConfig{}
+}
+
+func (d RouteDescConfig) Set(prefix string, desc string) error {
+	d[prefix] = desc
+	return nil
+}
+
+func (d RouteDescConfig) Get(f func(string) error) error {
+	prefixes := []string{}
+	for prefix, _ := range d {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+
+	for _, prefix := range prefixes {
+		line := fmt.Sprintf("%s=%s", prefix, d[prefix])
+		if err := f(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var staticRouteLineRe = regexp.MustCompile(`^(?:ip|ipv6) route (\S+)\s`)
+
+//
+// RenderRouteDescs inserts the description as the comment line
+// just before the first static route of the prefix in frr.conf.
+//
+func RenderRouteDescs(data []byte, descs RouteDescConfig) []byte {
+	buf := &bytes.Buffer{}
+	rendered := map[string]struct{}{}
+	for _, line := range strings.SplitAfter(string(data), "\n") {
+		if m := staticRouteLineRe.FindStringSubmatch(line); m != nil {
+			prefix := m[1]
+			if desc, ok := descs[prefix]; ok {
+				if _, ok := rendered[prefix]; !ok {
+					rendered[prefix] = struct{}{}
+					fmt.Fprintf(buf, "! description %s\n", strings.Replace(desc, "\n", " ", -1))
+				}
+			}
+		}
+
+		buf.WriteString(line)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,73 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vtylib
+
+import (
+	"bytes"
+	prop "netconf/lib/property"
+	"strings"
+	"testing"
+)
+
+func TestRouteDescConfig(t *testing.T) {
+	descs := NewRouteDescConfig()
+	if err := prop.Read(strings.NewReader("10.0.0.0/8=to core\n2001:db8::/32=v6 = default\n"), descs); err != nil {
+		t.Errorf("Read error. %s", err)
+	}
+
+	if v := descs["2001:db8::/32"]; v != "v6 = default" {
+		t.Errorf("RouteDescConfig unmatch. %s", v)
+	}
+
+	b := &bytes.Buffer{}
+	if err := prop.Write(b, descs); err != nil {
+		t.Errorf("Write error. %s", err)
+	}
+
+	if v := b.String(); v != "10.0.0.0/8=to core\n2001:db8::/32=v6 = default\n" {
+		t.Errorf("RouteDescConfig unmatch. %s", v)
+	}
+}
+
+func TestRenderRouteDescs(t *testing.T) {
+	conf := `!
+ip route 10.0.0.0/8 10.0.1.1 tag 10
+ip route 10.0.0.0/8 10.0.2.1
+ip route 20.0.0.0/8 10.0.1.1
+ipv6 route 2001:db8::/32 2001:db8:1::1
+!
+`
+	descs := RouteDescConfig{
+		"10.0.0.0/8":    "to core",
+		"2001:db8::/32": "v6",
+		"30.0.0.0/8":    "not found",
+	}
+
+	exp := `!
+! description to core
+ip route 10.0.0.0/8 10.0.1.1 tag 10
+ip route 10.0.0.0/8 10.0.2.1
+ip route 20.0.0.0/8 10.0.1.1
+! description v6
+ipv6 route 2001:db8::/32 2001:db8:1::1
+!
+`
+	if v := string(RenderRouteDescs([]byte(conf), descs)); v != exp {
+		t.Errorf("RenderRouteDescs unmatch. %s", v)
+	}
+}
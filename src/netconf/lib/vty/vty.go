@@ -70,6 +70,8 @@ const (
 	DAEMON_PIM
 	DAEMON_RIP
 	DAEMON_RIPNG
+	DAEMON_BFD
+	DAEMON_VRRP
 )
 
 var daemonTypeName = map[DaemonType]string{
@@ -84,6 +86,8 @@ var daemonTypeName = map[DaemonType]string{
 	DAEMON_PIM:   "pimd",
 	DAEMON_RIP:   "ripd",
 	DAEMON_RIPNG: "ripngd",
+	DAEMON_BFD:   "bfdd",
+	DAEMON_VRRP:  "vrrpd",
 }
 
 var daemonTypeVal = map[string]DaemonType{
@@ -98,6 +102,8 @@ var daemonTypeVal = map[string]DaemonType{
 	"pimd":   DAEMON_PIM,
 	"ripd":   DAEMON_RIP,
 	"ripngd": DAEMON_RIPNG,
+	"bfdd":   DAEMON_BFD,
+	"vrrpd":  DAEMON_VRRP,
 }
 
 func ParseDaemonType(s string) (DaemonType, error) {
@@ -0,0 +1,128 @@
+// -*- coding: utf-8 -*-
+
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ncxml
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+type XPathHandler interface {
+	Put([]*XPathNode, string) error
+}
+
+//
+// xpathElement is a decoded xml element.
+//
+type xpathElement struct {
+	Name     xml.Name
+	Text     string
+	Children []*xpathElement
+}
+
+func (e *xpathElement) IsLeaf() bool {
+	return len(e.Children) == 0
+}
+
+func (e *xpathElement) Value() string {
+	return strings.TrimSpace(e.Text)
+}
+
+//
+// Attrs returns values of leaf children.
+// Keys of list entry are always leaf children of the entry,
+// so xpath predicates (e.g. interface[name='eth1']) are rebuilt from them.
+//
+func (e *xpathElement) Attrs() map[string]string {
+	attrs := map[string]string{}
+	for _, child := range e.Children {
+		if child.IsLeaf() {
+			if _, ok := attrs[child.Name.Local]; !ok {
+				attrs[child.Name.Local] = child.Value()
+			}
+		}
+	}
+	return attrs
+}
+
+func decodeXPathElement(d *xml.Decoder, start xml.StartElement) (*xpathElement, error) {
+	elem := &xpathElement{
+		Name:     start.Name,
+		Children: []*xpathElement{},
+	}
+
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			child, err := decodeXPathElement(d, t)
+			if err != nil {
+				return nil, err
+			}
+			elem.Children = append(elem.Children, child)
+
+		case xml.CharData:
+			elem.Text += string(t)
+
+		case xml.EndElement:
+			return elem, nil
+		}
+	}
+}
+
+func putXPathElement(h XPathHandler, parents []*XPathNode, elem *xpathElement) error {
+	nodes := make([]*XPathNode, len(parents), len(parents)+1)
+	copy(nodes, parents)
+	nodes = append(nodes, NewXPathNode("", elem.Name.Local, elem.Attrs()))
+
+	if elem.IsLeaf() {
+		return h.Put(nodes, elem.Value())
+	}
+
+	for _, child := range elem.Children {
+		if err := putXPathElement(h, nodes, child); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//
+// DecodeXPath decodes the children of start and calls h.Put for each leaf
+// with the xpath nodes relative to start, as sysrepo change values are
+// dispatched to the top level container.
+//
+func DecodeXPath(d *xml.Decoder, start xml.StartElement, h XPathHandler) error {
+	root, err := decodeXPathElement(d, start)
+	if err != nil {
+		return err
+	}
+
+	for _, child := range root.Children {
+		if err := putXPathElement(h, []*XPathNode{}, child); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}